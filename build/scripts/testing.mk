@@ -234,12 +234,12 @@ test:
 test-unit: ## run golang unit tests
 	@echo "Running unit tests..."
 	@go list -f '{{.Dir}}/...' -m | xargs \
-		go test
+		go test -tags bls12381
 
 test-unit-cover: ## run golang unit tests with coverage
 	@echo "Running unit tests with coverage..."
 	@go list -f '{{.Dir}}/...' -m | xargs \
-		go test -race -coverprofile=test-unit-cover.txt 
+		go test -tags bls12381 -race -coverprofile=test-unit-cover.txt 
 
 test-unit-bench: ## run golang unit benchmarks
 	@echo "Running unit tests with benchmarks..."
@@ -255,6 +255,13 @@ test-unit-fuzz: ## run fuzz tests
 	go test ./mod/payload/pkg/cache/... -fuzz=FuzzPayloadIDCacheConcurrency -fuzztime=${SHORT_FUZZ_TIME}
 	go test -fuzz=FuzzHashTreeRoot ./mod/primitives/pkg/merkle -fuzztime=${MEDIUM_FUZZ_TIME}
 
+CONSENSUS_SPEC_TESTS_DIR ?= $(CURDIR)/.tmp/consensus-spec-tests/tests
+
+test-spec: ## run the consensus spec tests against the state transition
+	@echo "Running consensus spec tests from $(CONSENSUS_SPEC_TESTS_DIR)..."
+	@CONSENSUS_SPEC_TESTS_DIR=$(CONSENSUS_SPEC_TESTS_DIR) \
+		go test ./mod/state-transition/pkg/core/... -run TestSpec -v
+
 
 
 
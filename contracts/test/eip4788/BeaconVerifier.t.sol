@@ -7,6 +7,7 @@ import { Vm } from "forge-std/Vm.sol";
 
 import { SSZ } from "@src/eip4788/SSZ.sol";
 import { BeaconVerifier } from "@src/eip4788/BeaconVerifier.sol";
+import { Verifier } from "@src/eip4788/Verifier.sol";
 
 contract BeaconVerifierTest is Test {
     using stdJson for string;
@@ -34,11 +35,21 @@ contract BeaconVerifierTest is Test {
     uint256 constant DENEB_EXECUTION_NUMBER_GINDEX = 5894;
     uint256 constant DENEB_EXECUTION_FEE_RECIPIENT_GINDEX = 5889;
 
+    // From Electra the historical summaries move every field of the beacon
+    // state one level down.
+    uint256 constant ELECTRA_ZERO_VALIDATOR_PUBKEY_GINDEX =
+        6_350_779_162_034_176;
+    uint256 constant ELECTRA_EXECUTION_NUMBER_GINDEX = 11_526;
+    uint256 constant ELECTRA_EXECUTION_FEE_RECIPIENT_GINDEX = 11_521;
+
     uint64 timestamp = 31_337;
     BeaconVerifier public verifier;
     BlockProposerProofJson public blockProposerProofJson;
     CoinbaseProofJson public coinbaseProofJson;
     ExecutionNumberProofJson public executionNumberProofJson;
+    BlockProposerProofJson public electraBlockProposerProofJson;
+    CoinbaseProofJson public electraCoinbaseProofJson;
+    ExecutionNumberProofJson public electraExecutionNumberProofJson;
 
     function setUp() public {
         string memory root = vm.projectRoot();
@@ -65,6 +76,19 @@ contract BeaconVerifierTest is Test {
         executionNumberProofJson =
             abi.decode(executionNumberData, (ExecutionNumberProofJson));
 
+        electraBlockProposerProofJson = abi.decode(
+            _readFixture(root, "electra/block_proposer_proof.json"),
+            (BlockProposerProofJson)
+        );
+        electraCoinbaseProofJson = abi.decode(
+            _readFixture(root, "electra/coinbase_proof.json"),
+            (CoinbaseProofJson)
+        );
+        electraExecutionNumberProofJson = abi.decode(
+            _readFixture(root, "electra/execution_number_proof.json"),
+            (ExecutionNumberProofJson)
+        );
+
         verifier = new BeaconVerifier(
             DENEB_ZERO_VALIDATOR_PUBKEY_GINDEX,
             DENEB_EXECUTION_NUMBER_GINDEX,
@@ -114,4 +138,79 @@ contract BeaconVerifierTest is Test {
             executionNumberProofJson.executionNumberProof
         );
     }
+
+    function test_verifyElectraProofs() public {
+        _useElectraGIndices();
+
+        vm.mockCall(
+            verifier.BEACON_ROOTS(),
+            abi.encode(timestamp),
+            abi.encode(electraBlockProposerProofJson.beaconBlockRoot)
+        );
+        verifier.verifyBeaconBlockProposer(
+            timestamp,
+            electraBlockProposerProofJson.proposerIndex,
+            electraBlockProposerProofJson.proposerPubkey,
+            electraBlockProposerProofJson.proposerPubkeyProof
+        );
+
+        vm.mockCall(
+            verifier.BEACON_ROOTS(),
+            abi.encode(timestamp),
+            abi.encode(electraCoinbaseProofJson.beaconBlockRoot)
+        );
+        verifier.verifyCoinbase(
+            timestamp,
+            electraCoinbaseProofJson.coinbase,
+            electraCoinbaseProofJson.coinbaseProof
+        );
+
+        vm.mockCall(
+            verifier.BEACON_ROOTS(),
+            abi.encode(timestamp),
+            abi.encode(electraExecutionNumberProofJson.beaconBlockRoot)
+        );
+        verifier.verifyExecutionNumber(
+            timestamp,
+            electraExecutionNumberProofJson.executionNumber,
+            electraExecutionNumberProofJson.executionNumberProof
+        );
+    }
+
+    function test_verifyElectraProofsAtDenebGIndices() public {
+        vm.mockCall(
+            verifier.BEACON_ROOTS(),
+            abi.encode(timestamp),
+            abi.encode(electraExecutionNumberProofJson.beaconBlockRoot)
+        );
+        vm.expectRevert(Verifier.InvalidProof.selector);
+        verifier.verifyExecutionNumber(
+            timestamp,
+            electraExecutionNumberProofJson.executionNumber,
+            electraExecutionNumberProofJson.executionNumberProof
+        );
+    }
+
+    function _useElectraGIndices() internal {
+        verifier.setZeroValidatorPubkeyGIndex(
+            ELECTRA_ZERO_VALIDATOR_PUBKEY_GINDEX
+        );
+        verifier.setExecutionNumberGIndex(ELECTRA_EXECUTION_NUMBER_GINDEX);
+        verifier.setExecutionFeeRecipientGIndex(
+            ELECTRA_EXECUTION_FEE_RECIPIENT_GINDEX
+        );
+    }
+
+    function _readFixture(
+        string memory root,
+        string memory name
+    )
+        internal
+        view
+        returns (bytes memory)
+    {
+        string memory path =
+            string.concat(root, "/test/eip4788/fixtures/", name);
+        return vm.readFile(path).parseRaw("$");
+    }
 }
@@ -22,6 +22,8 @@ package broker
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"time"
 )
 
@@ -29,21 +31,26 @@ import (
 type Broker[T any] struct {
 	// name of the message broker.
 	name string
+	// mu protects clients.
+	mu sync.RWMutex
 	// clients is a map of registered clients.
-	clients map[chan T]struct{}
-	// msgs is the channel for publishing new messages.
-	msgs chan T
-	// timeout is the timeout for sending a msg to a client.
-	timeout time.Duration
+	clients map[chan T]*client[T]
+	// metrics reports the deliveries and drops of the broker.
+	metrics *brokerMetrics
 }
 
 // New creates a new b.
-func New[T any](name string) *Broker[T] {
+func New[T any](name string, opts ...Option) *Broker[T] {
+	o := &options{
+		sink: noopSink{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
 	return &Broker[T]{
-		clients: make(map[chan T]struct{}),
-		msgs:    make(chan T, defaultBufferSize),
-		timeout: defaultTimeout,
+		clients: make(map[chan T]*client[T]),
 		name:    name,
+		metrics: newBrokerMetrics(name, o.sink),
 	}
 }
 
@@ -52,59 +59,201 @@ func (b *Broker[T]) Name() string {
 	return b.name
 }
 
-// Start starts the broker loop.
+// Start starts the broker, which unsubscribes all of its clients once the
+// context is done.
 func (b *Broker[T]) Start(ctx context.Context) error {
-	go b.start(ctx)
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		clients := b.clients
+		b.clients = make(map[chan T]*client[T])
+		b.mu.Unlock()
+		for _, c := range clients {
+			c.close()
+		}
+	}()
 	return nil
 }
 
-// start starts the broker loop.
-func (b *Broker[T]) start(ctx context.Context) {
-	for {
-		select {
-		case <-ctx.Done():
-			// close all leftover clients and break the broker loop
-			for client := range b.clients {
-				b.Unsubscribe(client)
-			}
-			return
-		case msg := <-b.msgs:
-			// broadcast published msg to all clients
-			for client := range b.clients {
-				// send msg to client (or discard msg after timeout)
-				select {
-				case client <- msg:
-				case <-time.After(b.timeout):
-				}
-			}
+// Publish delivers the msg to every client concurrently, applying the
+// backpressure policy of each client. It returns the joined errors of the
+// clients the msg could not be delivered to, that is ErrClientFull for the
+// full clients with the PolicyError policy and the error of ctx for the
+// clients with the PolicyBlock policy still full once ctx is done.
+func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
+	defer b.metrics.measurePublishLatency(time.Now())
+
+	// Take a snapshot so that slow clients do not hold the lock, clients
+	// unsubscribing in the meantime are skipped by deliver.
+	b.mu.RLock()
+	clients := make([]*client[T], 0, len(b.clients))
+	for _, c := range b.clients {
+		clients = append(clients, c)
+	}
+	b.mu.RUnlock()
+
+	// Only the clients without room for the msg are waited on, each in its
+	// own goroutine.
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(clients))
+	)
+	for i, c := range clients {
+		if b.tryDeliver(c, msg) {
+			continue
 		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = b.deliver(ctx, c, msg)
+		}()
 	}
+	wg.Wait()
+	return errors.Join(errs...)
 }
 
-// Publish publishes a msg to the b.
-// Returns ErrTimeout on timeout.
-func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
+// Subscribe registers a new client to the broker and returns it to the
+// caller. The client has a buffer of defaultBufferSize msgs and uses the
+// PolicyBlock policy.
+func (b *Broker[T]) Subscribe() (chan T, error) {
+	return b.SubscribeWithOptions()
+}
+
+// SubscribeWithOptions registers a new client configured by the options to
+// the broker and returns it to the caller.
+func (b *Broker[T]) SubscribeWithOptions(
+	opts ...SubscriptionOption,
+) (chan T, error) {
+	o := &subscriptionOptions{
+		bufferSize: defaultBufferSize,
+		policy:     PolicyBlock,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.bufferSize < 0 {
+		return nil, ErrInvalidBufferSize
+	}
+
+	c := newClient[T](o.bufferSize, o.policy)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clients[c.ch] = c
+	return c.ch, nil
+}
+
+// Unsubscribe removes a client from the b and closes its channel.
+func (b *Broker[T]) Unsubscribe(ch chan T) {
+	b.mu.Lock()
+	c, ok := b.clients[ch]
+	delete(b.clients, ch)
+	b.mu.Unlock()
+	if ok {
+		c.close()
+	}
+}
+
+// tryDeliver sends the msg to the client if there is room in its buffer,
+// it returns false if the client is full.
+func (b *Broker[T]) tryDeliver(c *client[T], msg T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed {
+		return true
+	}
+
 	select {
-	case b.msgs <- msg:
+	case c.ch <- msg:
+		b.metrics.markDelivered(c.policy)
+		return true
+	default:
+		return false
+	}
+}
+
+// deliver sends the msg to a full client according to its policy.
+func (b *Broker[T]) deliver(
+	ctx context.Context, c *client[T], msg T,
+) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed {
 		return nil
-	case <-ctx.Done():
-		return ctx.Err()
+	}
+
+	switch c.policy {
+	case PolicyDropOldest:
+		// Make room by dropping the oldest msg, another publisher may take
+		// the freed slot in which case the msg itself is dropped.
+		select {
+		case <-c.ch:
+			b.metrics.markDropped(c.policy, dropReasonFull)
+		default:
+		}
+		select {
+		case c.ch <- msg:
+			b.metrics.markDelivered(c.policy)
+		default:
+			b.metrics.markDropped(c.policy, dropReasonFull)
+		}
+		return nil
+	case PolicyError:
+		// Another publisher may have been delayed, retry once without
+		// blocking before failing the publish.
+		select {
+		case c.ch <- msg:
+			b.metrics.markDelivered(c.policy)
+			return nil
+		default:
+			b.metrics.markDropped(c.policy, dropReasonFull)
+			return ErrClientFull
+		}
+	default:
+		// The msg is never dropped, the publisher waits until the client
+		// has room for it or unsubscribes.
+		select {
+		case c.ch <- msg:
+			b.metrics.markDelivered(c.policy)
+			return nil
+		case <-c.done:
+			return nil
+		case <-ctx.Done():
+			b.metrics.markDropped(c.policy, dropReasonCanceled)
+			return ctx.Err()
+		}
 	}
 }
 
-// Subscribe registers a new client to the broker and returns it to the caller.
-// Returns ErrTimeout on timeout.
-func (b *Broker[T]) Subscribe() (chan T, error) {
-	client := make(chan T)
-	b.clients[client] = struct{}{}
-	return client, nil
+// client is a subscriber of the broker.
+type client[T any] struct {
+	// ch is the channel the msgs are delivered on.
+	ch chan T
+	// policy is the backpressure policy applied when ch is full.
+	policy Policy
+	// done is closed when the client unsubscribes, unblocking publishers
+	// waiting on it.
+	done chan struct{}
+	// mu guards closing ch against in-flight deliveries.
+	mu sync.RWMutex
+	// closed is true once ch has been closed.
+	closed bool
+}
+
+// newClient creates a new client with the given buffer size and policy.
+func newClient[T any](bufferSize int, policy Policy) *client[T] {
+	return &client[T]{
+		ch:     make(chan T, bufferSize),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
 }
 
-// Unsubscribe removes a client from the b.
-// Returns ErrTimeout on timeout.
-func (b *Broker[T]) Unsubscribe(client chan T) {
-	// Remove the client from the broker
-	delete(b.clients, client)
-	// close the client channel
-	close(client)
+// close closes the channel of the client once no delivery is in flight.
+func (c *client[T]) close() {
+	// Unblock the publishers first, they hold the read lock.
+	close(c.done)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	close(c.ch)
 }
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_PolicyBlock(t *testing.T) {
+	sink := &testSink{counters: make(map[string]int)}
+	b := broker.New[int]("test", broker.WithTelemetrySink(sink))
+	ch, err := b.SubscribeWithOptions(broker.WithBufferSize(1))
+	require.NoError(t, err)
+
+	// A full client is waited on for as long as it takes, the msg is never
+	// dropped.
+	require.NoError(t, b.Publish(context.Background(), 1))
+	published := make(chan error)
+	go func() { published <- b.Publish(context.Background(), 2) }()
+	select {
+	case err = <-published:
+		t.Fatalf("publish returned %v while the client is full", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	require.Equal(t, 1, <-ch)
+	require.NoError(t, <-published)
+	require.Equal(t, 2, <-ch)
+	require.Zero(t, sink.get("beacon_kit.async.broker.dropped"))
+
+	// The publisher giving up fails the publish.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, b.Publish(ctx, 3))
+	require.ErrorIs(t, b.Publish(ctx, 4), context.Canceled)
+	require.Equal(t, 3, <-ch)
+	require.Equal(t, 1, sink.get("beacon_kit.async.broker.dropped"))
+}
+
+func TestBroker_PolicyBlockConcurrent(t *testing.T) {
+	b := broker.New[int]("test")
+	slow := make([]chan int, 4)
+	for i := range slow {
+		var err error
+		slow[i], err = b.SubscribeWithOptions(broker.WithBufferSize(0))
+		require.NoError(t, err)
+	}
+	fast, err := b.Subscribe()
+	require.NoError(t, err)
+
+	// The slow clients are waited on concurrently, so they can be read from
+	// in any order.
+	published := make(chan error)
+	go func() { published <- b.Publish(context.Background(), 1) }()
+	for i := len(slow) - 1; i >= 0; i-- {
+		require.Equal(t, 1, <-slow[i])
+	}
+	require.NoError(t, <-published)
+	require.Equal(t, 1, <-fast)
+}
+
+func TestBroker_PolicyBlockUnsubscribe(t *testing.T) {
+	b := broker.New[int]("test")
+	ch, err := b.SubscribeWithOptions(broker.WithBufferSize(0))
+	require.NoError(t, err)
+
+	// The publish is unblocked as soon as the client unsubscribes.
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		b.Unsubscribe(ch)
+	}()
+	require.NoError(t, b.Publish(context.Background(), 1))
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestBroker_PolicyDropOldest(t *testing.T) {
+	sink := &testSink{counters: make(map[string]int)}
+	b := broker.New[int]("test", broker.WithTelemetrySink(sink))
+	ch, err := b.SubscribeWithOptions(
+		broker.WithBufferSize(2),
+		broker.WithPolicy(broker.PolicyDropOldest),
+	)
+	require.NoError(t, err)
+
+	for i := range 4 {
+		require.NoError(t, b.Publish(context.Background(), i))
+	}
+	require.Equal(t, 2, <-ch)
+	require.Equal(t, 3, <-ch)
+	require.Equal(t, 4, sink.get("beacon_kit.async.broker.delivered"))
+	require.Equal(t, 2, sink.get("beacon_kit.async.broker.dropped"))
+	require.Equal(t, 4, sink.get("beacon_kit.async.broker.publish_duration"))
+}
+
+func TestBroker_PolicyError(t *testing.T) {
+	sink := &testSink{counters: make(map[string]int)}
+	b := broker.New[int]("test", broker.WithTelemetrySink(sink))
+	full, err := b.SubscribeWithOptions(
+		broker.WithBufferSize(1),
+		broker.WithPolicy(broker.PolicyError),
+	)
+	require.NoError(t, err)
+	other, err := b.Subscribe()
+	require.NoError(t, err)
+
+	require.NoError(t, b.Publish(context.Background(), 1))
+	require.ErrorIs(
+		t, b.Publish(context.Background(), 2), broker.ErrClientFull,
+	)
+	require.Equal(t, 1, sink.get("beacon_kit.async.broker.dropped"))
+
+	// The msg is still delivered to the clients with room for it.
+	require.Equal(t, 1, <-full)
+	require.Equal(t, 1, <-other)
+	require.Equal(t, 2, <-other)
+}
+
+func TestBroker_InvalidBufferSize(t *testing.T) {
+	b := broker.New[int]("test")
+	_, err := b.SubscribeWithOptions(broker.WithBufferSize(-1))
+	require.ErrorIs(t, err, broker.ErrInvalidBufferSize)
+}
+
+func TestBroker_Start(t *testing.T) {
+	b := broker.New[int]("test")
+	ch, err := b.Subscribe()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, b.Start(ctx))
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("client not closed on shutdown")
+	}
+}
+
+func TestBroker_Concurrent(t *testing.T) {
+	b := broker.New[int]("test")
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				_ = b.Publish(context.Background(), i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				ch, err := b.SubscribeWithOptions(
+					broker.WithPolicy(broker.PolicyDropOldest),
+				)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				b.Unsubscribe(ch)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestReplies(t *testing.T) {
+	replies := broker.NewReplies[reply]()
+	id := types.NewCorrelationID()
+	ch, cancel := replies.Expect(id)
+
+	// Replies to other requests are stale.
+	require.False(t, replies.Deliver(reply{id: types.NewCorrelationID()}))
+	require.True(t, replies.Deliver(reply{id: id, data: 1}))
+	require.Equal(t, 1, (<-ch).data)
+
+	// A reply is delivered once, and never after the caller stopped
+	// waiting.
+	require.False(t, replies.Deliver(reply{id: id}))
+	_, cancel = replies.Expect(id)
+	cancel()
+	require.False(t, replies.Deliver(reply{id: id}))
+}
+
+type reply struct {
+	id   types.CorrelationID
+	data int
+}
+
+func (r reply) CorrelationID() types.CorrelationID {
+	return r.id
+}
+
+type testSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *testSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+}
+
+func (s *testSink) MeasureSince(key string, _ time.Time, _ ...string) {
+	s.IncrementCounter(key)
+}
+
+func (s *testSink) get(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key]
+}
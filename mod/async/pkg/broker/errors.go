@@ -24,5 +24,12 @@ import (
 	"errors"
 )
 
-// ErrTimeout is the error returned when a broker operation timed out.
-var ErrTimeout = errors.New("timeout")
+var (
+	// ErrClientFull is returned when a msg could not be delivered to a
+	// client with the PolicyError policy because its buffer is full.
+	ErrClientFull = errors.New("client buffer is full")
+
+	// ErrInvalidBufferSize is returned when subscribing with a negative
+	// buffer size.
+	ErrInvalidBufferSize = errors.New("invalid buffer size")
+)
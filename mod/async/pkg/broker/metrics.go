@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import "time"
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the
+	// provided keys.
+	IncrementCounter(key string, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// noopSink is the TelemetrySink used when none is provided.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// Reasons a msg is dropped for a client.
+const (
+	// dropReasonFull is reported when the buffer of the client is full.
+	dropReasonFull = "full"
+	// dropReasonCanceled is reported when the publisher gave up waiting on a
+	// PolicyBlock client.
+	dropReasonCanceled = "canceled"
+)
+
+// brokerMetrics is a struct that contains metrics for the broker.
+type brokerMetrics struct {
+	// name is the name of the broker.
+	name string
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newBrokerMetrics creates a new brokerMetrics.
+func newBrokerMetrics(name string, sink TelemetrySink) *brokerMetrics {
+	return &brokerMetrics{
+		name: name,
+		sink: sink,
+	}
+}
+
+// markDelivered increments the counter of msgs delivered to a client.
+func (bm *brokerMetrics) markDelivered(policy Policy) {
+	bm.sink.IncrementCounter(
+		"beacon_kit.async.broker.delivered",
+		"broker", bm.name,
+		"policy", policy.String(),
+	)
+}
+
+// markDropped increments the counter of msgs dropped for a client.
+func (bm *brokerMetrics) markDropped(policy Policy, reason string) {
+	bm.sink.IncrementCounter(
+		"beacon_kit.async.broker.dropped",
+		"broker", bm.name,
+		"policy", policy.String(),
+		"reason", reason,
+	)
+}
+
+// measurePublishLatency measures the time taken to deliver a msg to all
+// the clients.
+func (bm *brokerMetrics) measurePublishLatency(start time.Time) {
+	bm.sink.MeasureSince(
+		"beacon_kit.async.broker.publish_duration", start,
+		"broker", bm.name,
+	)
+}
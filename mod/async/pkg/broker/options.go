@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+// Policy is the backpressure policy applied when the buffer of a client is
+// full.
+type Policy uint8
+
+const (
+	// PolicyBlock waits for room in the buffer, so that no msg is ever
+	// dropped, for as long as the publisher does.
+	PolicyBlock Policy = iota
+	// PolicyDropOldest drops the oldest msg in the buffer to make room for
+	// the new one.
+	PolicyDropOldest
+	// PolicyError drops the msg and fails the publish right away.
+	PolicyError
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case PolicyBlock:
+		return "block"
+	case PolicyDropOldest:
+		return "drop-oldest"
+	case PolicyError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Option configures a Broker.
+type Option func(*options)
+
+// options are the settings of a Broker.
+type options struct {
+	sink TelemetrySink
+}
+
+// WithTelemetrySink sets the sink the broker reports its metrics to.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.sink = sink
+	}
+}
+
+// SubscriptionOption configures a client of a Broker.
+type SubscriptionOption func(*subscriptionOptions)
+
+// subscriptionOptions are the settings of a client.
+type subscriptionOptions struct {
+	bufferSize int
+	policy     Policy
+}
+
+// WithBufferSize sets the number of msgs buffered for the client.
+func WithBufferSize(size int) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.bufferSize = size
+	}
+}
+
+// WithPolicy sets the backpressure policy of the client.
+func WithPolicy(policy Policy) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.policy = policy
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Correlated is an event belonging to a request.
+type Correlated interface {
+	// CorrelationID returns the CorrelationID of the request.
+	CorrelationID() types.CorrelationID
+}
+
+// Replies routes reply events to the request awaiting them, keyed by the
+// CorrelationID of the request. Replies nobody awaits (e.g. to a request
+// that timed out) are stale and get dropped.
+type Replies[T Correlated] struct {
+	// mu protects pending.
+	mu sync.Mutex
+	// pending maps the requests in flight to their reply channel.
+	pending map[types.CorrelationID]chan T
+}
+
+// NewReplies creates a new Replies.
+func NewReplies[T Correlated]() *Replies[T] {
+	return &Replies[T]{
+		pending: make(map[types.CorrelationID]chan T),
+	}
+}
+
+// Expect registers a request awaiting a reply. It must be called before the
+// request is published, and the returned function called once the caller
+// stops waiting.
+func (r *Replies[T]) Expect(id types.CorrelationID) (<-chan T, func()) {
+	ch := make(chan T, 1)
+	r.mu.Lock()
+	r.pending[id] = ch
+	r.mu.Unlock()
+	return ch, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.pending[id] == ch {
+			delete(r.pending, id)
+		}
+	}
+}
+
+// Deliver hands the reply to the request awaiting it. It returns false if
+// the reply is stale.
+func (r *Replies[T]) Deliver(reply T) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ch, ok := r.pending[reply.CorrelationID()]
+	if !ok {
+		return false
+	}
+	delete(r.pending, reply.CorrelationID())
+	ch <- reply
+	return true
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// CorrelationID ties the events replying to a request to the event of the
+// request. The zero value means the event is not part of a request.
+type CorrelationID uint64
+
+// correlationIDKey is the context key under which the CorrelationID of a
+// request is stored.
+type correlationIDKey struct{}
+
+// lastCorrelationID is the last CorrelationID handed out.
+//
+//nolint:gochecknoglobals // process wide counter.
+var lastCorrelationID atomic.Uint64
+
+// NewCorrelationID returns a new, process unique, CorrelationID.
+func NewCorrelationID() CorrelationID {
+	return CorrelationID(lastCorrelationID.Add(1))
+}
+
+// WithCorrelationID returns a copy of the context carrying the given
+// CorrelationID. Since services reply to an event with the context of that
+// event, the replies carry the CorrelationID of the request.
+func WithCorrelationID(
+	ctx context.Context, id CorrelationID,
+) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the CorrelationID carried by the
+// context, or zero if there is none.
+func CorrelationIDFromContext(ctx context.Context) CorrelationID {
+	if ctx == nil {
+		return 0
+	}
+	id, _ := ctx.Value(correlationIDKey{}).(CorrelationID)
+	return id
+}
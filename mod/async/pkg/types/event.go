@@ -74,6 +74,12 @@ func (e Event[DataT]) Error() error {
 	return e.err
 }
 
+// CorrelationID returns the CorrelationID of the request the event belongs
+// to, or zero if it is not part of a request.
+func (e Event[DataT]) CorrelationID() CorrelationID {
+	return CorrelationIDFromContext(e.ctx)
+}
+
 // Is returns true if the event has the given type.
 func (e Event[DataT]) Is(eventType EventID) bool {
 	return e.eventType == eventType
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backfill
+
+const (
+	// defaultBatchSize is the default number of payloads sent to the
+	// execution client before its forkchoice is updated.
+	defaultBatchSize = 64
+	// maxBatchSize is the maximum number of payload bodies execution clients
+	// are required to serve per request.
+	maxBatchSize = 1024
+)
+
+// Config is the configuration for the backfill of the execution client.
+type Config struct {
+	// Enabled enables the backfill of the execution client from the payloads
+	// of the stored beacon blocks on startup.
+	Enabled bool `mapstructure:"enabled"`
+	// BatchSize is the number of payloads sent to the execution client before
+	// its forkchoice is updated, at most 1024.
+	BatchSize uint64 `mapstructure:"batch-size"`
+}
+
+// DefaultConfig returns the default configuration for the backfill of the
+// execution client.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		BatchSize: defaultBatchSize,
+	}
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package backfill replays the payloads of the stored beacon blocks into the
+// execution client, so that a fresh execution client can sync from the
+// history of the beacon node rather than from its peers.
+package backfill
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Service backfills the execution client on startup, from the block after its
+// head up to the latest stored beacon block, in batches of payloads that are
+// each followed by a forkchoice update to the last payload of the batch.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BlockStoreT BlockStore[SignedBeaconBlockT],
+	ExecutionEngineT ExecutionEngine[
+		ExecutionPayloadT, PayloadAttributesT, WithdrawalsT,
+	],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT, WithdrawalsT],
+	PayloadAttributesT any,
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT],
+	WithdrawalsT Withdrawals,
+] struct {
+	// config is the configuration for the backfill.
+	config Config
+	// logger is used for logging information and errors.
+	logger log.Logger[any]
+	// store is the store of the beacon blocks to backfill from.
+	store BlockStoreT
+	// engine is the execution engine to backfill.
+	engine ExecutionEngineT
+}
+
+// NewService creates a new backfill service.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BlockStoreT BlockStore[SignedBeaconBlockT],
+	ExecutionEngineT ExecutionEngine[
+		ExecutionPayloadT, PayloadAttributesT, WithdrawalsT,
+	],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT, WithdrawalsT],
+	PayloadAttributesT any,
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT],
+	WithdrawalsT Withdrawals,
+](
+	config Config,
+	logger log.Logger[any],
+	store BlockStoreT,
+	engine ExecutionEngineT,
+) *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockStoreT, ExecutionEngineT,
+	ExecutionPayloadT, PayloadAttributesT, SignedBeaconBlockT, WithdrawalsT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, BlockStoreT, ExecutionEngineT,
+		ExecutionPayloadT, PayloadAttributesT, SignedBeaconBlockT,
+		WithdrawalsT,
+	]{
+		config: config,
+		logger: logger,
+		store:  store,
+		engine: engine,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _, _, _, _, _]) Name() string {
+	return "execution-backfill"
+}
+
+// Start starts the backfill of the execution client in the background.
+func (s *Service[_, _, _, _, _, _, _, _]) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	if s.config.BatchSize == 0 || s.config.BatchSize > maxBatchSize {
+		return ErrInvalidBatchSize
+	}
+	go func() {
+		if err := s.backfill(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Error("Failed to backfill execution client", "error", err)
+		}
+	}()
+	return nil
+}
+
+// backfill replays the stored payloads after the head of the execution
+// client, until the next payload is not in the store.
+func (s *Service[_, _, _, _, _, _, _, _]) backfill(ctx context.Context) error {
+	head, err := s.engine.HeadBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := head + 1
+	s.logger.Info("Starting execution client backfill", "from", next)
+	for ctx.Err() == nil {
+		// Skip the payloads the execution client already has, in case it
+		// has imported payloads past its head.
+		bodies, bodiesErr := s.engine.PayloadBodiesByRange(
+			ctx, next, s.config.BatchSize,
+		)
+		if bodiesErr != nil {
+			return bodiesErr
+		}
+		for _, body := range bodies {
+			if body == nil {
+				break
+			}
+			next++
+		}
+
+		replayed, replayErr := s.replayBatch(ctx, next)
+		if replayErr != nil {
+			return replayErr
+		}
+		if replayed == 0 {
+			s.logger.Info(
+				"Finished execution client backfill",
+				"backfilled", (next - head - 1).Unwrap(),
+				"next", next,
+			)
+			return nil
+		}
+		next += math.U64(replayed)
+		s.logger.Info("Backfilled execution client", "head", next-1)
+	}
+	return ctx.Err()
+}
+
+// replayBatch sends the stored payloads from the given number to the
+// execution client, up to the batch size, and updates its forkchoice to the
+// last of them. It returns the number of payloads sent.
+func (s *Service[
+	_, _, _, _, ExecutionPayloadT, PayloadAttributesT, _, WithdrawalsT,
+]) replayBatch(ctx context.Context, start math.U64) (uint64, error) {
+	var (
+		last     ExecutionPayloadT
+		replayed uint64
+	)
+	for ; replayed < s.config.BatchSize; replayed++ {
+		slot, err := s.store.GetSlotByExecutionNumber(
+			start + math.U64(replayed),
+		)
+		if err != nil {
+			// The payload is yet to be stored, or has been pruned.
+			break
+		}
+		signed, err := s.store.Get(slot)
+		if err != nil {
+			return replayed, err
+		}
+		if last, err = s.replay(ctx, signed.GetMessage()); err != nil {
+			return replayed, err
+		}
+	}
+	if replayed == 0 {
+		return 0, nil
+	}
+
+	hash := last.GetBlockHash()
+	_, _, err := s.engine.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequestNoAttrs[PayloadAttributesT](
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      hash,
+				SafeBlockHash:      hash,
+				FinalizedBlockHash: hash,
+			},
+			last.Version(),
+		),
+	)
+	return replayed, err
+}
+
+// replay sends the payload of the given beacon block to the execution client
+// and returns it.
+func (s *Service[
+	BeaconBlockT, _, _, _, ExecutionPayloadT, _, _, WithdrawalsT,
+]) replay(ctx context.Context, blk BeaconBlockT) (ExecutionPayloadT, error) {
+	var (
+		body       = blk.GetBody()
+		payload    = body.GetExecutionPayload()
+		parentRoot = blk.GetParentBlockRoot()
+		err        error
+	)
+	req := &engineprimitives.NewPayloadRequest[
+		ExecutionPayloadT, WithdrawalsT,
+	]{
+		ExecutionPayload:      payload,
+		VersionedHashes:       body.GetBlobKzgCommitments().ToVersionedHashes(),
+		ParentBeaconBlockRoot: &parentRoot,
+		ForkVersion:           blk.Version(),
+	}
+	if requests := body.GetExecutionRequests(); requests != nil {
+		if req.ExecutionRequests, err = requests.Encode(); err != nil {
+			return payload, err
+		}
+	}
+	return payload, s.engine.VerifyAndNotifyNewPayload(ctx, req)
+}
@@ -0,0 +1,380 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestBackfillBatches(t *testing.T) {
+	engine := newTestEngine(0)
+	s := newTestService(2, newTestStore(1, 5), engine)
+
+	if err := s.backfill(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assertCalls(t, engine, []string{
+		"newPayload 1", "newPayload 2", "forkchoice 2",
+		"newPayload 3", "newPayload 4", "forkchoice 4",
+		"newPayload 5", "forkchoice 5",
+	})
+}
+
+func TestBackfillSkipsKnownPayloads(t *testing.T) {
+	// The execution client has imported the payloads up to 3, but its head
+	// is still at 1.
+	engine := newTestEngine(1, 2, 3)
+	s := newTestService(4, newTestStore(1, 5), engine)
+
+	if err := s.backfill(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assertCalls(t, engine, []string{
+		"newPayload 4", "newPayload 5", "forkchoice 5",
+	})
+}
+
+func TestBackfillStopsAtPrunedBlocks(t *testing.T) {
+	// The blocks with the payloads 4 and 5 have been pruned from the store.
+	store := newTestStore(1, 7)
+	store.prune(4, 5)
+	engine := newTestEngine(0)
+	s := newTestService(2, store, engine)
+
+	if err := s.backfill(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assertCalls(t, engine, []string{
+		"newPayload 1", "newPayload 2", "forkchoice 2",
+		"newPayload 3", "forkchoice 3",
+	})
+
+	// Without the block after the head of the execution client, nothing is
+	// backfilled.
+	engine = newTestEngine(3)
+	s = newTestService(2, store, engine)
+	if err := s.backfill(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assertCalls(t, engine, nil)
+}
+
+func TestBackfillFailingPayload(t *testing.T) {
+	engine := newTestEngine(0)
+	engine.invalid = 2
+	s := newTestService(4, newTestStore(1, 5), engine)
+
+	if err := s.backfill(context.Background()); !errors.Is(err, errInvalid) {
+		t.Fatalf("error %v, want %v", err, errInvalid)
+	}
+	assertCalls(t, engine, []string{"newPayload 1", "newPayload 2"})
+}
+
+func TestStartInvalidBatchSize(t *testing.T) {
+	for _, size := range []uint64{0, maxBatchSize + 1} {
+		s := newTestService(size, newTestStore(1, 1), newTestEngine(0))
+		if err := s.Start(context.Background()); !errors.Is(
+			err, ErrInvalidBatchSize,
+		) {
+			t.Fatalf("batch size %d: error %v, want %v",
+				size, err, ErrInvalidBatchSize)
+		}
+	}
+}
+
+func newTestService(
+	batchSize uint64,
+	store *testStore,
+	engine *testEngine,
+) *Service[
+	*testBlock, *testBody, *testStore, *testEngine, *testPayload, any,
+	*testSignedBlock, engineprimitives.Withdrawals,
+] {
+	return NewService[
+		*testBlock, *testBody, *testStore, *testEngine, *testPayload, any,
+		*testSignedBlock, engineprimitives.Withdrawals,
+	](
+		Config{Enabled: true, BatchSize: batchSize},
+		noop.NewLogger[any](),
+		store,
+		engine,
+	)
+}
+
+func assertCalls(t *testing.T, engine *testEngine, want []string) {
+	t.Helper()
+	if got := engine.getCalls(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("calls %v, want %v", got, want)
+	}
+}
+
+// payloadHash is the block hash of the test payload of the given number.
+func payloadHash(number math.U64) common.ExecutionHash {
+	return common.ExecutionHash{byte(number)}
+}
+
+type testPayload struct{ number math.U64 }
+
+func (*testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (*testPayload) Version() uint32 { return version.Deneb }
+
+func (*testPayload) GetPrevRandao() common.Bytes32 { return common.Bytes32{} }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return payloadHash(p.number)
+}
+
+func (p *testPayload) GetParentHash() common.ExecutionHash {
+	return payloadHash(p.number - 1)
+}
+
+func (p *testPayload) GetNumber() math.U64 { return p.number }
+
+func (*testPayload) GetGasLimit() math.U64 { return 0 }
+
+func (*testPayload) GetGasUsed() math.U64 { return 0 }
+
+func (*testPayload) GetTimestamp() math.U64 { return 0 }
+
+func (*testPayload) GetExtraData() []byte { return nil }
+
+func (*testPayload) GetBaseFeePerGas() *math.U256 { return math.NewU256(0) }
+
+func (*testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (*testPayload) GetStateRoot() common.Bytes32 { return common.Bytes32{} }
+
+func (*testPayload) GetReceiptsRoot() common.Bytes32 {
+	return common.Bytes32{}
+}
+
+func (*testPayload) GetLogsBloom() bytes.B256 { return bytes.B256{} }
+
+func (*testPayload) GetBlobGasUsed() math.U64 { return 0 }
+
+func (*testPayload) GetExcessBlobGas() math.U64 { return 0 }
+
+func (*testPayload) GetWithdrawals() engineprimitives.Withdrawals {
+	return nil
+}
+
+func (*testPayload) GetTransactions() engineprimitives.Transactions {
+	return nil
+}
+
+type testCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+type testBody struct{ payload *testPayload }
+
+func (b *testBody) GetExecutionPayload() *testPayload { return b.payload }
+
+func (*testBody) GetBlobKzgCommitments() testCommitments {
+	return nil
+}
+
+func (*testBody) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	return nil
+}
+
+type testBlock struct {
+	slot math.Slot
+	body *testBody
+}
+
+func (b *testBlock) GetSlot() math.Slot { return b.slot }
+
+func (*testBlock) GetParentBlockRoot() common.Root { return common.Root{} }
+
+func (b *testBlock) GetBody() *testBody { return b.body }
+
+func (*testBlock) Version() uint32 { return version.Deneb }
+
+type testSignedBlock struct{ block *testBlock }
+
+func (b *testSignedBlock) GetMessage() *testBlock { return b.block }
+
+// testStore stores the blocks with the payloads of a range of numbers, each
+// at the slot after its payload number.
+type testStore struct {
+	slots  map[math.U64]math.Slot
+	blocks map[math.Slot]*testSignedBlock
+}
+
+func newTestStore(first, last math.U64) *testStore {
+	s := &testStore{
+		slots:  make(map[math.U64]math.Slot),
+		blocks: make(map[math.Slot]*testSignedBlock),
+	}
+	for number := first; number <= last; number++ {
+		slot := math.Slot(number + 1)
+		s.slots[number] = slot
+		s.blocks[slot] = &testSignedBlock{block: &testBlock{
+			slot: slot,
+			body: &testBody{payload: &testPayload{number: number}},
+		}}
+	}
+	return s
+}
+
+func (s *testStore) prune(numbers ...math.U64) {
+	for _, number := range numbers {
+		delete(s.blocks, s.slots[number])
+		delete(s.slots, number)
+	}
+}
+
+func (s *testStore) Get(slot math.Slot) (*testSignedBlock, error) {
+	blk, ok := s.blocks[slot]
+	if !ok {
+		return nil, errNotFound
+	}
+	return blk, nil
+}
+
+func (s *testStore) GetSlotByExecutionNumber(
+	number math.U64,
+) (math.Slot, error) {
+	slot, ok := s.slots[number]
+	if !ok {
+		return 0, errNotFound
+	}
+	return slot, nil
+}
+
+var errInvalid = errors.New("invalid payload")
+
+// testEngine is an execution client that has the payloads up to its head,
+// along with the payloads of the given numbers past it, and rejects the
+// payload of the invalid number.
+type testEngine struct {
+	mu      sync.Mutex
+	head    math.U64
+	known   map[math.U64]struct{}
+	invalid math.U64
+	calls   []string
+}
+
+func newTestEngine(head math.U64, known ...math.U64) *testEngine {
+	e := &testEngine{head: head, known: make(map[math.U64]struct{})}
+	for number := range head + 1 {
+		e.known[number] = struct{}{}
+	}
+	for _, number := range known {
+		e.known[number] = struct{}{}
+	}
+	return e
+}
+
+func (e *testEngine) HeadBlockNumber(context.Context) (math.U64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.head, nil
+}
+
+func (e *testEngine) PayloadBodiesByRange(
+	_ context.Context,
+	start math.U64,
+	count uint64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// The bodies are truncated at the latest payload.
+	var bodies []*engineprimitives.ExecutionPayloadBodyV1
+	for number := start; number < start+math.U64(count); number++ {
+		if number > e.latest() {
+			break
+		}
+		var body *engineprimitives.ExecutionPayloadBodyV1
+		if _, ok := e.known[number]; ok {
+			body = &engineprimitives.ExecutionPayloadBodyV1{}
+		}
+		bodies = append(bodies, body)
+	}
+	return bodies, nil
+}
+
+func (e *testEngine) VerifyAndNotifyNewPayload(
+	_ context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		*testPayload, engineprimitives.Withdrawals,
+	],
+) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	number := req.ExecutionPayload.GetNumber()
+	e.calls = append(e.calls, fmt.Sprintf("newPayload %d", number))
+	if number == e.invalid {
+		return errInvalid
+	}
+	e.known[number] = struct{}{}
+	return nil
+}
+
+func (e *testEngine) NotifyForkchoiceUpdate(
+	_ context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest[any],
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for number := range e.latest() + 1 {
+		if payloadHash(number) == req.State.HeadBlockHash {
+			e.head = number
+			e.calls = append(e.calls, fmt.Sprintf("forkchoice %d", number))
+			return nil, nil, nil
+		}
+	}
+	return nil, nil, errNotFound
+}
+
+func (e *testEngine) latest() math.U64 {
+	var latest math.U64
+	for number := range e.known {
+		latest = max(latest, number)
+	}
+	return latest
+}
+
+func (e *testEngine) getCalls() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.calls...)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backfill
+
+import (
+	stdbytes "bytes"
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetParentBlockRoot returns the root of the parent block.
+	GetParentBlockRoot() common.Root
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+	// Version returns the fork version of the block.
+	Version() uint32
+}
+
+// BeaconBlockBody is the interface for a beacon block body.
+type BeaconBlockBody[ExecutionPayloadT any] interface {
+	// GetExecutionPayload returns the execution payload of the body.
+	GetExecutionPayload() ExecutionPayloadT
+	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetExecutionRequests returns the execution requests, which are nil
+	// before Electra.
+	GetExecutionRequests() *engineprimitives.ExecutionRequests
+}
+
+// BlockStore is the interface for the store of beacon blocks.
+type BlockStore[SignedBeaconBlockT any] interface {
+	// Get retrieves the block at a given slot from the store.
+	Get(slot math.Slot) (SignedBeaconBlockT, error)
+	// GetSlotByExecutionNumber retrieves the slot of the block with the
+	// payload of the given number from the store.
+	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+}
+
+// ExecutionEngine is the interface for the execution engine.
+type ExecutionEngine[
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT, WithdrawalsT],
+	PayloadAttributesT any,
+	WithdrawalsT Withdrawals,
+] interface {
+	// HeadBlockNumber returns the number of the head block of the execution
+	// client.
+	HeadBlockNumber(ctx context.Context) (math.U64, error)
+	// PayloadBodiesByRange returns the bodies of the count canonical
+	// payloads from the start block number, a body being nil if the
+	// execution client does not have the payload.
+	PayloadBodiesByRange(
+		ctx context.Context,
+		start math.U64,
+		count uint64,
+	) ([]*engineprimitives.ExecutionPayloadBodyV1, error)
+	// VerifyAndNotifyNewPayload verifies the new payload and notifies the
+	// execution client.
+	VerifyAndNotifyNewPayload(
+		ctx context.Context,
+		req *engineprimitives.NewPayloadRequest[
+			ExecutionPayloadT, WithdrawalsT,
+		],
+	) error
+	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
+	// update.
+	NotifyForkchoiceUpdate(
+		ctx context.Context,
+		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
+	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
+}
+
+// ExecutionPayload is the interface for an execution payload.
+type ExecutionPayload[ExecutionPayloadT, WithdrawalsT any] interface {
+	constraints.ForkTyped[ExecutionPayloadT]
+	GetPrevRandao() common.Bytes32
+	GetBlockHash() common.ExecutionHash
+	GetParentHash() common.ExecutionHash
+	GetNumber() math.U64
+	GetGasLimit() math.U64
+	GetGasUsed() math.U64
+	GetTimestamp() math.U64
+	GetExtraData() []byte
+	GetBaseFeePerGas() *math.U256
+	GetFeeRecipient() common.ExecutionAddress
+	GetStateRoot() common.Bytes32
+	GetReceiptsRoot() common.Bytes32
+	GetLogsBloom() bytes.B256
+	GetBlobGasUsed() math.U64
+	GetExcessBlobGas() math.U64
+	GetWithdrawals() WithdrawalsT
+	GetTransactions() engineprimitives.Transactions
+}
+
+// SignedBeaconBlock is the interface for a signed beacon block.
+type SignedBeaconBlock[BeaconBlockT any] interface {
+	// GetMessage returns the signed block.
+	GetMessage() BeaconBlockT
+}
+
+// Withdrawals is the interface for the withdrawals of a payload.
+type Withdrawals interface {
+	Len() int
+	EncodeIndex(int, *stdbytes.Buffer)
+}
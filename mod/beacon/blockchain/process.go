@@ -25,6 +25,7 @@ import (
 	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
@@ -32,7 +33,7 @@ import (
 // ProcessGenesisData processes the genesis state and initializes the beacon
 // state.
 func (s *Service[
-	_, _, _, _, _, _, _, _, GenesisT, _, _,
+	_, _, _, _, _, _, _, _, GenesisT, _, _, _, _,
 ]) ProcessGenesisData(
 	ctx context.Context,
 	genesisData GenesisT,
@@ -45,18 +46,19 @@ func (s *Service[
 	)
 }
 
-// ProcessBeaconBlock receives an incoming beacon block, it first validates
-// and then processes the block.
+// ProcessBeaconBlock receives an incoming signed beacon block, it first
+// validates and then processes the block.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, SignedBeaconBlockT, _, _,
 ]) ProcessBeaconBlock(
 	ctx context.Context,
-	blk BeaconBlockT,
+	signedBlk SignedBeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
 	// If the block is nil, exit early.
-	if blk.IsNil() {
+	if signedBlk.IsNil() {
 		return nil, ErrNilBlk
 	}
+	blk := signedBlk.GetMessage()
 
 	// We set `OptimisticEngine` to true since this is called during
 	// FinalizeBlock. We want to assume the payload is valid. If it
@@ -64,7 +66,8 @@ func (s *Service[
 	// which is completely fine. This means we were syncing from a
 	// bad peer, and we would likely AppHash anyways.
 	st := s.sb.StateFromContext(ctx)
-	valUpdates, err := s.executeStateTransition(ctx, st, blk)
+	rewards := &transition.BlockRewards{}
+	valUpdates, err := s.executeStateTransition(ctx, st, blk, rewards)
 	if err != nil {
 		return nil, err
 	}
@@ -72,37 +75,46 @@ func (s *Service[
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
 	// since it has been verified as correct already.
-	if !s.sb.AvailabilityStore().IsDataAvailable(
-		ctx, blk.GetSlot(), blk.GetBody(),
-	) {
+	if !s.isDataAvailable(ctx, blk) {
 		return nil, ErrDataNotAvailable
 	}
 
-	// If required, we want to forkchoice at the end of post
-	// block processing.
-	// TODO: this is hood as fuck.
-	// We won't send a fcu if the block is bad, should be addressed
-	// via ticker later.
+	// The block store, deposit service and pruners subscribe to the
+	// finalized blocks without ever dropping them, a publish only fails if
+	// the block could not reach them in which case finalization fails too.
 	if err = s.blkBroker.Publish(ctx,
 		asynctypes.NewEvent(
 			ctx, events.BeaconBlockFinalized, blk,
 		),
 	); err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "failed to publish finalized block")
+	}
+
+	// The signed block is published separately for it to be stored.
+	if err = s.signedBlkBroker.Publish(ctx,
+		asynctypes.NewEvent(
+			ctx, events.BeaconBlockFinalized, signedBlk,
+		),
+	); err != nil {
+		return nil, errors.Wrap(
+			err, "failed to publish finalized signed block",
+		)
 	}
 
 	go s.sendPostBlockFCU(ctx, st, blk)
+	go s.storeBlockRewards(ctx, blk, rewards)
 
 	return valUpdates.RemoveDuplicates().Sort(), nil
 }
 
 // executeStateTransition runs the stf.
 func (s *Service[
-	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _,
 ]) executeStateTransition(
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
+	rewards *transition.BlockRewards,
 ) (transition.ValidatorUpdates, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
@@ -124,9 +136,32 @@ func (s *Service[
 			// the "verification aspect" of this NewPayload call is
 			// actually irrelevant at this point.
 			SkipPayloadVerification: false,
+			BlockRewards:            rewards,
+			CommitVoters:            transition.CommitVotersFromContext(ctx),
 		},
 		st,
 		blk,
 	)
 	return valUpdates, err
 }
+
+// isDataAvailable returns whether the data the block commits to has been
+// stored. Once PeerDAS is active the blobs are distributed as data columns,
+// so only the columns this node samples are required to be stored.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) isDataAvailable(ctx context.Context, blk BeaconBlockT) bool {
+	if !s.cs.PeerDASActiveForSlot(blk.GetSlot()) {
+		return s.sb.AvailabilityStore().IsDataAvailable(
+			ctx, blk.GetSlot(), blk.GetBody(),
+		)
+	}
+
+	// Blocks without blobs come without data columns.
+	if len(blk.GetBody().GetBlobKzgCommitments()) == 0 {
+		return true
+	}
+	return s.das.IsDataColumnsAvailable(
+		ctx, blk.GetSlot(), blk.HashTreeRoot(),
+	)
+}
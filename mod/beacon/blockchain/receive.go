@@ -26,45 +26,54 @@ import (
 
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // ReceiveBlock receives a block and blobs from the
 // network and processes them.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, SignedBeaconBlockT, _, _,
 ]) ReceiveBlock(
 	ctx context.Context,
-	blk BeaconBlockT,
+	signedBlk SignedBeaconBlockT,
 ) error {
-	return s.VerifyIncomingBlock(ctx, blk)
+	return s.VerifyIncomingBlock(ctx, signedBlk)
 }
 
-// VerifyIncomingBlock verifies the state root of an incoming block
-// and logs the process.
+// VerifyIncomingBlock verifies the proposer signature and the state root of
+// an incoming block and logs the process.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, SignedBeaconBlockT, _, _,
 ]) VerifyIncomingBlock(
 	ctx context.Context,
-	blk BeaconBlockT,
+	signedBlk SignedBeaconBlockT,
 ) error {
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.sb.StateFromContext(ctx)
 
-	// Force a sync of the startup head if we haven't done so already.
-	//
-	// TODO: This is a super hacky. It should be handled better elsewhere,
-	// ideally via some broader sync service.
-	s.forceStartupSyncOnce.Do(func() { s.forceStartupHead(ctx, preState) })
-
 	// If the block is nil or a nil pointer, exit early.
-	if blk.IsNil() {
+	if signedBlk.IsNil() {
 		s.logger.Warn(
 			"Aborting block verification - beacon block not found in proposal",
 		)
 		return errors.WrapNonFatal(ErrNilBlk)
 	}
 
+	// Verify that the block was signed by its proposer.
+	blk := signedBlk.GetMessage()
+	if err := s.verifyProposerSignature(preState, signedBlk); err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			"state_root",
+			blk.GetStateRoot(),
+			"reason",
+			err,
+		)
+		return err
+	}
+
 	s.logger.Info(
 		"Received incoming beacon block",
 		"state_root", blk.GetStateRoot(),
@@ -108,9 +117,39 @@ func (s *Service[
 	return nil
 }
 
+// verifyProposerSignature verifies the signature of an incoming block against
+// the public key of its proposer.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, SignedBeaconBlockT, _, _,
+]) verifyProposerSignature(
+	st BeaconStateT,
+	signedBlk SignedBeaconBlockT,
+) error {
+	blk := signedBlk.GetMessage()
+	proposer, err := st.ValidatorByIndex(blk.GetProposerIndex())
+	if err != nil {
+		return err
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	return signedBlk.VerifySignature(
+		version.FromUint32[common.Version](
+			s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
+		),
+		genesisValidatorsRoot,
+		s.cs.DomainTypeProposer(),
+		proposer.GetPubkey(),
+		s.signer.VerifySignature,
+	)
+}
+
 // verifyStateRoot verifies the state root of an incoming block.
 func (s *Service[
-	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _,
 ]) verifyStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -127,6 +166,7 @@ func (s *Service[
 			SkipPayloadVerification: false,
 			SkipValidateResult:      false,
 			SkipValidateRandao:      false,
+			CommitVoters:            transition.CommitVotersFromContext(ctx),
 		},
 		st, blk,
 	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
@@ -146,7 +186,7 @@ func (s *Service[
 // shouldBuildOptimisticPayloads returns true if optimistic
 // payload builds are enabled.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) shouldBuildOptimisticPayloads() bool {
 	return s.optimisticPayloadBuilds && s.lb.Enabled()
 }
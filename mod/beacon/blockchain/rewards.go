@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// storeBlockRewardsTimeout is the time allowed to fetch the value of the
+// execution payload of a block for its rewards.
+const storeBlockRewardsTimeout = 10 * time.Second
+
+// storeBlockRewards completes the rewards recorded by the state transition of
+// the block with the value of its execution payload and stores them. It runs
+// after the request that finalized the block returns, so it does not use the
+// cancellation of the request's context.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) storeBlockRewards(
+	ctx context.Context,
+	blk BeaconBlockT,
+	rewards *transition.BlockRewards,
+) {
+	ctx, cancel := context.WithTimeout(
+		context.WithoutCancel(ctx), storeBlockRewardsTimeout,
+	)
+	defer cancel()
+
+	// The value of the payload is only known to the execution client, a
+	// failure to fetch it must not prevent the rest of the rewards from
+	// being stored.
+	value, err := s.ee.PayloadValue(
+		ctx, blk.GetBody().GetExecutionPayload().GetBlockHash(),
+	)
+	if err != nil {
+		s.logger.Warn(
+			"failed to get the value of the execution payload",
+			"slot", blk.GetSlot(), "error", err,
+		)
+	} else {
+		rewards.ExecutionValue = math.GweiFromWei(value.ToBig())
+	}
+
+	if err = s.rs.SetRewards(rewards); err != nil {
+		s.logger.Error(
+			"failed to store block rewards",
+			"slot", blk.GetSlot(), "error", err,
+		)
+	}
+}
@@ -22,11 +22,11 @@ package blockchain
 
 import (
 	"context"
-	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
@@ -38,7 +38,7 @@ type Service[
 	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
-		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT,
+		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 	DepositT any,
 	ExecutionPayloadT ExecutionPayload,
@@ -49,6 +49,8 @@ type Service[
 		Version() uint32
 		GetSuggestedFeeRecipient() common.ExecutionAddress
 	},
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT],
+	ValidatorT Validator,
 	WithdrawalT any,
 ] struct {
 	// sb represents the backend storage for beacon states and associated
@@ -75,19 +77,26 @@ type Service[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	]
+	// das reports the availability of the sampled data columns once PeerDAS
+	// is active.
+	das DataColumnAvailability
+	// rs is the store for the rewards of the finalized blocks.
+	rs RewardsStore
+	// signer is used to verify the signatures of the proposers.
+	signer crypto.BLSSigner
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// genesisBroker is the event feed for genesis data.
 	genesisBroker EventFeed[*asynctypes.Event[GenesisT]]
 	// blkBroker is the event feed for new blocks.
 	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]]
+	// signedBlkBroker is the event feed for new signed blocks.
+	signedBlkBroker EventFeed[*asynctypes.Event[SignedBeaconBlockT]]
 	// validatorUpdateBroker is the event feed for validator updates.
 	validatorUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]]
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
-	// forceStartupSyncOnce is used to force a sync of the startup head.
-	forceStartupSyncOnce *sync.Once
 }
 
 // NewService creates a new validator service.
@@ -97,8 +106,7 @@ func NewService[
 	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
-		BeaconStateT, BeaconBlockHeaderT,
-		ExecutionPayloadHeaderT,
+		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 	DepositT any,
 	ExecutionPayloadT ExecutionPayload,
@@ -109,6 +117,8 @@ func NewService[
 		Version() uint32
 		GetSuggestedFeeRecipient() common.ExecutionAddress
 	},
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT],
+	ValidatorT Validator,
 	WithdrawalT any,
 ](
 	sb StorageBackend[
@@ -127,21 +137,26 @@ func NewService[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	],
+	das DataColumnAvailability,
+	rs RewardsStore,
+	signer crypto.BLSSigner,
 	ts TelemetrySink,
 	genesisBroker EventFeed[*asynctypes.Event[GenesisT]],
 	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]],
+	signedBlkBroker EventFeed[*asynctypes.Event[SignedBeaconBlockT]],
 	//nolint:lll // annoying formatter.
 	validatorUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]],
 	optimisticPayloadBuilds bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	GenesisT, PayloadAttributesT, WithdrawalT,
+	GenesisT, PayloadAttributesT, SignedBeaconBlockT, ValidatorT, WithdrawalT,
 ] {
 	return &Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		GenesisT, PayloadAttributesT, WithdrawalT,
+		GenesisT, PayloadAttributesT, SignedBeaconBlockT, ValidatorT,
+		WithdrawalT,
 	]{
 		sb:                      sb,
 		logger:                  logger,
@@ -149,26 +164,29 @@ func NewService[
 		ee:                      ee,
 		lb:                      lb,
 		sp:                      sp,
+		das:                     das,
+		rs:                      rs,
+		signer:                  signer,
 		metrics:                 newChainMetrics(ts),
 		genesisBroker:           genesisBroker,
 		blkBroker:               blkBroker,
+		signedBlkBroker:         signedBlkBroker,
 		validatorUpdateBroker:   validatorUpdateBroker,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
-		forceStartupSyncOnce:    new(sync.Once),
 	}
 }
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "blockchain"
 }
 
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	subBlkCh, err := s.blkBroker.Subscribe()
+	subBlkCh, err := s.signedBlkBroker.Subscribe()
 	if err != nil {
 		return err
 	}
@@ -181,10 +199,10 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, GenesisT, _, _,
+	_, _, _, _, _, _, _, _, GenesisT, _, SignedBeaconBlockT, _, _,
 ]) start(
 	ctx context.Context,
-	subBlkCh chan *asynctypes.Event[BeaconBlockT],
+	subBlkCh chan *asynctypes.Event[SignedBeaconBlockT],
 	subGenCh chan *asynctypes.Event[GenesisT],
 ) {
 	for {
@@ -207,7 +225,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, _, _, _, _, _, _, _, GenesisT, _, _,
+	_, _, _, _, _, _, _, _, GenesisT, _, _, _, _,
 ]) handleProcessGenesisDataRequest(msg *asynctypes.Event[GenesisT]) {
 	if msg.Error() != nil {
 		s.logger.Error("Error processing genesis data", "error", msg.Error())
@@ -239,9 +257,9 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, SignedBeaconBlockT, _, _,
 ]) handleBeaconBlockReceived(
-	msg *asynctypes.Event[BeaconBlockT],
+	msg *asynctypes.Event[SignedBeaconBlockT],
 ) {
 	// If the block is nil, exit early.
 	if msg.Error() != nil {
@@ -250,7 +268,7 @@ func (s *Service[
 	}
 
 	// Publish the verified block event.
-	if err := s.blkBroker.Publish(
+	if err := s.signedBlkBroker.Publish(
 		msg.Context(),
 		asynctypes.NewEvent(
 			msg.Context(),
@@ -264,9 +282,9 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, SignedBeaconBlockT, _, _,
 ]) handleBeaconBlockFinalization(
-	msg *asynctypes.Event[BeaconBlockT],
+	msg *asynctypes.Event[SignedBeaconBlockT],
 ) {
 	// If there's an error in the event, log it and return
 	if msg.Error() != nil {
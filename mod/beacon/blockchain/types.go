@@ -27,6 +27,8 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
@@ -42,6 +44,17 @@ type AvailabilityStore[BeaconBlockBodyT any] interface {
 	) bool
 }
 
+// DataColumnAvailability reports whether the data columns a node samples for
+// a block have been stored, which replaces the blob availability check once
+// PeerDAS is active.
+type DataColumnAvailability interface {
+	// IsDataColumnsAvailable returns whether all the data columns sampled for
+	// the block with the given root have been stored.
+	IsDataColumnsAvailable(
+		ctx context.Context, slot math.Slot, blockRoot common.Root,
+	) bool
+}
+
 // BeaconBlock represents a beacon block interface.
 type BeaconBlock[
 	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
@@ -51,6 +64,8 @@ type BeaconBlock[
 	constraints.Nillable
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
 	// GetStateRoot returns the state root of the beacon block.
@@ -66,6 +81,8 @@ type BeaconBlockBody[ExecutionPayloadT any] interface {
 	// GetExecutionPayload returns the execution payload of the beacon block
 	// body.
 	GetExecutionPayload() ExecutionPayloadT
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 }
 
 // BeaconBlockHeader represents the interface for the beacon block header.
@@ -91,6 +108,12 @@ type ExecutionEngine[PayloadAttributesT any] interface {
 		ctx context.Context,
 		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
+	// PayloadValue returns the value of the payload with the given block
+	// hash.
+	PayloadValue(
+		ctx context.Context,
+		blockHash common.ExecutionHash,
+	) (*math.U256, error)
 }
 
 // EventFeed is a generic interface for sending events.
@@ -140,12 +163,6 @@ type LocalBuilder[BeaconStateT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (*engineprimitives.PayloadID, error)
-	// SendForceHeadFCU sends a force head FCU request.
-	SendForceHeadFCU(
-		ctx context.Context,
-		st BeaconStateT,
-		slot math.Slot,
-	) error
 }
 
 // ReadOnlyBeaconState defines the interface for accessing various components of
@@ -154,9 +171,12 @@ type ReadOnlyBeaconState[
 	T any,
 	BeaconBlockHeaderT BeaconBlockHeader,
 	ExecutionPayloadHeaderT any,
+	ValidatorT any,
 ] interface {
 	// Copy creates a copy of the beacon state.
 	Copy() T
+	// GetGenesisValidatorsRoot returns the root of the genesis validators.
+	GetGenesisValidatorsRoot() (common.Root, error)
 	// GetLatestBlockHeader returns the most recent block header.
 	GetLatestBlockHeader() (
 		BeaconBlockHeaderT,
@@ -172,6 +192,28 @@ type ReadOnlyBeaconState[
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
+	// ValidatorByIndex retrieves the validator at the given index.
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+}
+
+// SignedBeaconBlock represents a beacon block signed by its proposer.
+type SignedBeaconBlock[BeaconBlockT any] interface {
+	constraints.Nillable
+	// GetMessage returns the beacon block that was signed.
+	GetMessage() BeaconBlockT
+	// VerifySignature verifies the signature of the proposer over the block
+	// in the proposer domain of the given fork.
+	VerifySignature(
+		forkVersion common.Version,
+		genesisValidatorsRoot common.Root,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte,
+			signature crypto.BLSSignature,
+		) error,
+	) error
 }
 
 // StateProcessor defines the interface for processing various state transitions
@@ -204,6 +246,12 @@ type StateProcessor[
 	) (transition.ValidatorUpdates, error)
 }
 
+// RewardsStore is the interface for the storage of the block rewards.
+type RewardsStore interface {
+	// SetRewards stores the rewards of a block.
+	SetRewards(rewards *transition.BlockRewards) error
+}
+
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
 type StorageBackend[
@@ -217,6 +265,12 @@ type StorageBackend[
 	StateFromContext(context.Context) BeaconStateT
 }
 
+// Validator represents the interface for a validator.
+type Validator interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments the counter identified by
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+import "time"
+
+const (
+	// defaultPollInterval is the default interval at which the execution
+	// client is polled.
+	defaultPollInterval = 2 * time.Second
+	// defaultSyncDistance is the default number of blocks the head of the
+	// execution client may trail the beacon chain by while being synced.
+	defaultSyncDistance = 1
+	// defaultStallTimeout is the default duration without sync progress
+	// after which the execution client is considered stalled.
+	defaultStallTimeout = time.Minute
+)
+
+// Config is the configuration for the monitor of the execution client sync.
+type Config struct {
+	// PollInterval is the interval at which the sync status and the head of
+	// the execution client are polled.
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+	// SyncDistance is the number of blocks the head of the execution client
+	// may trail the latest execution payload of the beacon chain by while
+	// still being considered synced.
+	SyncDistance uint64 `mapstructure:"sync-distance"`
+	// StallTimeout is the duration without sync progress after which the
+	// execution client is considered stalled.
+	StallTimeout time.Duration `mapstructure:"stall-timeout"`
+}
+
+// DefaultConfig returns the default configuration for the monitor of the
+// execution client sync.
+func DefaultConfig() Config {
+	return Config{
+		PollInterval: defaultPollInterval,
+		SyncDistance: defaultSyncDistance,
+		StallTimeout: defaultStallTimeout,
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidPollInterval is returned when the monitor is started with a
+	// poll interval that is not positive.
+	ErrInvalidPollInterval = errors.New("invalid sync monitor poll interval")
+
+	// ErrExecutionClientSyncing is returned when the execution client is
+	// still syncing to the head of the beacon chain.
+	ErrExecutionClientSyncing = errors.New("execution client is syncing")
+
+	// ErrExecutionClientStalled is returned when the execution client has
+	// made no sync progress for longer than the stall timeout.
+	ErrExecutionClientStalled = errors.New("execution client is stalled")
+)
@@ -0,0 +1,352 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package syncmonitor tracks whether the execution client is in sync with the
+// beacon chain, by polling its sync progress and comparing its head with the
+// latest execution payload of the beacon chain.
+package syncmonitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+)
+
+// Service monitors the sync status of the execution client. It moves between
+// syncing, synced and stalled as the execution client falls behind and
+// catches up with the beacon chain, publishes the transitions to the status
+// feed and, when the execution client is behind without syncing, updates its
+// forkchoice to the latest execution payload of the beacon chain.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	ExecutionEngineT ExecutionEngine[PayloadAttributesT],
+	ExecutionPayloadT ExecutionPayload,
+	ExecutionPayloadHeaderT ExecutionPayload,
+	PayloadAttributesT any,
+] struct {
+	// config is the configuration for the monitor.
+	config Config
+	// logger is used for logging information and errors.
+	logger log.Logger[any]
+	// engine is the execution engine to monitor.
+	engine ExecutionEngineT
+	// states provides the committed beacon state, from which the latest
+	// execution payload of the beacon chain is seeded on start.
+	states StateProvider[BeaconStateT]
+	// blkSub is the feed of the beacon blocks, from which the latest
+	// execution payload of the beacon chain is tracked.
+	blkSub chan *asynctypes.Event[BeaconBlockT]
+	// statusPublisher is the feed the sync status is published to.
+	statusPublisher EventPublisher[*asynctypes.Event[*service.StatusEvent]]
+	// tracker is the state machine of the sync status.
+	tracker *tracker
+	// target is the latest execution payload, or the header of it, of the
+	// beacon chain.
+	target ExecutionPayload
+	// lastCatchUp is the time of the last forkchoice update sent to catch
+	// the execution client up with the beacon chain.
+	lastCatchUp time.Time
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// status is the latest sync status.
+	status Status
+	// head is the number of the latest block the execution client was
+	// observed to have synced.
+	head math.U64
+	// targetNumber is the block number of the latest execution payload of
+	// the beacon chain.
+	targetNumber math.U64
+}
+
+// NewService creates a new sync monitor.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	ExecutionEngineT ExecutionEngine[PayloadAttributesT],
+	ExecutionPayloadT ExecutionPayload,
+	ExecutionPayloadHeaderT ExecutionPayload,
+	PayloadAttributesT any,
+](
+	config Config,
+	logger log.Logger[any],
+	engine ExecutionEngineT,
+	states StateProvider[BeaconStateT],
+	blkSub chan *asynctypes.Event[BeaconBlockT],
+	statusPublisher EventPublisher[*asynctypes.Event[*service.StatusEvent]],
+) *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, ExecutionEngineT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, PayloadAttributesT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, ExecutionEngineT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, PayloadAttributesT,
+	]{
+		config:          config,
+		logger:          logger,
+		engine:          engine,
+		states:          states,
+		blkSub:          blkSub,
+		statusPublisher: statusPublisher,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _, _, _, _]) Name() string {
+	return "execution-sync-monitor"
+}
+
+// Start starts monitoring the execution client in the background.
+func (s *Service[_, _, _, _, _, _, _]) Start(ctx context.Context) error {
+	if s.config.PollInterval <= 0 {
+		return ErrInvalidPollInterval
+	}
+	s.tracker = newTracker(s.config.StallTimeout, time.Now())
+	go s.start(ctx)
+	return nil
+}
+
+// Status returns the latest sync status of the execution client.
+func (s *Service[_, _, _, _, _, _, _]) Status() Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
+
+// Syncing returns whether the execution client is behind the beacon chain,
+// whether it is making progress or stalled.
+func (s *Service[_, _, _, _, _, _, _]) Syncing() bool {
+	status := s.Status()
+	return status == StatusSyncing || status == StatusStalled
+}
+
+// Stalled returns whether the execution client has made no sync progress for
+// longer than the stall timeout.
+func (s *Service[_, _, _, _, _, _, _]) Stalled() bool {
+	return s.Status() == StatusStalled
+}
+
+// Ready returns nil if the execution client is in sync with the beacon chain,
+// or an error describing why it is not. An execution client that has not been
+// polled yet is assumed to be ready.
+func (s *Service[_, _, _, _, _, _, _]) Ready() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	switch s.status {
+	case StatusSyncing:
+		return errors.Wrapf(
+			ErrExecutionClientSyncing,
+			"at block %d of %d", s.head, s.targetNumber,
+		)
+	case StatusStalled:
+		return errors.Wrapf(
+			ErrExecutionClientStalled,
+			"at block %d of %d", s.head, s.targetNumber,
+		)
+	default:
+		return nil
+	}
+}
+
+// start polls the execution client at every interval and tracks the latest
+// execution payload of the beacon chain from the finalized beacon blocks.
+func (s *Service[_, _, _, _, _, _, _]) start(ctx context.Context) {
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	// Until the next block is finalized, the target is the latest execution
+	// payload of the committed state. The execution client is pointed to it
+	// right away, rather than once a poll finds it behind, so that it does
+	// not idle until then after a restart.
+	if s.seedTarget() {
+		s.catchUp(ctx)
+	}
+	s.poll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-s.blkSub:
+			if msg.Type() == events.BeaconBlockFinalized &&
+				msg.Error() == nil {
+				s.setTarget(msg.Data())
+			}
+		case <-ticker.C:
+			s.poll(ctx)
+		}
+	}
+}
+
+// seedTarget sets the header of the latest execution payload of the
+// committed beacon state as the target, it returns false if there is none.
+func (s *Service[
+	_, _, BeaconStateT, _, _, ExecutionPayloadHeaderT, _,
+]) seedTarget() bool {
+	st, err := s.states.CommittedState(0)
+	if err != nil {
+		// There is no committed state before the first block.
+		s.logger.Debug("No committed state to seed the target", "error", err)
+		return false
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error(
+			"Failed to get the latest execution payload header",
+			"error", err,
+		)
+		return false
+	}
+	if header.IsNil() || header.GetNumber() == 0 {
+		return false
+	}
+	s.target = header
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.targetNumber = header.GetNumber()
+	return true
+}
+
+// setTarget sets the execution payload of the given finalized beacon block as
+// the latest one of the beacon chain.
+func (s *Service[
+	BeaconBlockT, _, _, _, _, _, _,
+]) setTarget(blk BeaconBlockT) {
+	payload := blk.GetBody().GetExecutionPayload()
+	if payload.IsNil() {
+		return
+	}
+	s.target = payload
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.targetNumber = payload.GetNumber()
+}
+
+// poll observes the sync progress and the head of the execution client, moves
+// the sync status accordingly and, if the execution client is behind the
+// beacon chain without syncing to it, catches it up.
+func (s *Service[_, _, _, _, _, _, _]) poll(ctx context.Context) {
+	var obs observation
+	progress, err := s.engine.SyncProgress(ctx)
+	if err == nil {
+		obs.current, err = s.engine.HeadBlockNumber(ctx)
+	}
+	obs.err = err
+
+	behind := false
+	if err == nil {
+		if progress != nil {
+			obs.current = max(obs.current, progress.CurrentBlock)
+		}
+		if s.target != nil && !s.target.IsNil() {
+			behind = obs.current+math.U64(s.config.SyncDistance) <
+				s.target.GetNumber()
+		}
+		obs.syncing = progress != nil || behind
+	}
+
+	status := s.tracker.observe(obs, time.Now())
+	s.setStatus(ctx, status, s.tracker.current)
+
+	if behind && (progress == nil || status == StatusStalled) {
+		s.catchUp(ctx)
+	}
+}
+
+// setStatus records the given sync status and, on a transition, logs and
+// publishes it to the status feed.
+func (s *Service[_, _, _, _, _, _, _]) setStatus(
+	ctx context.Context,
+	status Status,
+	head math.U64,
+) {
+	s.mu.Lock()
+	prev := s.status
+	s.status, s.head = status, head
+	target := s.targetNumber
+	s.mu.Unlock()
+
+	if status == prev {
+		return
+	}
+
+	s.logger.Info(
+		"Execution client sync status changed",
+		"from", prev,
+		"to", status,
+		"head", head,
+		"target", target,
+	)
+	if err := s.statusPublisher.Publish(ctx, asynctypes.NewEvent(
+		ctx,
+		events.ExecutionSyncStatusChanged,
+		service.NewStatusEvent(s.Name(), status == StatusSynced),
+	)); err != nil {
+		s.logger.Error("Failed to publish sync status", "error", err)
+	}
+}
+
+// catchUp updates the forkchoice of the execution client to the latest
+// execution payload of the beacon chain, so that it syncs to it, at most once
+// per stall timeout.
+func (s *Service[_, _, _, _, _, _, PayloadAttributesT]) catchUp(
+	ctx context.Context,
+) {
+	if time.Since(s.lastCatchUp) < s.config.StallTimeout {
+		return
+	}
+	s.lastCatchUp = time.Now()
+
+	s.logger.Info(
+		"Updating forkchoice to catch up execution client",
+		"head_eth1_hash", s.target.GetBlockHash(),
+		"number", s.target.GetNumber(),
+	)
+	if _, _, err := s.engine.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequestNoAttrs[
+			PayloadAttributesT,
+		](
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      s.target.GetBlockHash(),
+				SafeBlockHash:      s.target.GetParentHash(),
+				FinalizedBlockHash: s.target.GetParentHash(),
+			},
+			s.target.Version(),
+		),
+	); err != nil {
+		s.logger.Error(
+			"Failed to update forkchoice to catch up execution client",
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+func TestServiceSeedsTargetFromState(t *testing.T) {
+	header := &testPayload{number: 100, hash: common.ExecutionHash{1}}
+	engine := &testEngine{head: 10}
+	s := newTestService(engine, &testStates{st: &testState{header: header}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := s.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return s.Status() != StatusUnknown })
+
+	// The forkchoice is updated to the latest execution payload of the
+	// committed state before the execution client is first polled.
+	calls := engine.getCalls()
+	if len(calls) < 2 || calls[0] != "forkchoice" || calls[1] != "poll" {
+		t.Fatalf("unexpected engine calls %v", calls)
+	}
+	if engine.getHead() != header.hash {
+		t.Fatalf("forkchoice updated to %s", engine.getHead())
+	}
+	if !s.Syncing() {
+		t.Fatalf("status %s, want syncing", s.Status())
+	}
+}
+
+func TestServiceWithoutCommittedState(t *testing.T) {
+	engine := &testEngine{head: 10}
+	s := newTestService(
+		engine, &testStates{err: errors.New("no committed state")},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := s.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return s.Status() != StatusUnknown })
+
+	for _, call := range engine.getCalls() {
+		if call == "forkchoice" {
+			t.Fatal("forkchoice updated without a target")
+		}
+	}
+	if s.Status() != StatusSynced {
+		t.Fatalf("status %s, want synced", s.Status())
+	}
+}
+
+func newTestService(
+	engine *testEngine,
+	states *testStates,
+) *Service[
+	*testBlock, *testBody, *testState, *testEngine, *testPayload,
+	*testPayload, any,
+] {
+	return NewService[
+		*testBlock, *testBody, *testState, *testEngine, *testPayload,
+		*testPayload, any,
+	](
+		Config{
+			PollInterval: time.Hour,
+			SyncDistance: 1,
+			StallTimeout: time.Hour,
+		},
+		noop.NewLogger[any](),
+		engine,
+		states,
+		make(chan *asynctypes.Event[*testBlock]),
+		testPublisher{},
+	)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+type testPayload struct {
+	number math.U64
+	hash   common.ExecutionHash
+}
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (*testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash { return p.hash }
+
+func (*testPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (p *testPayload) GetNumber() math.U64 { return p.number }
+
+type testBody struct{ payload *testPayload }
+
+func (b *testBody) GetExecutionPayload() *testPayload { return b.payload }
+
+type testBlock struct{ body *testBody }
+
+func (b *testBlock) GetBody() *testBody { return b.body }
+
+type testState struct{ header *testPayload }
+
+func (s *testState) GetLatestExecutionPayloadHeader() (*testPayload, error) {
+	return s.header, nil
+}
+
+type testStates struct {
+	st  *testState
+	err error
+}
+
+func (s *testStates) CommittedState(math.Slot) (*testState, error) {
+	return s.st, s.err
+}
+
+type testPublisher struct{}
+
+func (testPublisher) Publish(
+	context.Context, *asynctypes.Event[*service.StatusEvent],
+) error {
+	return nil
+}
+
+type testEngine struct {
+	mu    sync.Mutex
+	head  math.U64
+	calls []string
+	fcu   common.ExecutionHash
+}
+
+func (e *testEngine) HeadBlockNumber(context.Context) (math.U64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.head, nil
+}
+
+func (e *testEngine) SyncProgress(
+	context.Context,
+) (*engineprimitives.SyncProgress, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, "poll")
+	return nil, nil
+}
+
+func (e *testEngine) NotifyForkchoiceUpdate(
+	_ context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest[any],
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, "forkchoice")
+	e.fcu = req.State.HeadBlockHash
+	return nil, nil, nil
+}
+
+func (e *testEngine) getCalls() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.calls...)
+}
+
+func (e *testEngine) getHead() common.ExecutionHash {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.fcu
+}
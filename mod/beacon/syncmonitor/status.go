@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+// Status is the sync status of the execution client.
+type Status uint8
+
+const (
+	// StatusUnknown is the status before the execution client was polled.
+	StatusUnknown Status = iota
+	// StatusSyncing is the status of an execution client that is behind the
+	// beacon chain and making progress.
+	StatusSyncing
+	// StatusSynced is the status of an execution client that has caught up
+	// with the beacon chain.
+	StatusSynced
+	// StatusStalled is the status of an execution client that is behind the
+	// beacon chain, or unreachable, and has made no progress for longer
+	// than the stall timeout.
+	StatusStalled
+)
+
+// String returns the name of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusSyncing:
+		return "syncing"
+	case StatusSynced:
+		return "synced"
+	case StatusStalled:
+		return "stalled"
+	default:
+		return "unknown"
+	}
+}
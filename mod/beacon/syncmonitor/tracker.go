@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// observation is the result of polling the execution client.
+type observation struct {
+	// err is the error polling the execution client failed with, if any.
+	err error
+	// syncing is whether the execution client is behind the beacon chain.
+	syncing bool
+	// current is the number of the latest block the execution client
+	// synced.
+	current math.U64
+}
+
+// tracker is the state machine of the sync status of the execution client,
+// which moves on every observation of it.
+type tracker struct {
+	// stallTimeout is the duration without progress after which the
+	// execution client is considered stalled.
+	stallTimeout time.Duration
+	// status is the current sync status.
+	status Status
+	// current is the number of the latest block the execution client was
+	// observed to have synced.
+	current math.U64
+	// lastProgress is the time the execution client was last observed to be
+	// synced or to make progress.
+	lastProgress time.Time
+}
+
+// newTracker creates a new tracker, with the execution client making progress
+// from the given time.
+func newTracker(stallTimeout time.Duration, now time.Time) *tracker {
+	return &tracker{
+		stallTimeout: stallTimeout,
+		status:       StatusUnknown,
+		lastProgress: now,
+	}
+}
+
+// observe moves the state machine with the given observation and returns the
+// resulting status. An execution client that is synced, or that has fallen
+// behind or made progress since the last observation, is making progress. One
+// that is unreachable, or behind without making progress, keeps its status
+// until the stall timeout elapses and it is considered stalled.
+func (t *tracker) observe(obs observation, now time.Time) Status {
+	switch {
+	case obs.err == nil && !obs.syncing:
+		t.status = StatusSynced
+		t.current = obs.current
+		t.lastProgress = now
+	case obs.err == nil &&
+		(t.status == StatusUnknown || t.status == StatusSynced ||
+			obs.current > t.current):
+		t.status = StatusSyncing
+		t.current = obs.current
+		t.lastProgress = now
+	case now.Sub(t.lastProgress) >= t.stallTimeout:
+		t.status = StatusStalled
+	}
+	return t.status
+}
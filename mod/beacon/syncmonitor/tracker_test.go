@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTrackerTransitions(t *testing.T) {
+	var (
+		start   = time.Unix(0, 0)
+		timeout = time.Minute
+		errPoll = errors.New("connection refused")
+	)
+
+	steps := []struct {
+		name  string
+		obs   observation
+		after time.Duration
+		want  Status
+	}{
+		{
+			name:  "unreachable before the stall timeout",
+			obs:   observation{err: errPoll},
+			after: time.Second,
+			want:  StatusUnknown,
+		},
+		{
+			name:  "caught up",
+			obs:   observation{current: 10},
+			after: 2 * time.Second,
+			want:  StatusSynced,
+		},
+		{
+			name:  "fell behind",
+			obs:   observation{syncing: true, current: 10},
+			after: 3 * time.Second,
+			want:  StatusSyncing,
+		},
+		{
+			name:  "no progress before the stall timeout",
+			obs:   observation{syncing: true, current: 10},
+			after: 3*time.Second + timeout/2,
+			want:  StatusSyncing,
+		},
+		{
+			name:  "no progress past the stall timeout",
+			obs:   observation{syncing: true, current: 10},
+			after: 3*time.Second + timeout,
+			want:  StatusStalled,
+		},
+		{
+			name:  "unreachable while stalled",
+			obs:   observation{err: errPoll},
+			after: 4*time.Second + timeout,
+			want:  StatusStalled,
+		},
+		{
+			name:  "progress after stalling",
+			obs:   observation{syncing: true, current: 11},
+			after: 5*time.Second + timeout,
+			want:  StatusSyncing,
+		},
+		{
+			name:  "caught up again",
+			obs:   observation{current: 12},
+			after: 6*time.Second + timeout,
+			want:  StatusSynced,
+		},
+		{
+			name:  "unreachable while synced",
+			obs:   observation{err: errPoll},
+			after: 7*time.Second + timeout,
+			want:  StatusSynced,
+		},
+		{
+			name:  "unreachable past the stall timeout",
+			obs:   observation{err: errPoll},
+			after: 6*time.Second + 2*timeout,
+			want:  StatusStalled,
+		},
+	}
+
+	tr := newTracker(timeout, start)
+	for _, step := range steps {
+		if got := tr.observe(step.obs, start.Add(step.after)); got != step.want {
+			t.Fatalf("%s: got status %s, want %s", step.name, got, step.want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncmonitor
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is the interface for a beacon block body.
+type BeaconBlockBody[ExecutionPayloadT any] interface {
+	// GetExecutionPayload returns the execution payload of the body.
+	GetExecutionPayload() ExecutionPayloadT
+}
+
+// BeaconState is the interface for the beacon state.
+type BeaconState[ExecutionPayloadHeaderT any] interface {
+	// GetLatestExecutionPayloadHeader returns the header of the latest
+	// execution payload of the beacon chain.
+	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+}
+
+// EventPublisher is the interface for publishing events.
+type EventPublisher[EventT any] interface {
+	// Publish publishes the given event.
+	Publish(ctx context.Context, event EventT) error
+}
+
+// ExecutionEngine is the interface for the execution engine.
+type ExecutionEngine[PayloadAttributesT any] interface {
+	// HeadBlockNumber returns the number of the head block of the execution
+	// client.
+	HeadBlockNumber(ctx context.Context) (math.U64, error)
+	// SyncProgress returns the progress of the execution client syncing its
+	// chain, which is nil if it is not syncing.
+	SyncProgress(
+		ctx context.Context,
+	) (*engineprimitives.SyncProgress, error)
+	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
+	// update.
+	NotifyForkchoiceUpdate(
+		ctx context.Context,
+		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
+	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
+}
+
+// ExecutionPayload is the interface for an execution payload.
+type ExecutionPayload interface {
+	constraints.Nillable
+	constraints.Versionable
+	// GetBlockHash returns the hash of the payload.
+	GetBlockHash() common.ExecutionHash
+	// GetParentHash returns the hash of the parent of the payload.
+	GetParentHash() common.ExecutionHash
+	// GetNumber returns the block number of the payload.
+	GetNumber() math.U64
+}
+
+// StateProvider is the interface for reading the committed beacon state.
+type StateProvider[BeaconStateT any] interface {
+	// CommittedState returns the state committed at the given slot, a slot
+	// of 0 referring to the latest one.
+	CommittedState(slot math.Slot) (BeaconStateT, error)
+}
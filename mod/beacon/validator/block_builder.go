@@ -35,17 +35,20 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-// buildBlockAndSidecars builds a new beacon block.
+// buildBlockAndSidecars builds a new signed beacon block along with its blob
+// sidecars or, once PeerDAS is active, its data column sidecars.
 func (s *Service[
-	AttestationDataT, BeaconBlockT, _, _,
-	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, _, BlobSidecarsT, DataColumnSidecarsT,
+	_, _, _, _, _, _, SignedBeaconBlockT, SlashingInfoT, SlotDataT,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
-) (BeaconBlockT, BlobSidecarsT, error) {
+) (SignedBeaconBlockT, BlobSidecarsT, DataColumnSidecarsT, error) {
 	var (
 		blk       BeaconBlockT
+		signedBlk SignedBeaconBlockT
 		sidecars  BlobSidecarsT
+		columns   DataColumnSidecarsT
 		startTime = time.Now()
 		g, _      = errgroup.WithContext(ctx)
 	)
@@ -65,14 +68,14 @@ func (s *Service[
 		st,
 		slotData.GetSlot(),
 	); err != nil {
-		return blk, sidecars, err
+		return signedBlk, sidecars, columns, err
 	}
 
 	// Build the reveal for the current slot.
 	// TODO: We can optimize to pre-compute this in parallel?
 	reveal, err := s.buildRandaoReveal(st, slotData.GetSlot())
 	if err != nil {
-		return blk, sidecars, err
+		return signedBlk, sidecars, columns, err
 	}
 
 	// Create a new empty block from the current state.
@@ -80,15 +83,15 @@ func (s *Service[
 		st, slotData.GetSlot(),
 	)
 	if err != nil {
-		return blk, sidecars, err
+		return signedBlk, sidecars, columns, err
 	}
 
 	// Get the payload for the block.
 	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
 	if err != nil {
-		return blk, sidecars, err
+		return signedBlk, sidecars, columns, err
 	} else if envelope == nil {
-		return blk, sidecars, ErrNilPayload
+		return signedBlk, sidecars, columns, ErrNilPayload
 	}
 
 	// We have to assemble the block body prior to producing the sidecars
@@ -96,16 +99,23 @@ func (s *Service[
 	if err = s.buildBlockBody(
 		ctx, st, blk, reveal, envelope, slotData,
 	); err != nil {
-		return blk, sidecars, err
+		return signedBlk, sidecars, columns, err
 	}
 
 	// Produce blob sidecars, we produce them in parallel to computing the state
-	// root as an optimization.
+	// root as an optimization. Once PeerDAS is active, the blobs are
+	// distributed as data column sidecars instead.
 	//
 	// TODO: Figure out a clean way to break "BlockAndSidecars" into two
 	// functions
 	// without giving up the parallelization benefits.
 	g.Go(func() error {
+		if s.chainSpec.PeerDASActiveForSlot(slotData.GetSlot()) {
+			columns, err = s.blobFactory.BuildDataColumnSidecars(
+				blk, envelope.GetBlobsBundle(),
+			)
+			return err
+		}
 		sidecars, err = s.blobFactory.BuildSidecars(
 			blk, envelope.GetBlobsBundle(),
 		)
@@ -119,7 +129,12 @@ func (s *Service[
 
 	// Wait for all the goroutines to finish.
 	if err = g.Wait(); err != nil {
-		return blk, sidecars, err
+		return signedBlk, sidecars, columns, err
+	}
+
+	// Sign the block now that its state root is set.
+	if signedBlk, err = s.signBeaconBlock(st, blk); err != nil {
+		return signedBlk, sidecars, columns, err
 	}
 
 	s.logger.Info(
@@ -129,12 +144,12 @@ func (s *Service[
 		"duration", time.Since(startTime).String(),
 	)
 
-	return blk, sidecars, nil
+	return signedBlk, sidecars, columns, nil
 }
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -172,7 +187,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, ForkDataT, _, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -198,10 +213,44 @@ func (s *Service[
 	return s.signer.Sign(signingRoot[:])
 }
 
+// signBeaconBlock signs the block with the proposer domain of its epoch.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, ForkDataT,
+	SignedBeaconBlockT, _, _,
+]) signBeaconBlock(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (SignedBeaconBlockT, error) {
+	var (
+		forkData  ForkDataT
+		signedBlk SignedBeaconBlockT
+		epoch     = s.chainSpec.SlotToEpoch(blk.GetSlot())
+	)
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return signedBlk, err
+	}
+
+	signingRoot := forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	).ComputeBlockSigningRoot(
+		s.chainSpec.DomainTypeProposer(),
+		blk.HashTreeRoot(),
+	)
+	signature, err := s.signer.Sign(signingRoot[:])
+	if err != nil {
+		return signedBlk, err
+	}
+	return signedBlk.New(blk, signature), nil
+}
+
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, _, _, _, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
@@ -257,8 +306,8 @@ func (s *Service[
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	AttestationDataT, BeaconBlockT, _, BeaconStateT, _,
-	_, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, _, _, _, _, Eth1DataT,
+	ExecutionPayloadT, _, _, _, SlashingInfoT, SlotDataT,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
@@ -319,13 +368,25 @@ func (s *Service[
 		epoch,
 	)
 	if activeForkVersion >= version.DenebPlus {
-		// Set the attestations on the block body.
-		body.SetAttestations(slotData.GetAttestationData())
-
 		// Set the slashing info on the block body.
 		body.SetSlashingInfo(slotData.GetSlashingInfo())
 	}
 
+	// Set the requests emitted by the execution layer and the attestations
+	// aggregated from the vote extensions of the last commit on the block
+	// body.
+	if activeForkVersion >= version.Electra {
+		body.SetAttestations(slotData.GetAttestationData())
+
+		var requests *engineprimitives.ExecutionRequests
+		if requests, err = engineprimitives.DecodeExecutionRequests(
+			envelope.GetExecutionRequests(),
+		); err != nil {
+			return err
+		}
+		body.SetExecutionRequests(requests)
+	}
+
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
 	return nil
 }
@@ -333,7 +394,7 @@ func (s *Service[
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -354,7 +415,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -372,6 +433,7 @@ func (s *Service[
 			SkipPayloadVerification: true,
 			SkipValidateResult:      true,
 			SkipValidateRandao:      true,
+			CommitVoters:            transition.CommitVotersFromContext(ctx),
 		},
 		st, blk,
 	); err != nil {
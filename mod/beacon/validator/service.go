@@ -43,12 +43,14 @@ type Service[
 	],
 	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
 	BlobSidecarsT,
+	DataColumnSidecarsT,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT, SignedBeaconBlockT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
 ] struct {
@@ -63,7 +65,8 @@ type Service[
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
-		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		DataColumnSidecarsT, DepositT, Eth1DataT, ExecutionPayloadT,
+		SlashingInfoT,
 	]
 	// bsb is the beacon state backend.
 	bsb StorageBackend[
@@ -84,12 +87,17 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// syncMonitor reports whether the execution client is in sync with the
+	// beacon chain, which is required to build on top of it.
+	syncMonitor SyncMonitor
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
-	// blkBroker is a publisher for blocks.
-	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]]
+	// blkBroker is a publisher for signed blocks.
+	blkBroker EventPublisher[*asynctypes.Event[SignedBeaconBlockT]]
 	// sidecarBroker is a publisher for sidecars.
 	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]]
+	// columnBroker is a publisher for data column sidecars.
+	columnBroker EventPublisher[*asynctypes.Event[DataColumnSidecarsT]]
 	// newSlotSub is a feed for slots.
 	newSlotSub chan *asynctypes.Event[SlotDataT]
 }
@@ -106,12 +114,14 @@ func NewService[
 	],
 	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
 	BlobSidecarsT,
+	DataColumnSidecarsT,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT, SignedBeaconBlockT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
 ](
@@ -130,23 +140,28 @@ func NewService[
 	signer crypto.BLSSigner,
 	blobFactory BlobFactory[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
-		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		DataColumnSidecarsT, DepositT, Eth1DataT, ExecutionPayloadT,
+		SlashingInfoT,
 	],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	syncMonitor SyncMonitor,
 	ts TelemetrySink,
-	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]],
+	blkBroker EventPublisher[*asynctypes.Event[SignedBeaconBlockT]],
 	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]],
+	columnBroker EventPublisher[*asynctypes.Event[DataColumnSidecarsT]],
 	newSlotSub chan *asynctypes.Event[SlotDataT],
 ) *Service[
 	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
-	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	BlobSidecarsT, DataColumnSidecarsT, DepositT, DepositStoreT, Eth1DataT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SignedBeaconBlockT,
+	SlashingInfoT, SlotDataT,
 ] {
 	return &Service[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
-		BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+		BlobSidecarsT, DataColumnSidecarsT, DepositT, DepositStoreT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT,
+		SignedBeaconBlockT, SlashingInfoT, SlotDataT,
 	]{
 		cfg:                   cfg,
 		logger:                logger,
@@ -157,23 +172,25 @@ func NewService[
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		syncMonitor:           syncMonitor,
 		metrics:               newValidatorMetrics(ts),
 		blkBroker:             blkBroker,
 		sidecarBroker:         sidecarBroker,
+		columnBroker:          columnBroker,
 		newSlotSub:            newSlotSub,
 	}
 }
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
 
 // Start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
@@ -183,7 +200,7 @@ func (s *Service[
 
 // start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) start(
 	ctx context.Context,
 ) {
@@ -201,12 +218,27 @@ func (s *Service[
 
 // handleBlockRequest handles a block request.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, SlotDataT,
+	_, _, _, _, BlobSidecarsT, DataColumnSidecarsT, _, _, _, _, _, _,
+	SignedBeaconBlockT, _, SlotDataT,
 ]) handleNewSlot(msg *asynctypes.Event[SlotDataT]) {
-	blk, sidecars, err := s.buildBlockAndSidecars(
-		msg.Context(), msg.Data(),
+	var (
+		blk      SignedBeaconBlockT
+		sidecars BlobSidecarsT
+		columns  DataColumnSidecarsT
+		err      = s.syncMonitor.Ready()
 	)
+
+	// Building on top of an execution client that is not in sync with the
+	// beacon chain fails, so we skip the proposal with the reason instead.
 	if err != nil {
+		s.logger.Warn(
+			"Skipping block proposal - execution client is not in sync",
+			"slot", msg.Data().GetSlot().Base10(),
+			"reason", err,
+		)
+	} else if blk, sidecars, columns, err = s.buildBlockAndSidecars(
+		msg.Context(), msg.Data(),
+	); err != nil {
 		s.logger.Error("failed to build block", "err", err)
 	}
 
@@ -220,6 +252,21 @@ func (s *Service[
 		s.logger.Error("failed to publish block", "err", err)
 	}
 
+	// Once PeerDAS is active the blobs travel as data columns, so publish
+	// those in place of the blob sidecars.
+	if s.chainSpec.PeerDASActiveForSlot(msg.Data().GetSlot()) {
+		if columnsErr := s.columnBroker.Publish(
+			msg.Context(),
+			asynctypes.NewEvent(
+				// Propagate the error from buildBlockAndSidecars
+				msg.Context(), events.DataColumnsBuilt, columns, err,
+			),
+		); columnsErr != nil {
+			s.logger.Error("failed to publish data columns", "err", err)
+		}
+		return
+	}
+
 	// Publish our built blobs to the broker.
 	if sidecarsErr := s.sidecarBroker.Publish(
 		msg.Context(),
@@ -45,7 +45,7 @@ type BeaconBlock[
 	ExecutionPayloadT,
 	SlashingInfoT any,
 ] interface {
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	// NewWithVersion creates a new beacon block with the given parameters.
 	NewWithVersion(
 		slot math.Slot,
@@ -88,6 +88,9 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetExecutionRequests sets the execution requests of the beacon block
+	// body.
+	SetExecutionRequests(*engineprimitives.ExecutionRequests)
 }
 
 // BeaconState represents a beacon state interface.
@@ -123,6 +126,7 @@ type BlobFactory[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
 	BlobSidecarsT,
+	DataColumnSidecarsT,
 	DepositT,
 	Eth1DataT,
 	ExecutionPayloadT,
@@ -133,6 +137,12 @@ type BlobFactory[
 		blk BeaconBlockT,
 		blobs engineprimitives.BlobsBundle,
 	) (BlobSidecarsT, error)
+	// BuildDataColumnSidecars builds the data column sidecars for a given
+	// block and blobs bundle.
+	BuildDataColumnSidecars(
+		blk BeaconBlockT,
+		blobs engineprimitives.BlobsBundle,
+	) (DataColumnSidecarsT, error)
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -185,6 +195,12 @@ type ForkData[T any] interface {
 		common.DomainType,
 		math.Epoch,
 	) common.Root
+	// ComputeBlockSigningRoot computes the signing root of a beacon block
+	// from its root.
+	ComputeBlockSigningRoot(
+		common.DomainType,
+		common.Root,
+	) common.Root
 }
 
 // PayloadBuilder represents a service that is responsible for
@@ -209,6 +225,13 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
+// SignedBeaconBlock represents a signed beacon block interface.
+type SignedBeaconBlock[BeaconBlockT, T any] interface {
+	// New creates a new signed beacon block from a block and the signature
+	// of its proposer.
+	New(BeaconBlockT, crypto.BLSSignature) T
+}
+
 // SlotData represents the slot data interface.
 type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	// GetSlot returns the slot of the incoming slot.
@@ -251,6 +274,13 @@ type StorageBackend[
 	StateFromContext(context.Context) BeaconStateT
 }
 
+// SyncMonitor is the interface for the monitor of the execution client sync.
+type SyncMonitor interface {
+	// Ready returns nil if the execution client is in sync with the beacon
+	// chain, or an error describing why it is not.
+	Ready() error
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -108,6 +108,9 @@ type Spec[
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// PeerDASForkEpoch returns the epoch at which data column sidecars take
+	// effect.
+	PeerDASForkEpoch() EpochT
 
 	// State list lengths
 
@@ -125,11 +128,27 @@ type Spec[
 	// registry.
 	ValidatorRegistryLimit() uint64
 
+	// MaxActiveValidators returns the maximum number of validators, ranked by
+	// effective balance, that are part of the CometBFT validator set.
+	MaxActiveValidators() uint64
+
 	// Rewards and Penalties
 
+	// BaseRewardFactor returns the factor used to compute the base reward of
+	// a validator.
+	BaseRewardFactor() uint64
+
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
 	InactivityPenaltyQuotient() uint64
 
+	// InactivityScoreBias returns the increase of the inactivity score of a
+	// validator for each missed epoch.
+	InactivityScoreBias() uint64
+
+	// InactivityScoreRecoveryRate returns the decrease of the inactivity score
+	// of a validator for each epoch it participates in.
+	InactivityScoreRecoveryRate() uint64
+
 	// ProportionalSlashingMultiplier returns the multiplier for calculating
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
@@ -163,6 +182,30 @@ type Spec[
 	// BytesPerBlob returns the number of bytes per blob.
 	BytesPerBlob() uint64
 
+	// PeerDAS Values
+
+	// CustodyRequirement returns the minimum number of data columns a node
+	// custodies.
+	CustodyRequirement() uint64
+
+	// SamplesPerSlot returns the number of data columns a node samples per
+	// slot.
+	SamplesPerSlot() uint64
+
+	// Electra Values
+
+	// MinPerEpochChurnLimitElectra returns the minimum balance churn per
+	// epoch.
+	MinPerEpochChurnLimitElectra() uint64
+
+	// MaxPerEpochActivationExitChurnLimit returns the maximum balance that
+	// can activate or exit per epoch.
+	MaxPerEpochActivationExitChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the quotient of the total active balance
+	// that can churn per epoch.
+	ChurnLimitQuotient() uint64
+
 	// Helpers for ChainSpecData
 
 	// ActiveForkVersionForSlot returns the active fork version for a given
@@ -173,6 +216,10 @@ type Spec[
 	// epoch.
 	ActiveForkVersionForEpoch(epoch EpochT) uint32
 
+	// PeerDASActiveForSlot returns whether data column sidecars are in use
+	// for a given slot.
+	PeerDASActiveForSlot(slot SlotT) bool
+
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot SlotT) EpochT
 
@@ -374,6 +421,13 @@ func (c chainSpec[
 	return c.Data.ElectraForkEpoch
 }
 
+// PeerDASForkEpoch returns the epoch of the PeerDAS fork.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) PeerDASForkEpoch() EpochT {
+	return c.Data.PeerDASForkEpoch
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -402,6 +456,20 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// MaxActiveValidators returns the maximum number of active validators.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxActiveValidators() uint64 {
+	return c.Data.MaxActiveValidators
+}
+
+// BaseRewardFactor returns the base reward factor.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BaseRewardFactor() uint64 {
+	return c.Data.BaseRewardFactor
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -409,6 +477,20 @@ func (c chainSpec[
 	return c.Data.InactivityPenaltyQuotient
 }
 
+// InactivityScoreBias returns the inactivity score bias.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) InactivityScoreBias() uint64 {
+	return c.Data.InactivityScoreBias
+}
+
+// InactivityScoreRecoveryRate returns the inactivity score recovery rate.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) InactivityScoreRecoveryRate() uint64 {
+	return c.Data.InactivityScoreRecoveryRate
+}
+
 // ProportionalSlashingMultiplier returns the proportional slashing multiplier.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -469,6 +551,44 @@ func (c chainSpec[
 	return c.Data.BytesPerBlob
 }
 
+// CustodyRequirement returns the minimum number of data columns a node
+// custodies.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) CustodyRequirement() uint64 {
+	return c.Data.CustodyRequirement
+}
+
+// SamplesPerSlot returns the number of data columns a node samples per slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SamplesPerSlot() uint64 {
+	return c.Data.SamplesPerSlot
+}
+
+// MinPerEpochChurnLimitElectra returns the minimum balance churn per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimitElectra() uint64 {
+	return c.Data.MinPerEpochChurnLimitElectra
+}
+
+// MaxPerEpochActivationExitChurnLimit returns the maximum balance that can
+// activate or exit per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxPerEpochActivationExitChurnLimit() uint64 {
+	return c.Data.MaxPerEpochActivationExitChurnLimit
+}
+
+// ChurnLimitQuotient returns the quotient of the total active balance that
+// can churn per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
 // GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 // slot.
 func (c chainSpec[
@@ -95,6 +95,9 @@ type SpecData[
 	DenebPlusForkEpoch EpochT `mapstructure:"deneb-plus-fork-epoch"`
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// PeerDASForkEpoch is the epoch from which blobs are distributed as
+	// erasure coded data column sidecars.
+	PeerDASForkEpoch EpochT `mapstructure:"peerdas-fork-epoch"`
 
 	// State list lengths
 	//
@@ -108,11 +111,23 @@ type SpecData[
 	// ValidatorRegistryLimit is the maximum number of validators in the
 	// registry.
 	ValidatorRegistryLimit uint64 `mapstructure:"validator-registry-limit"`
+	// MaxActiveValidators is the maximum number of validators, ranked by
+	// effective balance, that are part of the CometBFT validator set.
+	MaxActiveValidators uint64 `mapstructure:"max-active-validators"`
 
 	// Rewards and penalties constants.
 	//
+	// BaseRewardFactor is the factor used to compute the base reward of a
+	// validator from its effective balance.
+	BaseRewardFactor uint64 `mapstructure:"base-reward-factor"`
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
 	InactivityPenaltyQuotient uint64 `mapstructure:"inactivity-penalty-quotient"`
+	// InactivityScoreBias is the increase of the inactivity score of a
+	// validator for each epoch it misses.
+	InactivityScoreBias uint64 `mapstructure:"inactivity-score-bias"`
+	// InactivityScoreRecoveryRate is the decrease of the inactivity score of
+	// a validator for each epoch it participates in.
+	InactivityScoreRecoveryRate uint64 `mapstructure:"inactivity-score-recovery-rate"`
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
@@ -144,6 +159,25 @@ type SpecData[
 	// KZGCommitmentInclusionProofDepth is the depth of the KZG inclusion proof.
 	KZGCommitmentInclusionProofDepth uint64 `mapstructure:"kzg-commitment-inclusion-proof-depth"`
 
+	// PeerDAS Values
+	//
+	// CustodyRequirement is the minimum number of data columns a node
+	// custodies.
+	CustodyRequirement uint64 `mapstructure:"custody-requirement"`
+	// SamplesPerSlot is the number of data columns a node samples per slot.
+	SamplesPerSlot uint64 `mapstructure:"samples-per-slot"`
+
+	// Electra Values
+	//
+	// MinPerEpochChurnLimitElectra is the minimum balance churn per epoch.
+	MinPerEpochChurnLimitElectra uint64 `mapstructure:"min-per-epoch-churn-limit-electra"`
+	// MaxPerEpochActivationExitChurnLimit is the maximum balance that can
+	// activate or exit per epoch.
+	MaxPerEpochActivationExitChurnLimit uint64 `mapstructure:"max-per-epoch-activation-exit-churn-limit"`
+	// ChurnLimitQuotient is the quotient of the total active balance that
+	// can churn per epoch.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
 }
@@ -48,6 +48,16 @@ func (c chainSpec[
 	return version.Deneb
 }
 
+// PeerDASActiveForSlot returns whether blobs are distributed as data column
+// sidecars at the given slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) PeerDASActiveForSlot(
+	slot SlotT,
+) bool {
+	return c.SlotToEpoch(slot) >= c.Data.PeerDASForkEpoch
+}
+
 // SlotToEpoch converts a slot to an epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
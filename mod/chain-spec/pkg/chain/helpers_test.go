@@ -44,6 +44,7 @@ var spec = chain.NewChainSpec(
 	]{
 		DenebPlusForkEpoch:               9,
 		ElectraForkEpoch:                 10,
+		PeerDASForkEpoch:                 12,
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
 	},
@@ -123,6 +124,29 @@ func TestActiveForkVersionForSlot(t *testing.T) {
 	}
 }
 
+// TestPeerDASActiveForSlot tests the PeerDASActiveForSlot method.
+func TestPeerDASActiveForSlot(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		slot     slot
+		expected bool
+	}{
+		{name: "Before PeerDAS Fork", slot: 0, expected: false},
+		{name: "Just Before PeerDAS Fork", slot: 383, expected: false},
+		{name: "At PeerDAS Fork", slot: 384, expected: true},
+		{name: "After PeerDAS Fork", slot: 640, expected: true},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.PeerDASActiveForSlot(tt.slot)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
 // TestWithinDAPeriod tests the WithinDAPeriod method.
 func TestWithinDAPeriod(t *testing.T) {
 	// Define test cases
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// eraAnchor checks the state of the given era against trusted data, so that
+// the block roots of the state can be trusted in turn.
+type eraAnchor func(
+	number uint64, st *components.BeaconStateMarshallable,
+) error
+
+// newStateRootAnchor returns an anchor that accepts the era state of which
+// the hash tree root is the given hex encoded root.
+func newStateRootAnchor(trustedRoot string) (eraAnchor, error) {
+	root, err := common.NewRootFromHex(trustedRoot)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidTrustedStateRoot, "%v", err)
+	}
+	return func(_ uint64, st *components.BeaconStateMarshallable) error {
+		if htr := st.HashTreeRoot(); htr != root {
+			return errors.Wrapf(
+				ErrUntrustedEraState,
+				"state root %s, trusted %s", htr, root,
+			)
+		}
+		return nil
+	}, nil
+}
+
+// newSummariesAnchor returns an anchor that accepts the era states of which
+// the block and state roots match the historical summaries of the latest
+// state committed by the node at the given home directory. The summary of
+// era n is appended at the end of its last slot, hence is the n-th one as
+// summaries are tracked from genesis.
+func newSummariesAnchor(
+	cs common.ChainSpec,
+	home string,
+	backend dbm.BackendType,
+) (eraAnchor, error) {
+	states, err := openStateStore(home, backend, cs)
+	if err != nil {
+		return nil, err
+	}
+	defer states.Close()
+
+	if states.LatestSlot() == 0 {
+		return nil, errors.Wrap(
+			ErrUntrustedEraState,
+			"no committed state, use the --"+trustedStateRoot+" flag",
+		)
+	}
+	latest, err := states.StateAtSlot(states.LatestSlot())
+	if err != nil {
+		return nil, err
+	}
+	summaries := latest.HistoricalSummaries
+
+	return func(number uint64, st *components.BeaconStateMarshallable) error {
+		if number == 0 || number > uint64(len(summaries)) {
+			return errors.Wrapf(
+				ErrUntrustedEraState,
+				"era %d is beyond the %d eras of the latest committed state",
+				number, len(summaries),
+			)
+		}
+		summary := summaries[number-1]
+
+		blockSummaryRoot, rootErr := historicalRootsRoot(cs, st.BlockRoots)
+		if rootErr != nil {
+			return rootErr
+		}
+		stateSummaryRoot, rootErr := historicalRootsRoot(cs, st.StateRoots)
+		if rootErr != nil {
+			return rootErr
+		}
+		if blockSummaryRoot != summary.BlockSummaryRoot ||
+			stateSummaryRoot != summary.StateSummaryRoot {
+			return errors.Wrapf(
+				ErrUntrustedEraState,
+				"historical summary mismatch for era %d", number,
+			)
+		}
+		return nil
+	}, nil
+}
+
+// historicalRootsRoot returns the hash tree root of the vector of
+// SlotsPerHistoricalRoot roots, as recorded in a historical summary.
+func historicalRootsRoot(
+	cs common.ChainSpec,
+	roots []common.Root,
+) (common.Root, error) {
+	tree, err := merkle.NewTreeWithMaxLeaves(
+		roots, cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.Root(), nil
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidEraRange is returned when the range of eras to export is
+	// empty or beyond the latest committed era.
+	ErrInvalidEraRange = errors.New("invalid era range")
+
+	// ErrBlockNotFound is returned when a block of an era to export is not in
+	// the block store, e.g. because it was pruned.
+	ErrBlockNotFound = errors.New("block not found")
+
+	// ErrInvalidEraState is returned when the state of an era file is not at
+	// the start of an era.
+	ErrInvalidEraState = errors.New("invalid era state")
+
+	// ErrBlockRootMismatch is returned when a block of an era file does not
+	// match the block root of its slot in the era state.
+	ErrBlockRootMismatch = errors.New("block root mismatch")
+
+	// ErrUntrustedEraState is returned when the state of an era file can not
+	// be anchored to trusted data.
+	ErrUntrustedEraState = errors.New("untrusted era state")
+
+	// ErrInvalidTrustedStateRoot is returned when the trusted state root is
+	// malformed or used to import several era files.
+	ErrInvalidTrustedStateRoot = errors.New("invalid trusted state root")
+)
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/era"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// NewExportCommand creates a new command for exporting the blocks and states
+// of past eras to era files.
+func NewExportCommand(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the blocks and states of past eras to era files",
+		Long: `This command writes an era file for each era of the range, from the
+blocks of the block store and the state committed at the start of the next
+era. The blocks of the exported eras must not have been pruned, nor the states
+they end with.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := context.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			start, err := cmd.Flags().GetUint64(startEra)
+			if err != nil {
+				return err
+			}
+			end, err := cmd.Flags().GetUint64(endEra)
+			if err != nil {
+				return err
+			}
+			networkName, err := cmd.Flags().GetString(network)
+			if err != nil {
+				return err
+			}
+			dir, err := cmd.Flags().GetString(outputDir)
+			if err != nil {
+				return err
+			}
+			if dir == "" {
+				dir = filepath.Join(home, "era")
+			}
+			//#nosec:G301 // era files are public.
+			if err = os.MkdirAll(dir, 0o755); err != nil {
+				return err
+			}
+
+			blocks, err := openBlockStore(home)
+			if err != nil {
+				return err
+			}
+			defer blocks.Close()
+			states, err := openStateStore(
+				home, server.GetAppDBBackend(serverCtx.Viper), cs,
+			)
+			if err != nil {
+				return err
+			}
+			defer states.Close()
+
+			// The state of an era is committed at the start of the next one.
+			latest := states.LatestSlot().Unwrap() / cs.SlotsPerHistoricalRoot()
+			if end == defaultEndEra {
+				end = latest
+			}
+			if start > end || end > latest {
+				return errors.Wrapf(
+					ErrInvalidEraRange,
+					"[%d, %d] with latest era %d", start, end, latest,
+				)
+			}
+
+			for number := start; number <= end; number++ {
+				path, exportErr := exportEra(
+					cs, blocks, states, number, dir, networkName,
+				)
+				if exportErr != nil {
+					return errors.Wrapf(exportErr, "era %d", number)
+				}
+				cmd.Printf("Exported era %d to %s\n", number, path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(startEra, defaultStartEra, startEraMsg)
+	cmd.Flags().Uint64(endEra, defaultEndEra, endEraMsg)
+	cmd.Flags().String(network, defaultNetwork, networkMsg)
+	cmd.Flags().String(outputDir, defaultOutputDir, outputDirMsg)
+
+	return cmd
+}
+
+// exportEra writes the era file of the given era to the given directory,
+// returning its path.
+func exportEra(
+	cs common.ChainSpec,
+	blocks *blockStore,
+	states *stateStore,
+	number uint64,
+	dir string,
+	networkName string,
+) (string, error) {
+	st, err := states.StateAtSlot(
+		era.StateSlot(number, cs.SlotsPerHistoricalRoot()),
+	)
+	if err != nil {
+		return "", err
+	}
+	stateBz, err := st.MarshalSSZ()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(
+		dir, era.FileName(networkName, number, shortHistoricalRoot(st)),
+	)
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	w, err := era.NewWriter(f, number, cs.SlotsPerHistoricalRoot())
+	if err != nil {
+		return "", err
+	}
+	for slot := era.StartSlot(number, cs.SlotsPerHistoricalRoot()); slot <
+		era.StateSlot(number, cs.SlotsPerHistoricalRoot()); slot++ {
+		// The genesis slot has no block.
+		if slot == 0 {
+			continue
+		}
+		blk, getErr := blocks.Get(slot)
+		if getErr != nil {
+			return "", errors.Wrapf(
+				ErrBlockNotFound, "slot %d: %v", slot, getErr,
+			)
+		}
+		bz, marshalErr := blk.MarshalSSZ()
+		if marshalErr != nil {
+			return "", marshalErr
+		}
+		if err = w.WriteBlock(slot, bz); err != nil {
+			return "", err
+		}
+	}
+	if err = w.Finalize(stateBz); err != nil {
+		return "", err
+	}
+	return path, f.Sync()
+}
+
+// shortHistoricalRoot returns the root the name of the era file of the given
+// state is derived from: the root of the last historical summary, or the
+// genesis validators root before the first one.
+func shortHistoricalRoot(st *components.BeaconStateMarshallable) common.Root {
+	if len(st.HistoricalSummaries) == 0 {
+		return st.GenesisValidatorsRoot
+	}
+	return st.HistoricalSummaries[len(st.HistoricalSummaries)-1].HashTreeRoot()
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+const (
+	// startEra is the flag for the first era to export.
+	startEra = "start-era"
+
+	// endEra is the flag for the last era to export.
+	endEra = "end-era"
+
+	// network is the flag for the network name of the era files.
+	network = "network"
+
+	// outputDir is the flag for the directory the era files are written to.
+	outputDir = "output-dir"
+
+	// trustedStateRoot is the flag for the trusted hash tree root of the
+	// state of the era file to import.
+	trustedStateRoot = "trusted-state-root"
+)
+
+const (
+	// defaultStartEra is the default value for the startEra flag, the genesis
+	// era being skipped as the genesis state is not committed.
+	defaultStartEra = 1
+
+	// defaultEndEra is the default value for the endEra flag, which exports
+	// up to the latest era of which the state is committed.
+	defaultEndEra = 0
+
+	// defaultNetwork is the default value for the network flag.
+	defaultNetwork = "beacond"
+
+	// defaultOutputDir is the default value for the outputDir flag, which
+	// writes the era files to the era directory of the node home.
+	defaultOutputDir = ""
+
+	// defaultTrustedStateRoot is the default value for the trustedStateRoot
+	// flag, which anchors era files to the latest committed state instead.
+	defaultTrustedStateRoot = ""
+)
+
+const (
+	// startEraMsg is the usage description for the startEra flag.
+	startEraMsg = "first era to export"
+
+	// endEraMsg is the usage description for the endEra flag.
+	endEraMsg = "last era to export, defaults to the latest committed era"
+
+	// networkMsg is the usage description for the network flag.
+	networkMsg = "network name used in the era file names"
+
+	// outputDirMsg is the usage description for the outputDir flag.
+	outputDirMsg = "directory to write the era files to, defaults to <home>/era"
+
+	// trustedStateRootMsg is the usage description for the trustedStateRoot
+	// flag.
+	trustedStateRootMsg = "trusted hash tree root of the state of the era " +
+		"file to import, defaults to checking the era against the " +
+		"historical summaries of the latest committed state"
+)
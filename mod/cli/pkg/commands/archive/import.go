@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/era"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// NewImportCommand creates a new command for importing the blocks of era
+// files into the block store.
+func NewImportCommand(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [era files...]",
+		Short: "Imports the blocks of era files into the block store",
+		Long: `This command writes the blocks of the given era files into the block
+store, after checking each of them against the block root of its slot in the
+state of its era.
+
+The state of an era file is itself checked against trusted data before any
+block is written: by default, the block and state roots of the era must match
+the historical summary of the era in the latest state committed by the node.
+A node without that history may instead import a single era file of which the
+state hash tree root matches the --trusted-state-root flag, obtained from a
+trusted source.
+
+Only the blocks are imported: the states of the era files are verified but not
+written into the state store. An era state is the SSZ beacon state, which lacks
+data the state store holds alongside it, such as the participation flags and
+inactivity scores used by the epoch rewards and the CometBFT voting powers, so
+it can not be restored as a state the node could serve or replay from.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := context.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			trustedRoot, err := cmd.Flags().GetString(trustedStateRoot)
+			if err != nil {
+				return err
+			}
+			var anchor eraAnchor
+			if trustedRoot != "" {
+				if len(args) != 1 {
+					return errors.Wrapf(
+						ErrInvalidTrustedStateRoot,
+						"a trusted state root anchors a single era file, "+
+							"got %d", len(args),
+					)
+				}
+				anchor, err = newStateRootAnchor(trustedRoot)
+			} else {
+				anchor, err = newSummariesAnchor(
+					cs, home, server.GetAppDBBackend(serverCtx.Viper),
+				)
+			}
+			if err != nil {
+				return err
+			}
+
+			blocks, err := openBlockStore(home)
+			if err != nil {
+				return err
+			}
+			defer blocks.Close()
+
+			for _, path := range args {
+				count, importErr := importEra(cs, blocks, anchor, path)
+				if importErr != nil {
+					return errors.Wrapf(importErr, "era file %s", path)
+				}
+				cmd.Printf("Imported %d blocks from %s\n", count, path)
+			}
+			cmd.Println(
+				"The era states were verified but not restored, only " +
+					"the states committed by the node can be served",
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		trustedStateRoot, defaultTrustedStateRoot, trustedStateRootMsg,
+	)
+
+	return cmd
+}
+
+// importEra writes the blocks of the era file at the given path into the
+// block store once its state is anchored, returning the number of imported
+// blocks. The state of the era file is only used to verify the blocks, it is
+// not complete enough to be written into the state store.
+func importEra(
+	cs common.ChainSpec,
+	blocks *blockStore,
+	anchor eraAnchor,
+	path string,
+) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	r, err := era.NewReader(f, info.Size())
+	if err != nil {
+		return 0, err
+	}
+	stateBz, err := r.State()
+	if err != nil {
+		return 0, err
+	}
+	st, err := new(components.BeaconStateMarshallable).NewFromSSZ(
+		stateBz, cs.ActiveForkVersionForSlot(r.StateSlot()),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if st.Slot != r.StateSlot() ||
+		st.Slot.Unwrap()%cs.SlotsPerHistoricalRoot() != 0 ||
+		uint64(len(st.BlockRoots)) != cs.SlotsPerHistoricalRoot() {
+		return 0, errors.Wrapf(ErrInvalidEraState, "slot %d", st.Slot)
+	}
+
+	// The genesis era holds no block to import.
+	slots := r.BlockSlots()
+	if len(slots) == 0 {
+		return 0, nil
+	}
+	number := st.Slot.Unwrap() / cs.SlotsPerHistoricalRoot()
+	if err = anchor(number, st); err != nil {
+		return 0, err
+	}
+
+	// The state of an era holds the roots of the blocks of the era, which
+	// are all checked before any of them is written.
+	blks := make([]*components.SignedBeaconBlock, len(slots))
+	for i, slot := range slots {
+		bz, blockErr := r.Block(slot)
+		if blockErr != nil {
+			return 0, blockErr
+		}
+		blks[i], err = blks[i].NewFromSSZ(
+			bz, cs.ActiveForkVersionForSlot(slot),
+		)
+		if err != nil {
+			return 0, err
+		}
+		root := st.BlockRoots[slot.Unwrap()%cs.SlotsPerHistoricalRoot()]
+		if blks[i].GetSlot() != slot || blks[i].GetBlockRoot() != root {
+			return 0, errors.Wrapf(ErrBlockRootMismatch, "slot %d", slot)
+		}
+	}
+
+	for i, slot := range slots {
+		if err = blocks.Set(slot, blks[i]); err != nil {
+			return 0, err
+		}
+	}
+	return len(slots), nil
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/core/appmodule"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockStore is the block store of the node along with its database.
+type blockStore struct {
+	*components.BlockStore
+	db corestore.KVStoreWithBatch
+}
+
+// openBlockStore opens the block store in the data directory of the node at
+// the given home directory.
+func openBlockStore(home string) (*blockStore, error) {
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "blocks", filepath.Join(home, "data"), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &blockStore{
+		BlockStore: block.NewStore[*components.SignedBeaconBlock](
+			storage.NewKVStoreProvider(db),
+		),
+		db: db,
+	}, nil
+}
+
+// Close closes the database of the block store.
+func (s *blockStore) Close() error {
+	return s.db.Close()
+}
+
+// stateStore reads the beacon states committed by the node at past heights
+// from its application database, a slot being committed at the height of the
+// same number.
+type stateStore struct {
+	db  dbm.DB
+	cms *rootmulti.Store
+	kvs *components.KVStore
+	cs  common.ChainSpec
+}
+
+// openStateStore opens the application database of the node at the given
+// home directory, loading the latest version of the beacon store only.
+func openStateStore(
+	home string,
+	backend dbm.BackendType,
+	cs common.ChainSpec,
+) (*stateStore, error) {
+	db, err := server.OpenDB(home, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	key := storetypes.NewKVStoreKey(beacon.ModuleName)
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	return &stateStore{
+		db:  db,
+		cms: cms,
+		kvs: components.ProvideKVStore(components.KVStoreInput{
+			Environment: appmodule.Environment{
+				KVStoreService: runtime.NewKVStoreService(key),
+			},
+		}),
+		cs: cs,
+	}, nil
+}
+
+// LatestSlot returns the slot of the latest committed state.
+func (s *stateStore) LatestSlot() math.Slot {
+	//#nosec:G115 // heights are positive.
+	return math.Slot(s.cms.LatestVersion())
+}
+
+// StateAtSlot returns the beacon state committed at the given slot.
+func (s *stateStore) StateAtSlot(
+	slot math.Slot,
+) (*components.BeaconStateMarshallable, error) {
+	//#nosec:G115 // slots are committed at the height of the same number.
+	ms, err := s.cms.CacheMultiStoreWithVersion(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+
+	var st *components.BeaconState
+	st = st.NewFromDB(
+		s.kvs.WithContext(sdk.NewContext(ms, false, log.NewNopLogger())),
+		s.cs,
+	)
+	return st.GetMarshallable()
+}
+
+// Close closes the application database.
+func (s *stateStore) Close() error {
+	return s.db.Close()
+}
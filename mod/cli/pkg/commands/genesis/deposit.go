@@ -87,14 +87,8 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				return err
 			}
 
-			// TODO: configurable.
-			currentVersion := version.FromUint32[common.Version](
-				version.Deneb,
-			)
-
-			depositMsg, signature, err := types.CreateAndSignDepositMessage(
-				types.NewForkData(currentVersion, common.Root{}),
-				cs.DomainTypeDeposit(),
+			deposit, err := newGenesisDeposit(
+				cs,
 				blsSigner,
 				// TODO: configurable.
 				types.NewCredentialsFromExecutionAddress(
@@ -106,23 +100,6 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				return err
 			}
 
-			// Verify the deposit message.
-			if err = depositMsg.VerifyCreateValidator(
-				types.NewForkData(currentVersion, common.Root{}),
-				signature,
-				cs.DomainTypeDeposit(),
-				signer.BLSSigner{}.VerifySignature,
-			); err != nil {
-				return err
-			}
-
-			deposit := types.Deposit{
-				Pubkey:      depositMsg.Pubkey,
-				Amount:      depositMsg.Amount,
-				Signature:   signature,
-				Credentials: depositMsg.Credentials,
-			}
-
 			//#nosec:G703 // Ignore errors on this line.
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 			if outputDocument == "" {
@@ -133,7 +110,7 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				}
 			}
 
-			if err = writeDepositToFile(outputDocument, &deposit); err != nil {
+			if err = writeDepositToFile(outputDocument, deposit); err != nil {
 				return errors.Wrap(err, "failed to write signed gen tx")
 			}
 
@@ -147,6 +124,48 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 	return cmd
 }
 
+// newGenesisDeposit creates, signs and verifies a premined deposit for the
+// given signer.
+func newGenesisDeposit(
+	cs common.ChainSpec,
+	blsSigner crypto.BLSSigner,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+) (*types.Deposit, error) {
+	// TODO: configurable.
+	currentVersion := version.FromUint32[common.Version](
+		version.Deneb,
+	)
+
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(currentVersion, common.Root{}),
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		credentials,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the deposit message.
+	if err = depositMsg.VerifyCreateValidator(
+		types.NewForkData(currentVersion, common.Root{}),
+		signature,
+		cs.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.Deposit{
+		Pubkey:      depositMsg.Pubkey,
+		Amount:      depositMsg.Amount,
+		Signature:   signature,
+		Credentials: depositMsg.Credentials,
+	}, nil
+}
+
 func makeOutputFilepath(rootDir, pubkey string) (string, error) {
 	writePath := filepath.Join(rootDir, "config", "premined-deposits")
 	if err := afero.NewOsFs().MkdirAll(writePath, os.ModePerm); err != nil {
@@ -25,3 +25,40 @@ const (
 	defaultDepositAmount = "32000000000" // 32e9
 	depositAmountFlagMsg = "The amount of deposit to be made"
 )
+
+const (
+	validatorsFlag    = "validators"
+	defaultValidators = 4
+	validatorsFlagMsg = "Number of validator keys to derive from the mnemonic"
+
+	validatorKeysFlag    = "validator-keys"
+	validatorKeysFlagMsg = `Comma separated list of hex encoded BLS secret
+	keys. Takes precedence over the mnemonic.`
+
+	mnemonicFlag    = "mnemonic"
+	mnemonicFlagMsg = `BIP-39 mnemonic to derive validator keys from. If
+	neither a mnemonic nor validator keys are provided, a new mnemonic is
+	generated.`
+
+	withdrawalAddressFlag    = "withdrawal-address"
+	defaultWithdrawalAddress = "0x0000000000000000000000000000000000000000"
+	withdrawalAddressFlagMsg = "Execution address used for the withdrawal " +
+		"credentials of every validator"
+
+	outputDirFlag    = "output-dir"
+	defaultOutputDir = "./.testnets"
+	outputDirFlagMsg = "Directory to write the node home directories to"
+
+	nodeDirPrefixFlag    = "node-dir-prefix"
+	defaultNodeDirPrefix = "node"
+	nodeDirPrefixFlagMsg = "Prefix of the per-node home directory names"
+
+	startingIPAddressFlag    = "starting-ip-address"
+	defaultStartingIPAddress = "192.168.0.1"
+	startingIPAddressFlagMsg = `Starting IP address of the nodes, incremented
+	for every node and used to build the persistent peers list.`
+
+	genesisTimeFlag    = "genesis-time"
+	genesisTimeFlagMsg = `Genesis time as a unix timestamp. Defaults to the
+	timestamp of the eth1 genesis block.`
+)
@@ -46,6 +46,7 @@ func Commands(
 		CollectGenesisDepositsCmd(),
 		AddExecutionPayloadCmd(cs),
 		GetGenesisValidatorRootCmd(cs),
+		InitNetworkCmd(cs),
 	)
 
 	// Add additional commands
@@ -0,0 +1,380 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// mnemonicEntropyBits is the entropy used when generating a new mnemonic.
+	mnemonicEntropyBits = 256
+	// p2pPort is the CometBFT p2p port used in the persistent peers list.
+	p2pPort = 26656
+)
+
+// InitNetworkCmd returns the cobra command to deterministically create the
+// genesis and the per-node home directories of a multi-validator network.
+func InitNetworkCmd(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-network [eth/genesis/file.json]",
+		Short: "creates the genesis and node home directories of a network",
+		Long: `Creates a complete multi-validator network from a set of
+		validator keys and an eth1 genesis file. The keys are either provided
+		directly or derived from a mnemonic along the EIP-2334 validator
+		paths. For every key a premined deposit is added to the beacon
+		genesis, the execution payload header is derived from the eth1
+		genesis and a node home directory containing the CometBFT genesis,
+		config and keys is written to the output directory.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keys, err := networkValidatorKeys(cmd)
+			if err != nil {
+				return err
+			}
+
+			appGenesis, validatorsRoot, err := buildNetworkGenesis(
+				cmd, cs, keys, args[0],
+			)
+			if err != nil {
+				return err
+			}
+
+			nodeIDs, err := writeNetworkNodes(cmd, appGenesis, keys)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("chain id: %s\n", appGenesis.ChainID)
+			cmd.Printf("genesis validators root: %s\n", validatorsRoot)
+			for i, nodeID := range nodeIDs {
+				cmd.Printf("validator %d: node id %s\n", i, nodeID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(validatorsFlag, defaultValidators, validatorsFlagMsg)
+	cmd.Flags().String(validatorKeysFlag, "", validatorKeysFlagMsg)
+	cmd.Flags().String(mnemonicFlag, "", mnemonicFlagMsg)
+	cmd.Flags().
+		String(depositAmountFlag, defaultDepositAmount, depositAmountFlagMsg)
+	cmd.Flags().String(
+		withdrawalAddressFlag, defaultWithdrawalAddress,
+		withdrawalAddressFlagMsg,
+	)
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id")
+	cmd.Flags().Int64(genesisTimeFlag, 0, genesisTimeFlagMsg)
+	cmd.Flags().String(outputDirFlag, defaultOutputDir, outputDirFlagMsg)
+	cmd.Flags().
+		String(nodeDirPrefixFlag, defaultNodeDirPrefix, nodeDirPrefixFlagMsg)
+	cmd.Flags().String(
+		startingIPAddressFlag, defaultStartingIPAddress,
+		startingIPAddressFlagMsg,
+	)
+	if err := cmd.MarkFlagRequired(flags.FlagChainID); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// networkValidatorKeys returns the validator keys of the network, either
+// parsed from the validator keys flag or derived from the mnemonic.
+func networkValidatorKeys(cmd *cobra.Command) ([]signer.LegacyKey, error) {
+	rawKeys, err := cmd.Flags().GetString(validatorKeysFlag)
+	if err != nil {
+		return nil, err
+	}
+	if rawKeys != "" {
+		split := strings.Split(rawKeys, ",")
+		keys := make([]signer.LegacyKey, len(split))
+		for i, rawKey := range split {
+			if keys[i], err = signer.LegacyKeyFromString(
+				strings.TrimPrefix(strings.TrimSpace(rawKey), "0x"),
+			); err != nil {
+				return nil, errors.Wrapf(err, "invalid validator key %d", i)
+			}
+		}
+		return keys, nil
+	}
+
+	numValidators, err := cmd.Flags().GetInt(validatorsFlag)
+	if err != nil {
+		return nil, err
+	}
+	if numValidators <= 0 {
+		return nil, ErrInvalidNumValidators
+	}
+
+	mnemonic, err := cmd.Flags().GetString(mnemonicFlag)
+	if err != nil {
+		return nil, err
+	}
+	if mnemonic == "" {
+		var entropy []byte
+		if entropy, err = bip39.NewEntropy(mnemonicEntropyBits); err != nil {
+			return nil, err
+		}
+		if mnemonic, err = bip39.NewMnemonic(entropy); err != nil {
+			return nil, err
+		}
+		cmd.Printf("generated mnemonic: %s\n", mnemonic)
+	}
+
+	keys := make([]signer.LegacyKey, numValidators)
+	for i := range keys {
+		if keys[i], err = signer.LegacyKeyFromMnemonic(
+			//#nosec:G115 // bounded by the number of validators.
+			mnemonic, "", signer.ValidatorSigningKeyPath(uint32(i)),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// buildNetworkGenesis builds the CometBFT genesis containing the beacon
+// genesis with a premined deposit for every key and the execution payload
+// header of the eth1 genesis. It also returns the genesis validators root.
+func buildNetworkGenesis(
+	cmd *cobra.Command,
+	cs common.ChainSpec,
+	keys []signer.LegacyKey,
+	ethGenesisPath string,
+) (*genutiltypes.AppGenesis, common.Root, error) {
+	depositAmountString, err := cmd.Flags().GetString(depositAmountFlag)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	depositAmount, err := parser.ConvertAmount(depositAmountString)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	withdrawalAddress, err := cmd.Flags().GetString(withdrawalAddressFlag)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	credentials := types.NewCredentialsFromExecutionAddress(
+		common.NewExecutionAddressFromHex(withdrawalAddress),
+	)
+
+	genesisInfo := types.DefaultGenesisDeneb()
+	for i, key := range keys {
+		var blsSigner *signer.LegacySigner
+		if blsSigner, err = signer.NewLegacySigner(key); err != nil {
+			return nil, common.Root{}, err
+		}
+
+		var deposit *types.Deposit
+		if deposit, err = newGenesisDeposit(
+			cs, blsSigner, credentials, depositAmount,
+		); err != nil {
+			return nil, common.Root{}, err
+		}
+		//#nosec:G701 // won't realistically overflow.
+		deposit.Index = uint64(i)
+		genesisInfo.Deposits = append(genesisInfo.Deposits, deposit)
+	}
+
+	if genesisInfo.ExecutionPayloadHeader, err =
+		executionPayloadHeaderFromEthGenesis(
+			ethGenesisPath,
+			version.ToUint32(genesisInfo.ForkVersion),
+			cs,
+		); err != nil {
+		return nil, common.Root{}, err
+	}
+
+	genesisTime, err := cmd.Flags().GetInt64(genesisTimeFlag)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	if genesisTime == 0 {
+		//#nosec:G115 // timestamps won't realistically overflow.
+		genesisTime = int64(genesisInfo.ExecutionPayloadHeader.Timestamp)
+	}
+
+	chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	beaconGenesis, err := json.Marshal(genesisInfo)
+	if err != nil {
+		return nil, common.Root{}, errors.Wrap(
+			err, "failed to marshal beacon genesis",
+		)
+	}
+	appState, err := json.MarshalIndent(
+		map[string]json.RawMessage{"beacon": beaconGenesis}, "", "  ",
+	)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	consensusParams := cmttypes.DefaultConsensusParams()
+	consensusParams.Validator.PubKeyTypes = []string{crypto.CometBLSType}
+	consensusParams.Feature.VoteExtensionsEnableHeight = 1
+
+	return &genutiltypes.AppGenesis{
+		AppName:       sdkversion.AppName,
+		AppVersion:    sdkversion.Version,
+		GenesisTime:   time.Unix(genesisTime, 0).UTC(),
+		ChainID:       chainID,
+		InitialHeight: 1,
+		AppState:      appState,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: consensusParams,
+		},
+	}, genesisValidatorsRoot(cs, genesisInfo.Deposits), nil
+}
+
+// writeNetworkNodes writes a home directory for every validator of the
+// network into the output directory and returns the node IDs. The node keys
+// are derived from the validator keys so that the output is deterministic.
+func writeNetworkNodes(
+	cmd *cobra.Command,
+	appGenesis *genutiltypes.AppGenesis,
+	keys []signer.LegacyKey,
+) ([]p2p.ID, error) {
+	outputDir, err := cmd.Flags().GetString(outputDirFlag)
+	if err != nil {
+		return nil, err
+	}
+	nodeDirPrefix, err := cmd.Flags().GetString(nodeDirPrefixFlag)
+	if err != nil {
+		return nil, err
+	}
+	startingIP, err := cmd.Flags().GetString(startingIPAddressFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeIDs := make([]p2p.ID, len(keys))
+	peers := make([]string, len(keys))
+	for i, key := range keys {
+		nodeKey := &p2p.NodeKey{PrivKey: ed25519.GenPrivKeyFromSecret(key[:])}
+		nodeIDs[i] = nodeKey.ID()
+
+		var ip string
+		if ip, err = nthIPAddress(startingIP, i); err != nil {
+			return nil, err
+		}
+		peers[i] = p2p.IDAddressString(
+			nodeIDs[i], fmt.Sprintf("%s:%d", ip, p2pPort),
+		)
+	}
+
+	baseConfig := context.GetServerContextFromCmd(cmd).Config
+	for i, key := range keys {
+		moniker := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeHome := filepath.Join(outputDir, moniker)
+		if _, err = os.Stat(nodeHome); !os.IsNotExist(err) {
+			return nil, errors.Newf("node home %q already exists", nodeHome)
+		}
+
+		// Copy the config so that nodes don't share the P2P settings.
+		config := *baseConfig
+		p2pConfig := *baseConfig.P2P
+		config.P2P = &p2pConfig
+		config.SetRoot(nodeHome)
+		config.Moniker = moniker
+		config.P2P.PersistentPeers = strings.Join(
+			append(append([]string{}, peers[:i]...), peers[i+1:]...), ",",
+		)
+		cmtcfg.EnsureRoot(nodeHome)
+		cmtcfg.WriteConfigFile(
+			filepath.Join(nodeHome, "config", "config.toml"), &config,
+		)
+
+		if err = writeNodeKeys(&config, key); err != nil {
+			return nil, err
+		}
+
+		if err = genutil.ExportGenesisFile(
+			appGenesis, config.GenesisFile(),
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to export genesis file")
+		}
+	}
+
+	return nodeIDs, nil
+}
+
+// writeNodeKeys writes the CometBFT private validator and node keys of a node.
+func writeNodeKeys(config *cmtcfg.Config, key signer.LegacyKey) error {
+	if err := signer.WritePrivValFiles(
+		key,
+		config.PrivValidatorKeyFile(),
+		config.PrivValidatorStateFile(),
+	); err != nil {
+		return err
+	}
+
+	nodeKey := &p2p.NodeKey{PrivKey: ed25519.GenPrivKeyFromSecret(key[:])}
+	return nodeKey.SaveAs(config.NodeKeyFile())
+}
+
+// nthIPAddress returns the IPv4 address n positions after the given one.
+func nthIPAddress(ip string, n int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", errors.Newf("%q is not a valid IPv4 address", ip)
+	}
+
+	ipv4 = append(net.IP{}, ipv4...)
+	for ; n > 0; n-- {
+		for j := len(ipv4) - 1; j >= 0; j-- {
+			ipv4[j]++
+			if ipv4[j] != 0 {
+				break
+			}
+		}
+	}
+	return ipv4.String(), nil
+}
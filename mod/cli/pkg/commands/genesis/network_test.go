@@ -0,0 +1,158 @@
+//go:build bls12381
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMnemonic = "abandon abandon abandon abandon abandon abandon " +
+		"abandon abandon abandon abandon abandon about"
+	ethGenesisFile = "../../../../../testing/files/eth-genesis.json"
+	rootPrefix     = "genesis validators root: "
+)
+
+// The private validator key files are written with the CometBFT BLS12-381
+// keys, which are only enabled by the bls12381 build tag.
+func TestInitNetworkCmd(t *testing.T) {
+	first, firstOut := initNetwork(t)
+	second, secondOut := initNetwork(t)
+
+	// The network is derived from the mnemonic alone.
+	require.Equal(t, firstOut, secondOut)
+	files := readTree(t, first)
+	require.Contains(t, files, filepath.Join("node0", "config", "genesis.json"))
+	require.Contains(t, files, filepath.Join("node2", "config", "node_key.json"))
+	require.Equal(t, files, readTree(t, second))
+
+	lines := strings.Split(strings.TrimSpace(firstOut), "\n")
+	require.Len(t, lines, 5)
+	require.Equal(t, "chain id: test-network", lines[0])
+	require.True(t, strings.HasPrefix(lines[1], rootPrefix))
+	for i, line := range lines[2:] {
+		require.Contains(t, line, "node id ", "validator %d", i)
+	}
+
+	// Each node has the genesis, and the validators root printed matches
+	// the one computed from it.
+	validatorsRoot := strings.TrimPrefix(lines[1], rootPrefix)
+	for _, node := range []string{"node0", "node1", "node2"} {
+		out, err := execute(t,
+			genesis.GetGenesisValidatorRootCmd(spec.TestnetChainSpec()),
+			filepath.Join(first, node, "config", "genesis.json"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, validatorsRoot+"\n", out)
+	}
+
+	// The nodes of a network are never overwritten.
+	_, err := execute(t,
+		genesis.InitNetworkCmd(spec.TestnetChainSpec()),
+		networkArgs(first)...,
+	)
+	require.ErrorContains(t, err, "already exists")
+}
+
+// initNetwork creates a network of three validators and returns its output
+// directory along with the output of the command.
+func initNetwork(t *testing.T) (string, string) {
+	t.Helper()
+	outputDir := filepath.Join(t.TempDir(), "network")
+	out, err := execute(t,
+		genesis.InitNetworkCmd(spec.TestnetChainSpec()),
+		networkArgs(outputDir)...,
+	)
+	require.NoError(t, err)
+	return outputDir, out
+}
+
+func networkArgs(outputDir string) []string {
+	return []string{
+		ethGenesisFile,
+		"--chain-id", "test-network",
+		"--validators", "3",
+		"--mnemonic", testMnemonic,
+		"--output-dir", outputDir,
+	}
+}
+
+func execute(
+	t *testing.T,
+	cmd *cobra.Command,
+	args ...string,
+) (string, error) {
+	t.Helper()
+	serverCtx := clicontext.CreateServerContext(
+		noop.NewLogger[sdklog.Logger](), viper.New(),
+	)
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.String(), err
+}
+
+// readTree returns the contents of the files in the directory by their
+// relative paths.
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	require.NoError(t, filepath.WalkDir(
+		dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			bz, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			files[rel] = string(bz)
+			return err
+		},
+	))
+	return files
+}
@@ -45,26 +45,6 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 		Short: "adds the eth1 genesis execution payload to the genesis file",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Read the genesis file.
-			genesisBz, err := afero.ReadFile(afero.NewOsFs(), args[0])
-			if err != nil {
-				return errors.Wrap(err, "failed to read eth1 genesis file")
-			}
-
-			// Unmarshal the genesis file.
-			ethGenesis := &gethprimitives.Genesis{}
-			if err = ethGenesis.UnmarshalJSON(genesisBz); err != nil {
-				return errors.Wrap(err, "failed to unmarshal eth1 genesis")
-			}
-			genesisBlock := ethGenesis.ToBlock()
-
-			// Create the execution payload.
-			payload := gethprimitives.BlockToExecutableData(
-				genesisBlock,
-				nil,
-				nil,
-			).ExecutionPayload
-
 			serverCtx := serverContext.GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
 
@@ -94,16 +74,13 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 			}
 
 			// Inject the execution payload.
-			header, err := executableDataToExecutionPayloadHeader(
+			header, err := executionPayloadHeaderFromEthGenesis(
+				args[0],
 				version.ToUint32(genesisInfo.ForkVersion),
-				payload,
-				chainSpec.MaxWithdrawalsPerPayload(),
+				chainSpec,
 			)
 			if err != nil {
-				return errors.Wrap(
-					err,
-					"failed to convert executable data to execution payload header",
-				)
+				return err
 			}
 			genesisInfo.ExecutionPayloadHeader = header
 
@@ -125,6 +102,47 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 	return cmd
 }
 
+// executionPayloadHeaderFromEthGenesis reads the eth1 genesis file at the
+// given path and converts its genesis block into an execution payload header.
+func executionPayloadHeaderFromEthGenesis(
+	path string,
+	forkVersion uint32,
+	chainSpec common.ChainSpec,
+) (*types.ExecutionPayloadHeader, error) {
+	// Read the genesis file.
+	genesisBz, err := afero.ReadFile(afero.NewOsFs(), path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read eth1 genesis file")
+	}
+
+	// Unmarshal the genesis file.
+	ethGenesis := &gethprimitives.Genesis{}
+	if err = ethGenesis.UnmarshalJSON(genesisBz); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	genesisBlock := ethGenesis.ToBlock()
+
+	// Create the execution payload.
+	payload := gethprimitives.BlockToExecutableData(
+		genesisBlock,
+		nil,
+		nil,
+	).ExecutionPayload
+
+	header, err := executableDataToExecutionPayloadHeader(
+		forkVersion,
+		payload,
+		chainSpec.MaxWithdrawalsPerPayload(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(
+			err,
+			"failed to convert executable data to execution payload header",
+		)
+	}
+	return header, nil
+}
+
 // Converts the eth executable data type to the beacon execution payload header
 // interface.
 func executableDataToExecutionPayloadHeader(
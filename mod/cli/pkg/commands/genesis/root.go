@@ -64,26 +64,42 @@ func GetGenesisValidatorRootCmd(cs common.ChainSpec) *cobra.Command {
 				return errors.Wrap(err, "failed to unmarshal JSON")
 			}
 
-			depositCount := uint64(len(genesis.AppState.Beacon.Deposits))
-			validators := make(
-				types.Validators,
-				depositCount,
+			deposits := make(
+				[]*types.Deposit,
+				len(genesis.AppState.Beacon.Deposits),
 			)
 			for i, deposit := range genesis.AppState.Beacon.Deposits {
-				var val *types.Validator
-				validators[i] = val.New(
-					deposit.Pubkey,
-					types.WithdrawalCredentials(deposit.Credentials),
-					deposit.Amount,
-					math.Gwei(cs.EffectiveBalanceIncrement()),
-					math.Gwei(cs.MaxEffectiveBalance()),
-				)
+				deposits[i] = &types.Deposit{
+					Pubkey:      deposit.Pubkey,
+					Credentials: types.WithdrawalCredentials(deposit.Credentials),
+					Amount:      deposit.Amount,
+				}
 			}
 
-			cmd.Printf("%s\n", validators.HashTreeRoot())
+			cmd.Printf("%s\n", genesisValidatorsRoot(cs, deposits))
 			return nil
 		},
 	}
 
 	return cmd
 }
+
+// genesisValidatorsRoot returns the hash tree root of the validator set
+// created by the given genesis deposits.
+func genesisValidatorsRoot(
+	cs common.ChainSpec,
+	deposits []*types.Deposit,
+) common.Root {
+	validators := make(types.Validators, len(deposits))
+	for i, deposit := range deposits {
+		var val *types.Validator
+		validators[i] = val.New(
+			deposit.Pubkey,
+			deposit.Credentials,
+			deposit.Amount,
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			math.Gwei(cs.MaxEffectiveBalance()),
+		)
+	}
+	return validators.HashTreeRoot()
+}
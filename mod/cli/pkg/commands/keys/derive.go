@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"math"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/spf13/cobra"
+)
+
+// NewDeriveCmd creates a new command for deriving validator keys from a
+// mnemonic.
+func NewDeriveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive",
+		Short: "Derives validator keys from a BIP-39 mnemonic",
+		Long: `Derives BLS12-381 validator keys from a BIP-39 mnemonic following
+		EIP-2333 along the EIP-2334 signing key paths m/12381/3600/i/0/0 and
+		prints their public keys. If an output directory is set, an EIP-2335
+		keystore is written for every derived key.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			count, err := cmd.Flags().GetUint32(countFlag)
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				return ErrInvalidCount
+			}
+			startIndex, err := cmd.Flags().GetUint32(startIndexFlag)
+			if err != nil {
+				return err
+			}
+			if count > math.MaxUint32-startIndex {
+				return ErrIndexOverflow
+			}
+			outputDir, err := cmd.Flags().GetString(outputDirFlag)
+			if err != nil {
+				return err
+			}
+
+			mnemonic, err := readMnemonic(cmd)
+			if err != nil {
+				return err
+			}
+
+			var password string
+			if outputDir != "" {
+				if password, err = readPassword(cmd, true); err != nil {
+					return err
+				}
+			}
+
+			for index := startIndex; index < startIndex+count; index++ {
+				path := signer.ValidatorSigningKeyPath(index)
+				var key signer.LegacyKey
+				if key, err = signer.LegacyKeyFromMnemonic(
+					mnemonic, "", path,
+				); err != nil {
+					return err
+				}
+
+				var blsSigner *signer.LegacySigner
+				if blsSigner, err = signer.NewLegacySigner(key); err != nil {
+					return err
+				}
+				cmd.Printf("%s %s\n", path, blsSigner.PublicKey())
+
+				if outputDir == "" {
+					continue
+				}
+
+				var keystore *signer.Keystore
+				if keystore, err = signer.EncryptKeystore(
+					key, password, path,
+				); err != nil {
+					return err
+				}
+				if err = writeKeystore(
+					filepath.Join(outputDir, keystoreFileName(path)), keystore,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(mnemonicFlag, "", mnemonicMsg)
+	cmd.Flags().Uint32(countFlag, defaultCount, countMsg)
+	cmd.Flags().Uint32(startIndexFlag, defaultStartIndex, startIndexMsg)
+	cmd.Flags().String(outputDirFlag, "", outputDirMsg)
+	cmd.Flags().String(passwordFileFlag, "", passwordFileMsg)
+
+	return cmd
+}
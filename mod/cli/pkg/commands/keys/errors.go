@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidCount is returned when the number of keys to derive is not
+	// positive.
+	ErrInvalidCount = errors.New("count must be positive")
+
+	// ErrIndexOverflow is returned when the indices of the keys to derive
+	// overflow the uint32 key index.
+	ErrIndexOverflow = errors.New("start index and count overflow uint32")
+
+	// ErrPubkeyMismatch is returned when the public key of a keystore does
+	// not match the one of its decrypted secret key.
+	ErrPubkeyMismatch = errors.New(
+		"keystore pubkey does not match its secret key",
+	)
+
+	// ErrPrivValKeyExists is returned when importing a key would overwrite
+	// an existing private validator key.
+	ErrPrivValKeyExists = errors.New(
+		"private validator key already exists, use --overwrite to replace it",
+	)
+
+	// ErrPasswordMismatch is returned when the password confirmation does not
+	// match.
+	ErrPasswordMismatch = errors.New("passwords do not match")
+)
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/spf13/cobra"
+)
+
+// NewExportCmd creates a new command for exporting the private validator key
+// of the node as a keystore.
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [keystore.json]",
+		Short: "Exports the private validator key as an EIP-2335 keystore",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetServerContextFromCmd(cmd).Config
+
+			key, err := signer.LegacyKeyFromPrivValFile(
+				config.PrivValidatorKeyFile(),
+			)
+			if err != nil {
+				return err
+			}
+			password, err := readPassword(cmd, true)
+			if err != nil {
+				return err
+			}
+
+			keystore, err := signer.EncryptKeystore(key, password, "")
+			if err != nil {
+				return err
+			}
+			if err = writeKeystore(args[0], keystore); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported validator key 0x%s\n", keystore.Pubkey)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(passwordFileFlag, "", passwordFileMsg)
+
+	return cmd
+}
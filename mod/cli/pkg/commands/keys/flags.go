@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+const (
+	// mnemonicFlag is the flag for the BIP-39 mnemonic to derive keys from.
+	mnemonicFlag = "mnemonic"
+
+	// countFlag is the flag for the number of keys to derive.
+	countFlag = "count"
+
+	// startIndexFlag is the flag for the first validator index to derive.
+	startIndexFlag = "start-index"
+
+	// outputDirFlag is the flag for the directory keystores are written to.
+	outputDirFlag = "output-dir"
+
+	// passwordFileFlag is the flag for the file holding the keystore
+	// password.
+	passwordFileFlag = "password-file"
+
+	// overwriteFlag is the flag for overwriting an existing private validator
+	// key.
+	overwriteFlag = "overwrite"
+)
+
+const (
+	// defaultCount is the default value for the count flag.
+	defaultCount = 1
+
+	// defaultStartIndex is the default value for the startIndex flag.
+	defaultStartIndex = 0
+
+	// defaultOverwrite is the default value for the overwrite flag.
+	defaultOverwrite = false
+)
+
+const (
+	// mnemonicMsg is the usage description for the mnemonic flag.
+	mnemonicMsg = `BIP-39 mnemonic to derive the keys from. If not set, the
+	mnemonic is read from stdin.`
+
+	// countMsg is the usage description for the count flag.
+	countMsg = "number of keys to derive"
+
+	// startIndexMsg is the usage description for the startIndex flag.
+	startIndexMsg = "validator index of the first key to derive"
+
+	// outputDirMsg is the usage description for the outputDir flag.
+	outputDirMsg = `directory to write EIP-2335 keystores of the derived keys
+	to. If not set, only the public keys are printed.`
+
+	// passwordFileMsg is the usage description for the passwordFile flag.
+	passwordFileMsg = `file holding the keystore password. If not set, the
+	password is read from stdin.`
+
+	// overwriteMsg is the usage description for the overwrite flag.
+	overwriteMsg = "overwrite an existing private validator key"
+)
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/spf13/cobra"
+)
+
+// NewImportCmd creates a new command for importing a keystore as the private
+// validator key of the node.
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [keystore.json]",
+		Short: "Imports an EIP-2335 keystore as the private validator key",
+		Long: `Decrypts an EIP-2335 keystore and writes its key to the
+		priv_validator_key.json file of the node. An existing sign state is
+		kept to preserve the double signing protection.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetServerContextFromCmd(cmd).Config
+
+			overwrite, err := cmd.Flags().GetBool(overwriteFlag)
+			if err != nil {
+				return err
+			}
+			if _, err = os.Stat(config.PrivValidatorKeyFile()); err == nil &&
+				!overwrite {
+				return ErrPrivValKeyExists
+			}
+
+			keystore, err := readKeystore(args[0])
+			if err != nil {
+				return err
+			}
+			password, err := readPassword(cmd, false)
+			if err != nil {
+				return err
+			}
+			key, err := keystore.Decrypt(password)
+			if err != nil {
+				return err
+			}
+
+			// The pubkey of the keystore is not authenticated by its
+			// checksum, the one of the decrypted key is the trusted one.
+			blsSigner, err := signer.NewLegacySigner(key)
+			if err != nil {
+				return err
+			}
+			pubkey := blsSigner.PublicKey()
+			if !strings.EqualFold(
+				strings.TrimPrefix(keystore.Pubkey, "0x"),
+				hex.EncodeToString(pubkey[:]),
+			) {
+				return errors.Wrapf(
+					ErrPubkeyMismatch, "keystore 0x%s, secret key %s",
+					strings.TrimPrefix(keystore.Pubkey, "0x"), pubkey,
+				)
+			}
+
+			if err = signer.WritePrivValFiles(
+				key,
+				config.PrivValidatorKeyFile(),
+				config.PrivValidatorStateFile(),
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf("imported validator key %s\n", pubkey)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(passwordFileFlag, "", passwordFileMsg)
+	cmd.Flags().Bool(overwriteFlag, defaultOverwrite, overwriteMsg)
+
+	return cmd
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/keys"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMnemonic = "abandon abandon abandon abandon abandon abandon abandon " +
+		"abandon abandon abandon abandon abandon abandon abandon abandon " +
+		"abandon abandon abandon abandon abandon abandon abandon abandon art"
+	testPassword = "password"
+)
+
+func TestMnemonicCmd(t *testing.T) {
+	out, err := execute(t, keys.NewMnemonicCmd(), "")
+	require.NoError(t, err)
+
+	mnemonic := strings.TrimSpace(out)
+	require.Len(t, strings.Fields(mnemonic), 24)
+	require.True(t, bip39.IsMnemonicValid(mnemonic))
+}
+
+func TestDeriveCmd(t *testing.T) {
+	outputDir := t.TempDir()
+	out, err := execute(t, keys.NewDeriveCmd(), "",
+		"--mnemonic", testMnemonic,
+		"--start-index", "1",
+		"--count", "2",
+		"--output-dir", outputDir,
+		"--password-file", writePasswordFile(t),
+	)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		path := signer.ValidatorSigningKeyPath(uint32(i + 1))
+		key, err := signer.LegacyKeyFromMnemonic(testMnemonic, "", path)
+		require.NoError(t, err)
+		blsSigner, err := signer.NewLegacySigner(key)
+		require.NoError(t, err)
+		require.Equal(t, path+" "+blsSigner.PublicKey().String(), line)
+
+		// The keystore of every derived key decrypts to it.
+		keystore := readKeystore(t, filepath.Join(
+			outputDir,
+			"keystore-"+strings.ReplaceAll(path, "/", "_")+".json",
+		))
+		require.Equal(t, path, keystore.Path)
+		decrypted, err := keystore.Decrypt(testPassword)
+		require.NoError(t, err)
+		require.Equal(t, key, decrypted)
+	}
+
+	// Keystores are never overwritten.
+	_, err = execute(t, keys.NewDeriveCmd(), "",
+		"--mnemonic", testMnemonic,
+		"--start-index", "2",
+		"--output-dir", outputDir,
+		"--password-file", writePasswordFile(t),
+	)
+	require.ErrorIs(t, err, os.ErrExist)
+}
+
+func TestDeriveCmdInvalid(t *testing.T) {
+	_, err := execute(t, keys.NewDeriveCmd(), "",
+		"--mnemonic", testMnemonic, "--count", "0",
+	)
+	require.ErrorIs(t, err, keys.ErrInvalidCount)
+
+	_, err = execute(t, keys.NewDeriveCmd(), "",
+		"--mnemonic", testMnemonic,
+		"--start-index", "4294967295", "--count", "2",
+	)
+	require.ErrorIs(t, err, keys.ErrIndexOverflow)
+
+	// The password read from stdin has to be confirmed.
+	_, err = execute(t, keys.NewDeriveCmd(), "password\nmismatch\n",
+		"--mnemonic", testMnemonic, "--output-dir", t.TempDir(),
+	)
+	require.ErrorIs(t, err, keys.ErrPasswordMismatch)
+
+	_, err = execute(t, keys.NewDeriveCmd(), "",
+		"--mnemonic", "not a mnemonic",
+	)
+	require.Error(t, err)
+}
+
+// execute runs the command with the given stdin and args, returning its
+// output.
+func execute(
+	t *testing.T,
+	cmd *cobra.Command,
+	stdin string,
+	args ...string,
+) (string, error) {
+	t.Helper()
+	return executeWithHome(t, cmd, t.TempDir(), stdin, args...)
+}
+
+// executeWithHome runs the command for the node at the given home with the
+// given stdin and args, returning its output.
+func executeWithHome(
+	t *testing.T,
+	cmd *cobra.Command,
+	home string,
+	stdin string,
+	args ...string,
+) (string, error) {
+	t.Helper()
+	serverCtx := clicontext.CreateServerContext(
+		noop.NewLogger[sdklog.Logger](), viper.New(),
+	)
+	serverCtx.Config.SetRoot(home)
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetArgs(args)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.String(), err
+}
+
+func writePasswordFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte(testPassword+"\n"), 0o600))
+	return path
+}
+
+func readKeystore(t *testing.T, path string) *signer.Keystore {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	keystore := &signer.Keystore{}
+	require.NoError(t, json.Unmarshal(bz, keystore))
+	return keystore
+}
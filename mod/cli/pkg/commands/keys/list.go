@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/spf13/cobra"
+)
+
+// NewListCmd creates a new command for listing validator public keys.
+func NewListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [keystore-dir]",
+		Short: "Lists the public keys of the node and of a keystore directory",
+		Long: `Prints the public key of the private validator key of the node
+		and, if a directory is given, the public keys of all EIP-2335
+		keystores in it.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyFile := context.GetServerContextFromCmd(cmd).
+				Config.PrivValidatorKeyFile()
+			if _, err := os.Stat(keyFile); err == nil {
+				key, err := signer.LegacyKeyFromPrivValFile(keyFile)
+				if err != nil {
+					return err
+				}
+				blsSigner, err := signer.NewLegacySigner(key)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("%s %s\n", keyFile, blsSigner.PublicKey())
+			}
+
+			if len(args) == 0 {
+				return nil
+			}
+
+			entries, err := os.ReadDir(args[0])
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+					continue
+				}
+
+				path := filepath.Join(args[0], entry.Name())
+				keystore, err := readKeystore(path)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("%s 0x%s\n", path, keystore.Pubkey)
+			}
+			return nil
+		},
+	}
+}
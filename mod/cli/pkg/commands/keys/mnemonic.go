@@ -18,32 +18,35 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package core
+package keys
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) processSyncCommitteeUpdates(
-	st BeaconStateT,
-) (transition.ValidatorUpdates, error) {
-	vals, err := st.GetValidatorsByEffectiveBalance()
-	if err != nil {
-		return nil, err
-	}
+// mnemonicEntropyBits is the entropy of a generated 24 word mnemonic.
+const mnemonicEntropyBits = 256
+
+// NewMnemonicCmd creates a new command for generating a BIP-39 mnemonic.
+func NewMnemonicCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "mnemonic",
+		Short: "Generates a new BIP-39 mnemonic to derive validator keys from",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+			if err != nil {
+				return err
+			}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
+			mnemonic, err := bip39.NewMnemonic(entropy)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(mnemonic)
+			return nil
 		},
-	)
+	}
 }
@@ -0,0 +1,109 @@
+//go:build bls12381
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/keys"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/stretchr/testify/require"
+)
+
+// The private validator key files are written with the CometBFT BLS12-381
+// keys, which are only enabled by the bls12381 build tag.
+func TestImportExportListCmds(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o700))
+	keystoreDir := t.TempDir()
+	passwordFile := writePasswordFile(t)
+
+	key, err := signer.LegacyKeyFromMnemonic(
+		testMnemonic, "", signer.ValidatorSigningKeyPath(0),
+	)
+	require.NoError(t, err)
+	keystore, err := signer.EncryptKeystore(key, testPassword, "")
+	require.NoError(t, err)
+	keystoreFile := filepath.Join(keystoreDir, "keystore.json")
+	bz, err := json.Marshal(keystore)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keystoreFile, bz, 0o600))
+
+	// The keystore is imported as the private validator key of the node.
+	out, err := executeWithHome(t, keys.NewImportCmd(), home, "",
+		keystoreFile, "--password-file", passwordFile,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "imported validator key 0x"+keystore.Pubkey+"\n", out)
+	keyFile := filepath.Join(home, "config", "priv_validator_key.json")
+	imported, err := signer.LegacyKeyFromPrivValFile(keyFile)
+	require.NoError(t, err)
+	require.Equal(t, key, imported)
+
+	// An existing key is only replaced on request.
+	_, err = executeWithHome(t, keys.NewImportCmd(), home, "",
+		keystoreFile, "--password-file", passwordFile,
+	)
+	require.ErrorIs(t, err, keys.ErrPrivValKeyExists)
+	_, err = executeWithHome(t, keys.NewImportCmd(), home, "wrongpassword\n",
+		keystoreFile, "--overwrite",
+	)
+	require.ErrorIs(t, err, signer.ErrInvalidKeystorePassword)
+
+	// A keystore whose pubkey is not the one of its key is rejected.
+	tampered := *keystore
+	tampered.Pubkey = strings.Repeat("ab", 48)
+	tamperedFile := filepath.Join(t.TempDir(), "tampered.json")
+	bz, err = json.Marshal(&tampered)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tamperedFile, bz, 0o600))
+	_, err = executeWithHome(t, keys.NewImportCmd(), home, "",
+		tamperedFile, "--password-file", passwordFile, "--overwrite",
+	)
+	require.ErrorIs(t, err, keys.ErrPubkeyMismatch)
+
+	// The exported keystore holds the private validator key.
+	exported := filepath.Join(keystoreDir, "exported.json")
+	_, err = executeWithHome(t, keys.NewExportCmd(), home, "",
+		exported, "--password-file", passwordFile,
+	)
+	require.NoError(t, err)
+	decrypted, err := readKeystore(t, exported).Decrypt(testPassword)
+	require.NoError(t, err)
+	require.Equal(t, key, decrypted)
+
+	// Both the node key and the keystores are listed.
+	out, err = executeWithHome(t, keys.NewListCmd(), home, "", keystoreDir)
+	require.NoError(t, err)
+	require.Equal(t,
+		keyFile+" 0x"+keystore.Pubkey+"\n"+
+			exported+" 0x"+keystore.Pubkey+"\n"+
+			keystoreFile+" 0x"+keystore.Pubkey+"\n",
+		out,
+	)
+}
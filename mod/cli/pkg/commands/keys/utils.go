@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// readPassword returns the keystore password from the password file flag or
+// prompts for it on stdin. If confirm is set, the prompted password has to be
+// entered twice.
+func readPassword(cmd *cobra.Command, confirm bool) (string, error) {
+	passwordFile, err := cmd.Flags().GetString(passwordFileFlag)
+	if err != nil {
+		return "", err
+	}
+	if passwordFile != "" {
+		var bz []byte
+		if bz, err = afero.ReadFile(afero.NewOsFs(), passwordFile); err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(bz), "\r\n"), nil
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	password, err := input.GetPassword("Enter keystore password:", buf)
+	if err != nil || !confirm {
+		return password, err
+	}
+
+	confirmation, err := input.GetPassword("Repeat keystore password:", buf)
+	if err != nil {
+		return "", err
+	}
+	if password != confirmation {
+		return "", ErrPasswordMismatch
+	}
+	return password, nil
+}
+
+// readMnemonic returns the mnemonic from the mnemonic flag or prompts for it
+// on stdin.
+func readMnemonic(cmd *cobra.Command) (string, error) {
+	mnemonic, err := cmd.Flags().GetString(mnemonicFlag)
+	if err != nil || mnemonic != "" {
+		return mnemonic, err
+	}
+	return input.GetString(
+		"Enter your bip39 mnemonic", bufio.NewReader(cmd.InOrStdin()),
+	)
+}
+
+// readKeystore reads an EIP-2335 keystore from the given path.
+func readKeystore(path string) (*signer.Keystore, error) {
+	bz, err := afero.ReadFile(afero.NewOsFs(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	keystore := &signer.Keystore{}
+	if err = json.Unmarshal(bz, keystore); err != nil {
+		return nil, err
+	}
+	return keystore, nil
+}
+
+// writeKeystore writes an EIP-2335 keystore to the given path. It never
+// overwrites an existing file.
+func writeKeystore(path string, keystore *signer.Keystore) error {
+	bz, err := json.MarshalIndent(keystore, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fs := afero.NewOsFs()
+	if err = fs.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	//#nosec:G302,G304 // the path is provided by the operator.
+	file, err := fs.OpenFile(
+		path,
+		os.O_CREATE|os.O_EXCL|os.O_WRONLY,
+		0o600, //nolint:mnd // file permissions.
+	)
+	if err != nil {
+		return err
+	}
+	//#nosec:G307 // Ignore errors on this line.
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\n", bz)
+	return err
+}
+
+// keystoreFileName returns the file name of a keystore for the key at the
+// given EIP-2334 path, following the naming of the staking deposit CLI.
+func keystoreFileName(path string) string {
+	return fmt.Sprintf("keystore-%s.json", strings.ReplaceAll(path, "/", "_"))
+}
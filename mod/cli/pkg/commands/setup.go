@@ -22,15 +22,16 @@ package commands
 
 import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/archive"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/keys"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
-	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -56,6 +57,8 @@ func DefaultRootCommandSetup[
 
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `archive`
+		archive.Commands(chainSpec),
 		// `comet`
 		cometbft.Commands(appCreator),
 
@@ -43,6 +43,7 @@ const (
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	FollowerRPCDialURLs     = engineRoot + "follower-rpc-dial-urls"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
@@ -69,11 +70,45 @@ const (
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
 
+	// Blob Archive Config.
+	blobArchiveRoot    = beaconKitRoot + "blob-archive."
+	BlobArchiveEnabled = blobArchiveRoot + "enabled"
+	BlobArchivePath    = blobArchiveRoot + "path"
+
 	// Node API Config.
-	nodeAPIRoot    = beaconKitRoot + "node-api."
-	NodeAPIEnabled = nodeAPIRoot + "enabled"
-	NodeAPIAddress = nodeAPIRoot + "address"
-	NodeAPILogging = nodeAPIRoot + "logging"
+	nodeAPIRoot               = beaconKitRoot + "node-api."
+	NodeAPIEnabled            = nodeAPIRoot + "enabled"
+	NodeAPIAddress            = nodeAPIRoot + "address"
+	NodeAPIEngine             = nodeAPIRoot + "engine"
+	NodeAPIGRPCAddress        = nodeAPIRoot + "grpc-address"
+	NodeAPILogging            = nodeAPIRoot + "logging"
+	NodeAPITLSCertPath        = nodeAPIRoot + "tls-cert-path"
+	NodeAPITLSKeyPath         = nodeAPIRoot + "tls-key-path"
+	NodeAPIJWTSecretPath      = nodeAPIRoot + "jwt-secret-path"
+	NodeAPICORSAllowedOrigins = nodeAPIRoot + "cors-allowed-origins"
+	NodeAPIRateLimit          = nodeAPIRoot + "rate-limit"
+	NodeAPIRateLimitBurst     = nodeAPIRoot + "rate-limit-burst"
+	NodeAPICompression        = nodeAPIRoot + "compression"
+	NodeAPIRequestTimeout     = nodeAPIRoot + "request-timeout"
+	NodeAPIIdleTimeout        = nodeAPIRoot + "idle-timeout"
+
+	// State Replay Config.
+	stateReplayRoot               = beaconKitRoot + "state-replay."
+	StateReplayEnabled            = stateReplayRoot + "enabled"
+	StateReplayCacheSize          = stateReplayRoot + "cache-size"
+	StateReplayMaxReplaySlots     = stateReplayRoot + "max-replay-slots"
+	StateReplayCheckpointInterval = stateReplayRoot + "checkpoint-interval"
+
+	// Execution Backfill Config.
+	executionBackfillRoot      = beaconKitRoot + "execution-backfill."
+	ExecutionBackfillEnabled   = executionBackfillRoot + "enabled"
+	ExecutionBackfillBatchSize = executionBackfillRoot + "batch-size"
+
+	// Execution Sync Config.
+	executionSyncRoot         = beaconKitRoot + "execution-sync."
+	ExecutionSyncPollInterval = executionSyncRoot + "poll-interval"
+	ExecutionSyncSyncDistance = executionSyncRoot + "sync-distance"
+	ExecutionSyncStallTimeout = executionSyncRoot + "stall-timeout"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
@@ -87,6 +122,9 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		FollowerRPCDialURLs, nil, "follower rpc dial urls",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -148,6 +186,16 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().Bool(
+		BlobArchiveEnabled,
+		defaultCfg.BlobArchive.Enabled,
+		"blob archive enabled",
+	)
+	startCmd.Flags().String(
+		BlobArchivePath,
+		defaultCfg.BlobArchive.Path,
+		"blob archive path",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -158,9 +206,109 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Address,
 		"node api address",
 	)
+	startCmd.Flags().String(
+		NodeAPIEngine,
+		defaultCfg.NodeAPI.Engine,
+		"node api engine, either echo or mux",
+	)
+	startCmd.Flags().String(
+		NodeAPIGRPCAddress,
+		defaultCfg.NodeAPI.GRPCAddress,
+		"node api grpc gateway address",
+	)
 	startCmd.Flags().Bool(
 		NodeAPILogging,
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().String(
+		NodeAPITLSCertPath,
+		defaultCfg.NodeAPI.TLSCertPath,
+		"node api tls certificate path",
+	)
+	startCmd.Flags().String(
+		NodeAPITLSKeyPath,
+		defaultCfg.NodeAPI.TLSKeyPath,
+		"node api tls key path",
+	)
+	startCmd.Flags().String(
+		NodeAPIJWTSecretPath,
+		defaultCfg.NodeAPI.JWTSecretPath,
+		"node api jwt secret path",
+	)
+	startCmd.Flags().String(
+		NodeAPICORSAllowedOrigins,
+		defaultCfg.NodeAPI.CORSAllowedOrigins,
+		"node api cors allowed origins",
+	)
+	startCmd.Flags().Float64(
+		NodeAPIRateLimit,
+		defaultCfg.NodeAPI.RateLimit,
+		"node api rate limit",
+	)
+	startCmd.Flags().Int(
+		NodeAPIRateLimitBurst,
+		defaultCfg.NodeAPI.RateLimitBurst,
+		"node api rate limit burst",
+	)
+	startCmd.Flags().Bool(
+		NodeAPICompression,
+		defaultCfg.NodeAPI.Compression,
+		"node api compression",
+	)
+	startCmd.Flags().Duration(
+		NodeAPIRequestTimeout,
+		defaultCfg.NodeAPI.RequestTimeout,
+		"node api request timeout",
+	)
+	startCmd.Flags().Duration(
+		NodeAPIIdleTimeout,
+		defaultCfg.NodeAPI.IdleTimeout,
+		"node api idle timeout",
+	)
+	startCmd.Flags().Bool(
+		StateReplayEnabled,
+		defaultCfg.StateReplay.Enabled,
+		"state replay enabled",
+	)
+	startCmd.Flags().Int(
+		StateReplayCacheSize,
+		defaultCfg.StateReplay.CacheSize,
+		"state replay cache size",
+	)
+	startCmd.Flags().Uint64(
+		StateReplayMaxReplaySlots,
+		defaultCfg.StateReplay.MaxReplaySlots,
+		"state replay max replay slots",
+	)
+	startCmd.Flags().Uint64(
+		StateReplayCheckpointInterval,
+		defaultCfg.StateReplay.CheckpointInterval,
+		"state replay checkpoint interval",
+	)
+	startCmd.Flags().Bool(
+		ExecutionBackfillEnabled,
+		defaultCfg.ExecutionBackfill.Enabled,
+		"execution backfill enabled",
+	)
+	startCmd.Flags().Uint64(
+		ExecutionBackfillBatchSize,
+		defaultCfg.ExecutionBackfill.BatchSize,
+		"execution backfill batch size",
+	)
+	startCmd.Flags().Duration(
+		ExecutionSyncPollInterval,
+		defaultCfg.ExecutionSync.PollInterval,
+		"execution sync poll interval",
+	)
+	startCmd.Flags().Uint64(
+		ExecutionSyncSyncDistance,
+		defaultCfg.ExecutionSync.SyncDistance,
+		"execution sync distance",
+	)
+	startCmd.Flags().Duration(
+		ExecutionSyncStallTimeout,
+		defaultCfg.ExecutionSync.StallTimeout,
+		"execution sync stall timeout",
+	)
 }
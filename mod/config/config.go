@@ -21,14 +21,18 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/mod/beacon/backfill"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
+	"github.com/berachain/beacon-kit/mod/beacon/syncmonitor"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/replay"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/mitchellh/mapstructure"
@@ -49,7 +53,11 @@ func DefaultConfig() *Config {
 		PayloadBuilder:    builder.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
+		BlobArchive:       archive.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		StateReplay:       replay.DefaultConfig(),
+		ExecutionBackfill: backfill.DefaultConfig(),
+		ExecutionSync:     syncmonitor.DefaultConfig(),
 	}
 }
 
@@ -67,8 +75,19 @@ type Config struct {
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// BlobArchive is the configuration for the archive of expired blobs.
+	BlobArchive archive.Config `mapstructure:"blob-archive"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// StateReplay is the configuration for the regeneration of the states
+	// of pruned heights.
+	StateReplay replay.Config `mapstructure:"state-replay"`
+	// ExecutionBackfill is the configuration for the backfill of the
+	// execution client from the stored beacon blocks.
+	ExecutionBackfill backfill.Config `mapstructure:"execution-backfill"`
+	// ExecutionSync is the configuration for the monitor of the execution
+	// client sync.
+	ExecutionSync syncmonitor.Config `mapstructure:"execution-sync"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -51,6 +51,8 @@ func BaseSpec() chain.SpecData[
 ] {
 	cmtConsensusParams := cmttypes.DefaultConsensusParams()
 	cmtConsensusParams.Validator.PubKeyTypes = []string{crypto.CometBLSType}
+	// Validators extend their votes with their execution layer view.
+	cmtConsensusParams.Feature.VoteExtensionsEnableHeight = 1
 
 	return chain.SpecData[
 		common.DomainType,
@@ -103,13 +105,20 @@ func BaseSpec() chain.SpecData[
 		// Fork-related values.
 		DenebPlusForkEpoch: 9999999999999998,
 		ElectraForkEpoch:   9999999999999999,
+		PeerDASForkEpoch:   9999999999999999,
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
 		EpochsPerSlashingsVector:  8,
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
+		MaxActiveValidators:       256,
 		// Max operations per block constants.
 		MaxDepositsPerBlock: 16,
+		// Rewards and penalties.
+		BaseRewardFactor:            64,
+		InactivityPenaltyQuotient:   1 << 24,
+		InactivityScoreBias:         4,
+		InactivityScoreRecoveryRate: 16,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
 		// Capella values.
@@ -122,6 +131,13 @@ func BaseSpec() chain.SpecData[
 		FieldElementsPerBlob:             4096,
 		BytesPerBlob:                     131072,
 		KZGCommitmentInclusionProofDepth: 17,
-		CometValues:                      cmtConsensusParams,
+		// PeerDAS values.
+		CustodyRequirement: 4,
+		SamplesPerSlot:     8,
+		// Electra values.
+		MinPerEpochChurnLimitElectra:        128e9,
+		MaxPerEpochActivationExitChurnLimit: 256e9,
+		ChurnLimitQuotient:                  1 << 16,
+		CometValues:                         cmtConsensusParams,
 	}
 }
@@ -30,6 +30,10 @@ const TomlTemplate = `
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
+# HTTP urls of the JSON-RPC endpoints of additional execution clients. They
+# follow the primary execution client and payloads are built on all of them.
+follower-rpc-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.FollowerRPCDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
 # Number of retries before shutting down consensus client.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
@@ -95,6 +99,15 @@ pruner-enabled = "{{ .BeaconKit.BlockStoreService.PrunerEnabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+[beacon-kit.blob-archive]
+# Enabled determines if expired blob sidecars are archived instead of being
+# discarded once they fall outside of the data availability window.
+enabled = "{{ .BeaconKit.BlobArchive.Enabled }}"
+
+# Path is the directory of the blob archive, relative to the home directory
+# unless absolute.
+path = "{{ .BeaconKit.BlobArchive.Path }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -102,6 +115,86 @@ enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
 # Address is the address to bind the node API to.
 address = "{{ .BeaconKit.NodeAPI.Address }}"
 
+# Engine is the engine serving the node API, either "echo" or "mux", the
+# latter being built on the net/http standard library.
+engine = "{{ .BeaconKit.NodeAPI.Engine }}"
+
+# GRPCAddress is the address to bind the gRPC gateway of the node API to. The
+# gateway is disabled if it is empty.
+grpc-address = "{{ .BeaconKit.NodeAPI.GRPCAddress }}"
+
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# TLSCertPath is the path to the TLS certificate, the node API being served
+# over HTTPS if it is set along with TLSKeyPath.
+tls-cert-path = "{{ .BeaconKit.NodeAPI.TLSCertPath }}"
+
+# TLSKeyPath is the path to the private key of the TLS certificate.
+tls-key-path = "{{ .BeaconKit.NodeAPI.TLSKeyPath }}"
+
+# JWTSecretPath is the path to the secret the bearer JWTs of the write
+# requests are verified with. Write requests are not authenticated if empty.
+jwt-secret-path = "{{ .BeaconKit.NodeAPI.JWTSecretPath }}"
+
+# CORSAllowedOrigins is the comma-separated list of the origins allowed to make
+# cross-origin requests, "*" allowing any origin and an empty list none.
+cors-allowed-origins = "{{ .BeaconKit.NodeAPI.CORSAllowedOrigins }}"
+
+# RateLimit is the number of requests per second allowed from a single IP
+# address, 0 disabling rate limiting.
+rate-limit = "{{ .BeaconKit.NodeAPI.RateLimit }}"
+
+# RateLimitBurst is the number of requests allowed at once from a single IP
+# address.
+rate-limit-burst = "{{ .BeaconKit.NodeAPI.RateLimitBurst }}"
+
+# Compression determines if the responses are gzip compressed.
+compression = "{{ .BeaconKit.NodeAPI.Compression }}"
+
+# RequestTimeout is the maximum duration of reading and handling a request.
+# The event stream and the debug routes are not bounded by it once their
+# request is read.
+request-timeout = "{{ .BeaconKit.NodeAPI.RequestTimeout }}"
+
+# IdleTimeout is the maximum duration to wait for the next request on a
+# keep-alive connection.
+idle-timeout = "{{ .BeaconKit.NodeAPI.IdleTimeout }}"
+
+[beacon-kit.state-replay]
+# Enabled determines if the states of pruned heights are regenerated by
+# replaying the stored blocks on top of the nearest retained state.
+enabled = "{{ .BeaconKit.StateReplay.Enabled }}"
+
+# CacheSize is the number of regenerated states and checkpoints to keep.
+cache-size = "{{ .BeaconKit.StateReplay.CacheSize }}"
+
+# MaxReplaySlots is the maximum number of blocks replayed to regenerate a state.
+max-replay-slots = "{{ .BeaconKit.StateReplay.MaxReplaySlots }}"
+
+# CheckpointInterval is the number of replayed slots between two states kept
+# and persisted to disk as checkpoints for later regenerations.
+checkpoint-interval = "{{ .BeaconKit.StateReplay.CheckpointInterval }}"
+
+[beacon-kit.execution-backfill]
+# Enabled determines if the execution client is backfilled on startup with the
+# payloads of the stored beacon blocks after its head.
+enabled = "{{ .BeaconKit.ExecutionBackfill.Enabled }}"
+
+# BatchSize is the number of payloads sent to the execution client before its
+# forkchoice is updated, at most 1024.
+batch-size = "{{ .BeaconKit.ExecutionBackfill.BatchSize }}"
+
+[beacon-kit.execution-sync]
+# PollInterval is the interval at which the sync status and the head of the
+# execution client are polled.
+poll-interval = "{{ .BeaconKit.ExecutionSync.PollInterval }}"
+
+# SyncDistance is the number of blocks the head of the execution client may
+# trail the beacon chain by while still being considered synced.
+sync-distance = "{{ .BeaconKit.ExecutionSync.SyncDistance }}"
+
+# StallTimeout is the duration without sync progress after which the execution
+# client is considered stalled.
+stall-timeout = "{{ .BeaconKit.ExecutionSync.StallTimeout }}"
 `
@@ -28,14 +28,17 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
 // AttestationDataSize is the size of the AttestationData object in bytes.
-// 8 bytes for Slot + 8 bytes for Index + 32 bytes for BeaconBlockRoot.
-const AttestationDataSize = 48
+// 8 bytes for Slot + 8 bytes for Index + 32 bytes for BeaconBlockRoot +
+// 32 bytes for ExecutionHeadHash + 32 bytes for VerifiedBlockHash + 8 bytes
+// for DepositIndex.
+const AttestationDataSize = 120
 
 var (
 	_ ssz.StaticObject                    = (*AttestationData)(nil)
@@ -50,6 +53,15 @@ type AttestationData struct {
 	Index math.U64 `json:"index"`
 	// BeaconBlockRoot is the root of the beacon block.
 	BeaconBlockRoot common.Root `json:"beaconBlockRoot"`
+	// ExecutionHeadHash is the head block hash of the execution client of
+	// the validator.
+	ExecutionHeadHash common.ExecutionHash `json:"executionHeadHash"`
+	// VerifiedBlockHash is the hash of the latest execution payload verified
+	// by the validator.
+	VerifiedBlockHash common.ExecutionHash `json:"verifiedBlockHash"`
+	// DepositIndex is the next deposit index the validator has seen on the
+	// deposit contract.
+	DepositIndex math.U64 `json:"depositIndex"`
 }
 
 /* -------------------------------------------------------------------------- */
@@ -61,11 +73,17 @@ func (a *AttestationData) New(
 	slot math.U64,
 	index math.U64,
 	beaconBlockRoot common.Root,
+	executionHeadHash common.ExecutionHash,
+	verifiedBlockHash common.ExecutionHash,
+	depositIndex math.U64,
 ) *AttestationData {
 	a = &AttestationData{
-		Slot:            slot,
-		Index:           index,
-		BeaconBlockRoot: beaconBlockRoot,
+		Slot:              slot,
+		Index:             index,
+		BeaconBlockRoot:   beaconBlockRoot,
+		ExecutionHeadHash: executionHeadHash,
+		VerifiedBlockHash: verifiedBlockHash,
+		DepositIndex:      depositIndex,
 	}
 	return a
 }
@@ -84,6 +102,21 @@ func (a *AttestationData) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &a.Slot)
 	ssz.DefineUint64(codec, &a.Index)
 	ssz.DefineStaticBytes(codec, &a.BeaconBlockRoot)
+	ssz.DefineStaticBytes(codec, &a.ExecutionHeadHash)
+	ssz.DefineStaticBytes(codec, &a.VerifiedBlockHash)
+	ssz.DefineUint64(codec, &a.DepositIndex)
+}
+
+// SSZSchema returns the SSZ schema of the AttestationData.
+func (*AttestationData) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("index", schema.U64()),
+		schema.NewField("beacon_block_root", schema.B32()),
+		schema.NewField("execution_head_hash", schema.B32()),
+		schema.NewField("verified_block_hash", schema.B32()),
+		schema.NewField("deposit_index", schema.U64()),
+	)
 }
 
 // HashTreeRoot computes the SSZ hash tree root of the AttestationData object.
@@ -130,6 +163,15 @@ func (a *AttestationData) HashTreeRootWith(hh fastssz.HashWalker) error {
 	// Field (2) 'BeaconBlockRoot'
 	hh.PutBytes(a.BeaconBlockRoot[:])
 
+	// Field (3) 'ExecutionHeadHash'
+	hh.PutBytes(a.ExecutionHeadHash[:])
+
+	// Field (4) 'VerifiedBlockHash'
+	hh.PutBytes(a.VerifiedBlockHash[:])
+
+	// Field (5) 'DepositIndex'
+	hh.PutUint64(uint64(a.DepositIndex))
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -157,3 +199,20 @@ func (a *AttestationData) GetIndex() math.U64 {
 func (a *AttestationData) GetBeaconBlockRoot() common.Root {
 	return a.BeaconBlockRoot
 }
+
+// GetExecutionHeadHash returns the execution head block hash of the
+// attestation data.
+func (a *AttestationData) GetExecutionHeadHash() common.ExecutionHash {
+	return a.ExecutionHeadHash
+}
+
+// GetVerifiedBlockHash returns the latest verified execution block hash of
+// the attestation data.
+func (a *AttestationData) GetVerifiedBlockHash() common.ExecutionHash {
+	return a.VerifiedBlockHash
+}
+
+// GetDepositIndex returns the deposit index of the attestation data.
+func (a *AttestationData) GetDepositIndex() math.U64 {
+	return a.DepositIndex
+}
@@ -38,6 +38,9 @@ func generateAttestationData() *types.AttestationData {
 			1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 			21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32,
 		},
+		ExecutionHeadHash: common.ExecutionHash{1, 2, 3},
+		VerifiedBlockHash: common.ExecutionHash{4, 5, 6},
+		DepositIndex:      7,
 	}
 }
 
@@ -129,4 +132,11 @@ func TestAttestationData_Getters(t *testing.T) {
 	require.Equal(t, math.U64(12345), data.GetSlot())
 	require.Equal(t, math.U64(67890), data.GetIndex())
 	require.Equal(t, beaconBlockRoot, data.GetBeaconBlockRoot())
+	require.Equal(
+		t, common.ExecutionHash{1, 2, 3}, data.GetExecutionHeadHash(),
+	)
+	require.Equal(
+		t, common.ExecutionHash{4, 5, 6}, data.GetVerifiedBlockHash(),
+	)
+	require.Equal(t, math.U64(7), data.GetDepositIndex())
 }
@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
@@ -56,24 +57,18 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	var (
-		block *BeaconBlock
-	)
-
-	switch forkVersion {
-	case version.Deneb:
-		block = &BeaconBlock{
-			Slot:          slot,
-			ProposerIndex: proposerIndex,
-			ParentRoot:    parentBlockRoot,
-			StateRoot:     common.Root{},
-			Body:          &BeaconBlockBody{},
-		}
-	default:
-		return &BeaconBlock{}, ErrForkVersionNotSupported
+	body, err := newBeaconBlockBody(forkVersion)
+	if err != nil {
+		return &BeaconBlock{}, err
 	}
 
-	return block, nil
+	return &BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentBlockRoot,
+		StateRoot:     common.Root{},
+		Body:          body,
+	}, nil
 }
 
 // NewFromSSZ creates a new beacon block from the given SSZ bytes.
@@ -81,16 +76,12 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	var block = new(BeaconBlock)
-	switch forkVersion {
-	case version.Deneb:
-		block = &BeaconBlock{}
-	case version.DenebPlus:
-		panic("unsupported fork version")
-	default:
-		return block, ErrForkVersionNotSupported
+	body, err := newBeaconBlockBody(forkVersion)
+	if err != nil {
+		return new(BeaconBlock), err
 	}
 
+	block := &BeaconBlock{Body: body}
 	return block, block.UnmarshalSSZ(bz)
 }
 
@@ -122,6 +113,20 @@ func (b *BeaconBlock) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineDynamicObjectContent(codec, &b.Body)
 }
 
+// SSZSchema returns the SSZ schema of the BeaconBlock at the given fork
+// version.
+func (*BeaconBlock) SSZSchema(forkVersion uint32) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField(
+			"body", (*BeaconBlockBody)(nil).SSZSchema(forkVersion),
+		),
+	)
+}
+
 // MarshalSSZ marshals the BeaconBlock object to SSZ format.
 func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, b.SizeSSZ(false))
@@ -208,9 +213,13 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 	return b.StateRoot
 }
 
-// Version identifies the version of the BeaconBlock.
+// Version identifies the version of the BeaconBlock, which is the fork
+// version its body is encoded at.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
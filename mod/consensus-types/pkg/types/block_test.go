@@ -29,42 +29,47 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
 )
 
 // generateValidBeaconBlock generates a valid beacon block for the Deneb.
 func generateValidBeaconBlock() *types.BeaconBlock {
-	// Initialize your block here
+	return generateValidBeaconBlockAt(version.Deneb)
+}
+
+// generateValidBeaconBlockAt generates a valid beacon block whose body is
+// encoded at the given fork version.
+func generateValidBeaconBlockAt(forkVersion uint32) *types.BeaconBlock {
+	body := (&types.BeaconBlockBody{}).Empty(forkVersion)
+	body.ExecutionPayload = &types.ExecutionPayload{
+		Number:    10,
+		ExtraData: []byte("dummy extra data for testing"),
+		Transactions: [][]byte{
+			[]byte("tx1"),
+			[]byte("tx2"),
+			[]byte("tx3"),
+		},
+		Withdrawals: []*engineprimitives.Withdrawal{
+			{Index: 0, Amount: 100},
+			{Index: 1, Amount: 200},
+		},
+		BaseFeePerGas: math.NewU256(0),
+	}
+	body.Deposits = []*types.Deposit{
+		{
+			Index: 1,
+		},
+	}
+	body.BlobKzgCommitments = []eip4844.KZGCommitment{
+		{1, 2, 3},
+	}
 	return &types.BeaconBlock{
 		Slot:          10,
 		ProposerIndex: 5,
 		ParentRoot:    common.Root{1, 2, 3, 4, 5},
 		StateRoot:     common.Root{5, 4, 3, 2, 1},
-		Body: &types.BeaconBlockBody{
-			ExecutionPayload: &types.ExecutionPayload{
-				Number:    10,
-				ExtraData: []byte("dummy extra data for testing"),
-				Transactions: [][]byte{
-					[]byte("tx1"),
-					[]byte("tx2"),
-					[]byte("tx3"),
-				},
-				Withdrawals: []*engineprimitives.Withdrawal{
-					{Index: 0, Amount: 100},
-					{Index: 1, Amount: 200},
-				},
-				BaseFeePerGas: math.NewU256(0),
-			},
-			Eth1Data: &types.Eth1Data{},
-			Deposits: []*types.Deposit{
-				{
-					Index: 1,
-				},
-			},
-			BlobKzgCommitments: []eip4844.KZGCommitment{
-				{1, 2, 3},
-			},
-		},
+		Body:          body,
 	}
 }
 
@@ -127,7 +132,10 @@ func TestBeaconBlock_MarshalUnmarshalSSZ(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, sszBlock)
 
-	var unmarshalledBlock types.BeaconBlock
+	// The fork version of the body selects the schema to decode.
+	unmarshalledBlock := types.BeaconBlock{
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}
 	err = unmarshalledBlock.UnmarshalSSZ(sszBlock)
 	require.NoError(t, err)
 
@@ -198,3 +206,78 @@ func TestBeaconBlock_GetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestBeaconBlockFromSSZElectra(t *testing.T) {
+	denebRoot := generateValidBeaconBlock().HashTreeRoot()
+	originalBlock := generateValidBeaconBlockAt(version.Electra)
+	originalBlock.Body.ExecutionRequests = &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{Pubkey: [48]byte{1}, Amount: 32e9, Index: 2},
+		},
+	}
+	originalBlock.Body.SetAttestations([]*types.AttestationData{
+		{Slot: 9, Index: 1, ExecutionHeadHash: common.ExecutionHash{1}},
+		{Slot: 9, Index: 3, DepositIndex: 4},
+	})
+	require.Equal(t, version.Electra, originalBlock.Version())
+	require.Equal(t, types.BodyLengthElectra, originalBlock.Body.Length())
+	require.NotEqual(t, denebRoot, originalBlock.HashTreeRoot())
+
+	sszBlock, err := originalBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrappedBlock, err := (&types.BeaconBlock{}).NewFromSSZ(
+		sszBlock, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, originalBlock, wrappedBlock)
+
+	// The Deneb schema must not accept an Electra encoded block.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(sszBlock, version.Deneb)
+	require.Error(t, err)
+
+	// The fastssz and karalabe hash tree roots must agree.
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	require.NoError(t, originalBlock.Body.HashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, originalBlock.Body.HashTreeRoot(), common.Root(root))
+}
+
+// The fork version the body is built at selects its schema, rather than the
+// requests it carries.
+func TestBeaconBlockBodyVersion(t *testing.T) {
+	block := generateValidBeaconBlock()
+	denebRoot := block.HashTreeRoot()
+	block.Body.ExecutionRequests = &engineprimitives.ExecutionRequests{}
+	require.Equal(t, version.Deneb, block.Version())
+	require.Nil(t, block.GetBody().GetExecutionRequests())
+	require.Equal(t, types.BodyLengthDeneb, block.Body.Length())
+	require.Equal(t, denebRoot, block.HashTreeRoot())
+
+	// The requests of an Electra body are mandatory, hence empty if unset.
+	block = generateValidBeaconBlockAt(version.Electra)
+	block.Body.ExecutionRequests = nil
+	require.Equal(t, version.Electra, block.Version())
+	require.NotNil(t, block.GetBody().GetExecutionRequests())
+	require.NotEqual(t, denebRoot, block.HashTreeRoot())
+
+	block = generateValidBeaconBlockAt(version.DenebPlus)
+	require.Equal(t, version.DenebPlus, block.Version())
+	require.Equal(t, denebRoot, block.HashTreeRoot())
+	bz, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.DenebPlus)
+	require.NoError(t, err)
+	require.Equal(t, block, decoded)
+}
+
+func TestNewWithVersionElectra(t *testing.T) {
+	block, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 5, common.Root{1}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, block.GetBody().GetExecutionRequests())
+	require.Equal(t, version.Electra, block.Version())
+}
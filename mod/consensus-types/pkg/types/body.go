@@ -26,9 +26,12 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
@@ -47,23 +50,46 @@ const (
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
 
+	// BodyLengthElectra is the number of fields in the BeaconBlockBody
+	// struct from Electra onwards, which appends the ExecutionRequests and
+	// the Attestations.
+	BodyLengthElectra uint64 = 8
+
 	// ExtraDataSize is the size of ExtraData in bytes.
 	ExtraDataSize = 32
+
+	// MaxBlobCommitmentsPerBlock is the limit of the BlobKzgCommitments list
+	// in the block body.
+	MaxBlobCommitmentsPerBlock = 16
 )
 
 // Empty returns a new BeaconBlockBody with empty fields
 // for the given fork version.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
+	body, err := newBeaconBlockBody(forkVersion)
+	if err != nil {
+		panic(err)
+	}
+	body.Eth1Data = new(Eth1Data)
+	body.ExecutionPayload = &ExecutionPayload{
+		ExtraData: make([]byte, ExtraDataSize),
+	}
+	return body
+}
+
+// newBeaconBlockBody returns a new BeaconBlockBody to be built or decoded at
+// the given fork version.
+func newBeaconBlockBody(forkVersion uint32) (*BeaconBlockBody, error) {
 	switch forkVersion {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus:
+		return &BeaconBlockBody{forkVersion: forkVersion}, nil
+	case version.Electra:
 		return &BeaconBlockBody{
-			Eth1Data: new(Eth1Data),
-			ExecutionPayload: &ExecutionPayload{
-				ExtraData: make([]byte, ExtraDataSize),
-			},
-		}
+			ExecutionRequests: new(engineprimitives.ExecutionRequests),
+			forkVersion:       forkVersion,
+		}, nil
 	default:
-		panic("unsupported fork version")
+		return nil, ErrForkVersionNotSupported
 	}
 }
 
@@ -75,15 +101,31 @@ func BlockBodyKZGOffset(
 	cs common.ChainSpec,
 ) uint64 {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic("unsupported fork version")
 	}
 }
 
+// BlockBodyKZGPosition returns the position of the KZG commitments list
+// among the top level fields of the block body.
+func BlockBodyKZGPosition(
+	slot math.Slot,
+	cs common.ChainSpec,
+) uint64 {
+	switch cs.ActiveForkVersionForSlot(slot) {
+	case version.Deneb, version.Electra:
+		return KZGPositionDeneb
+	default:
+		panic("unsupported fork version")
+	}
+}
+
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain. From Electra onwards the body also carries the ExecutionRequests
+// and the Attestations, the fork version the body is built or decoded at
+// selects its SSZ schema.
 type BeaconBlockBody struct {
 	// RandaoReveal is the reveal of the RANDAO.
 	RandaoReveal crypto.BLSSignature
@@ -97,6 +139,38 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// ExecutionRequests are the requests emitted by the execution layer,
+	// nil before Electra.
+	ExecutionRequests *engineprimitives.ExecutionRequests
+	// Attestations are the execution layer views signed by the validators in
+	// their vote extensions for the previous block, empty before Electra.
+	Attestations []*AttestationData
+
+	// forkVersion is the fork version the body is encoded at.
+	forkVersion uint32
+}
+
+// Version returns the fork version the BeaconBlockBody is encoded at, which
+// is Deneb if the body was not built for a given fork version.
+func (b *BeaconBlockBody) Version() uint32 {
+	return max(b.forkVersion, version.Deneb)
+}
+
+// isElectra returns whether the ExecutionRequests and the Attestations are
+// part of the SSZ schema of the BeaconBlockBody.
+func (b *BeaconBlockBody) isElectra() bool {
+	return b.forkVersion >= version.Electra
+}
+
+// executionRequests returns the ExecutionRequests of an Electra body, setting
+// them to empty requests if unset as they are mandatory from Electra onwards.
+func (
+	b *BeaconBlockBody,
+) executionRequests() *engineprimitives.ExecutionRequests {
+	if b.ExecutionRequests == nil {
+		b.ExecutionRequests = new(engineprimitives.ExecutionRequests)
+	}
+	return b.ExecutionRequests
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +180,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.isElectra() {
+		size += 4 + 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +190,10 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
 	size += ssz.SizeDynamicObject(b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.isElectra() {
+		size += ssz.SizeDynamicObject(b.executionRequests())
+		size += ssz.SizeSliceOfStaticObjects(b.Attestations)
+	}
 	return size
 }
 
@@ -127,11 +208,65 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		b.executionRequests()
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+		)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+		)
+	}
+}
+
+// SSZSchema returns the SSZ schema of the BeaconBlockBody at the given fork
+// version. The ExecutionRequests and the Attestations are part of it from
+// Electra onwards.
+func (*BeaconBlockBody) SSZSchema(forkVersion uint32) schema.SSZType {
+	fields := []*schema.Field[schema.SSZType]{
+		schema.NewField("randao_reveal", schema.B96()),
+		schema.NewField("eth1_data", (*Eth1Data)(nil).SSZSchema()),
+		schema.NewField("graffiti", schema.B32()),
+		schema.NewField(
+			"deposits",
+			schema.DefineList(
+				(*Deposit)(nil).SSZSchema(), constants.MaxDepositsPerBlock,
+			),
+		),
+		schema.NewField(
+			"execution_payload", (*ExecutionPayload)(nil).SSZSchema(),
+		),
+		schema.NewField(
+			"blob_kzg_commitments",
+			schema.DefineList(schema.B48(), MaxBlobCommitmentsPerBlock),
+		),
+	}
+	if forkVersion >= version.Electra {
+		fields = append(
+			fields,
+			schema.NewField(
+				"execution_requests",
+				(*engineprimitives.ExecutionRequests)(nil).SSZSchema(),
+			),
+			schema.NewField(
+				"attestations",
+				schema.DefineList(
+					(*AttestationData)(nil).SSZSchema(),
+					constants.MaxAttestationsPerBlock,
+				),
+			),
+		)
+	}
+	return schema.DefineContainer(fields...)
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -221,6 +356,28 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	// Field (6) 'ExecutionRequests'
+	if b.isElectra() {
+		if err := b.executionRequests().HashTreeRootWith(hh); err != nil {
+			return err
+		}
+
+		// Field (7) 'Attestations'
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > constants.MaxAttestationsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.Attestations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxAttestationsPerBlock,
+		)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -269,29 +426,34 @@ func (b *BeaconBlockBody) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
+// GetAttestations returns the Attestations of the BeaconBlockBody, which
+// are empty before Electra.
 func (b *BeaconBlockBody) GetAttestations() []*AttestationData {
-	panic("not implemented")
+	if !b.isElectra() {
+		return nil
+	}
+	return b.Attestations
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetAttestations(_ []*AttestationData) {
-	panic("not implemented")
+// SetAttestations sets the Attestations of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetAttestations(attestations []*AttestationData) {
+	b.Attestations = attestations
 }
 
-// GetSlashingInfo is not implemented for BeaconBlockDeneb.
+// GetSlashingInfo returns the slashing info of the BeaconBlockBody, which is
+// always empty as the body does not carry it. CometBFT commits to the
+// misbehavior evidence in its own block, from which it is read instead.
 func (b *BeaconBlockBody) GetSlashingInfo() []*SlashingInfo {
-	panic("not implemented")
+	return nil
 }
 
-// SetSlashingInfo is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
-	panic("not implemented")
-}
+// SetSlashingInfo is a no-op, the BeaconBlockBody does not carry the slashing
+// info.
+func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {}
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,10 +462,21 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.isElectra() {
+		roots = append(
+			roots,
+			b.executionRequests().HashTreeRoot(),
+			Attestations(b.Attestations).HashTreeRoot(),
+		)
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.isElectra() {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
@@ -341,3 +514,21 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
+
+// GetExecutionRequests returns the ExecutionRequests of the
+// BeaconBlockBody, which are nil before Electra.
+func (
+	b *BeaconBlockBody,
+) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	if !b.isElectra() {
+		return nil
+	}
+	return b.executionRequests()
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetExecutionRequests(
+	requests *engineprimitives.ExecutionRequests,
+) {
+	b.ExecutionRequests = requests
+}
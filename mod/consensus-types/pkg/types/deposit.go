@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -120,6 +121,17 @@ func (d *Deposit) DefineSSZ(c *ssz.Codec) {
 	ssz.DefineUint64(c, &d.Index)
 }
 
+// SSZSchema returns the SSZ schema of the Deposit.
+func (*Deposit) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("withdrawal_credentials", schema.B32()),
+		schema.NewField("amount", schema.U64()),
+		schema.NewField("signature", schema.B96()),
+		schema.NewField("index", schema.U64()),
+	)
+}
+
 // MarshalSSZ marshals the Deposit object to SSZ format.
 func (d *Deposit) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, d.SizeSSZ())
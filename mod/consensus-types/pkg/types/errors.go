@@ -38,4 +38,8 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrInvalidBlockSignature is an error for when the signature of the
+	// proposer over a beacon block doesn't match.
+	ErrInvalidBlockSignature = errors.New("invalid block signature")
 )
@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -85,6 +86,15 @@ func (e *Eth1Data) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineStaticBytes(codec, &e.BlockHash)
 }
 
+// SSZSchema returns the SSZ schema of the Eth1Data.
+func (*Eth1Data) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("deposit_root", schema.B32()),
+		schema.NewField("deposit_count", schema.U64()),
+		schema.NewField("block_hash", schema.B32()),
+	)
+}
+
 // HashTreeRoot computes the SSZ hash tree root of the Eth1Data object.
 func (e *Eth1Data) HashTreeRoot() common.Root {
 	return ssz.HashSequential(e)
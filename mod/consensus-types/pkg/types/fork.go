@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -88,6 +89,15 @@ func (f *Fork) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &f.Epoch)
 }
 
+// SSZSchema returns the SSZ schema of the Fork.
+func (*Fork) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("previous_version", schema.B4()),
+		schema.NewField("current_version", schema.B4()),
+		schema.NewField("epoch", schema.U64()),
+	)
+}
+
 // MarshalSSZ marshals the Fork object to SSZ format.
 func (f *Fork) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, f.SizeSSZ())
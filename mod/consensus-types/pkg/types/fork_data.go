@@ -117,3 +117,15 @@ func (fd *ForkData) ComputeRandaoSigningRoot(
 		fd.ComputeDomain(domainType),
 	)
 }
+
+// ComputeBlockSigningRoot computes the signing root of the beacon block with
+// the given root.
+func (fd *ForkData) ComputeBlockSigningRoot(
+	domainType common.DomainType,
+	blockRoot common.Root,
+) common.Root {
+	return (&SigningData{
+		ObjectRoot: blockRoot,
+		Domain:     fd.ComputeDomain(domainType),
+	}).HashTreeRoot()
+}
@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -110,6 +111,17 @@ func (b *BeaconBlockHeader) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineStaticBytes(codec, &b.BodyRoot)
 }
 
+// SSZSchema returns the SSZ schema of the BeaconBlockHeader.
+func (*BeaconBlockHeader) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("body_root", schema.B32()),
+	)
+}
+
 // MarshalSSZ marshals the BeaconBlockBody object to SSZ format.
 func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, b.SizeSSZ())
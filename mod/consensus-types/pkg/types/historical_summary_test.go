@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalSummary_Serialization(t *testing.T) {
+	original := (&types.HistoricalSummary{}).New(
+		common.Root{1, 2, 3},
+		common.Root{4, 5, 6},
+	)
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.HistoricalSummarySize)
+
+	var unmarshalled types.HistoricalSummary
+	err = unmarshalled.UnmarshalSSZ(data)
+	require.NoError(t, err)
+	require.Equal(t, original, &unmarshalled)
+
+	var buf []byte
+	buf, err = original.MarshalSSZTo(buf)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+}
+
+func TestHistoricalSummary_HashTreeRoot(t *testing.T) {
+	summary := &types.HistoricalSummary{
+		BlockSummaryRoot: common.Root{1, 2, 3},
+		StateSummaryRoot: common.Root{4, 5, 6},
+	}
+
+	// The root of a container of two roots is the hash of the two roots.
+	expected := sha256.Sum256(append(
+		summary.BlockSummaryRoot[:], summary.StateSummaryRoot[:]...,
+	))
+	require.Equal(t, common.Root(expected), summary.HashTreeRoot())
+
+	tree, err := summary.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, expected[:], tree.Hash())
+}
+
+func TestHistoricalSummary_UnmarshalSSZ_ErrSize(t *testing.T) {
+	var summary types.HistoricalSummary
+	err := summary.UnmarshalSSZ(make([]byte, 32))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
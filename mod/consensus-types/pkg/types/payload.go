@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
@@ -130,6 +131,41 @@ func (p *ExecutionPayload) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsContent(codec, &p.Withdrawals, 16)
 }
 
+// SSZSchema returns the SSZ schema of the ExecutionPayload.
+func (*ExecutionPayload) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("parent_hash", schema.B32()),
+		schema.NewField("fee_recipient", schema.B20()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("receipts_root", schema.B32()),
+		schema.NewField("logs_bloom", schema.B256()),
+		schema.NewField("prev_randao", schema.B32()),
+		schema.NewField("block_number", schema.U64()),
+		schema.NewField("gas_limit", schema.U64()),
+		schema.NewField("gas_used", schema.U64()),
+		schema.NewField("timestamp", schema.U64()),
+		schema.NewField("extra_data", schema.DefineByteList(ExtraDataSize)),
+		schema.NewField("base_fee_per_gas", schema.U256()),
+		schema.NewField("block_hash", schema.B32()),
+		schema.NewField(
+			"transactions",
+			schema.DefineList(
+				schema.DefineByteList(constants.MaxBytesPerTx),
+				constants.MaxTxsPerPayload,
+			),
+		),
+		schema.NewField(
+			"withdrawals",
+			schema.DefineList(
+				(*engineprimitives.Withdrawal)(nil).SSZSchema(),
+				constants.MaxWithdrawalsPerPayload,
+			),
+		),
+		schema.NewField("blob_gas_used", schema.U64()),
+		schema.NewField("excess_blob_gas", schema.U64()),
+	)
+}
+
 // MarshalSSZ serializes the ExecutionPayload object into a slice of bytes.
 func (p *ExecutionPayload) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, p.SizeSSZ(false))
@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
@@ -145,6 +146,29 @@ func (h *ExecutionPayloadHeader) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineDynamicBytesContent(codec, (*[]byte)(&h.ExtraData), 32)
 }
 
+// SSZSchema returns the SSZ schema of the ExecutionPayloadHeader.
+func (*ExecutionPayloadHeader) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("parent_hash", schema.B32()),
+		schema.NewField("fee_recipient", schema.B20()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("receipts_root", schema.B32()),
+		schema.NewField("logs_bloom", schema.B256()),
+		schema.NewField("prev_randao", schema.B32()),
+		schema.NewField("block_number", schema.U64()),
+		schema.NewField("gas_limit", schema.U64()),
+		schema.NewField("gas_used", schema.U64()),
+		schema.NewField("timestamp", schema.U64()),
+		schema.NewField("extra_data", schema.DefineByteList(ExtraDataSize)),
+		schema.NewField("base_fee_per_gas", schema.U256()),
+		schema.NewField("block_hash", schema.B32()),
+		schema.NewField("transactions_root", schema.B32()),
+		schema.NewField("withdrawals_root", schema.B32()),
+		schema.NewField("blob_gas_used", schema.U64()),
+		schema.NewField("excess_blob_gas", schema.U64()),
+	)
+}
+
 // MarshalSSZ serializes the ExecutionPayloadHeader object into a slice of
 // bytes.
 func (h *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// The schemas must resolve every path to the node of the proof tree that
+// holds the object at the path.
+func TestBeaconBlockSSZSchema(t *testing.T) {
+	blk := generateValidBeaconBlockAt(version.Electra)
+	blk.Body.ExecutionRequests = &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{Pubkey: [48]byte{1}, Amount: 32e9, Index: 2},
+		},
+		Withdrawals: []*engineprimitives.WithdrawalRequest{
+			{SourceAddress: [20]byte{2}, ValidatorPubkey: [48]byte{3}},
+		},
+	}
+	blk.Body.SetAttestations([]*types.AttestationData{
+		{Slot: 9, Index: 1},
+		{Slot: 9, Index: 3, DepositIndex: 4},
+	})
+	body := blk.GetBody()
+	payload := body.GetExecutionPayload()
+
+	requireSchemaNodes(
+		t, blk.SSZSchema(version.Electra), blk,
+		map[string]common.Root{
+			"slot":                   uint64Root(uint64(blk.Slot)),
+			"body":                   body.HashTreeRoot(),
+			"body/eth1_data":         body.Eth1Data.HashTreeRoot(),
+			"body/deposits/0":        body.Deposits[0].HashTreeRoot(),
+			"body/deposits/__len__":  uint64Root(1),
+			"body/execution_payload": payload.HashTreeRoot(),
+			"body/execution_payload/block_number": uint64Root(
+				uint64(payload.Number),
+			),
+			"body/execution_payload/transactions/__len__": uint64Root(3),
+			"body/execution_payload/transactions/2/__len__": uint64Root(
+				uint64(len(payload.Transactions[2])),
+			),
+			"body/execution_payload/withdrawals/1": payload.Withdrawals[1].
+				HashTreeRoot(),
+			"body/execution_payload/withdrawals/1/amount": uint64Root(200),
+			"body/blob_kzg_commitments/__len__":           uint64Root(1),
+			"body/execution_requests": body.ExecutionRequests.
+				HashTreeRoot(),
+			"body/execution_requests/deposits/0": body.ExecutionRequests.
+				Deposits[0].HashTreeRoot(),
+			"body/execution_requests/withdrawals/0": body.ExecutionRequests.
+				Withdrawals[0].HashTreeRoot(),
+			"body/execution_requests/consolidations/__len__": uint64Root(0),
+			"body/attestations/1/deposit_index":              uint64Root(4),
+			"body/attestations/1": body.Attestations[1].
+				HashTreeRoot(),
+		},
+	)
+
+	// The Deneb body ends at the KZG commitments, of which the data root is
+	// where the commitment inclusion proofs point to.
+	blk = generateValidBeaconBlock()
+	requireSchemaNodes(
+		t, blk.SSZSchema(version.Deneb), blk,
+		map[string]common.Root{
+			"body": blk.Body.HashTreeRoot(),
+			"body/execution_payload/withdrawals/0": payload.Withdrawals[0].
+				HashTreeRoot(),
+		},
+	)
+	_, _, _, err := merkle.ObjectPath[uint64, common.Root](
+		"body/attestations",
+	).GetGeneralizedIndex(blk.SSZSchema(version.Deneb))
+	require.Error(t, err)
+	_, gIndex, _, err := merkle.ObjectPath[uint64, common.Root](
+		"blob_kzg_commitments",
+	).GetGeneralizedIndex(body.SSZSchema(version.Deneb))
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.KZGMerkleIndexDeneb), 2*gIndex)
+
+	header := blk.GetHeader()
+	requireSchemaNodes(
+		t, header.SSZSchema(), header,
+		map[string]common.Root{
+			"proposer_index": uint64Root(uint64(header.ProposerIndex)),
+			"body_root":      header.BodyRoot,
+		},
+	)
+}
+
+func TestBeaconStateSSZSchema(t *testing.T) {
+	st := generateValidElectraBeaconState(t)
+	requireSchemaNodes(
+		t, st.SSZSchema(version.Electra), st,
+		map[string]common.Root{
+			"slot":                uint64Root(uint64(st.Slot)),
+			"fork":                st.Fork.HashTreeRoot(),
+			"latest_block_header": st.LatestBlockHeader.HashTreeRoot(),
+			"block_roots/1":       st.BlockRoots[1],
+			"eth1_data":           st.Eth1Data.HashTreeRoot(),
+			"latest_execution_payload_header": st.
+				LatestExecutionPayloadHeader.HashTreeRoot(),
+			"latest_execution_payload_header/block_number": uint64Root(
+				uint64(st.LatestExecutionPayloadHeader.Number),
+			),
+			"validators/1":                   st.Validators[1].HashTreeRoot(),
+			"validators/1/effective_balance": uint64Root(31e9),
+			"balances/__len__":               uint64Root(2),
+			"randao_mixes/3":                 common.Root(st.RandaoMixes[3]),
+			"total_slashing":                 uint64Root(uint64(st.TotalSlashing)),
+			"historical_summaries/0": st.HistoricalSummaries[0].
+				HashTreeRoot(),
+			"exit_balance_to_consume":               uint64Root(64e9),
+			"earliest_exit_epoch":                   uint64Root(9),
+			"pending_consolidations/0/source_index": uint64Root(1),
+			"pending_consolidations/__len__":        uint64Root(1),
+		},
+	)
+
+	for _, path := range []string{
+		"historical_summaries", "earliest_consolidation_epoch",
+	} {
+		_, _, _, err := merkle.ObjectPath[uint64, common.Root](
+			path,
+		).GetGeneralizedIndex(st.SSZSchema(version.Deneb))
+		require.Error(t, err, path)
+	}
+}
+
+// requireSchemaNodes requires the paths to resolve in the schema to the nodes
+// of the proof tree of the object with the given roots.
+func requireSchemaNodes(
+	t *testing.T,
+	typ schema.SSZType,
+	obj interface{ GetTree() (*fastssz.Node, error) },
+	roots map[string]common.Root,
+) {
+	t.Helper()
+	tree, err := obj.GetTree()
+	require.NoError(t, err)
+	for path, root := range roots {
+		_, gIndex, _, err := merkle.ObjectPath[uint64, common.Root](
+			path,
+		).GetGeneralizedIndex(typ)
+		require.NoError(t, err, path)
+
+		//#nosec:G701 // generalized indices of the tests fit in an int.
+		node, err := tree.Get(int(gIndex))
+		require.NoError(t, err, path)
+		require.Equal(t, root, common.Root(node.Hash()), path)
+	}
+}
+
+// uint64Root returns the hash tree root of a uint64.
+func uint64Root(v uint64) common.Root {
+	var root common.Root
+	binary.LittleEndian.PutUint64(root[:], v)
+	return root
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// SignedBeaconBlock as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedbeaconblock
+//
+//nolint:lll
+type SignedBeaconBlock struct {
+	// Message is the beacon block signed by the proposer.
+	Message *BeaconBlock `json:"message"`
+	// Signature is the signature of the proposer over the block root.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// NewSignedBeaconBlock creates a new signed beacon block.
+func NewSignedBeaconBlock(
+	message *BeaconBlock,
+	signature crypto.BLSSignature,
+) *SignedBeaconBlock {
+	return &SignedBeaconBlock{
+		Message:   message,
+		Signature: signature,
+	}
+}
+
+// Empty creates an empty signed beacon block.
+func (*SignedBeaconBlock) Empty() *SignedBeaconBlock {
+	return &SignedBeaconBlock{}
+}
+
+// New creates a new signed beacon block.
+func (*SignedBeaconBlock) New(
+	message *BeaconBlock,
+	signature crypto.BLSSignature,
+) *SignedBeaconBlock {
+	return NewSignedBeaconBlock(message, signature)
+}
+
+// NewFromSSZ creates a new signed beacon block from the given SSZ bytes.
+func (*SignedBeaconBlock) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*SignedBeaconBlock, error) {
+	body, err := newBeaconBlockBody(forkVersion)
+	if err != nil {
+		return new(SignedBeaconBlock), err
+	}
+
+	block := &SignedBeaconBlock{Message: &BeaconBlock{Body: body}}
+	return block, block.UnmarshalSSZ(bz)
+}
+
+// VerifySignature verifies the signature of the proposer over the block root,
+// in the proposer domain of the given fork.
+func (b *SignedBeaconBlock) VerifySignature(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := NewForkData(
+		forkVersion, genesisValidatorsRoot,
+	).ComputeBlockSigningRoot(domainType, b.Message.HashTreeRoot())
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], b.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBlockSignature)
+	}
+
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SignedBeaconBlock object in SSZ encoding.
+func (b *SignedBeaconBlock) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // 4 + 96 = 100.
+	var size = uint32(4 + 96)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Message)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBeaconBlock object.
+func (b *SignedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &b.Message)
+	ssz.DefineStaticBytes(codec, &b.Signature)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Message)
+}
+
+// MarshalSSZ marshals the SignedBeaconBlock object to SSZ format.
+func (b *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the SignedBeaconBlock object from SSZ format.
+func (b *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the Merkleization of the SignedBeaconBlock object.
+func (b *SignedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// IsNil checks if the signed beacon block or its message is nil.
+func (b *SignedBeaconBlock) IsNil() bool {
+	return b == nil || b.Message == nil
+}
+
+// GetMessage retrieves the beacon block of the SignedBeaconBlock.
+func (b *SignedBeaconBlock) GetMessage() *BeaconBlock {
+	return b.Message
+}
+
+// GetSignature retrieves the signature of the SignedBeaconBlock.
+func (b *SignedBeaconBlock) GetSignature() crypto.BLSSignature {
+	return b.Signature
+}
+
+// GetBlockRoot retrieves the root of the beacon block, which identifies the
+// block rather than the root of the signed envelope.
+func (b *SignedBeaconBlock) GetBlockRoot() common.Root {
+	return b.Message.HashTreeRoot()
+}
+
+// GetHeader builds a BeaconBlockHeader from the beacon block.
+func (b *SignedBeaconBlock) GetHeader() *BeaconBlockHeader {
+	return b.Message.GetHeader()
+}
+
+// GetSlot retrieves the slot of the beacon block.
+func (b *SignedBeaconBlock) GetSlot() math.Slot {
+	return b.Message.GetSlot()
+}
+
+// GetParentBlockRoot retrieves the parent block root of the beacon block.
+func (b *SignedBeaconBlock) GetParentBlockRoot() common.Root {
+	return b.Message.GetParentBlockRoot()
+}
+
+// GetExecutionNumber retrieves the execution number of the beacon block.
+func (b *SignedBeaconBlock) GetExecutionNumber() math.U64 {
+	return b.Message.GetExecutionNumber()
+}
+
+// GetAttestations retrieves the attestations of the body of the beacon block.
+func (b *SignedBeaconBlock) GetAttestations() []*AttestationData {
+	return b.Message.GetBody().GetAttestations()
+}
+
+// Version identifies the version of the beacon block.
+func (b *SignedBeaconBlock) Version() uint32 {
+	return b.Message.Version()
+}
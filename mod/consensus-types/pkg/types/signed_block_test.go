@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func generateValidSignedBeaconBlock() *types.SignedBeaconBlock {
+	return types.NewSignedBeaconBlock(
+		generateValidBeaconBlock(), crypto.BLSSignature{1, 2, 3},
+	)
+}
+
+func TestSignedBeaconBlock_MarshalUnmarshalSSZ(t *testing.T) {
+	originalBlock := generateValidSignedBeaconBlock()
+
+	sszBlock, err := originalBlock.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, sszBlock, int(originalBlock.SizeSSZ(false)))
+
+	wrappedBlock, err := (&types.SignedBeaconBlock{}).NewFromSSZ(
+		sszBlock, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, originalBlock, wrappedBlock)
+	require.Equal(t, version.Deneb, wrappedBlock.Version())
+
+	// A signed block is not a valid encoding of its message.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(sszBlock, version.Deneb)
+	require.Error(t, err)
+}
+
+func TestSignedBeaconBlockFromSSZForkVersionNotSupported(t *testing.T) {
+	_, err := (&types.SignedBeaconBlock{}).NewFromSSZ([]byte{}, 1)
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+}
+
+func TestSignedBeaconBlock_Getters(t *testing.T) {
+	block := generateValidSignedBeaconBlock()
+	message := block.GetMessage()
+
+	require.Equal(t, block.Message, message)
+	require.Equal(t, block.Signature, block.GetSignature())
+	require.Equal(t, message.GetSlot(), block.GetSlot())
+	require.Equal(t, message.GetExecutionNumber(), block.GetExecutionNumber())
+	require.Equal(t, message.GetHeader(), block.GetHeader())
+
+	// The block is identified by the root of its message.
+	require.Equal(t, message.HashTreeRoot(), block.GetBlockRoot())
+	require.Equal(t, block.GetHeader().HashTreeRoot(), block.GetBlockRoot())
+	require.NotEqual(t, block.GetBlockRoot(), block.HashTreeRoot())
+}
+
+func TestSignedBeaconBlock_IsNil(t *testing.T) {
+	var block *types.SignedBeaconBlock
+	require.True(t, block.IsNil())
+	require.True(t, (&types.SignedBeaconBlock{}).IsNil())
+	require.False(t, generateValidSignedBeaconBlock().IsNil())
+}
+
+func TestSignedBeaconBlock_VerifySignature(t *testing.T) {
+	var (
+		block       = generateValidSignedBeaconBlock()
+		forkVersion = common.Version{0x04, 0x00, 0x00, 0x00}
+		root        = common.Root{0x01}
+		domainType  = common.DomainType{0x00, 0x00, 0x00, 0x00}
+		pubkey      = crypto.BLSPubkey{0x02}
+		errVerify   = errors.New("signature mismatch")
+	)
+	signingRoot := types.NewForkData(forkVersion, root).
+		ComputeBlockSigningRoot(domainType, block.GetBlockRoot())
+
+	verifyFn := func(
+		pk crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+	) error {
+		if pk != pubkey || common.Root(msg) != signingRoot ||
+			sig != block.Signature {
+			return errVerify
+		}
+		return nil
+	}
+	require.NoError(t, block.VerifySignature(
+		forkVersion, root, domainType, pubkey, verifyFn,
+	))
+
+	// The signature is bound to the domain it was produced in.
+	err := block.VerifySignature(
+		forkVersion, root, common.DomainType{0x01}, pubkey, verifyFn,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidBlockSignature)
+	require.ErrorIs(t, err, errVerify)
+}
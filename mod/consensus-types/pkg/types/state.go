@@ -21,25 +21,51 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
+const (
+	// stateHistoricalRootsLimit is the limit of the block and state roots in
+	// the BeaconState.
+	stateHistoricalRootsLimit = 8192
+	// stateRandaoMixesLimit is the limit of the randao mixes in the
+	// BeaconState.
+	stateRandaoMixesLimit = 65536
+	// stateHistoricalSummariesLimit is the limit of the historical summaries
+	// in the BeaconState.
+	stateHistoricalSummariesLimit = 16777216
+)
+
 // BeaconState represents the entire state of the beacon chain.
 type BeaconState[
-	BeaconBlockHeaderT constraints.
-		StaticSSZField[BeaconBlockHeaderT, B],
-	Eth1DataT constraints.
-		StaticSSZField[Eth1DataT, E],
-	ExecutionPayloadHeaderT constraints.
-		DynamicSSZField[ExecutionPayloadHeaderT, P],
-	ForkT constraints.
-		StaticSSZField[ForkT, F],
-	ValidatorT constraints.
-		StaticSSZField[ValidatorT, V],
+	BeaconBlockHeaderT interface {
+		constraints.StaticSSZField[BeaconBlockHeaderT, B]
+		constraints.SSZSchemaDefiner
+	},
+	Eth1DataT interface {
+		constraints.StaticSSZField[Eth1DataT, E]
+		constraints.SSZSchemaDefiner
+	},
+	ExecutionPayloadHeaderT interface {
+		constraints.DynamicSSZField[ExecutionPayloadHeaderT, P]
+		constraints.SSZSchemaDefiner
+	},
+	ForkT interface {
+		constraints.StaticSSZField[ForkT, F]
+		constraints.SSZSchemaDefiner
+	},
+	ValidatorT interface {
+		constraints.StaticSSZField[ValidatorT, V]
+		constraints.SSZSchemaDefiner
+	},
 	B, E, P, F, V any,
 ] struct {
 	// Versioning
@@ -71,6 +97,19 @@ type BeaconState[
 	// Slashing
 	Slashings     []uint64
 	TotalSlashing math.Gwei
+
+	// Historical summaries, part of the SSZ schema from Electra onwards.
+	HistoricalSummaries []*HistoricalSummary
+
+	// Churn, part of the SSZ schema from Electra onwards.
+	ExitBalanceToConsume          math.Gwei
+	EarliestExitEpoch             math.Epoch
+	ConsolidationBalanceToConsume math.Gwei
+	EarliestConsolidationEpoch    math.Epoch
+	PendingConsolidations         []*engineprimitives.PendingConsolidation
+
+	// forkVersion is the fork version the state is encoded at.
+	forkVersion uint32
 }
 
 // New creates a new BeaconState.
@@ -82,7 +121,7 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ]) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork ForkT,
@@ -99,6 +138,12 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []uint64,
 	totalSlashing math.Gwei,
+	historicalSummaries []*HistoricalSummary,
+	exitBalanceToConsume math.Gwei,
+	earliestExitEpoch math.Epoch,
+	consolidationBalanceToConsume math.Gwei,
+	earliestConsolidationEpoch math.Epoch,
+	pendingConsolidations []*engineprimitives.PendingConsolidation,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	Eth1DataT,
@@ -115,25 +160,80 @@ func (st *BeaconState[
 		ValidatorT,
 		B, E, P, F, V,
 	]{
-		Slot:                         slot,
-		GenesisValidatorsRoot:        genesisValidatorsRoot,
-		Fork:                         fork,
-		LatestBlockHeader:            latestBlockHeader,
-		BlockRoots:                   blockRoots,
-		StateRoots:                   stateRoots,
-		LatestExecutionPayloadHeader: latestExecutionPayloadHeader,
-		Eth1Data:                     eth1Data,
-		Eth1DepositIndex:             eth1DepositIndex,
-		Validators:                   validators,
-		Balances:                     balances,
-		RandaoMixes:                  randaoMixes,
-		NextWithdrawalIndex:          nextWithdrawalIndex,
-		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
-		Slashings:                    slashings,
-		TotalSlashing:                totalSlashing,
+		Slot:                          slot,
+		GenesisValidatorsRoot:         genesisValidatorsRoot,
+		Fork:                          fork,
+		LatestBlockHeader:             latestBlockHeader,
+		BlockRoots:                    blockRoots,
+		StateRoots:                    stateRoots,
+		LatestExecutionPayloadHeader:  latestExecutionPayloadHeader,
+		Eth1Data:                      eth1Data,
+		Eth1DepositIndex:              eth1DepositIndex,
+		Validators:                    validators,
+		Balances:                      balances,
+		RandaoMixes:                   randaoMixes,
+		NextWithdrawalIndex:           nextWithdrawalIndex,
+		NextWithdrawalValidatorIndex:  nextWithdrawalValidatorIndex,
+		Slashings:                     slashings,
+		TotalSlashing:                 totalSlashing,
+		HistoricalSummaries:           historicalSummaries,
+		ExitBalanceToConsume:          exitBalanceToConsume,
+		EarliestExitEpoch:             earliestExitEpoch,
+		ConsolidationBalanceToConsume: consolidationBalanceToConsume,
+		EarliestConsolidationEpoch:    earliestConsolidationEpoch,
+		PendingConsolidations:         pendingConsolidations,
+		forkVersion:                   forkVersion,
 	}, nil
 }
 
+// NewFromSSZ creates a new BeaconState from the given SSZ bytes, encoded at
+// the given fork version.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+]) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+], error) {
+	state := &BeaconState[
+		BeaconBlockHeaderT,
+		Eth1DataT,
+		ExecutionPayloadHeaderT,
+		ForkT,
+		ValidatorT,
+		B, E, P, F, V,
+	]{
+		forkVersion: forkVersion,
+	}
+	return state, state.UnmarshalSSZ(bz)
+}
+
+// Version returns the fork version the BeaconState is encoded at.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) Version() uint32 {
+	return st.forkVersion
+}
+
+// isElectra returns whether the historical summaries and the churn fields are
+// part of the SSZ schema of the BeaconState.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) isElectra() bool {
+	return st.forkVersion >= version.Electra
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -143,6 +243,9 @@ func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 300
+	if st.isElectra() {
+		size += 40
+	}
 
 	if fixed {
 		return size
@@ -156,6 +259,10 @@ func (st *BeaconState[
 	size += ssz.SizeSliceOfUint64s(st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(st.Slashings)
+	if st.isElectra() {
+		size += ssz.SizeSliceOfStaticObjects(st.HistoricalSummaries)
+		size += ssz.SizeSliceOfStaticObjects(st.PendingConsolidations)
+	}
 
 	return size
 }
@@ -196,6 +303,20 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Historical summaries and churn
+	if st.isElectra() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.HistoricalSummaries, 16777216,
+		)
+		ssz.DefineUint64(codec, &st.ExitBalanceToConsume)
+		ssz.DefineUint64(codec, &st.EarliestExitEpoch)
+		ssz.DefineUint64(codec, &st.ConsolidationBalanceToConsume)
+		ssz.DefineUint64(codec, &st.EarliestConsolidationEpoch)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.PendingConsolidations, 262144,
+		)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -204,6 +325,115 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	if st.isElectra() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.HistoricalSummaries, 16777216,
+		)
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.PendingConsolidations, 262144,
+		)
+	}
+}
+
+// SSZSchema returns the SSZ schema of the BeaconState at the given fork
+// version. The historical summaries and the churn fields are part of it from
+// Electra onwards.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	_, _, _, _, _,
+]) SSZSchema(forkVersion uint32) schema.SSZType {
+	var (
+		fork                         ForkT
+		latestBlockHeader            BeaconBlockHeaderT
+		eth1Data                     Eth1DataT
+		latestExecutionPayloadHeader ExecutionPayloadHeaderT
+		validator                    ValidatorT
+	)
+	fields := []*schema.Field[schema.SSZType]{
+		// Versioning
+		schema.NewField("genesis_validators_root", schema.B32()),
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("fork", fork.SSZSchema()),
+
+		// History
+		schema.NewField("latest_block_header", latestBlockHeader.SSZSchema()),
+		schema.NewField(
+			"block_roots",
+			schema.DefineList(schema.B32(), stateHistoricalRootsLimit),
+		),
+		schema.NewField(
+			"state_roots",
+			schema.DefineList(schema.B32(), stateHistoricalRootsLimit),
+		),
+
+		// Eth1
+		schema.NewField("eth1_data", eth1Data.SSZSchema()),
+		schema.NewField("eth1_deposit_index", schema.U64()),
+		schema.NewField(
+			"latest_execution_payload_header",
+			latestExecutionPayloadHeader.SSZSchema(),
+		),
+
+		// Registry
+		schema.NewField(
+			"validators",
+			schema.DefineList(
+				validator.SSZSchema(), constants.ValidatorRegistryLimit,
+			),
+		),
+		schema.NewField(
+			"balances",
+			schema.DefineList(schema.U64(), constants.ValidatorRegistryLimit),
+		),
+
+		// Randomness
+		schema.NewField(
+			"randao_mixes",
+			schema.DefineList(schema.B32(), stateRandaoMixesLimit),
+		),
+
+		// Withdrawals
+		schema.NewField("next_withdrawal_index", schema.U64()),
+		schema.NewField("next_withdrawal_validator_index", schema.U64()),
+
+		// Slashing
+		schema.NewField(
+			"slashings",
+			schema.DefineList(schema.U64(), constants.ValidatorRegistryLimit),
+		),
+		schema.NewField("total_slashing", schema.U64()),
+	}
+
+	// Historical summaries and churn
+	if forkVersion >= version.Electra {
+		fields = append(fields,
+			schema.NewField(
+				"historical_summaries",
+				schema.DefineList(
+					(*HistoricalSummary)(nil).SSZSchema(),
+					stateHistoricalSummariesLimit,
+				),
+			),
+			schema.NewField("exit_balance_to_consume", schema.U64()),
+			schema.NewField("earliest_exit_epoch", schema.U64()),
+			schema.NewField(
+				"consolidation_balance_to_consume", schema.U64(),
+			),
+			schema.NewField("earliest_consolidation_epoch", schema.U64()),
+			schema.NewField(
+				"pending_consolidations",
+				schema.DefineList(
+					(*engineprimitives.PendingConsolidation)(nil).SSZSchema(),
+					constants.PendingConsolidationsLimit,
+				),
+			),
+		)
+	}
+	return schema.DefineContainer(fields...)
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -391,6 +621,47 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	// Fields (16) to (21), part of the schema from Electra onwards.
+	if st.isElectra() {
+		// Field (16) 'HistoricalSummaries'
+		subIndx = hh.Index()
+		num = uint64(len(st.HistoricalSummaries))
+		if num > 16777216 {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.HistoricalSummaries {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16777216)
+
+		// Field (17) 'ExitBalanceToConsume'
+		hh.PutUint64(uint64(st.ExitBalanceToConsume))
+
+		// Field (18) 'EarliestExitEpoch'
+		hh.PutUint64(uint64(st.EarliestExitEpoch))
+
+		// Field (19) 'ConsolidationBalanceToConsume'
+		hh.PutUint64(uint64(st.ConsolidationBalanceToConsume))
+
+		// Field (20) 'EarliestConsolidationEpoch'
+		hh.PutUint64(uint64(st.EarliestConsolidationEpoch))
+
+		// Field (21) 'PendingConsolidations'
+		subIndx = hh.Index()
+		num = uint64(len(st.PendingConsolidations))
+		if num > 262144 {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.PendingConsolidations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 262144)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
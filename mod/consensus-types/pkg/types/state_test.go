@@ -31,8 +31,11 @@ import (
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -137,6 +140,58 @@ func generateValidBeaconState() *types.BeaconState[
 	}
 }
 
+// generateValidElectraBeaconState generates a valid beacon state encoded at
+// the Electra fork, which carries the historical summaries.
+func generateValidElectraBeaconState(t *testing.T) *types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+] {
+	t.Helper()
+	st := generateValidBeaconState()
+	electra, err := st.New(
+		version.Electra,
+		st.GenesisValidatorsRoot,
+		st.Slot,
+		st.Fork,
+		st.LatestBlockHeader,
+		st.BlockRoots,
+		st.StateRoots,
+		st.Eth1Data,
+		st.Eth1DepositIndex,
+		st.LatestExecutionPayloadHeader,
+		st.Validators,
+		st.Balances,
+		st.RandaoMixes,
+		st.NextWithdrawalIndex,
+		st.NextWithdrawalValidatorIndex,
+		st.Slashings,
+		st.TotalSlashing,
+		[]*types.HistoricalSummary{
+			{
+				BlockSummaryRoot: common.Root{0x44, 0x45, 0x46},
+				StateSummaryRoot: common.Root{0x47, 0x48, 0x49},
+			},
+		},
+		64e9,
+		9,
+		128e9,
+		10,
+		[]*engineprimitives.PendingConsolidation{
+			{SourceIndex: 1, TargetIndex: 0},
+		},
+	)
+	require.NoError(t, err)
+	return electra
+}
+
 func generateRandomBytes32(count int) []common.Bytes32 {
 	result := make([]common.Bytes32, count)
 	for i := range result {
@@ -239,3 +294,48 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 		"HashTreeRoot and HashSequential should produce the same result",
 	)
 }
+
+func TestBeaconState_GetTreeMatchesHashTreeRoot(t *testing.T) {
+	for _, state := range []interface {
+		GetTree() (*fastssz.Node, error)
+		HashTreeRoot() common.Root
+	}{
+		generateValidBeaconState(),
+		generateValidElectraBeaconState(t),
+	} {
+		tree, err := state.GetTree()
+		require.NoError(t, err)
+		root := state.HashTreeRoot()
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
+
+func TestBeaconState_HistoricalSummariesFromElectra(t *testing.T) {
+	electra := generateValidElectraBeaconState(t)
+	require.Equal(t, version.Electra, electra.Version())
+
+	data, err := electra.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := electra.NewFromSSZ(data, version.Electra)
+	require.NoError(t, err)
+	require.EqualValues(t, electra, decoded)
+	require.Equal(t, electra.HashTreeRoot(), decoded.HashTreeRoot())
+
+	// Before Electra the historical summaries and the churn fields are not
+	// part of the schema, which keeps the layout of the Deneb state.
+	deneb := generateValidBeaconState()
+	denebData, err := deneb.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(
+		t, data, len(denebData)+40+types.HistoricalSummarySize+
+			engineprimitives.PendingConsolidationSize,
+	)
+
+	deneb.HistoricalSummaries = electra.HistoricalSummaries
+	deneb.EarliestExitEpoch = electra.EarliestExitEpoch
+	deneb.PendingConsolidations = electra.PendingConsolidations
+	require.NotEqual(t, electra.HashTreeRoot(), deneb.HashTreeRoot())
+	data, err = deneb.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, denebData, data)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -140,6 +141,20 @@ func (v *Validator) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &v.WithdrawableEpoch)
 }
 
+// SSZSchema returns the SSZ schema of the Validator.
+func (*Validator) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("withdrawal_credentials", schema.B32()),
+		schema.NewField("effective_balance", schema.U64()),
+		schema.NewField("slashed", schema.Bool()),
+		schema.NewField("activation_eligibility_epoch", schema.U64()),
+		schema.NewField("activation_epoch", schema.U64()),
+		schema.NewField("exit_epoch", schema.U64()),
+		schema.NewField("withdrawable_epoch", schema.U64()),
+	)
+}
+
 // HashTreeRoot computes the SSZ hash tree root of the Validator object.
 func (v *Validator) HashTreeRoot() common.Root {
 	return ssz.HashSequential(v)
@@ -318,6 +333,21 @@ func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch when the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch when the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
@@ -22,10 +22,11 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/karalabe/ssz"
 )
 
-const MaxValidators = 1099511627776
+const MaxValidators = constants.ValidatorRegistryLimit
 
 type Validators []*Validator
 
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// VoteExtensionSize is the size of the VoteExtension object in bytes.
+// 8 bytes for Slot + 32 bytes for ExecutionHeadHash + 32 bytes for
+// VerifiedBlockHash + 8 bytes for DepositIndex.
+const VoteExtensionSize = 80
+
+var (
+	_ ssz.StaticObject                    = (*VoteExtension)(nil)
+	_ constraints.SSZMarshallableRootable = (*VoteExtension)(nil)
+)
+
+// VoteExtension is the view of the execution layer that a validator signs
+// into its CometBFT pre-commit vote. The proposer of the next block
+// aggregates the vote extensions into the attestation data of the block.
+type VoteExtension struct {
+	// Slot is the slot of the block the vote is cast for.
+	Slot math.Slot `json:"slot"`
+	// ExecutionHeadHash is the head block hash of the execution client.
+	ExecutionHeadHash common.ExecutionHash `json:"executionHeadHash"`
+	// VerifiedBlockHash is the hash of the latest execution payload that
+	// was verified by the execution client.
+	VerifiedBlockHash common.ExecutionHash `json:"verifiedBlockHash"`
+	// DepositIndex is the next deposit index seen on the deposit contract.
+	DepositIndex math.U64 `json:"depositIndex"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// New creates a new VoteExtension.
+func (v *VoteExtension) New(
+	slot math.Slot,
+	executionHeadHash common.ExecutionHash,
+	verifiedBlockHash common.ExecutionHash,
+	depositIndex math.U64,
+) *VoteExtension {
+	v = &VoteExtension{
+		Slot:              slot,
+		ExecutionHeadHash: executionHeadHash,
+		VerifiedBlockHash: verifiedBlockHash,
+		DepositIndex:      depositIndex,
+	}
+	return v
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the VoteExtension object in SSZ encoding.
+func (*VoteExtension) SizeSSZ() uint32 {
+	return VoteExtensionSize
+}
+
+// DefineSSZ defines the SSZ encoding for the VoteExtension object.
+func (v *VoteExtension) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &v.Slot)
+	ssz.DefineStaticBytes(codec, &v.ExecutionHeadHash)
+	ssz.DefineStaticBytes(codec, &v.VerifiedBlockHash)
+	ssz.DefineUint64(codec, &v.DepositIndex)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the VoteExtension object.
+func (v *VoteExtension) HashTreeRoot() common.Root {
+	return ssz.HashSequential(v)
+}
+
+// MarshalSSZ marshals the VoteExtension object to SSZ format.
+func (v *VoteExtension) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, v.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, v)
+}
+
+// UnmarshalSSZ unmarshals the VoteExtension object from SSZ format.
+func (v *VoteExtension) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, v)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetSlot returns the slot of the vote extension.
+func (v *VoteExtension) GetSlot() math.Slot {
+	return v.Slot
+}
+
+// GetExecutionHeadHash returns the execution head block hash of the vote
+// extension.
+func (v *VoteExtension) GetExecutionHeadHash() common.ExecutionHash {
+	return v.ExecutionHeadHash
+}
+
+// GetVerifiedBlockHash returns the latest verified execution block hash of
+// the vote extension.
+func (v *VoteExtension) GetVerifiedBlockHash() common.ExecutionHash {
+	return v.VerifiedBlockHash
+}
+
+// GetDepositIndex returns the deposit index of the vote extension.
+func (v *VoteExtension) GetDepositIndex() math.U64 {
+	return v.DepositIndex
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package types_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func generateVoteExtension() *types.VoteExtension {
+	return (&types.VoteExtension{}).New(
+		math.Slot(12345),
+		common.ExecutionHash{1, 2, 3},
+		common.ExecutionHash{4, 5, 6},
+		math.U64(7),
+	)
+}
+
+func TestVoteExtension_MarshalSSZ_UnmarshalSSZ(t *testing.T) {
+	ext := generateVoteExtension()
+
+	data, err := ext.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.VoteExtensionSize)
+
+	var unmarshalled types.VoteExtension
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, ext, &unmarshalled)
+	require.Equal(t, ext.HashTreeRoot(), unmarshalled.HashTreeRoot())
+
+	// Truncated vote extensions are rejected.
+	err = unmarshalled.UnmarshalSSZ(data[:32])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestVoteExtension_Getters(t *testing.T) {
+	ext := generateVoteExtension()
+
+	require.Equal(t, math.Slot(12345), ext.GetSlot())
+	require.Equal(t, common.ExecutionHash{1, 2, 3}, ext.GetExecutionHeadHash())
+	require.Equal(t, common.ExecutionHash{4, 5, 6}, ext.GetVerifiedBlockHash())
+	require.Equal(t, math.U64(7), ext.GetDepositIndex())
+}
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/libs/protoio"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxSigningMsgLen is the maximum length of a message CometBFT validators
+// sign as is. Longer messages, such as vote extensions, are signed by their
+// SHA-256 digest.
+const maxSigningMsgLen = 32
+
+// voteExtensionsEnabled returns whether the votes of the last commit of the
+// block at the given height carry vote extensions.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	enableHeight := cp.GetFeature().GetVoteExtensionsEnableHeight().GetValue()
+	return enableHeight > 0 && height > enableHeight
+}
+
+// verifyAttestations verifies that the attestations of the proposed block are
+// exactly the ones aggregated from the signed vote extensions of its last
+// commit, so that the proposer can neither forge the attestation of a
+// validator nor leave out a validator that attested.
+func (c *ConsensusEngine[
+	AttestationDataT, BeaconBlockT, _, _, _, _, _, _, _,
+]) verifyAttestations(
+	ctx sdk.Context,
+	req *cmtabci.ProcessProposalRequest,
+) error {
+	//#nosec:G701 // safe.
+	slot := math.Slot(req.Height)
+	forkVersion := c.cs.ActiveForkVersionForSlot(slot)
+	if forkVersion < version.Electra ||
+		uint(len(req.Txs)) <= beaconBlockTxIndex {
+		return nil
+	}
+
+	// The block itself is verified by the middleware.
+	var blk BeaconBlockT
+	blk, err := blk.NewFromSSZ(req.Txs[beaconBlockTxIndex], forkVersion)
+	if err != nil || blk.IsNil() {
+		//nolint:nilerr // the middleware rejects the block.
+		return nil
+	}
+
+	var expected []AttestationDataT
+	if voteExtensionsEnabled(ctx, req.Height) {
+		if uint(len(req.Txs)) <= extendedCommitTxIndex {
+			return ErrMissingExtendedCommit
+		}
+		extCommit := new(v1.ExtendedCommitInfo)
+		if err = extCommit.Unmarshal(
+			req.Txs[extendedCommitTxIndex],
+		); err != nil {
+			return errors.Join(ErrInvalidExtendedCommit, err)
+		}
+		if err = c.verifyExtendedCommit(
+			ctx, req.Height, extCommit, &req.ProposedLastCommit,
+		); err != nil {
+			return err
+		}
+		if expected, err = c.attestationsFromVotes(
+			ctx, extCommit.Votes, slot,
+		); err != nil {
+			return err
+		}
+	}
+
+	attestations := blk.GetAttestations()
+	if len(attestations) != len(expected) {
+		return errors.Wrapf(
+			ErrAttestationsMismatch, "expected %d attestations, got %d",
+			len(expected), len(attestations),
+		)
+	}
+	for i, att := range attestations {
+		if att.HashTreeRoot() != expected[i].HashTreeRoot() {
+			return errors.Wrapf(
+				ErrAttestationsMismatch, "attestation of validator %d",
+				att.GetIndex(),
+			)
+		}
+	}
+	return nil
+}
+
+// verifyExtendedCommit verifies that the extended commit proposed along with
+// the block at the given height matches its last commit, which is verified by
+// CometBFT, and that every vote for the previous block carries a vote
+// extension signed by its validator.
+func (c *ConsensusEngine[
+	_, _, _, _, _, _, _, _, _,
+]) verifyExtendedCommit(
+	ctx sdk.Context,
+	height int64,
+	extCommit *v1.ExtendedCommitInfo,
+	lastCommit *v1.CommitInfo,
+) error {
+	if extCommit.Round != lastCommit.Round {
+		return errors.Wrapf(
+			ErrInvalidExtendedCommit, "expected round %d, got %d",
+			lastCommit.Round, extCommit.Round,
+		)
+	}
+	if len(extCommit.Votes) != len(lastCommit.Votes) {
+		return errors.Wrapf(
+			ErrInvalidExtendedCommit, "expected %d votes, got %d",
+			len(lastCommit.Votes), len(extCommit.Votes),
+		)
+	}
+
+	st := c.sb.StateFromContext(ctx)
+	for i, vote := range extCommit.Votes {
+		committed := lastCommit.Votes[i]
+		if !bytes.Equal(
+			vote.Validator.Address, committed.Validator.Address,
+		) || vote.Validator.Power != committed.Validator.Power ||
+			vote.BlockIdFlag != committed.BlockIdFlag {
+			return errors.Wrapf(
+				ErrInvalidExtendedCommit, "vote %d does not match last commit",
+				i,
+			)
+		}
+
+		// Only the votes for the previous block are extended.
+		if vote.BlockIdFlag != cmttypes.BlockIDFlagCommit {
+			if len(vote.VoteExtension) != 0 ||
+				len(vote.ExtensionSignature) != 0 {
+				return errors.Wrapf(
+					ErrInvalidExtendedCommit,
+					"vote %d extends a vote not for the block", i,
+				)
+			}
+			continue
+		}
+
+		index, err := st.ValidatorIndexByCometBFTAddress(
+			vote.Validator.Address,
+		)
+		if err != nil {
+			return err
+		}
+		val, err := st.ValidatorByIndex(index)
+		if err != nil {
+			return err
+		}
+		if len(vote.ExtensionSignature) != len(crypto.BLSSignature{}) {
+			return errors.Wrapf(
+				ErrInvalidVoteExtensionSignature, "validator %d", index,
+			)
+		}
+		if err = c.verifier.VerifySignature(
+			val.GetPubkey(),
+			voteExtensionSigningMessage(
+				ctx.ChainID(), height-1, extCommit.Round, vote.VoteExtension,
+			),
+			crypto.BLSSignature(vote.ExtensionSignature),
+		); err != nil {
+			return errors.Join(
+				errors.Wrapf(
+					ErrInvalidVoteExtensionSignature, "validator %d", index,
+				),
+				err,
+			)
+		}
+	}
+	return nil
+}
+
+// voteExtensionSigningMessage returns the message a CometBFT validator signs
+// for its vote extension in the given round of the given height.
+func voteExtensionSigningMessage(
+	chainID string,
+	height int64,
+	round int32,
+	extension []byte,
+) []byte {
+	signBytes, err := protoio.MarshalDelimited(
+		&cmttypes.CanonicalVoteExtension{
+			Extension: extension,
+			Height:    height,
+			Round:     int64(round),
+			ChainId:   chainID,
+		},
+	)
+	if err != nil {
+		// The canonical vote extension always marshals.
+		panic(err)
+	}
+	if len(signBytes) > maxSigningMsgLen {
+		digest := sha256.Sum256(signBytes)
+		return digest[:]
+	}
+	return signBytes
+}
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const testChainID = "beacond-test"
+
+// testEngine is the consensus engine under test, only its verification of the
+// extended commit is exercised.
+type testEngine = ConsensusEngine[
+	*testAttestation, *testBlock, *testState, *testSlashingInfo,
+	*testSlotData, *testStorage, *testValidator, any, *testVoteExtension,
+]
+
+func TestVerifyExtendedCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(ext *v1.ExtendedCommitInfo, last *v1.CommitInfo)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(*v1.ExtendedCommitInfo, *v1.CommitInfo) {},
+		},
+		{
+			name: "round mismatch",
+			modify: func(ext *v1.ExtendedCommitInfo, _ *v1.CommitInfo) {
+				ext.Round++
+			},
+			wantErr: ErrInvalidExtendedCommit,
+		},
+		{
+			name: "omitted vote",
+			modify: func(ext *v1.ExtendedCommitInfo, _ *v1.CommitInfo) {
+				ext.Votes = ext.Votes[:1]
+			},
+			wantErr: ErrInvalidExtendedCommit,
+		},
+		{
+			name: "vote flag mismatch",
+			modify: func(ext *v1.ExtendedCommitInfo, _ *v1.CommitInfo) {
+				ext.Votes[1].BlockIdFlag = cmttypes.BlockIDFlagAbsent
+				ext.Votes[1].VoteExtension = nil
+				ext.Votes[1].ExtensionSignature = nil
+			},
+			wantErr: ErrInvalidExtendedCommit,
+		},
+		{
+			name: "forged extension",
+			modify: func(ext *v1.ExtendedCommitInfo, _ *v1.CommitInfo) {
+				ext.Votes[0].VoteExtension = []byte("forged")
+			},
+			wantErr: ErrInvalidVoteExtensionSignature,
+		},
+		{
+			name: "missing signature",
+			modify: func(ext *v1.ExtendedCommitInfo, _ *v1.CommitInfo) {
+				ext.Votes[1].ExtensionSignature = nil
+			},
+			wantErr: ErrInvalidVoteExtensionSignature,
+		},
+		{
+			name: "extended absent vote",
+			modify: func(ext *v1.ExtendedCommitInfo, last *v1.CommitInfo) {
+				ext.Votes[1].BlockIdFlag = cmttypes.BlockIDFlagAbsent
+				last.Votes[1].BlockIdFlag = cmttypes.BlockIDFlagAbsent
+			},
+			wantErr: ErrInvalidExtendedCommit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext, last := testCommits(t)
+			tt.modify(ext, last)
+			err := newTestEngine().verifyExtendedCommit(
+				sdk.Context{}.WithChainID(testChainID), 2, ext, last,
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// testCommits returns an extended commit of the first block, signed by two
+// validators, along with the commit CometBFT proposes for the second block.
+func testCommits(t *testing.T) (*v1.ExtendedCommitInfo, *v1.CommitInfo) {
+	t.Helper()
+	ext := &v1.ExtendedCommitInfo{Round: 1}
+	last := &v1.CommitInfo{Round: 1}
+	for i := range 2 {
+		extension := []byte{byte(i), 1, 2, 3}
+		val := v1.Validator{Address: testAddress(i), Power: 32}
+		ext.Votes = append(ext.Votes, v1.ExtendedVoteInfo{
+			Validator:     val,
+			VoteExtension: extension,
+			ExtensionSignature: testSign(
+				testPubkey(i), voteExtensionSigningMessage(
+					testChainID, 1, 1, extension,
+				),
+			),
+			BlockIdFlag: cmttypes.BlockIDFlagCommit,
+		})
+		last.Votes = append(last.Votes, v1.VoteInfo{
+			Validator:   val,
+			BlockIdFlag: cmttypes.BlockIDFlagCommit,
+		})
+	}
+	return ext, last
+}
+
+func newTestEngine() *testEngine {
+	return &testEngine{
+		sb:       &testStorage{},
+		verifier: testVerifier{},
+	}
+}
+
+func testAddress(i int) []byte {
+	return bytes.Repeat([]byte{byte(i + 1)}, 20)
+}
+
+func testPubkey(i int) crypto.BLSPubkey {
+	var pk crypto.BLSPubkey
+	pk[0] = byte(i + 1)
+	return pk
+}
+
+// testSign returns the signature testVerifier accepts for the given pubkey
+// and message, which is the message prefixed by the first pubkey byte.
+func testSign(pk crypto.BLSPubkey, msg []byte) []byte {
+	var sig crypto.BLSSignature
+	sig[0] = pk[0]
+	copy(sig[1:], msg)
+	return sig[:]
+}
+
+type testVerifier struct{}
+
+func (testVerifier) VerifySignature(
+	pk crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+) error {
+	if !bytes.Equal(testSign(pk, msg), sig[:]) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+type testStorage struct{}
+
+func (*testStorage) StateFromContext(context.Context) *testState {
+	return &testState{}
+}
+
+type testState struct{}
+
+func (*testState) ValidatorIndexByCometBFTAddress(
+	addr []byte,
+) (math.ValidatorIndex, error) {
+	for i := range 2 {
+		if bytes.Equal(addr, testAddress(i)) {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errors.New("unknown validator")
+}
+
+func (*testState) HashTreeRoot() common.Root {
+	return common.Root{}
+}
+
+func (*testState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*testValidator, error) {
+	return &testValidator{pubkey: testPubkey(int(index))}, nil
+}
+
+type testValidator struct{ pubkey crypto.BLSPubkey }
+
+func (v *testValidator) GetPubkey() crypto.BLSPubkey { return v.pubkey }
+
+type testAttestation struct{}
+
+func (*testAttestation) GetIndex() math.U64 { return 0 }
+
+func (*testAttestation) HashTreeRoot() common.Root { return common.Root{} }
+
+func (a *testAttestation) New(
+	math.U64, math.U64, common.Root, common.ExecutionHash,
+	common.ExecutionHash, math.U64,
+) *testAttestation {
+	return a
+}
+
+type testBlock struct{}
+
+func (*testBlock) IsNil() bool { return false }
+
+func (b *testBlock) NewFromSSZ([]byte, uint32) (*testBlock, error) {
+	return b, nil
+}
+
+func (*testBlock) GetAttestations() []*testAttestation { return nil }
+
+type testSlashingInfo struct{}
+
+func (s *testSlashingInfo) New(math.U64, math.U64) *testSlashingInfo {
+	return s
+}
+
+type testSlotData struct{}
+
+func (s *testSlotData) New(
+	math.Slot, []*testAttestation, []*testSlashingInfo,
+) *testSlotData {
+	return s
+}
+
+type testVoteExtension struct{}
+
+func (*testVoteExtension) MarshalSSZ() ([]byte, error) { return nil, nil }
+
+func (*testVoteExtension) UnmarshalSSZ([]byte) error { return nil }
+
+func (e *testVoteExtension) New(
+	math.Slot, common.ExecutionHash, common.ExecutionHash, math.U64,
+) *testVoteExtension {
+	return e
+}
+
+func (*testVoteExtension) GetSlot() math.Slot { return 0 }
+
+func (*testVoteExtension) GetExecutionHeadHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (*testVoteExtension) GetVerifiedBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (*testVoteExtension) GetDepositIndex() math.U64 { return 0 }
@@ -23,6 +23,8 @@ package cometbft
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sourcegraph/conc/iter"
@@ -34,49 +36,69 @@ import (
 // eventually fully decouple this.
 type ConsensusEngine[
 	AttestationDataT AttestationData[AttestationDataT],
-	BeaconStateT BeaconState,
+	BeaconBlockT BeaconBlock[AttestationDataT, BeaconBlockT],
+	BeaconStateT BeaconState[ValidatorT],
 	SlashingInfoT SlashingInfo[SlashingInfoT],
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT, SlotDataT],
 	StorageBackendT StorageBackend[BeaconStateT],
+	ValidatorT Validator,
 	ValidatorUpdateT any,
+	VoteExtensionT VoteExtension[VoteExtensionT],
 ] struct {
 	Middleware[AttestationDataT, SlashingInfoT, SlotDataT]
-	sb StorageBackendT
+	cs       common.ChainSpec
+	sb       StorageBackendT
+	verifier SignatureVerifier
 }
 
 // NewConsensusEngine returns a new consensus middleware.
 func NewConsensusEngine[
 	AttestationDataT AttestationData[AttestationDataT],
-	BeaconStateT BeaconState,
+	BeaconBlockT BeaconBlock[AttestationDataT, BeaconBlockT],
+	BeaconStateT BeaconState[ValidatorT],
 	SlashingInfoT SlashingInfo[SlashingInfoT],
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT, SlotDataT],
 	StorageBackendT StorageBackend[BeaconStateT],
+	ValidatorT Validator,
 	ValidatorUpdateT any,
+	VoteExtensionT VoteExtension[VoteExtensionT],
 ](
 	m Middleware[AttestationDataT, SlashingInfoT, SlotDataT],
+	cs common.ChainSpec,
 	sb StorageBackendT,
+	verifier SignatureVerifier,
 ) *ConsensusEngine[
 	AttestationDataT,
+	BeaconBlockT,
 	BeaconStateT,
 	SlashingInfoT,
 	SlotDataT,
 	StorageBackendT,
+	ValidatorT,
 	ValidatorUpdateT,
+	VoteExtensionT,
 ] {
 	return &ConsensusEngine[
 		AttestationDataT,
+		BeaconBlockT,
 		BeaconStateT,
 		SlashingInfoT,
 		SlotDataT,
 		StorageBackendT,
+		ValidatorT,
 		ValidatorUpdateT,
+		VoteExtensionT,
 	]{
 		Middleware: m,
+		cs:         cs,
 		sb:         sb,
+		verifier:   verifier,
 	}
 }
 
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) InitGenesis(
+func (c *ConsensusEngine[
+	_, _, _, _, _, _, _, ValidatorUpdateT, _,
+]) InitGenesis(
 	ctx context.Context,
 	genesisBz []byte,
 ) ([]ValidatorUpdateT, error) {
@@ -89,7 +111,7 @@ func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) InitGenesis(
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, _]) PrepareProposal(
+func (c *ConsensusEngine[_, _, _, _, _, _, _, _, _]) PrepareProposal(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
@@ -101,22 +123,36 @@ func (c *ConsensusEngine[_, _, _, _, _, _]) PrepareProposal(
 		return nil, err
 	}
 	blkBz, sidecarsBz, err := c.Middleware.PrepareProposal(
-		ctx,
+		transition.WithCommitVoters(
+			ctx, commitVoters(req.LocalLastCommit.Votes),
+		),
 		slotData,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	txs := [][]byte{blkBz, sidecarsBz}
+
+	// The signed vote extensions the attestations are aggregated from are
+	// proposed along with the block, for the other validators to verify.
+	if voteExtensionsEnabled(ctx, req.Height) {
+		var extCommitBz []byte
+		if extCommitBz, err = req.LocalLastCommit.Marshal(); err != nil {
+			return nil, err
+		}
+		txs = append(txs, extCommitBz)
+	}
+	return &cmtabci.PrepareProposalResponse{Txs: txs}, nil
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
+func (c *ConsensusEngine[_, _, _, _, _, _, _, _, _]) ProcessProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
+	if err := c.verifyAttestations(ctx, req); err != nil {
+		return nil, err
+	}
 	resp, err := c.Middleware.ProcessProposal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -125,14 +161,16 @@ func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) PreBlock(
+func (c *ConsensusEngine[_, _, _, _, _, _, _, _, _]) PreBlock(
 	ctx sdk.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) error {
 	return c.Middleware.PreBlock(ctx, req)
 }
 
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) EndBlock(
+func (c *ConsensusEngine[
+	_, _, _, _, _, _, _, ValidatorUpdateT, _,
+]) EndBlock(
 	ctx context.Context,
 ) ([]ValidatorUpdateT, error) {
 	updates, err := c.Middleware.EndBlock(ctx)
@@ -28,4 +28,35 @@ var (
 	ErrUndefinedValidatorUpdate = errors.New(
 		"undefined validator update",
 	)
+
+	// ErrVoteExtensionSlotMismatch is returned when a vote extension is not
+	// signed for the height of the vote it extends.
+	ErrVoteExtensionSlotMismatch = errors.New(
+		"vote extension slot mismatch",
+	)
+
+	// ErrMissingExtendedCommit is returned when a proposal does not carry the
+	// extended commit its attestations are aggregated from.
+	ErrMissingExtendedCommit = errors.New(
+		"missing extended commit in proposal",
+	)
+
+	// ErrInvalidExtendedCommit is returned when the extended commit of a
+	// proposal does not match its last commit.
+	ErrInvalidExtendedCommit = errors.New(
+		"invalid extended commit",
+	)
+
+	// ErrInvalidVoteExtensionSignature is returned when a vote extension is
+	// not signed by the validator that cast the vote.
+	ErrInvalidVoteExtensionSignature = errors.New(
+		"invalid vote extension signature",
+	)
+
+	// ErrAttestationsMismatch is returned when the attestations of a block
+	// are not the ones aggregated from the vote extensions of its last
+	// commit.
+	ErrAttestationsMismatch = errors.New(
+		"attestations do not match the vote extensions of the last commit",
+	)
 )
@@ -24,11 +24,13 @@ import (
 	"sort"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -55,7 +57,7 @@ func convertValidatorUpdate[ValidatorUpdateT any](
 // convertPrepareProposalToSlotData converts a prepare proposal request to
 // a slot data.
 func (c *ConsensusEngine[
-	_, _, _, SlotDataT, _, _,
+	_, _, _, _, SlotDataT, _, _, _, _,
 ]) convertPrepareProposalToSlotData(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
@@ -91,9 +93,12 @@ func (c *ConsensusEngine[
 	return t, nil
 }
 
-// attestationsFromVotes returns a list of attestation data from the votes.
+// attestationsFromVotes returns a list of attestation data from the vote
+// extensions of the votes for the previous block. Validators that did not
+// commit to the previous block or that did not extend their vote with a
+// valid execution layer view are left out.
 func (c *ConsensusEngine[
-	AttestationDataT, _, _, _, _, _,
+	AttestationDataT, _, _, _, _, _, _, _, VoteExtensionT,
 ]) attestationsFromVotes(
 	ctx sdk.Context,
 	votes []v1.ExtendedVoteInfo,
@@ -101,10 +106,22 @@ func (c *ConsensusEngine[
 ) ([]AttestationDataT, error) {
 	var err error
 	var index math.U64
-	attestations := make([]AttestationDataT, len(votes))
+	attestations := make([]AttestationDataT, 0, len(votes))
 	st := c.sb.StateFromContext(ctx)
 	root := st.HashTreeRoot()
-	for i, vote := range votes {
+	for _, vote := range votes {
+		if vote.BlockIdFlag != cmttypes.BlockIDFlagCommit ||
+			len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var ext VoteExtensionT
+		ext = ext.New(0, common.ExecutionHash{}, common.ExecutionHash{}, 0)
+		if err = ext.UnmarshalSSZ(vote.VoteExtension); err != nil ||
+			ext.GetSlot()+1 != slot {
+			continue
+		}
+
 		index, err = st.ValidatorIndexByCometBFTAddress(vote.Validator.Address)
 		if err != nil {
 			return nil, err
@@ -112,24 +129,40 @@ func (c *ConsensusEngine[
 
 		var t AttestationDataT
 		t = t.New(
-			slot,
+			ext.GetSlot(),
 			index,
 			root,
+			ext.GetExecutionHeadHash(),
+			ext.GetVerifiedBlockHash(),
+			ext.GetDepositIndex(),
 		)
-		attestations[i] = t
+		attestations = append(attestations, t)
 	}
 
-	// Attestations are sorted by index.
+	// Attestations are sorted by index. There is at most one vote per
+	// validator, hence every voter fits within MaxAttestationsPerBlock.
 	sort.Slice(attestations, func(i, j int) bool {
 		return attestations[i].GetIndex() < attestations[j].GetIndex()
 	})
 	return attestations, nil
 }
 
+// commitVoters returns the CometBFT addresses of the validators whose votes
+// for the previous block are committed in the last commit of the proposal.
+func commitVoters(votes []v1.ExtendedVoteInfo) [][]byte {
+	voters := make([][]byte, 0, len(votes))
+	for _, vote := range votes {
+		if vote.BlockIdFlag == cmttypes.BlockIDFlagCommit {
+			voters = append(voters, vote.Validator.Address)
+		}
+	}
+	return voters
+}
+
 // slashingInfoFromMisbehaviors returns a list of slashing info from the
 // comet misbehaviors.
 func (c *ConsensusEngine[
-	_, _, SlashingInfoT, _, _, _,
+	_, _, _, SlashingInfoT, _, _, _, _, _,
 ]) slashingInfoFromMisbehaviors(
 	ctx sdk.Context,
 	misbehaviors []v1.Misbehavior,
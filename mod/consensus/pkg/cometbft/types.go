@@ -24,6 +24,8 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/cosmos/gogoproto/proto"
@@ -33,18 +35,58 @@ import (
 type AttestationData[AttestationDataT any] interface {
 	// GetIndex returns the index of the attestation data.
 	GetIndex() math.U64
+	// HashTreeRoot returns the hash tree root of the attestation data.
+	HashTreeRoot() common.Root
 	// New creates a new attestation data instance.
-	New(math.U64, math.U64, common.Root) AttestationDataT
+	New(
+		slot math.U64,
+		index math.U64,
+		beaconBlockRoot common.Root,
+		executionHeadHash common.ExecutionHash,
+		verifiedBlockHash common.ExecutionHash,
+		depositIndex math.U64,
+	) AttestationDataT
+}
+
+// BeaconBlock is an interface for accessing the signed beacon block of a
+// proposal.
+type BeaconBlock[AttestationDataT, BeaconBlockT any] interface {
+	constraints.Nillable
+	// NewFromSSZ decodes the signed beacon block of the given fork version.
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+	// GetAttestations returns the attestations carried by the block.
+	GetAttestations() []AttestationDataT
 }
 
 // BeaconState is an interface for accessing the beacon state.
-type BeaconState interface {
+type BeaconState[ValidatorT any] interface {
 	// GetValidatorIndexByCometBFTAddress returns the validator index by the
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
+}
+
+// DepositStore defines the interface for the local view of the deposit
+// contract.
+type DepositStore interface {
+	// NextDepositIndex returns the index of the next deposit expected from
+	// the deposit contract.
+	NextDepositIndex() (uint64, error)
+}
+
+// ExecutionEngine defines the interface for the local view of the execution
+// client.
+type ExecutionEngine interface {
+	// HeadBlockHash returns the hash of the head block of the execution
+	// client.
+	HeadBlockHash(ctx context.Context) (common.ExecutionHash, error)
+	// LatestVerifiedBlockHash returns the hash of the latest execution
+	// payload that was verified by the execution client.
+	LatestVerifiedBlockHash() common.ExecutionHash
 }
 
 // Middleware is the interface for the CometBFT middleware.
@@ -64,6 +106,17 @@ type Middleware[
 	EndBlock(ctx context.Context) (transition.ValidatorUpdates, error)
 }
 
+// SignatureVerifier verifies the BLS signatures of the validators.
+type SignatureVerifier interface {
+	// VerifySignature verifies a signature against a message and a public
+	// key.
+	VerifySignature(
+		pubKey crypto.BLSPubkey,
+		msg []byte,
+		signature crypto.BLSSignature,
+	) error
+}
+
 // SlashingInfo is an interface for accessing the slashing info.
 type SlashingInfo[SlashingInfoT any] interface {
 	// New creates a new slashing info instance.
@@ -78,7 +131,37 @@ type SlotData[AttestationDataT, SlashingInfoT, SlotDataT any] interface {
 
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
-type StorageBackend[BeaconStateT BeaconState] interface {
+type StorageBackend[BeaconStateT any] interface {
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
 }
+
+// Validator is an interface for accessing a validator of the beacon state.
+type Validator interface {
+	// GetPubkey returns the BLS public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+}
+
+// VoteExtension is an interface for the execution layer view that validators
+// sign into their vote extensions.
+type VoteExtension[VoteExtensionT any] interface {
+	constraints.SSZMarshallable
+	// New creates a new vote extension instance.
+	New(
+		slot math.Slot,
+		executionHeadHash common.ExecutionHash,
+		verifiedBlockHash common.ExecutionHash,
+		depositIndex math.U64,
+	) VoteExtensionT
+	// GetSlot returns the slot the vote is cast for.
+	GetSlot() math.Slot
+	// GetExecutionHeadHash returns the head block hash of the execution
+	// client.
+	GetExecutionHeadHash() common.ExecutionHash
+	// GetVerifiedBlockHash returns the hash of the latest verified execution
+	// payload.
+	GetVerifiedBlockHash() common.ExecutionHash
+	// GetDepositIndex returns the next deposit index seen on the deposit
+	// contract.
+	GetDepositIndex() math.U64
+}
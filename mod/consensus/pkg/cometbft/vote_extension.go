@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtender extends the pre-commit votes of the validator with its view
+// of the execution layer, and verifies the vote extensions of the other
+// validators.
+type VoteExtender[VoteExtensionT VoteExtension[VoteExtensionT]] struct {
+	// ee is the local view of the execution client.
+	ee ExecutionEngine
+	// ds is the local view of the deposit contract.
+	ds DepositStore
+}
+
+// NewVoteExtender returns a new vote extender.
+func NewVoteExtender[VoteExtensionT VoteExtension[VoteExtensionT]](
+	ee ExecutionEngine,
+	ds DepositStore,
+) *VoteExtender[VoteExtensionT] {
+	return &VoteExtender[VoteExtensionT]{
+		ee: ee,
+		ds: ds,
+	}
+}
+
+// ExtendVote signs the view of the local execution client into the vote for
+// the block at the requested height.
+func (v *VoteExtender[VoteExtensionT]) ExtendVote(
+	ctx sdk.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	headHash, err := v.ee.HeadBlockHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	depositIndex, err := v.ds.NextDepositIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var ext VoteExtensionT
+	bz, err := ext.New(
+		//#nosec:G701 // safe.
+		math.Slot(req.Height),
+		headHash,
+		v.ee.LatestVerifiedBlockHash(),
+		math.U64(depositIndex),
+	).MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &cmtabci.ExtendVoteResponse{VoteExtension: bz}, nil
+}
+
+// VerifyVoteExtension verifies that the vote extension of a validator is a
+// well formed execution layer view for the requested height. Empty vote
+// extensions are accepted, since a validator whose execution client is
+// unavailable must still be able to vote.
+func (v *VoteExtender[VoteExtensionT]) VerifyVoteExtension(
+	_ sdk.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	if len(req.VoteExtension) == 0 {
+		return &cmtabci.VerifyVoteExtensionResponse{
+			Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		}, nil
+	}
+
+	var ext VoteExtensionT
+	ext = ext.New(0, common.ExecutionHash{}, common.ExecutionHash{}, 0)
+	if err := ext.UnmarshalSSZ(req.VoteExtension); err != nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // safe.
+	if ext.GetSlot() != math.Slot(req.Height) {
+		return nil, errors.Wrapf(
+			ErrVoteExtensionSlotMismatch, "expected: %d, got: %d",
+			req.Height, ext.GetSlot(),
+		)
+	}
+	return &cmtabci.VerifyVoteExtensionResponse{
+		Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+	}, nil
+}
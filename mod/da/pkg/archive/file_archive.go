@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"cmp"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+)
+
+const (
+	// bundleExtension is the extension of the compressed sidecar bundles.
+	bundleExtension = ".ssz.gz"
+	// indexExtension is the extension of the bundle indexes.
+	indexExtension = ".index.json"
+	// tmpExtension is appended to files while they are being written.
+	tmpExtension = ".tmp"
+)
+
+// indexEntry locates a single sidecar within the bundle of its epoch.
+type indexEntry struct {
+	// Slot is the slot of the block the sidecar belongs to.
+	Slot math.Slot `json:"slot"`
+	// Index is the index of the blob in the block.
+	Index uint64 `json:"index"`
+	// KzgCommitment is the KZG commitment of the blob.
+	KzgCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	// Offset is the offset of the sidecar in the uncompressed bundle.
+	Offset uint64 `json:"offset"`
+	// Size is the size of the SSZ encoded sidecar.
+	Size uint64 `json:"size"`
+}
+
+// bundleIndex is the index of the bundle of an epoch.
+type bundleIndex struct {
+	// Epoch is the epoch of the bundle.
+	Epoch math.Epoch `json:"epoch"`
+	// Sidecars are the sidecars of the bundle, ordered by offset.
+	Sidecars []indexEntry `json:"sidecars"`
+}
+
+// FileArchive is a blob archive backed by a filesystem. The sidecars of every
+// epoch are stored as a single gzip compressed bundle of SSZ encoded sidecars,
+// along with a JSON index of the bundle. The index is written last, so that
+// only complete bundles are ever served.
+type FileArchive struct {
+	// fs is the filesystem the archive is stored in.
+	fs afero.Fs
+	// slotsPerEpoch is used to map slots to the epoch bundles.
+	slotsPerEpoch uint64
+}
+
+// NewFileArchive creates a new FileArchive storing the bundles at the root of
+// the given filesystem.
+func NewFileArchive(fs afero.Fs, slotsPerEpoch uint64) *FileArchive {
+	return &FileArchive{
+		fs:            fs,
+		slotsPerEpoch: slotsPerEpoch,
+	}
+}
+
+// ArchiveEpoch writes the blob sidecars of the given epoch to the archive,
+// replacing any previous bundle of the epoch.
+func (a *FileArchive) ArchiveEpoch(
+	epoch math.Epoch,
+	sidecars []*types.BlobSidecar,
+) error {
+	sidecars = slices.Clone(sidecars)
+	slices.SortFunc(sidecars, func(x, y *types.BlobSidecar) int {
+		return cmp.Or(
+			cmp.Compare(
+				x.BeaconBlockHeader.GetSlot(), y.BeaconBlockHeader.GetSlot(),
+			),
+			cmp.Compare(x.Index, y.Index),
+		)
+	})
+
+	index := &bundleIndex{
+		Epoch:    epoch,
+		Sidecars: make([]indexEntry, 0, len(sidecars)),
+	}
+	if err := a.writeAtomically(
+		a.bundlePath(epoch),
+		func(w io.Writer) error {
+			return a.writeBundle(w, index, sidecars)
+		},
+	); err != nil {
+		return err
+	}
+
+	return a.writeAtomically(
+		a.indexPath(epoch),
+		func(w io.Writer) error {
+			return json.NewEncoder(w).Encode(index)
+		},
+	)
+}
+
+// GetBlobSidecars returns the archived blob sidecars of the block at the
+// given slot, which are empty if the slot was not archived.
+func (a *FileArchive) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	epoch := math.Epoch(slot.Unwrap() / a.slotsPerEpoch)
+	index, err := a.readIndex(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]indexEntry, 0)
+	for _, entry := range index.Sidecars {
+		if entry.Slot == slot {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == 0 {
+		return &types.BlobSidecars{
+			Sidecars: make([]*types.BlobSidecar, 0),
+		}, nil
+	}
+
+	file, err := a.fs.Open(a.bundlePath(epoch))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	r, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var (
+		pos      uint64
+		sidecars = make([]*types.BlobSidecar, 0, len(entries))
+	)
+	for _, entry := range entries {
+		// Skip the sidecars of the other slots of the epoch.
+		if _, err = io.CopyN(
+			io.Discard, r, int64(entry.Offset-pos),
+		); err != nil {
+			return nil, errors.Wrap(ErrCorruptBundle, err.Error())
+		}
+		buf := make([]byte, entry.Size)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, errors.Wrap(ErrCorruptBundle, err.Error())
+		}
+		pos = entry.Offset + entry.Size
+
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(buf); err != nil {
+			return nil, err
+		}
+		if sidecar.Index != entry.Index ||
+			sidecar.KzgCommitment != entry.KzgCommitment {
+			return nil, ErrCorruptBundle
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
+}
+
+// writeBundle writes the compressed bundle of the sidecars to w, recording
+// their location in the index.
+func (a *FileArchive) writeBundle(
+	w io.Writer,
+	index *bundleIndex,
+	sidecars []*types.BlobSidecar,
+) error {
+	var (
+		offset uint64
+		gw     = gzip.NewWriter(w)
+	)
+	for _, sidecar := range sidecars {
+		slot := sidecar.BeaconBlockHeader.GetSlot()
+		if slot.Unwrap()/a.slotsPerEpoch != index.Epoch.Unwrap() {
+			return errors.Wrapf(ErrEpochMismatch, "slot %d", slot)
+		}
+
+		bz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if _, err = gw.Write(bz); err != nil {
+			return err
+		}
+
+		index.Sidecars = append(index.Sidecars, indexEntry{
+			Slot:          slot,
+			Index:         sidecar.Index,
+			KzgCommitment: sidecar.KzgCommitment,
+			Offset:        offset,
+			Size:          uint64(len(bz)),
+		})
+		offset += uint64(len(bz))
+	}
+	return gw.Close()
+}
+
+// readIndex reads the index of the bundle of the given epoch, which is empty
+// if the epoch was not archived.
+func (a *FileArchive) readIndex(epoch math.Epoch) (*bundleIndex, error) {
+	bz, err := afero.ReadFile(a.fs, a.indexPath(epoch))
+	if os.IsNotExist(err) {
+		return &bundleIndex{Epoch: epoch}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	index := new(bundleIndex)
+	if err = json.Unmarshal(bz, index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// writeAtomically writes a file through a temporary file that is renamed
+// once fully written.
+func (a *FileArchive) writeAtomically(
+	path string,
+	write func(io.Writer) error,
+) error {
+	tmpPath := path + tmpExtension
+	file, err := a.fs.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	if err = write(file); err != nil {
+		_ = file.Close()
+		_ = a.fs.Remove(tmpPath)
+		return err
+	}
+	if err = file.Close(); err != nil {
+		_ = a.fs.Remove(tmpPath)
+		return err
+	}
+	return a.fs.Rename(tmpPath, path)
+}
+
+// bundlePath returns the path of the bundle of the given epoch.
+func (a *FileArchive) bundlePath(epoch math.Epoch) string {
+	return strconv.FormatUint(epoch.Unwrap(), 10) + bundleExtension
+}
+
+// indexPath returns the path of the index of the given epoch.
+func (a *FileArchive) indexPath(epoch math.Epoch) string {
+	return strconv.FormatUint(epoch.Unwrap(), 10) + indexExtension
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+const slotsPerEpoch = 8
+
+func newSidecar(slot math.Slot, index uint64) *types.BlobSidecar {
+	blob := &eip4844.Blob{byte(slot), byte(index)}
+	return types.BuildBlobSidecar(
+		math.U64(index),
+		&ctypes.BeaconBlockHeader{Slot: slot},
+		blob,
+		eip4844.KZGCommitment{byte(slot), byte(index)},
+		eip4844.KZGProof{byte(index)},
+		make([]common.Root, 8),
+	)
+}
+
+func TestFileArchive(t *testing.T) {
+	var (
+		fs = afero.NewMemMapFs()
+		a  = archive.NewFileArchive(fs, slotsPerEpoch)
+	)
+
+	// Sidecars are archived in any order.
+	sidecars := []*types.BlobSidecar{
+		newSidecar(10, 1), newSidecar(9, 0), newSidecar(10, 0),
+		newSidecar(15, 0),
+	}
+	require.NoError(t, a.ArchiveEpoch(1, sidecars))
+
+	got, err := a.GetBlobSidecars(10)
+	require.NoError(t, err)
+	require.Equal(t, []*types.BlobSidecar{
+		newSidecar(10, 0), newSidecar(10, 1),
+	}, got.Sidecars)
+
+	got, err = a.GetBlobSidecars(15)
+	require.NoError(t, err)
+	require.Equal(t, []*types.BlobSidecar{newSidecar(15, 0)}, got.Sidecars)
+
+	// Slots without blobs and epochs that were never archived are empty.
+	for _, slot := range []math.Slot{11, 3, 100} {
+		got, err = a.GetBlobSidecars(slot)
+		require.NoError(t, err)
+		require.Zero(t, got.Len())
+	}
+
+	// No temporary files are left behind.
+	files, err := afero.ReadDir(fs, "/")
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
+
+func TestFileArchiveEpochMismatch(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	a := archive.NewFileArchive(fs, slotsPerEpoch)
+
+	require.ErrorIs(t, a.ArchiveEpoch(0, []*types.BlobSidecar{
+		newSidecar(1, 0), newSidecar(slotsPerEpoch, 0),
+	}), archive.ErrEpochMismatch)
+
+	// The failed bundle is not served.
+	got, err := a.GetBlobSidecars(1)
+	require.NoError(t, err)
+	require.Zero(t, got.Len())
+	files, err := afero.ReadDir(fs, "/")
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// noopSink is a TelemetrySink that discards every metric.
+type noopSink struct{}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func TestBuildAndVerifyDataColumnSidecars(t *testing.T) {
+	var (
+		spec     = &MockSpec{}
+		verifier = noop.NewVerifier()
+		factory  = blob.NewSidecarFactory[
+			*ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+		](spec, ctypes.KZGPositionDeneb, verifier, noopSink{})
+		bundle = &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{
+			Commitments: []eip4844.KZGCommitment{{0x01}, {0x02}},
+			Proofs:      []eip4844.KZGProof{{0x03}, {0x04}},
+			Blobs:       []*eip4844.Blob{{0x05}, {0x06}},
+		}
+	)
+
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Deneb)
+	body.SetBlobKzgCommitments(bundle.Commitments)
+	blk := &ctypes.BeaconBlock{Slot: 1, Body: body}
+
+	sidecars, err := factory.BuildDataColumnSidecars(blk, bundle)
+	require.NoError(t, err)
+	require.Equal(t, eip7594.NumberOfColumns, sidecars.Len())
+	for i, sc := range sidecars.Sidecars {
+		require.Equal(t, uint64(i), sc.Index)
+		require.Len(t, sc.Column, 2)
+		require.Len(t, sc.KzgProofs, 2)
+	}
+
+	v := blob.NewVerifier(verifier, noopSink{})
+	require.NoError(t, v.VerifyDataColumnSidecars(
+		sidecars, ctypes.KZGPositionDeneb, spec.MaxBlobCommitmentsPerBlock(),
+	))
+
+	// A column whose commitments do not match the body is rejected.
+	sidecars.Sidecars[3].KzgCommitments = []eip4844.KZGCommitment{
+		{0x01}, {0x07},
+	}
+	require.ErrorIs(t, v.VerifyDataColumnSidecars(
+		sidecars, ctypes.KZGPositionDeneb, spec.MaxBlobCommitmentsPerBlock(),
+	), types.ErrInvalidInclusionProof)
+}
+
+func TestBuildDataColumnSidecarsNoBlobs(t *testing.T) {
+	factory := blob.NewSidecarFactory[
+		*ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+	](&MockSpec{}, ctypes.KZGPositionDeneb, noop.NewVerifier(), noopSink{})
+
+	sidecars, err := factory.BuildDataColumnSidecars(
+		&ctypes.BeaconBlock{
+			Body: (&ctypes.BeaconBlockBody{}).Empty(version.Deneb),
+		},
+		&engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{},
+	)
+	require.NoError(t, err)
+	require.Zero(t, sidecars.Len())
+}
@@ -23,9 +23,12 @@ package blob
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"golang.org/x/sync/errgroup"
@@ -42,6 +45,9 @@ type SidecarFactory[
 	//
 	// TODO: This needs to be made configurable / modular.
 	kzgPosition uint64
+	// proofVerifier is used to compute the cells and cell proofs of the
+	// extended blobs when building data column sidecars.
+	proofVerifier kzg.BlobProofVerifier
 	// metrics is used to collect and report factory metrics.
 	metrics *factoryMetrics
 }
@@ -54,13 +60,15 @@ func NewSidecarFactory[
 	chainSpec ChainSpec,
 	// todo: calculate from config.
 	kzgPosition uint64,
+	proofVerifier kzg.BlobProofVerifier,
 	telemetrySink TelemetrySink,
 ) *SidecarFactory[BeaconBlockT, BeaconBlockBodyT] {
 	return &SidecarFactory[BeaconBlockT, BeaconBlockBodyT]{
 		chainSpec: chainSpec,
 		// TODO: This should be configurable / modular.
-		kzgPosition: kzgPosition,
-		metrics:     newFactoryMetrics(telemetrySink),
+		kzgPosition:   kzgPosition,
+		proofVerifier: proofVerifier,
+		metrics:       newFactoryMetrics(telemetrySink),
 	}
 }
 
@@ -105,6 +113,67 @@ func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildSidecars(
 	return &types.BlobSidecars{Sidecars: sidecars}, g.Wait()
 }
 
+// BuildDataColumnSidecars extends the blobs of the bundle and builds one
+// data column sidecar per column of the extended blob matrix.
+func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildDataColumnSidecars(
+	blk BeaconBlockT,
+	bundle engineprimitives.BlobsBundle,
+) (*types.DataColumnSidecars, error) {
+	var (
+		blobs    = bundle.GetBlobs()
+		numBlobs = uint64(len(blobs))
+		cells    = make([][]eip7594.Cell, numBlobs)
+		proofs   = make([][]eip4844.KZGProof, numBlobs)
+		g        = errgroup.Group{}
+	)
+
+	startTime := time.Now()
+	defer f.metrics.measureBuildDataColumnSidecarsDuration(
+		startTime, math.U64(numBlobs),
+	)
+
+	// Nothing to sample if the block does not carry any blob.
+	if numBlobs == 0 {
+		return &types.DataColumnSidecars{}, nil
+	}
+
+	for i := range numBlobs {
+		g.Go(func() error {
+			var err error
+			cells[i], proofs[i], err = f.proofVerifier.
+				ComputeCellsAndKZGProofs(blobs[i])
+			return err
+		})
+	}
+
+	// All the columns share the same proof of the commitments list root.
+	inclusionProof, err := f.BuildBlockBodyProof(blk.GetBody())
+	if err != nil {
+		return nil, err
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var (
+		header      = blk.GetHeader()
+		commitments = bundle.GetCommitments()
+		sidecars    = make([]*types.DataColumnSidecar, eip7594.NumberOfColumns)
+	)
+	for col := range uint64(eip7594.NumberOfColumns) {
+		column := make([]*eip7594.Cell, numBlobs)
+		columnProofs := make([]eip4844.KZGProof, numBlobs)
+		for row := range numBlobs {
+			column[row] = &cells[row][col]
+			columnProofs[row] = proofs[row][col]
+		}
+		sidecars[col] = types.BuildDataColumnSidecar(
+			col, header, column, commitments, columnProofs, inclusionProof,
+		)
+	}
+	return &types.DataColumnSidecars{Sidecars: sidecars}, nil
+}
+
 // BuildKZGInclusionProof builds a KZG inclusion proof.
 func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildKZGInclusionProof(
 	body BeaconBlockBodyT,
@@ -53,6 +53,19 @@ func (fm *factoryMetrics) measureBuildSidecarsDuration(
 	)
 }
 
+// measureBuildDataColumnSidecarsDuration measures the duration of the build
+// data column sidecars.
+func (fm *factoryMetrics) measureBuildDataColumnSidecarsDuration(
+	startTime time.Time, numBlobs math.U64,
+) {
+	fm.sink.MeasureSince(
+		"beacon_kit.da.blob.factory.build_data_column_sidecars_duration",
+		startTime,
+		"num_blobs",
+		numBlobs.Base10(),
+	)
+}
+
 // measureBuildKZGInclusionProofDuration measures the duration of the build KZG
 // inclusion proof.
 func (fm *factoryMetrics) measureBuildKZGInclusionProofDuration(
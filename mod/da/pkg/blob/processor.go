@@ -46,6 +46,9 @@ type Processor[
 	// blockBodyOffsetFn is a function that calculates the block body offset
 	// based on the slot and chain specifications.
 	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64
+	// blockBodyKZGPositionFn is a function that returns the position of the
+	// KZG commitments list in the block body for the given slot.
+	blockBodyKZGPositionFn func(math.Slot, common.ChainSpec) uint64
 	// metrics is used to collect and report processor metrics.
 	metrics *processorMetrics
 }
@@ -61,14 +64,16 @@ func NewProcessor[
 	chainSpec common.ChainSpec,
 	verifier *Verifier,
 	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64,
+	blockBodyKZGPositionFn func(math.Slot, common.ChainSpec) uint64,
 	telemetrySink TelemetrySink,
 ) *Processor[AvailabilityStoreT, BeaconBlockBodyT] {
 	return &Processor[AvailabilityStoreT, BeaconBlockBodyT]{
-		logger:            logger,
-		chainSpec:         chainSpec,
-		verifier:          verifier,
-		blockBodyOffsetFn: blockBodyOffsetFn,
-		metrics:           newProcessorMetrics(telemetrySink),
+		logger:                 logger,
+		chainSpec:              chainSpec,
+		verifier:               verifier,
+		blockBodyOffsetFn:      blockBodyOffsetFn,
+		blockBodyKZGPositionFn: blockBodyKZGPositionFn,
+		metrics:                newProcessorMetrics(telemetrySink),
 	}
 }
 
@@ -118,3 +123,53 @@ func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) ProcessSidecars(
 		sidecars,
 	)
 }
+
+// VerifyDataColumnSidecars verifies the data columns and ensures they match
+// the local state.
+func (sp *Processor[
+	AvailabilityStoreT, BeaconBlockBodyT,
+]) VerifyDataColumnSidecars(
+	sidecars *types.DataColumnSidecars,
+) error {
+	startTime := time.Now()
+	defer sp.metrics.measureVerifyDataColumnSidecarsDuration(
+		startTime, math.U64(sidecars.Len()),
+	)
+
+	// Abort if there are no columns to verify.
+	if sidecars.Len() == 0 {
+		return nil
+	}
+
+	return sp.verifier.VerifyDataColumnSidecars(
+		sidecars,
+		sp.blockBodyKZGPositionFn(
+			sidecars.Sidecars[0].BeaconBlockHeader.Slot,
+			sp.chainSpec,
+		),
+		sp.chainSpec.MaxBlobCommitmentsPerBlock(),
+	)
+}
+
+// ProcessDataColumnSidecars persists the verified data columns.
+func (sp *Processor[
+	AvailabilityStoreT, BeaconBlockBodyT,
+]) ProcessDataColumnSidecars(
+	avs AvailabilityStoreT,
+	sidecars *types.DataColumnSidecars,
+) error {
+	startTime := time.Now()
+	defer sp.metrics.measureProcessDataColumnSidecarsDuration(
+		startTime, math.U64(sidecars.Len()),
+	)
+
+	// Abort if there are no columns to store.
+	if sidecars.Len() == 0 {
+		return nil
+	}
+
+	return avs.PersistDataColumns(
+		sidecars.Sidecars[0].BeaconBlockHeader.Slot,
+		sidecars,
+	)
+}
@@ -66,3 +66,31 @@ func (pm *processorMetrics) measureProcessSidecarsDuration(
 		numSidecars.Base10(),
 	)
 }
+
+// measureVerifyDataColumnSidecarsDuration measures the duration of the data
+// column verification.
+func (pm *processorMetrics) measureVerifyDataColumnSidecarsDuration(
+	startTime time.Time,
+	numSidecars math.U64,
+) {
+	pm.sink.MeasureSince(
+		"beacon_kit.da.blob.processor.verify_data_columns_duration",
+		startTime,
+		"num_sidecars",
+		numSidecars.Base10(),
+	)
+}
+
+// measureProcessDataColumnSidecarsDuration measures the duration of the data
+// column processing.
+func (pm *processorMetrics) measureProcessDataColumnSidecarsDuration(
+	startTime time.Time,
+	numSidecars math.U64,
+) {
+	pm.sink.MeasureSince(
+		"beacon_kit.da.blob.processor.process_data_columns_duration",
+		startTime,
+		"num_sidecars",
+		numSidecars.Base10(),
+	)
+}
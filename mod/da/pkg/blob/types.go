@@ -24,7 +24,8 @@ import (
 	"context"
 	"time"
 
-	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -40,11 +41,14 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// PersistDataColumns makes sure that the data column sidecars remain
+	// accessible for data availability sampling.
+	PersistDataColumns(math.Slot, *types.DataColumnSidecars) error
 }
 
 type BeaconBlock[BeaconBlockBodyT any] interface {
 	GetBody() BeaconBlockBodyT
-	GetHeader() *types.BeaconBlockHeader
+	GetHeader() *ctypes.BeaconBlockHeader
 }
 
 type BeaconBlockBody interface {
@@ -124,3 +124,52 @@ func (bv *Verifier) VerifyKZGProofs(
 		return bv.proofVerifier.VerifyBlobProofBatch(kzg.ArgsFromSidecars(scs))
 	}
 }
+
+// VerifyDataColumnSidecars verifies the data columns for their structure, the
+// inclusion of their commitments and their cell KZG proofs.
+func (bv *Verifier) VerifyDataColumnSidecars(
+	sidecars *types.DataColumnSidecars,
+	kzgPosition uint64,
+	maxBlobCommitmentsPerBlock uint64,
+) error {
+	var (
+		g, _      = errgroup.WithContext(context.Background())
+		startTime = time.Now()
+	)
+
+	defer bv.metrics.measureVerifyDataColumnSidecarsDuration(
+		startTime, math.U64(sidecars.Len()),
+		bv.proofVerifier.GetImplementation(),
+	)
+
+	// The cell proofs are only meaningful on well formed columns.
+	if err := sidecars.ValidateStructure(); err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		return sidecars.VerifyInclusionProofs(
+			kzgPosition, maxBlobCommitmentsPerBlock,
+		)
+	})
+
+	g.Go(func() error {
+		return bv.VerifyCellKZGProofs(sidecars)
+	})
+
+	g.Go(func() error {
+		return sidecars.ValidateBlockRoots()
+	})
+
+	return g.Wait()
+}
+
+// VerifyCellKZGProofs verifies the cell proofs of all the data columns in a
+// single batch.
+func (bv *Verifier) VerifyCellKZGProofs(
+	scs *types.DataColumnSidecars,
+) error {
+	return bv.proofVerifier.VerifyCellKZGProofBatch(
+		kzg.ArgsFromDataColumnSidecars(scs),
+	)
+}
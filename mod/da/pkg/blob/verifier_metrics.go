@@ -87,3 +87,20 @@ func (vm *verifierMetrics) measureVerifyKZGProofsDuration(
 		kzgImplementation,
 	)
 }
+
+// measureVerifyDataColumnSidecarsDuration measures the duration of the data
+// column sidecars verification.
+func (vm *verifierMetrics) measureVerifyDataColumnSidecarsDuration(
+	startTime time.Time,
+	numSidecars math.U64,
+	kzgImplementation string,
+) {
+	vm.sink.MeasureSince(
+		"beacon_kit.da.blob.verifier.verify_data_columns_duration",
+		startTime,
+		"num_sidecars",
+		numSidecars.Base10(),
+		"kzg_implementation",
+		kzgImplementation,
+	)
+}
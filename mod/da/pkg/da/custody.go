@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+)
+
+// Custody determines which data columns a node keeps custody of and which
+// ones it samples for every block, as per EIP-7594.
+type Custody struct {
+	// nodeID is the identifier the custody columns are derived from.
+	nodeID common.Root
+	// columns are the sorted indices of the columns in custody.
+	columns []uint64
+	// samplesPerSlot is the number of columns sampled for every block.
+	samplesPerSlot uint64
+}
+
+// NewCustody creates a new Custody for the given node identifier.
+func NewCustody(
+	nodeID common.Root,
+	custodyRequirement uint64,
+	samplesPerSlot uint64,
+) *Custody {
+	return &Custody{
+		nodeID:         nodeID,
+		columns:        custodyColumns(nodeID, custodyRequirement),
+		samplesPerSlot: samplesPerSlot,
+	}
+}
+
+// Columns returns the sorted indices of the columns in custody.
+func (c *Custody) Columns() []uint64 {
+	return c.columns
+}
+
+// IsCustodied returns whether the column at the given index is in custody.
+func (c *Custody) IsCustodied(index uint64) bool {
+	_, found := slices.BinarySearch(c.columns, index)
+	return found
+}
+
+// SampleColumns returns the columns to sample for the block with the given
+// root in addition to the ones in custody. The samples are derived from the
+// node identifier and the block root, so every node samples a different but
+// reproducible set of columns for each block.
+func (c *Custody) SampleColumns(blockRoot common.Root) []uint64 {
+	var (
+		samples  = make([]uint64, 0, c.samplesPerSlot)
+		numFree  = eip7594.NumberOfColumns - uint64(len(c.columns))
+		preimage = make([]byte, 0, 2*len(common.Root{})+8)
+	)
+	for i := uint64(0); uint64(len(samples)) < min(
+		c.samplesPerSlot, numFree,
+	); i++ {
+		preimage = append(preimage[:0], c.nodeID[:]...)
+		preimage = append(preimage, blockRoot[:]...)
+		preimage = binary.LittleEndian.AppendUint64(preimage, i)
+		index := columnFromHash(sha256.Sum256(preimage))
+		if c.IsCustodied(index) || slices.Contains(samples, index) {
+			continue
+		}
+		samples = append(samples, index)
+	}
+	slices.Sort(samples)
+	return samples
+}
+
+// custodyColumns returns the sorted indices of the columns a node with the
+// given identifier is in custody of, as per get_custody_columns.
+func custodyColumns(nodeID common.Root, custodyRequirement uint64) []uint64 {
+	var (
+		count     = min(custodyRequirement, eip7594.NumberOfColumns)
+		columns   = make([]uint64, 0, count)
+		currentID = nodeID
+	)
+	for uint64(len(columns)) < count {
+		index := columnFromHash(sha256.Sum256(currentID[:]))
+		if !slices.Contains(columns, index) {
+			columns = append(columns, index)
+		}
+		incrementLittleEndian(&currentID)
+	}
+	slices.Sort(columns)
+	return columns
+}
+
+// columnFromHash maps a hash to a column index.
+func columnFromHash(h [32]byte) uint64 {
+	return binary.LittleEndian.Uint64(h[:8]) % eip7594.NumberOfColumns
+}
+
+// incrementLittleEndian increments the little endian uint256 in place,
+// wrapping around on overflow.
+func incrementLittleEndian(id *common.Root) {
+	for i := range id {
+		id[i]++
+		if id[i] != 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/da"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustodyColumns(t *testing.T) {
+	nodeID := common.Root{0xff, 0xff, 0x01}
+	custody := da.NewCustody(nodeID, 4, 8)
+
+	columns := custody.Columns()
+	require.Len(t, columns, 4)
+	require.True(t, slices.IsSorted(columns))
+	for _, index := range columns {
+		require.Less(t, index, uint64(eip7594.NumberOfColumns))
+		require.True(t, custody.IsCustodied(index))
+	}
+	require.Len(t, slices.Compact(slices.Clone(columns)), 4)
+
+	// The custody columns only depend on the node identifier.
+	require.Equal(t, columns, da.NewCustody(nodeID, 4, 0).Columns())
+	require.NotEqual(
+		t, columns, da.NewCustody(common.Root{0x02}, 4, 0).Columns(),
+	)
+
+	// A requirement above the number of columns custodies every column.
+	require.Len(
+		t,
+		da.NewCustody(nodeID, 2*eip7594.NumberOfColumns, 0).Columns(),
+		eip7594.NumberOfColumns,
+	)
+}
+
+func TestCustodySampleColumns(t *testing.T) {
+	custody := da.NewCustody(common.Root{0x01}, 4, 8)
+
+	samples := custody.SampleColumns(common.Root{0xaa})
+	require.Len(t, samples, 8)
+	require.True(t, slices.IsSorted(samples))
+	require.Len(t, slices.Compact(slices.Clone(samples)), 8)
+	for _, index := range samples {
+		require.False(t, custody.IsCustodied(index))
+	}
+
+	// Samples are reproducible for a block and differ across blocks.
+	require.Equal(t, samples, custody.SampleColumns(common.Root{0xaa}))
+	require.NotEqual(t, samples, custody.SampleColumns(common.Root{0xbb}))
+
+	// Samples never exceed the columns that are not in custody.
+	full := da.NewCustody(common.Root{0x01}, eip7594.NumberOfColumns-2, 8)
+	require.Len(t, full.SampleColumns(common.Root{0xaa}), 2)
+}
@@ -22,12 +22,20 @@ package da
 
 import (
 	"context"
+	"slices"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// columnsEvent is the event carrying the data column sidecars of a block.
+type columnsEvent = asynctypes.Event[*types.DataColumnSidecars]
+
 type Service[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
 	BeaconBlockBodyT any,
@@ -42,6 +50,9 @@ type Service[
 		BlobSidecarsT, ExecutionPayloadT,
 	]
 	sidecarsBroker EventPublisherSubscriberT
+	columnsBroker  EventPublisherSubscriber[*columnsEvent]
+	chainSpec      ChainSpec
+	custody        *Custody
 	logger         log.Logger[any]
 }
 
@@ -62,6 +73,9 @@ func NewService[
 		BlobSidecarsT, ExecutionPayloadT,
 	],
 	sidecarsBroker EventPublisherSubscriberT,
+	columnsBroker EventPublisherSubscriber[*columnsEvent],
+	chainSpec ChainSpec,
+	custody *Custody,
 	logger log.Logger[any],
 ) *Service[
 	AvailabilityStoreT, BeaconBlockBodyT,
@@ -74,6 +88,9 @@ func NewService[
 		avs:            avs,
 		bp:             bp,
 		sidecarsBroker: sidecarsBroker,
+		columnsBroker:  columnsBroker,
+		chainSpec:      chainSpec,
+		custody:        custody,
 		logger:         logger,
 	}
 }
@@ -89,7 +106,11 @@ func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	go s.start(ctx, subSidecarsCh)
+	subColumnsCh, err := s.columnsBroker.Subscribe()
+	if err != nil {
+		return err
+	}
+	go s.start(ctx, subSidecarsCh, subColumnsCh)
 	return nil
 }
 
@@ -97,6 +118,7 @@ func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
 func (s *Service[_, _, BlobSidecarsT, _, _]) start(
 	ctx context.Context,
 	sidecarsCh chan *asynctypes.Event[BlobSidecarsT],
+	columnsCh chan *columnsEvent,
 ) {
 	for {
 		select {
@@ -109,6 +131,13 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) start(
 			case events.BlobSidecarsReceived:
 				s.handleBlobSidecarsReceived(msg)
 			}
+		case msg := <-columnsCh:
+			switch msg.Type() {
+			case events.DataColumnsProcessRequest:
+				s.handleDataColumnsProcessRequest(msg)
+			case events.DataColumnsReceived:
+				s.handleDataColumnsReceived(msg)
+			}
 		}
 	}
 }
@@ -211,3 +240,166 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) receiveSidecars(
 
 	return nil
 }
+
+// handleDataColumnsProcessRequest handles the DataColumnsProcessRequest
+// event.
+// It persists the columns and publishes a DataColumnsProcessed event.
+func (s *Service[_, _, _, _, _]) handleDataColumnsProcessRequest(
+	msg *columnsEvent,
+) {
+	err := s.processDataColumns(msg.Data())
+	if err != nil {
+		s.logger.Error(
+			"Failed to process data column sidecars",
+			"error",
+			err,
+		)
+	}
+	s.publishDataColumnsProcessed(msg, err)
+}
+
+// handleDataColumnsReceived handles the DataColumnsReceived event.
+// It verifies the columns and publishes a DataColumnsProcessed event.
+func (s *Service[_, _, _, _, _]) handleDataColumnsReceived(
+	msg *columnsEvent,
+) {
+	err := s.receiveDataColumns(msg.Data())
+	if err != nil {
+		s.logger.Error(
+			"Failed to receive data column sidecars",
+			"error",
+			err,
+		)
+	}
+	s.publishDataColumnsProcessed(msg, err)
+}
+
+// publishDataColumnsProcessed replies to msg with a DataColumnsProcessed
+// event carrying the outcome of its handling.
+func (s *Service[_, _, _, _, _]) publishDataColumnsProcessed(
+	msg *columnsEvent,
+	err error,
+) {
+	if err = s.columnsBroker.Publish(
+		msg.Context(),
+		asynctypes.NewEvent(
+			msg.Context(), events.DataColumnsProcessed, msg.Data(), err,
+		)); err != nil {
+		s.logger.Error(
+			"Failed to publish data column sidecars processed event",
+			"error",
+			err,
+		)
+	}
+}
+
+// receiveDataColumns verifies the data columns of a proposed block that this
+// node is in custody of or samples. Sampling fails, and so does the data
+// availability of the block, if any of these columns is missing.
+func (s *Service[_, _, _, _, _]) receiveDataColumns(
+	sidecars *types.DataColumnSidecars,
+) error {
+	wanted, err := s.sampleDataColumns(sidecars)
+	if err != nil || wanted.Len() == 0 {
+		return err
+	}
+
+	if err = s.bp.VerifyDataColumnSidecars(wanted); err != nil {
+		s.logger.Error(
+			"rejecting incoming data column sidecars",
+			"reason", err,
+		)
+		return err
+	}
+
+	s.logger.Info(
+		"Data column sampling succeeded",
+		"slot", wanted.Sidecars[0].BeaconBlockHeader.GetSlot().Base10(),
+		"num_columns", wanted.Len(),
+	)
+	return nil
+}
+
+// processDataColumns persists the data columns of a finalized block that
+// this node is in custody of or samples.
+func (s *Service[_, _, _, _, _]) processDataColumns(
+	sidecars *types.DataColumnSidecars,
+) error {
+	wanted, err := s.sampleDataColumns(sidecars)
+	if err != nil || wanted.Len() == 0 {
+		return err
+	}
+	return s.bp.ProcessDataColumnSidecars(s.avs, wanted)
+}
+
+// sampleDataColumns returns the data columns this node is in custody of or
+// samples out of the columns of a block.
+func (s *Service[_, _, _, _, _]) sampleDataColumns(
+	sidecars *types.DataColumnSidecars,
+) (*types.DataColumnSidecars, error) {
+	// A block without blobs has no columns to sample.
+	if sidecars.IsNil() || sidecars.Len() == 0 {
+		return &types.DataColumnSidecars{}, nil
+	}
+
+	header := sidecars.Sidecars[0].BeaconBlockHeader
+	if header == nil {
+		return nil, types.ErrAttemptedToVerifyNilSidecar
+	}
+	if !s.chainSpec.PeerDASActiveForSlot(header.GetSlot()) {
+		return nil, ErrPeerDASNotActive
+	}
+	return s.selectColumns(header.HashTreeRoot(), sidecars)
+}
+
+// IsDataColumnsAvailable returns whether all the data columns this node is in
+// custody of or samples for the given block have been stored.
+func (s *Service[_, _, _, _, _]) IsDataColumnsAvailable(
+	ctx context.Context,
+	slot math.Slot,
+	blockRoot common.Root,
+) bool {
+	return s.avs.IsDataColumnsAvailable(
+		ctx, slot, s.sampledColumns(blockRoot),
+	)
+}
+
+// selectColumns returns the data columns this node is in custody of or
+// samples for the given block, failing if any of them is missing.
+func (s *Service[_, _, _, _, _]) selectColumns(
+	blockRoot common.Root,
+	sidecars *types.DataColumnSidecars,
+) (*types.DataColumnSidecars, error) {
+	var (
+		indices  = s.sampledColumns(blockRoot)
+		selected = make([]*types.DataColumnSidecar, 0, len(indices))
+	)
+	for _, index := range indices {
+		idx := slices.IndexFunc(
+			sidecars.Sidecars,
+			func(sc *types.DataColumnSidecar) bool {
+				return sc != nil && sc.Index == index
+			},
+		)
+		if idx < 0 {
+			return nil, errors.Wrapf(
+				ErrMissingDataColumn, "column %d", index,
+			)
+		}
+		selected = append(selected, sidecars.Sidecars[idx])
+	}
+	return &types.DataColumnSidecars{Sidecars: selected}, nil
+}
+
+// sampledColumns returns the sorted indices of the columns in custody along
+// with the ones sampled for the given block.
+func (s *Service[_, _, _, _, _]) sampledColumns(
+	blockRoot common.Root,
+) []uint64 {
+	indices := append(
+		slices.Clone(s.custody.Columns()),
+		s.custody.SampleColumns(blockRoot)...,
+	)
+	slices.Sort(indices)
+	return indices
+}
@@ -23,6 +23,7 @@ package da
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -33,6 +34,12 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// PersistDataColumns makes sure that the data column sidecars remain
+	// accessible for data availability sampling.
+	PersistDataColumns(math.Slot, *types.DataColumnSidecars) error
+	// IsDataColumnsAvailable ensures that the given data columns of the block
+	// at the given slot are stored.
+	IsDataColumnsAvailable(context.Context, math.Slot, []uint64) bool
 }
 
 // BlobProcessor is the interface for the blobs processor.
@@ -52,6 +59,23 @@ type BlobProcessor[
 	VerifySidecars(
 		sidecars BlobSidecarsT,
 	) error
+	// ProcessDataColumnSidecars persists the verified data columns.
+	ProcessDataColumnSidecars(
+		avs AvailabilityStoreT,
+		sidecars *types.DataColumnSidecars,
+	) error
+	// VerifyDataColumnSidecars verifies the data columns and ensures they
+	// match the local state.
+	VerifyDataColumnSidecars(
+		sidecars *types.DataColumnSidecars,
+	) error
+}
+
+// ChainSpec is the interface for the chain spec used by the DA service.
+type ChainSpec interface {
+	// PeerDASActiveForSlot returns whether data column sidecars are in use
+	// for the given slot.
+	PeerDASActiveForSlot(slot math.Slot) bool
 }
 
 // BlobSidecar is the interface for the blob sidecar.
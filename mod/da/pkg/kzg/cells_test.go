@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// errInvalidLength is returned when a vector input has the wrong length.
+var errInvalidLength = errors.New("invalid length")
+
+// verifiers holds a verifier per implementation. The c-kzg-4844 trusted
+// setup can only be loaded once per process, hence they are shared.
+var verifiers = sync.OnceValues(
+	func() (map[string]kzg.BlobProofVerifier, error) {
+		ts, err := loadTrustedSetupFromFile()
+		if err != nil {
+			return nil, err
+		}
+		verifiers := make(map[string]kzg.BlobProofVerifier)
+		for _, impl := range []string{
+			gokzg.Implementation, ckzg.Implementation,
+		} {
+			if verifiers[impl], err = kzg.NewBlobProofVerifier(
+				impl, ts,
+			); err != nil {
+				return nil, err
+			}
+		}
+		return verifiers, nil
+	},
+)
+
+// forEachVerifier runs f against every implementation.
+func forEachVerifier(
+	t *testing.T,
+	f func(t *testing.T, verifier kzg.BlobProofVerifier),
+) {
+	t.Helper()
+	verifiers, err := verifiers()
+	require.NoError(t, err)
+	for impl, verifier := range verifiers {
+		t.Run(impl, func(t *testing.T) { f(t, verifier) })
+	}
+}
+
+// The vectors under testing/files/eip7594 are the EIP-7594 KZG reference
+// tests, taken verbatim from ethereum/c-kzg-4844 (tests/*/kzg-mainnet).
+// A null output marks an input that must be rejected.
+
+type computeCellsVector struct {
+	Input struct {
+		Blob string `yaml:"blob"`
+	} `yaml:"input"`
+	Output *[2][]string `yaml:"output"`
+}
+
+type verifyCellBatchVector struct {
+	Input struct {
+		Commitments []string `yaml:"commitments"`
+		CellIndices []uint64 `yaml:"cell_indices"`
+		Cells       []string `yaml:"cells"`
+		Proofs      []string `yaml:"proofs"`
+	} `yaml:"input"`
+	Output *bool `yaml:"output"`
+}
+
+func TestComputeCellsAndKZGProofsVectors(t *testing.T) {
+	vectors := loadVectors(t, "compute_cells_and_kzg_proofs")
+	forEachVerifier(t, func(t *testing.T, verifier kzg.BlobProofVerifier) {
+		for name, data := range vectors {
+			t.Run(name, func(t *testing.T) {
+				var v computeCellsVector
+				require.NoError(t, yaml.Unmarshal(data, &v))
+
+				blob := new(eip4844.Blob)
+				err := decodeFixed(v.Input.Blob, blob[:])
+				if err == nil {
+					var (
+						cells  []eip7594.Cell
+						proofs []eip4844.KZGProof
+					)
+					cells, proofs, err = verifier.
+						ComputeCellsAndKZGProofs(blob)
+					if v.Output != nil {
+						require.NoError(t, err)
+						require.Len(t, cells, len(v.Output[0]))
+						require.Len(t, proofs, len(v.Output[1]))
+						for i := range cells {
+							require.Equal(
+								t, v.Output[0][i],
+								hex.FromBytes(cells[i].Bytes()).Unwrap(),
+							)
+							require.Equal(
+								t, v.Output[1][i],
+								hex.FromBytes(proofs[i][:]).Unwrap(),
+							)
+						}
+						return
+					}
+				}
+				require.Nil(t, v.Output)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestVerifyCellKZGProofBatchVectors(t *testing.T) {
+	vectors := loadVectors(t, "verify_cell_kzg_proof_batch")
+	forEachVerifier(t, func(t *testing.T, verifier kzg.BlobProofVerifier) {
+		for name, data := range vectors {
+			t.Run(name, func(t *testing.T) {
+				var v verifyCellBatchVector
+				require.NoError(t, yaml.Unmarshal(data, &v))
+
+				args, err := decodeCellProofArgs(&v)
+				if err == nil {
+					err = verifier.VerifyCellKZGProofBatch(args)
+				}
+				if v.Output != nil && *v.Output {
+					require.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+// loadVectors returns the raw test cases of the given EIP-7594 reference
+// test, keyed by case name.
+func loadVectors(t *testing.T, test string) map[string][]byte {
+	t.Helper()
+	paths, err := filepath.Glob(
+		filepath.Join(baseDir, "eip7594", test, "*.yaml"),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	vectors := make(map[string][]byte, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		vectors[filepath.Base(path)] = data
+	}
+	return vectors
+}
+
+// decodeCellProofArgs decodes the input of a verify_cell_kzg_proof_batch
+// vector, returning an error for malformed inputs.
+func decodeCellProofArgs(
+	v *verifyCellBatchVector,
+) (*types.CellProofArgs, error) {
+	args := &types.CellProofArgs{
+		Commitments: make([]eip4844.KZGCommitment, len(v.Input.Commitments)),
+		CellIndices: v.Input.CellIndices,
+		Cells:       make([]*eip7594.Cell, len(v.Input.Cells)),
+		Proofs:      make([]eip4844.KZGProof, len(v.Input.Proofs)),
+	}
+	for i, c := range v.Input.Commitments {
+		if err := decodeFixed(c, args.Commitments[i][:]); err != nil {
+			return nil, err
+		}
+	}
+	for i, c := range v.Input.Cells {
+		bz, err := hex.ToBytes(c)
+		if err != nil {
+			return nil, err
+		}
+		args.Cells[i] = new(eip7594.Cell)
+		if err = args.Cells[i].SetBytes(bz); err != nil {
+			return nil, err
+		}
+	}
+	for i, p := range v.Input.Proofs {
+		if err := decodeFixed(p, args.Proofs[i][:]); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// decodeFixed decodes a hex string into out, which it must fill exactly.
+func decodeFixed(input string, out []byte) error {
+	bz, err := hex.ToBytes(input)
+	if err != nil {
+		return err
+	}
+	if len(bz) != len(out) {
+		return errInvalidLength
+	}
+	copy(out, bz)
+	return nil
+}
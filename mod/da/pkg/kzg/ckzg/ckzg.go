@@ -21,8 +21,12 @@
 package ckzg
 
 import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
 )
 
@@ -30,7 +34,13 @@ import (
 const Implementation = "ethereum/c-kzg-4844"
 
 // Verifier is a verifier that utilizies the CKZG library.
-type Verifier struct{}
+type Verifier struct {
+	// cells computes and verifies the proofs of erasure coded blob cells.
+	// The c-kzg-4844 v1 bindings predate EIP-7594 and the v2 bindings
+	// cannot be linked next to the v1 ones go-ethereum depends on, so cells
+	// are handled by go-eth-kzg regardless of cgo.
+	cells *peerdas.Context
+}
 
 // GetImplementation returns the implementation of the verifier.
 func (v Verifier) GetImplementation() string {
@@ -40,8 +50,8 @@ func (v Verifier) GetImplementation() string {
 // NewVerifier creates a new CKZG verifier.
 //
 //nolint:mnd // lots of random numbers because cryptography.
-func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
-	if err := gokzg4844.CheckTrustedSetupIsWellFormed(ts); err != nil {
+func NewVerifier(ts *goethkzg.JSONTrustedSetup) (*Verifier, error) {
+	if err := goethkzg.CheckTrustedSetupIsWellFormed(ts); err != nil {
 		return nil, err
 	}
 	g1s := make(
@@ -58,5 +68,23 @@ func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
 	if err := ckzg4844.LoadTrustedSetup(g1s, g2s); err != nil {
 		return nil, err
 	}
-	return &Verifier{}, nil
+	cells, err := peerdas.NewContext(ts)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{cells: cells}, nil
+}
+
+// ComputeCellsAndKZGProofs erasure codes the blob into cells and computes
+// the KZG proof of each cell.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	return v.cells.ComputeCellsAndKZGProofs(blob)
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells
+// against the commitments of the blobs they were extended from.
+func (v Verifier) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	return v.cells.VerifyCellKZGProofBatch(args)
 }
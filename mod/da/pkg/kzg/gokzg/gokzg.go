@@ -23,8 +23,11 @@ package gokzg
 import (
 	"unsafe"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 )
 
@@ -33,15 +36,24 @@ const Implementation = "crate-crypto/go-kzg-4844"
 // Verifier is a KZG verifier that uses the Go implementation of KZG.
 type Verifier struct {
 	*gokzg4844.Context
+	// cells computes and verifies the proofs of erasure coded blob cells.
+	cells *peerdas.Context
 }
 
 // NewVerifier creates a new GoKZGVerifier.
-func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
-	ctx, err := gokzg4844.NewContext4096(ts)
+func NewVerifier(ts *goethkzg.JSONTrustedSetup) (*Verifier, error) {
+	ctx, err := gokzg4844.NewContext4096(&gokzg4844.JSONTrustedSetup{
+		SetupG2:         ts.SetupG2,
+		SetupG1Lagrange: ts.SetupG1Lagrange,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &Verifier{ctx}, nil
+	cells, err := peerdas.NewContext(ts)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{Context: ctx, cells: cells}, nil
 }
 
 // GetImplementation returns the implementation of the verifier.
@@ -83,3 +95,17 @@ func (v Verifier) VerifyBlobProofBatch(
 			*(*[]gokzg4844.KZGProof)(unsafe.Pointer(&args.Proofs)),
 		)
 }
+
+// ComputeCellsAndKZGProofs erasure codes the blob into cells and computes
+// the KZG proof of each cell.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	return v.cells.ComputeCellsAndKZGProofs(blob)
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells
+// against the commitments of the blobs they were extended from.
+func (v Verifier) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	return v.cells.VerifyCellKZGProofBatch(args)
+}
@@ -23,6 +23,7 @@ package noop
 import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
 )
 
 // Verifier is a no-op KZG proof verifier.
@@ -53,3 +54,18 @@ func (v Verifier) VerifyBlobProofBatch(
 ) error {
 	return nil
 }
+
+// ComputeCellsAndKZGProofs returns zeroed cells and proofs.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	*eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	return make([]eip7594.Cell, eip7594.CellsPerExtBlob),
+		make([]eip4844.KZGProof, eip7594.CellsPerExtBlob), nil
+}
+
+// VerifyCellKZGProofBatch is a no-op.
+func (v Verifier) VerifyCellKZGProofBatch(
+	*types.CellProofArgs,
+) error {
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+
+package peerdas
+
+import (
+	"unsafe"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+)
+
+// Context computes and verifies the KZG proofs of the cells of erasure
+// coded blobs, as specified by EIP-7594, using crate-crypto/go-eth-kzg.
+type Context struct {
+	*goethkzg.Context
+}
+
+// NewContext creates a new Context from the given trusted setup.
+func NewContext(ts *goethkzg.JSONTrustedSetup) (*Context, error) {
+	ctx, err := goethkzg.NewContext4096(ts)
+	if err != nil {
+		return nil, err
+	}
+	return &Context{Context: ctx}, nil
+}
+
+// ComputeCellsAndKZGProofs erasure codes the blob and returns the cells of
+// the extended blob together with the KZG proof of each cell.
+func (c *Context) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	cells, proofs, err := c.Context.ComputeCellsAndKZGProofs(
+		(*goethkzg.Blob)(blob), 0,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	extended := make([]eip7594.Cell, len(cells))
+	for i, cell := range cells {
+		//#nosec:G103 // cells share the memory layout of the library cells.
+		extended[i] = *(*eip7594.Cell)(unsafe.Pointer(cell))
+	}
+	kzgProofs := make([]eip4844.KZGProof, len(proofs))
+	for i, proof := range proofs {
+		kzgProofs[i] = eip4844.KZGProof(proof)
+	}
+	return extended, kzgProofs, nil
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells
+// against the commitments of the blobs they were extended from.
+func (c *Context) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	//#nosec:G103 // cells share the memory layout of the library cells.
+	return c.Context.VerifyCellKZGProofBatch(
+		*(*[]goethkzg.KZGCommitment)(unsafe.Pointer(&args.Commitments)),
+		args.CellIndices,
+		*(*[]*goethkzg.Cell)(unsafe.Pointer(&args.Cells)),
+		*(*[]goethkzg.KZGProof)(unsafe.Pointer(&args.Proofs)),
+	)
+}
@@ -27,7 +27,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 )
 
 // BlobProofVerifier is a verifier for blobs.
@@ -47,13 +48,21 @@ type BlobProofVerifier interface {
 	// For most implementations it is more efficient than VerifyBlobProof when
 	// verifying multiple proofs.
 	VerifyBlobProofBatch(*kzgtypes.BlobProofArgs) error
+	// ComputeCellsAndKZGProofs erasure codes the blob into cells and
+	// computes the KZG proof of each cell.
+	ComputeCellsAndKZGProofs(
+		blob *eip4844.Blob,
+	) ([]eip7594.Cell, []eip4844.KZGProof, error)
+	// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells
+	// against the commitments of the blobs they were extended from.
+	VerifyCellKZGProofBatch(*kzgtypes.CellProofArgs) error
 }
 
 // NewBlobProofVerifier creates a new BlobVerifier with the given
 // implementation.
 func NewBlobProofVerifier(
 	impl string,
-	ts *gokzg4844.JSONTrustedSetup,
+	ts *goethkzg.JSONTrustedSetup,
 ) (BlobProofVerifier, error) {
 	switch impl {
 	case gokzg.Implementation:
@@ -85,3 +94,24 @@ func ArgsFromSidecars(
 	}
 	return proofArgs
 }
+
+// ArgsFromDataColumnSidecars flattens the cells of DataColumnSidecars into a
+// single CellProofArgs batch.
+func ArgsFromDataColumnSidecars(
+	scs *types.DataColumnSidecars,
+) *kzgtypes.CellProofArgs {
+	proofArgs := &kzgtypes.CellProofArgs{}
+	for _, sidecar := range scs.Sidecars {
+		for i, cell := range sidecar.Column {
+			proofArgs.Commitments = append(
+				proofArgs.Commitments, sidecar.KzgCommitments[i],
+			)
+			proofArgs.CellIndices = append(
+				proofArgs.CellIndices, sidecar.Index,
+			)
+			proofArgs.Cells = append(proofArgs.Cells, cell)
+			proofArgs.Proofs = append(proofArgs.Proofs, sidecar.KzgProofs[i])
+		}
+	}
+	return proofArgs
+}
@@ -31,7 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -49,11 +49,10 @@ func TestNewBlobProofVerifier_KzgImpl(t *testing.T) {
 }
 
 func TestNewBlobProofVerifier_CkzgImpl(t *testing.T) {
-	ts, err := loadTrustedSetupFromFile()
+	verifiers, err := verifiers()
 	require.NoError(t, err)
 
-	verifier, err := kzg.NewBlobProofVerifier(ckzg.Implementation, ts)
-	require.NoError(t, err)
+	verifier := verifiers[ckzg.Implementation]
 	require.NotNil(t, verifier)
 	require.Equal(t, ckzg.Implementation, verifier.GetImplementation())
 }
@@ -68,7 +67,7 @@ func TestNewBlobProofVerifier_InvalidImpl(t *testing.T) {
 }
 
 // loadTrustedSetupFromFile is the helper function.
-func loadTrustedSetupFromFile() (*gokzg4844.JSONTrustedSetup, error) {
+func loadTrustedSetupFromFile() (*goethkzg.JSONTrustedSetup, error) {
 	fileName := "kzg-trusted-setup.json"
 	fullPath := filepath.Join(baseDir, fileName)
 	data, err := os.ReadFile(fullPath)
@@ -76,7 +75,7 @@ func loadTrustedSetupFromFile() (*gokzg4844.JSONTrustedSetup, error) {
 		return nil, err
 	}
 
-	var ts gokzg4844.JSONTrustedSetup
+	var ts goethkzg.JSONTrustedSetup
 	err = json.Unmarshal(data, &ts)
 	if err != nil {
 		return nil, err
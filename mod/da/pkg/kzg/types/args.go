@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
 )
 
 // BlobProofArgs represents the arguments for a blob proof.
@@ -33,3 +34,16 @@ type BlobProofArgs struct {
 	// Commitment is the KZG commitment.
 	Commitments []eip4844.KZGCommitment
 }
+
+// CellProofArgs represents the arguments for a batch of cell proofs.
+type CellProofArgs struct {
+	// Commitments are the KZG commitments of the blobs the cells were
+	// extended from.
+	Commitments []eip4844.KZGCommitment
+	// CellIndices are the indices of the cells in their extended blobs.
+	CellIndices []uint64
+	// Cells are the cells.
+	Cells []*eip7594.Cell
+	// Proofs are the KZG proofs of the cells.
+	Proofs []eip4844.KZGProof
+}
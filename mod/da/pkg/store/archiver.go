@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Archiver is a prunable that exports the blob sidecars of the pruned slots
+// to a BlobArchive before removing them from the availability store. It only
+// prunes whole epochs, so that every epoch is archived as a single bundle.
+//
+// NOTE: Data column sidecars are pruned without being archived, as the blobs
+// they are derived from are.
+type Archiver struct {
+	// db is the range database of the availability store.
+	db PrunableIndexDB
+	// archive is the cold storage the expired sidecars are exported to.
+	archive BlobArchive
+	// chainSpec contains the chain specification.
+	chainSpec common.ChainSpec
+	// logger is used for logging.
+	logger log.Logger[any]
+	// next is the first slot that has not been archived yet.
+	next uint64
+}
+
+// NewArchiver creates a new Archiver.
+func NewArchiver(
+	db PrunableIndexDB,
+	archive BlobArchive,
+	chainSpec common.ChainSpec,
+	logger log.Logger[any],
+) *Archiver {
+	return &Archiver{
+		db:        db,
+		archive:   archive,
+		chainSpec: chainSpec,
+		logger:    logger,
+	}
+}
+
+// Prune archives and then prunes the complete epochs within [start, end).
+// The slots of the epoch containing end are left for a later call.
+func (a *Archiver) Prune(start, end uint64) error {
+	slotsPerEpoch := a.chainSpec.SlotsPerEpoch()
+	end -= end % slotsPerEpoch
+	start = max(start, a.next)
+	start -= start % slotsPerEpoch
+	if start >= end {
+		return nil
+	}
+
+	for slot := start; slot < end; slot += slotsPerEpoch {
+		if err := a.archiveEpoch(
+			math.Epoch(slot/slotsPerEpoch), slotsPerEpoch,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := a.db.Prune(start, end); err != nil {
+		return err
+	}
+	a.next = end
+	return nil
+}
+
+// archiveEpoch exports the blob sidecars of all the slots of the given epoch
+// to the archive.
+func (a *Archiver) archiveEpoch(
+	epoch math.Epoch,
+	slotsPerEpoch uint64,
+) error {
+	sidecars := make([]*types.BlobSidecar, 0)
+	for slot := epoch.Unwrap() * slotsPerEpoch; slot <
+		(epoch.Unwrap()+1)*slotsPerEpoch; slot++ {
+		scs, err := getBlobSidecars(a.db, slot)
+		if err != nil {
+			return err
+		}
+		sidecars = append(sidecars, scs...)
+	}
+
+	// Avoid overwriting a previous bundle with an empty one, e.g. when the
+	// epoch was archived before a restart but not pruned yet.
+	if len(sidecars) == 0 {
+		return nil
+	}
+
+	if err := a.archive.ArchiveEpoch(epoch, sidecars); err != nil {
+		return err
+	}
+	a.logger.Info("Archived expired blob sidecars 🧊",
+		"epoch", epoch.Base10(), "num_sidecars", len(sidecars),
+	)
+	return nil
+}
+
+// getBlobSidecars reads the blob sidecars stored at the given slot of the
+// database, ordered by their index.
+func getBlobSidecars(db IndexDB, slot uint64) ([]*types.BlobSidecar, error) {
+	keys, err := db.Keys(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make([]*types.BlobSidecar, 0, len(keys))
+	for _, key := range keys {
+		// Blob sidecars are keyed by their commitment, which sets them apart
+		// from the data column sidecars stored alongside them.
+		if len(key) != len(eip4844.KZGCommitment{}) {
+			continue
+		}
+
+		var bz []byte
+		if bz, err = db.Get(slot, key); err != nil {
+			return nil, err
+		}
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+
+	slices.SortFunc(sidecars, func(x, y *types.BlobSidecar) int {
+		return cmp.Compare(x.Index, y.Index)
+	})
+	return sidecars, nil
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// memIndexDB is an in-memory PrunableIndexDB.
+type memIndexDB map[uint64]map[string][]byte
+
+func (db memIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	return db[index][string(key)], nil
+}
+
+func (db memIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db[index][string(key)]
+	return ok, nil
+}
+
+func (db memIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db[index] == nil {
+		db[index] = make(map[string][]byte)
+	}
+	db[index][string(key)] = value
+	return nil
+}
+
+func (db memIndexDB) Keys(index uint64) ([][]byte, error) {
+	keys := make([][]byte, 0, len(db[index]))
+	for key := range db[index] {
+		keys = append(keys, []byte(key))
+	}
+	return keys, nil
+}
+
+func (db memIndexDB) Prune(start, end uint64) error {
+	for ; start < end; start++ {
+		delete(db, start)
+	}
+	return nil
+}
+
+func newBlobSidecars(slot math.Slot, count uint64) *types.BlobSidecars {
+	sidecars := make([]*types.BlobSidecar, count)
+	for i := range count {
+		sidecars[i] = types.BuildBlobSidecar(
+			math.U64(i),
+			&ctypes.BeaconBlockHeader{Slot: slot},
+			&eip4844.Blob{byte(slot), byte(i)},
+			eip4844.KZGCommitment{byte(slot), byte(i)},
+			eip4844.KZGProof{},
+			make([]common.Root, 8),
+		)
+	}
+	return &types.BlobSidecars{Sidecars: sidecars}
+}
+
+func TestArchiver(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+			]{
+				SlotsPerEpoch:                    4,
+				MinEpochsForBlobsSidecarsRequest: 1024,
+			},
+		)
+		db       = make(memIndexDB)
+		logger   = noop.NewLogger[any]()
+		blobs    = archive.NewFileArchive(afero.NewMemMapFs(), 4)
+		st       = store.New[*ctypes.BeaconBlockBody](db, logger, cs, blobs)
+		archiver = store.NewArchiver(db, blobs, cs, logger)
+	)
+
+	for _, slot := range []math.Slot{1, 2, 5, 9} {
+		require.NoError(t, st.Persist(slot, newBlobSidecars(slot, 2)))
+	}
+
+	// Only the complete epochs of the range are archived and pruned.
+	require.NoError(t, archiver.Prune(0, 7))
+	require.NotContains(t, db, uint64(1))
+	require.NotContains(t, db, uint64(2))
+	require.Contains(t, db, uint64(5))
+
+	// The read path transparently falls back to the archive.
+	for _, slot := range []math.Slot{1, 2, 5} {
+		got, err := st.GetBlobSidecars(slot, nil)
+		require.NoError(t, err)
+		require.Equal(t, newBlobSidecars(slot, 2), got)
+	}
+
+	// Pruning resumes from the last archived epoch.
+	require.NoError(t, archiver.Prune(0, 12))
+	require.Empty(t, db)
+	for _, slot := range []math.Slot{5, 9} {
+		got, err := st.GetBlobSidecars(slot, nil)
+		require.NoError(t, err)
+		require.Equal(t, newBlobSidecars(slot, 2), got)
+	}
+
+	// Sidecars can be filtered by index.
+	got, err := st.GetBlobSidecars(9, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(
+		t, newBlobSidecars(9, 2).Sidecars[1:], got.Sidecars,
+	)
+
+	// Slots without blobs are empty.
+	got, err = st.GetBlobSidecars(3, nil)
+	require.NoError(t, err)
+	require.Zero(t, got.Len())
+}
+
+func TestGetBlobSidecarsWithoutArchive(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    4,
+			MinEpochsForBlobsSidecarsRequest: 1024,
+		},
+	)
+	db := make(memIndexDB)
+	st := store.New[*ctypes.BeaconBlockBody](
+		db, noop.NewLogger[any](), cs, nil,
+	)
+	require.NoError(t, st.Persist(3, newBlobSidecars(3, 3)))
+
+	got, err := st.GetBlobSidecars(3, nil)
+	require.NoError(t, err)
+	require.Equal(t, newBlobSidecars(3, 3), got)
+
+	require.NoError(t, db.Prune(0, 4))
+	got, err = st.GetBlobSidecars(3, nil)
+	require.NoError(t, err)
+	require.Zero(t, got.Len())
+}
@@ -22,6 +22,8 @@ package store
 
 import (
 	"context"
+	"encoding/binary"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -31,6 +33,9 @@ import (
 	"github.com/sourcegraph/conc/iter"
 )
 
+// dataColumnKeyPrefix is the prefix of the keys of the data column sidecars.
+const dataColumnKeyPrefix = "data_column"
+
 // Store is the default implementation of the AvailabilityStore.
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
@@ -39,19 +44,60 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger[any]
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// archive is the optional cold storage of the expired blob sidecars.
+	archive BlobArchive
 }
 
-// New creates a new instance of the AvailabilityStore.
+// New creates a new instance of the AvailabilityStore. The archive may be
+// nil if expired blob sidecars are not archived.
 func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
+	archive BlobArchive,
 ) *Store[BeaconBlockT] {
 	return &Store[BeaconBlockT]{
 		IndexDB:   db,
 		chainSpec: chainSpec,
 		logger:    logger,
+		archive:   archive,
+	}
+}
+
+// Archive returns the archive of the expired blob sidecars, which is nil if
+// they are not archived.
+func (s *Store[BeaconBlockBodyT]) Archive() BlobArchive {
+	return s.archive
+}
+
+// GetBlobSidecars returns the blob sidecars of the block at the given slot,
+// falling back to the archive once they have been pruned. Only the sidecars
+// with the given indices are returned, unless indices is empty.
+func (s *Store[BeaconBlockBodyT]) GetBlobSidecars(
+	slot math.Slot,
+	indices []uint64,
+) (*types.BlobSidecars, error) {
+	sidecars, err := getBlobSidecars(s.IndexDB, slot.Unwrap())
+	if err != nil {
+		return nil, err
 	}
+	if len(sidecars) == 0 && s.archive != nil {
+		var archived *types.BlobSidecars
+		if archived, err = s.archive.GetBlobSidecars(slot); err != nil {
+			return nil, err
+		}
+		sidecars = archived.Sidecars
+	}
+
+	if len(indices) > 0 {
+		sidecars = slices.DeleteFunc(
+			sidecars,
+			func(sc *types.BlobSidecar) bool {
+				return !slices.Contains(indices, sc.Index)
+			},
+		)
+	}
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
@@ -117,3 +163,69 @@ func (s *Store[BeaconBlockT]) Persist(
 	)
 	return nil
 }
+
+// IsDataColumnsAvailable ensures that the given data columns of the block at
+// the given slot are stored before it returns true.
+func (s *Store[BeaconBlockBodyT]) IsDataColumnsAvailable(
+	_ context.Context,
+	slot math.Slot,
+	indices []uint64,
+) bool {
+	for _, index := range indices {
+		ok, err := s.IndexDB.Has(uint64(slot), dataColumnKey(index))
+		if err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// PersistDataColumns ensures the data column sidecars remain accessible,
+// keyed by their column index.
+func (s *Store[BeaconBlockT]) PersistDataColumns(
+	slot math.Slot,
+	sidecars *types.DataColumnSidecars,
+) error {
+	// Exit early if there are no sidecars to store.
+	if sidecars.IsNil() || sidecars.Len() == 0 {
+		return nil
+	}
+
+	// Skip columns from outside the required DA period.
+	if !s.chainSpec.WithinDAPeriod(
+		sidecars.Sidecars[0].BeaconBlockHeader.GetSlot(),
+		slot,
+	) {
+		return nil
+	}
+
+	if err := errors.Join(iter.Map(
+		sidecars.Sidecars,
+		func(sidecar **types.DataColumnSidecar) error {
+			if *sidecar == nil {
+				return ErrAttemptedToStoreNilSidecar
+			}
+			sc := *sidecar
+			bz, err := sc.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			return s.Set(slot.Unwrap(), dataColumnKey(sc.Index), bz)
+		},
+	)...); err != nil {
+		return err
+	}
+
+	s.logger.Info("Successfully stored data column sidecars 🚚",
+		"slot", slot.Base10(), "num_columns", sidecars.Len(),
+	)
+	return nil
+}
+
+// dataColumnKey returns the key under which a data column is stored. The
+// prefix keeps the keys apart from the commitments of the blob sidecars.
+func dataColumnKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(
+		[]byte(dataColumnKeyPrefix), index,
+	)
+}
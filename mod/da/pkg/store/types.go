@@ -21,6 +21,7 @@
 package store
 
 import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,8 +39,28 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
+	Get(index uint64, key []byte) ([]byte, error)
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	// Keys returns the keys stored under the given index.
+	Keys(index uint64) ([][]byte, error)
+}
+
+// PrunableIndexDB is an IndexDB that can be pruned.
+type PrunableIndexDB interface {
+	IndexDB
+	// Prune prunes the database from [start, end).
+	Prune(start, end uint64) error
+}
+
+// BlobArchive is a cold storage backend that expired blob sidecars are
+// exported to before being pruned from the availability store.
+type BlobArchive interface {
+	// ArchiveEpoch stores the blob sidecars of the given epoch.
+	ArchiveEpoch(epoch math.Epoch, sidecars []*types.BlobSidecar) error
+	// GetBlobSidecars returns the archived blob sidecars of the block at the
+	// given slot.
+	GetBlobSidecars(slot math.Slot) (*types.BlobSidecars, error)
 }
 
 // BeaconBlockBody is the body of a beacon block.
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// KZGCommitmentsInclusionProofDepth is the depth of the proof of the
+	// blob KZG commitments list root within the beacon block body.
+	KZGCommitmentsInclusionProofDepth = 3
+	// maxBlobCommitmentsPerColumn is the SSZ limit of the number of cells,
+	// commitments and proofs held by a single data column sidecar.
+	maxBlobCommitmentsPerColumn = 16
+)
+
+// DataColumnSidecar as per the EIP-7594 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/_features/eip7594/das-core.md#datacolumnsidecar
+//
+//nolint:lll
+type DataColumnSidecar struct {
+	// Index represents the index of the column in the extended blob matrix.
+	Index uint64
+	// Column holds the cell of every blob of the block at this column.
+	Column []*eip7594.Cell
+	// KzgCommitments are the KZG commitments of all the blobs in the block.
+	KzgCommitments []eip4844.KZGCommitment
+	// KzgProofs are the cell KZG proofs, one per cell in the column.
+	KzgProofs []eip4844.KZGProof
+	// BeaconBlockHeader represents the beacon block header for which this
+	// column is being included.
+	BeaconBlockHeader *types.BeaconBlockHeader
+	// KzgCommitmentsInclusionProof is the inclusion proof of the list of
+	// KZG commitments in the beacon block body.
+	KzgCommitmentsInclusionProof []common.Root
+}
+
+// BuildDataColumnSidecar creates a data column sidecar from the given cells,
+// commitments and proofs of a beacon block.
+func BuildDataColumnSidecar(
+	index uint64,
+	header *types.BeaconBlockHeader,
+	column []*eip7594.Cell,
+	commitments []eip4844.KZGCommitment,
+	proofs []eip4844.KZGProof,
+	inclusionProof []common.Root,
+) *DataColumnSidecar {
+	return &DataColumnSidecar{
+		Index:                        index,
+		Column:                       column,
+		KzgCommitments:               commitments,
+		KzgProofs:                    proofs,
+		BeaconBlockHeader:            header,
+		KzgCommitmentsInclusionProof: inclusionProof,
+	}
+}
+
+// HasValidInclusionProof verifies the inclusion proof of the list of KZG
+// commitments in the beacon body.
+func (d *DataColumnSidecar) HasValidInclusionProof(
+	kzgPosition uint64,
+	maxBlobCommitmentsPerBlock uint64,
+) bool {
+	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		eip4844.KZGCommitments[common.ExecutionHash](
+			d.KzgCommitments,
+		).Leafify(),
+		maxBlobCommitmentsPerBlock,
+	)
+	if err != nil {
+		return false
+	}
+
+	return merkle.IsValidMerkleBranch(
+		tree.HashTreeRoot(),
+		d.KzgCommitmentsInclusionProof,
+		KZGCommitmentsInclusionProofDepth,
+		kzgPosition,
+		d.BeaconBlockHeader.BodyRoot,
+	)
+}
+
+// DefineSSZ defines the SSZ encoding for the DataColumnSidecar object.
+func (d *DataColumnSidecar) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &d.Index)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &d.Column, maxBlobCommitmentsPerColumn,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgCommitments, maxBlobCommitmentsPerColumn,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgProofs, maxBlobCommitmentsPerColumn,
+	)
+	ssz.DefineStaticObject(codec, &d.BeaconBlockHeader)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec,
+		&d.KzgCommitmentsInclusionProof,
+		KZGCommitmentsInclusionProofDepth,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &d.Column, maxBlobCommitmentsPerColumn,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgCommitments, maxBlobCommitmentsPerColumn,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgProofs, maxBlobCommitmentsPerColumn,
+	)
+}
+
+// SizeSSZ returns the size of the DataColumnSidecar object in SSZ encoding.
+func (d *DataColumnSidecar) SizeSSZ(fixed bool) uint32 {
+	var size = uint32(8 + // Index
+		4 + // Column offset
+		4 + // KzgCommitments offset
+		4 + // KzgProofs offset
+		112 + // BeaconBlockHeader
+		KZGCommitmentsInclusionProofDepth*32) // KzgCommitmentsInclusionProof
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(d.Column)
+	size += ssz.SizeSliceOfStaticBytes(d.KzgCommitments)
+	size += ssz.SizeSliceOfStaticBytes(d.KzgProofs)
+	return size
+}
+
+// MarshalSSZ marshals the DataColumnSidecar object to SSZ format.
+func (d *DataColumnSidecar) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DataColumnSidecar object from SSZ format.
+func (d *DataColumnSidecar) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DataColumnSidecar
+// object.
+func (d *DataColumnSidecar) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKZGPosition    = 5
+	testMaxCommitments = 16
+)
+
+// buildDataColumnSidecar builds a data column sidecar whose inclusion proof
+// is valid against a body made of eight top level roots.
+func buildDataColumnSidecar(
+	t *testing.T,
+	index uint64,
+	numBlobs int,
+) *types.DataColumnSidecar {
+	t.Helper()
+	var (
+		column      = make([]*eip7594.Cell, numBlobs)
+		commitments = make([]eip4844.KZGCommitment, numBlobs)
+		proofs      = make([]eip4844.KZGProof, numBlobs)
+	)
+	for i := range numBlobs {
+		column[i] = &eip7594.Cell{}
+		column[i][0][0] = byte(i + 1)
+		commitments[i][0] = byte(i + 1)
+		proofs[i][0] = byte(i + 2)
+	}
+
+	commitmentsTree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		eip4844.KZGCommitments[common.ExecutionHash](commitments).Leafify(),
+		testMaxCommitments,
+	)
+	require.NoError(t, err)
+
+	roots := make([]common.Root, 8)
+	for i := range roots {
+		roots[i] = common.Root{byte(i + 1)}
+	}
+	roots[testKZGPosition] = commitmentsTree.HashTreeRoot()
+	bodyTree, err := merkle.NewTreeWithMaxLeaves[common.Root](roots, 8)
+	require.NoError(t, err)
+	proof, err := bodyTree.MerkleProof(testKZGPosition)
+	require.NoError(t, err)
+
+	return types.BuildDataColumnSidecar(
+		index,
+		&ctypes.BeaconBlockHeader{BodyRoot: bodyTree.Root()},
+		column,
+		commitments,
+		proofs,
+		proof,
+	)
+}
+
+func TestDataColumnSidecarMarshalling(t *testing.T) {
+	sidecar := buildDataColumnSidecar(t, 7, 3)
+
+	marshalled, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, marshalled, int(sidecar.SizeSSZ(false)))
+
+	unmarshalled := &types.DataColumnSidecar{}
+	require.NoError(t, unmarshalled.UnmarshalSSZ(marshalled))
+	require.Equal(t, sidecar, unmarshalled)
+	require.Equal(t, sidecar.HashTreeRoot(), unmarshalled.HashTreeRoot())
+}
+
+func TestDataColumnSidecarHasValidInclusionProof(t *testing.T) {
+	sidecar := buildDataColumnSidecar(t, 0, 2)
+	require.True(t, sidecar.HasValidInclusionProof(
+		testKZGPosition, testMaxCommitments,
+	))
+
+	// Wrong position in the body.
+	require.False(t, sidecar.HasValidInclusionProof(
+		testKZGPosition+1, testMaxCommitments,
+	))
+
+	// Tampered commitment.
+	sidecar.KzgCommitments[1][0] ^= 0xff
+	require.False(t, sidecar.HasValidInclusionProof(
+		testKZGPosition, testMaxCommitments,
+	))
+}
+
+func TestDataColumnSidecars(t *testing.T) {
+	sidecars := &types.DataColumnSidecars{
+		Sidecars: []*types.DataColumnSidecar{
+			buildDataColumnSidecar(t, 0, 2),
+			buildDataColumnSidecar(t, 64, 2),
+		},
+	}
+	require.NoError(t, sidecars.ValidateBlockRoots())
+	require.NoError(t, sidecars.ValidateStructure())
+	require.NoError(t, sidecars.VerifyInclusionProofs(
+		testKZGPosition, testMaxCommitments,
+	))
+
+	marshalled, err := sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := (&types.DataColumnSidecars{}).Empty()
+	require.NoError(t, unmarshalled.UnmarshalSSZ(marshalled))
+	require.Equal(t, sidecars, unmarshalled)
+
+	sidecars.Sidecars[1].BeaconBlockHeader = &ctypes.BeaconBlockHeader{
+		Slot: 1,
+	}
+	require.ErrorIs(
+		t, sidecars.ValidateBlockRoots(),
+		types.ErrSidecarContainsDifferingBlockRoots,
+	)
+	require.Error(t, sidecars.VerifyInclusionProofs(
+		testKZGPosition, testMaxCommitments,
+	))
+}
+
+func TestDataColumnSidecarsValidateStructure(t *testing.T) {
+	outOfBounds := buildDataColumnSidecar(t, eip7594.NumberOfColumns, 1)
+	require.ErrorIs(t, (&types.DataColumnSidecars{
+		Sidecars: []*types.DataColumnSidecar{outOfBounds},
+	}).ValidateStructure(), types.ErrInvalidColumnIndex)
+
+	missingProof := buildDataColumnSidecar(t, 1, 2)
+	missingProof.KzgProofs = missingProof.KzgProofs[:1]
+	require.ErrorIs(t, (&types.DataColumnSidecars{
+		Sidecars: []*types.DataColumnSidecar{missingProof},
+	}).ValidateStructure(), types.ErrInvalidColumnLength)
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/karalabe/ssz"
+	"github.com/sourcegraph/conc/iter"
+)
+
+// DataColumnSidecars is a slice of data column sidecars of a block.
+type DataColumnSidecars struct {
+	// Sidecars is a slice of data column sidecars of a block.
+	Sidecars []*DataColumnSidecar
+}
+
+// Empty creates a new empty DataColumnSidecars object.
+func (ds *DataColumnSidecars) Empty() *DataColumnSidecars {
+	return &DataColumnSidecars{}
+}
+
+// IsNil checks to see if the data columns are nil.
+func (ds *DataColumnSidecars) IsNil() bool {
+	return ds == nil || ds.Sidecars == nil
+}
+
+// Len returns the number of data column sidecars.
+func (ds *DataColumnSidecars) Len() int {
+	return len(ds.Sidecars)
+}
+
+// ValidateBlockRoots checks to make sure that all data columns are from the
+// same block.
+func (ds *DataColumnSidecars) ValidateBlockRoots() error {
+	if sc := ds.Sidecars; len(sc) > 1 {
+		firstHtr := sc[0].BeaconBlockHeader.HashTreeRoot()
+		for i := 1; i < len(sc); i++ {
+			if firstHtr != sc[i].BeaconBlockHeader.HashTreeRoot() {
+				return ErrSidecarContainsDifferingBlockRoots
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateStructure checks that every data column is well formed, i.e. its
+// index is within bounds and it holds one cell and one proof per commitment.
+func (ds *DataColumnSidecars) ValidateStructure() error {
+	for _, sc := range ds.Sidecars {
+		switch {
+		case sc == nil:
+			return ErrAttemptedToVerifyNilSidecar
+		case sc.Index >= eip7594.NumberOfColumns:
+			return ErrInvalidColumnIndex
+		case len(sc.Column) != len(sc.KzgCommitments),
+			len(sc.KzgProofs) != len(sc.KzgCommitments):
+			return ErrInvalidColumnLength
+		}
+	}
+	return nil
+}
+
+// VerifyInclusionProofs verifies the commitments inclusion proofs for all
+// data column sidecars.
+func (ds *DataColumnSidecars) VerifyInclusionProofs(
+	kzgPosition uint64,
+	maxBlobCommitmentsPerBlock uint64,
+) error {
+	return errors.Join(iter.Map(
+		ds.Sidecars,
+		func(sidecar **DataColumnSidecar) error {
+			sc := *sidecar
+			if sc == nil {
+				return ErrAttemptedToVerifyNilSidecar
+			}
+
+			if !sc.HasValidInclusionProof(
+				kzgPosition, maxBlobCommitmentsPerBlock,
+			) {
+				return ErrInvalidInclusionProof
+			}
+			return nil
+		},
+	)...)
+}
+
+// DefineSSZ defines the SSZ encoding for the DataColumnSidecars object.
+func (ds *DataColumnSidecars) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfDynamicObjectsOffset(
+		codec, &ds.Sidecars, eip7594.NumberOfColumns,
+	)
+	ssz.DefineSliceOfDynamicObjectsContent(
+		codec, &ds.Sidecars, eip7594.NumberOfColumns,
+	)
+}
+
+// SizeSSZ returns the size of the DataColumnSidecars object in SSZ encoding.
+func (ds *DataColumnSidecars) SizeSSZ(fixed bool) uint32 {
+	if fixed {
+		return 4
+	}
+	return 4 + ssz.SizeSliceOfDynamicObjects(ds.Sidecars)
+}
+
+// MarshalSSZ marshals the DataColumnSidecars object to SSZ format.
+func (ds *DataColumnSidecars) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ds.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, ds)
+}
+
+// UnmarshalSSZ unmarshals the DataColumnSidecars object from SSZ format.
+func (ds *DataColumnSidecars) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, ds)
+}
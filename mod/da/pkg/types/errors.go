@@ -38,4 +38,13 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrInvalidColumnIndex is returned when a data column sidecar has an
+	// index outside of the extended blob matrix.
+	ErrInvalidColumnIndex = errors.New("invalid data column index")
+
+	// ErrInvalidColumnLength is returned when a data column sidecar does not
+	// hold exactly one cell and one proof per KZG commitment.
+	ErrInvalidColumnLength = errors.New(
+		"data column cells, commitments and proofs lengths mismatch")
 )
@@ -70,6 +70,37 @@ func BuildBlobSidecar(
 	}
 }
 
+// GetIndex returns the index of the blob in the block.
+func (b *BlobSidecar) GetIndex() uint64 {
+	return b.Index
+}
+
+// GetBlob returns the blob data.
+func (b *BlobSidecar) GetBlob() *eip4844.Blob {
+	return &b.Blob
+}
+
+// GetKzgCommitment returns the KZG commitment of the blob.
+func (b *BlobSidecar) GetKzgCommitment() eip4844.KZGCommitment {
+	return b.KzgCommitment
+}
+
+// GetKzgProof returns the KZG proof of the blob.
+func (b *BlobSidecar) GetKzgProof() eip4844.KZGProof {
+	return b.KzgProof
+}
+
+// GetBeaconBlockHeader returns the header of the block of the blob.
+func (b *BlobSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
+	return b.BeaconBlockHeader
+}
+
+// GetInclusionProof returns the inclusion proof of the blob in the beacon
+// block body.
+func (b *BlobSidecar) GetInclusionProof() []common.Root {
+	return b.InclusionProof
+}
+
 // HasValidInclusionProof verifies the inclusion proof of the
 // blob in the beacon body.
 func (b *BlobSidecar) HasValidInclusionProof(
@@ -85,6 +85,11 @@ func (bs *BlobSidecars) Len() int {
 	return len(bs.Sidecars)
 }
 
+// GetSidecars returns the blob sidecars.
+func (bs *BlobSidecars) GetSidecars() []*BlobSidecar {
+	return bs.Sidecars
+}
+
 // DefineSSZ defines the SSZ encoding for the BlobSidecars object.
 func (bs *BlobSidecars) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &bs.Sidecars, 6)
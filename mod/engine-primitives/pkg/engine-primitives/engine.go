@@ -26,6 +26,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // ClientVersionV1 contains information which identifies a client
@@ -104,3 +105,25 @@ type PayloadStatusV1 struct {
 
 // PayloadID is an identifier for the payload build process.
 type PayloadID = bytes.B8
+
+// ExecutionPayloadBodyV1 as per the EngineAPI Specification:
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/shanghai.md#executionpayloadbodyv1
+//
+//nolint:lll // link.
+type ExecutionPayloadBodyV1 struct {
+	// Transactions are the encoded transactions of the payload.
+	Transactions []bytes.Bytes `json:"transactions"`
+	// Withdrawals are the withdrawals of the payload.
+	Withdrawals []*Withdrawal `json:"withdrawals"`
+}
+
+// SyncProgress is the progress of an execution client syncing its chain, as
+// reported by eth_syncing.
+type SyncProgress struct {
+	// StartingBlock is the number of the block the sync started at.
+	StartingBlock math.U64
+	// CurrentBlock is the number of the latest block that was synced.
+	CurrentBlock math.U64
+	// HighestBlock is the number of the highest block known to the client.
+	HighestBlock math.U64
+}
@@ -52,4 +52,8 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrInvalidExecutionRequest indicates that an EIP-7685 encoded
+	// execution request is malformed.
+	ErrInvalidExecutionRequest = errors.New("invalid execution request")
 )
@@ -0,0 +1,640 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// DepositRequestType is the EIP-7685 request type of an EIP-6110
+	// deposit request.
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the EIP-7685 request type of an EIP-7002
+	// execution layer triggered withdrawal request.
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the EIP-7685 request type of an EIP-7251
+	// consolidation request.
+	ConsolidationRequestType byte = 0x02
+
+	// DepositRequestSize is the size of the DepositRequest in bytes.
+	DepositRequestSize = 192
+	// WithdrawalRequestSize is the size of the WithdrawalRequest in bytes.
+	WithdrawalRequestSize = 76
+	// ConsolidationRequestSize is the size of the ConsolidationRequest in
+	// bytes.
+	ConsolidationRequestSize = 116
+	// PendingConsolidationSize is the size of the PendingConsolidation in
+	// bytes.
+	PendingConsolidationSize = 16
+
+	// MaxDepositRequestsPerPayload is the maximum number of deposit requests
+	// in a single execution payload.
+	MaxDepositRequestsPerPayload = 8192
+	// MaxWithdrawalRequestsPerPayload is the maximum number of withdrawal
+	// requests in a single execution payload.
+	MaxWithdrawalRequestsPerPayload = 16
+	// MaxConsolidationRequestsPerPayload is the maximum number of
+	// consolidation requests in a single execution payload.
+	MaxConsolidationRequestsPerPayload = 2
+
+	// FullExitRequestAmount is the amount of a withdrawal request that asks
+	// for the full exit of the validator.
+	FullExitRequestAmount math.Gwei = 0
+)
+
+var (
+	_ ssz.StaticObject                    = (*DepositRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*DepositRequest)(nil)
+	_ ssz.StaticObject                    = (*WithdrawalRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*WithdrawalRequest)(nil)
+	_ ssz.StaticObject                    = (*ConsolidationRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*ConsolidationRequest)(nil)
+	_ ssz.StaticObject                    = (*PendingConsolidation)(nil)
+	_ constraints.SSZMarshallableRootable = (*PendingConsolidation)(nil)
+	_ ssz.DynamicObject                   = (*ExecutionRequests)(nil)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                               DepositRequest                               */
+/* -------------------------------------------------------------------------- */
+
+// DepositRequest is an EIP-6110 deposit processed in-protocol from the
+// execution layer.
+type DepositRequest struct {
+	// Pubkey is the BLS public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// Credentials is the withdrawal credentials of the validator.
+	Credentials common.Bytes32 `json:"withdrawalCredentials"`
+	// Amount is the amount of Gwei deposited.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature over the deposit message.
+	Signature crypto.BLSSignature `json:"signature"`
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+}
+
+// SizeSSZ returns the size of the DepositRequest in bytes when SSZ encoded.
+func (*DepositRequest) SizeSSZ() uint32 {
+	return DepositRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding of the DepositRequest.
+func (d *DepositRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+	ssz.DefineUint64(c, &d.Index)
+}
+
+// SSZSchema returns the SSZ schema of the DepositRequest.
+func (*DepositRequest) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("withdrawal_credentials", schema.B32()),
+		schema.NewField("amount", schema.U64()),
+		schema.NewField("signature", schema.B96()),
+		schema.NewField("index", schema.U64()),
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DepositRequest.
+func (d *DepositRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher.
+func (d *DepositRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(d.Pubkey[:])
+	hh.PutBytes(d.Credentials[:])
+	hh.PutUint64(uint64(d.Amount))
+	hh.PutBytes(d.Signature[:])
+	hh.PutUint64(uint64(d.Index))
+	hh.Merkleize(indx)
+	return nil
+}
+
+// MarshalSSZ marshals the DepositRequest object to SSZ format.
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to a DepositRequest object.
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              WithdrawalRequest                             */
+/* -------------------------------------------------------------------------- */
+
+// WithdrawalRequest is an EIP-7002 withdrawal or exit triggered from the
+// execution layer by the withdrawal address of a validator.
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validatorPubkey"`
+	// Amount is the amount of Gwei to withdraw, zero for a full exit.
+	Amount math.Gwei `json:"amount"`
+}
+
+// SizeSSZ returns the size of the WithdrawalRequest in bytes when SSZ
+// encoded.
+func (*WithdrawalRequest) SizeSSZ() uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding of the WithdrawalRequest.
+func (w *WithdrawalRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &w.SourceAddress)
+	ssz.DefineStaticBytes(c, &w.ValidatorPubkey)
+	ssz.DefineUint64(c, &w.Amount)
+}
+
+// SSZSchema returns the SSZ schema of the WithdrawalRequest.
+func (*WithdrawalRequest) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("source_address", schema.B20()),
+		schema.NewField("validator_pubkey", schema.B48()),
+		schema.NewField("amount", schema.U64()),
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the WithdrawalRequest.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher.
+func (w *WithdrawalRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(w.SourceAddress[:])
+	hh.PutBytes(w.ValidatorPubkey[:])
+	hh.PutUint64(uint64(w.Amount))
+	hh.Merkleize(indx)
+	return nil
+}
+
+// MarshalSSZ marshals the WithdrawalRequest object to SSZ format.
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, w.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to a WithdrawalRequest
+// object.
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+// IsFullExit returns true if the request asks for the full exit of the
+// validator.
+func (w *WithdrawalRequest) IsFullExit() bool {
+	return w.Amount == FullExitRequestAmount
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            ConsolidationRequest                            */
+/* -------------------------------------------------------------------------- */
+
+// ConsolidationRequest is an EIP-7251 request to consolidate the balance of
+// the source validator into the target validator.
+type ConsolidationRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"`
+	// SourcePubkey is the public key of the validator to consolidate from.
+	SourcePubkey crypto.BLSPubkey `json:"sourcePubkey"`
+	// TargetPubkey is the public key of the validator to consolidate into.
+	TargetPubkey crypto.BLSPubkey `json:"targetPubkey"`
+}
+
+// SizeSSZ returns the size of the ConsolidationRequest in bytes when SSZ
+// encoded.
+func (*ConsolidationRequest) SizeSSZ() uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding of the ConsolidationRequest.
+func (r *ConsolidationRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &r.SourceAddress)
+	ssz.DefineStaticBytes(c, &r.SourcePubkey)
+	ssz.DefineStaticBytes(c, &r.TargetPubkey)
+}
+
+// SSZSchema returns the SSZ schema of the ConsolidationRequest.
+func (*ConsolidationRequest) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("source_address", schema.B20()),
+		schema.NewField("source_pubkey", schema.B48()),
+		schema.NewField("target_pubkey", schema.B48()),
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ConsolidationRequest.
+func (r *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest object with a hasher.
+func (r *ConsolidationRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(r.SourceAddress[:])
+	hh.PutBytes(r.SourcePubkey[:])
+	hh.PutBytes(r.TargetPubkey[:])
+	hh.Merkleize(indx)
+	return nil
+}
+
+// MarshalSSZ marshals the ConsolidationRequest object to SSZ format.
+func (r *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, r.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to a ConsolidationRequest
+// object.
+func (r *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            PendingConsolidation                            */
+/* -------------------------------------------------------------------------- */
+
+// PendingConsolidation is an EIP-7251 consolidation accepted into the state,
+// whose source balance is moved to the target once the source validator is
+// withdrawable.
+type PendingConsolidation struct {
+	// SourceIndex is the index of the validator to consolidate from.
+	SourceIndex math.ValidatorIndex `json:"sourceIndex"`
+	// TargetIndex is the index of the validator to consolidate into.
+	TargetIndex math.ValidatorIndex `json:"targetIndex"`
+}
+
+// New creates a new PendingConsolidation.
+func (*PendingConsolidation) New(
+	sourceIndex, targetIndex math.ValidatorIndex,
+) *PendingConsolidation {
+	return &PendingConsolidation{
+		SourceIndex: sourceIndex,
+		TargetIndex: targetIndex,
+	}
+}
+
+// Empty returns an empty PendingConsolidation.
+func (*PendingConsolidation) Empty() *PendingConsolidation {
+	return &PendingConsolidation{}
+}
+
+// GetSourceIndex returns the index of the validator to consolidate from.
+func (p *PendingConsolidation) GetSourceIndex() math.ValidatorIndex {
+	return p.SourceIndex
+}
+
+// GetTargetIndex returns the index of the validator to consolidate into.
+func (p *PendingConsolidation) GetTargetIndex() math.ValidatorIndex {
+	return p.TargetIndex
+}
+
+// SizeSSZ returns the size of the PendingConsolidation in bytes when SSZ
+// encoded.
+func (*PendingConsolidation) SizeSSZ() uint32 {
+	return PendingConsolidationSize
+}
+
+// DefineSSZ defines the SSZ encoding of the PendingConsolidation.
+func (p *PendingConsolidation) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineUint64(c, &p.SourceIndex)
+	ssz.DefineUint64(c, &p.TargetIndex)
+}
+
+// SSZSchema returns the SSZ schema of the PendingConsolidation.
+func (*PendingConsolidation) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("source_index", schema.U64()),
+		schema.NewField("target_index", schema.U64()),
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the PendingConsolidation.
+func (p *PendingConsolidation) HashTreeRoot() common.Root {
+	return ssz.HashSequential(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingConsolidation object with a hasher.
+func (p *PendingConsolidation) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(uint64(p.SourceIndex))
+	hh.PutUint64(uint64(p.TargetIndex))
+	hh.Merkleize(indx)
+	return nil
+}
+
+// MarshalSSZ marshals the PendingConsolidation object to SSZ format.
+func (p *PendingConsolidation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to a PendingConsolidation
+// object.
+func (p *PendingConsolidation) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              ExecutionRequests                             */
+/* -------------------------------------------------------------------------- */
+
+// ExecutionRequests holds the requests emitted by the execution layer in a
+// payload, as introduced in Electra.
+type ExecutionRequests struct {
+	// Deposits are the EIP-6110 deposit requests.
+	Deposits []*DepositRequest `json:"deposits"`
+	// Withdrawals are the EIP-7002 withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"`
+	// Consolidations are the EIP-7251 consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// SizeSSZ returns the size of the ExecutionRequests in bytes when SSZ
+// encoded.
+func (e *ExecutionRequests) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(e.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(e.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(e.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding of the ExecutionRequests.
+func (e *ExecutionRequests) DefineSSZ(c *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		c, &e.Deposits, MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		c, &e.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		c, &e.Consolidations, MaxConsolidationRequestsPerPayload,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		c, &e.Deposits, MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		c, &e.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		c, &e.Consolidations, MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// SSZSchema returns the SSZ schema of the ExecutionRequests.
+func (*ExecutionRequests) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField(
+			"deposits",
+			schema.DefineList(
+				(*DepositRequest)(nil).SSZSchema(),
+				MaxDepositRequestsPerPayload,
+			),
+		),
+		schema.NewField(
+			"withdrawals",
+			schema.DefineList(
+				(*WithdrawalRequest)(nil).SSZSchema(),
+				MaxWithdrawalRequestsPerPayload,
+			),
+		),
+		schema.NewField(
+			"consolidations",
+			schema.DefineList(
+				(*ConsolidationRequest)(nil).SSZSchema(),
+				MaxConsolidationRequestsPerPayload,
+			),
+		),
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ExecutionRequests.
+func (e *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher.
+func (e *ExecutionRequests) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	if err := hashRequestsWith(
+		hh, e.Deposits, MaxDepositRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+	if err := hashRequestsWith(
+		hh, e.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+	if err := hashRequestsWith(
+		hh, e.Consolidations, MaxConsolidationRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+	hh.Merkleize(indx)
+	return nil
+}
+
+// MarshalSSZ marshals the ExecutionRequests object to SSZ format.
+func (e *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to an ExecutionRequests
+// object.
+func (e *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// Encode returns the EIP-7685 encoding of the requests as sent over
+// engine_newPayloadV4: one entry per non-empty request type, in ascending
+// type order, each being the type byte followed by the SSZ encoded list.
+func (e *ExecutionRequests) Encode() ([]bytes.Bytes, error) {
+	if e == nil {
+		return []bytes.Bytes{}, nil
+	}
+
+	var (
+		requests = make([]bytes.Bytes, 0)
+		err      error
+	)
+	if requests, err = appendRequests(
+		requests, DepositRequestType, e.Deposits,
+	); err != nil {
+		return nil, err
+	}
+	if requests, err = appendRequests(
+		requests, WithdrawalRequestType, e.Withdrawals,
+	); err != nil {
+		return nil, err
+	}
+	return appendRequests(
+		requests, ConsolidationRequestType, e.Consolidations,
+	)
+}
+
+// DecodeExecutionRequests decodes the EIP-7685 encoding of the requests as
+// returned by engine_getPayloadV4.
+func DecodeExecutionRequests(
+	requests []bytes.Bytes,
+) (*ExecutionRequests, error) {
+	var (
+		e        = &ExecutionRequests{}
+		lastType = -1
+		err      error
+	)
+	for _, request := range requests {
+		if len(request) < 2 {
+			return nil, ErrInvalidExecutionRequest
+		}
+		if int(request[0]) <= lastType {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequest,
+				"request type %d is out of order", request[0],
+			)
+		}
+		lastType = int(request[0])
+
+		switch request[0] {
+		case DepositRequestType:
+			e.Deposits, err = decodeRequests[DepositRequest](
+				request[1:], DepositRequestSize,
+				MaxDepositRequestsPerPayload,
+			)
+		case WithdrawalRequestType:
+			e.Withdrawals, err = decodeRequests[WithdrawalRequest](
+				request[1:], WithdrawalRequestSize,
+				MaxWithdrawalRequestsPerPayload,
+			)
+		case ConsolidationRequestType:
+			e.Consolidations, err = decodeRequests[ConsolidationRequest](
+				request[1:], ConsolidationRequestSize,
+				MaxConsolidationRequestsPerPayload,
+			)
+		default:
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequest,
+				"unknown request type %d", request[0],
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// appendRequests appends the type prefixed SSZ encoding of the given
+// requests, skipping empty lists as required by EIP-7685.
+func appendRequests[RequestT constraints.SSZMarshaler](
+	requests []bytes.Bytes,
+	requestType byte,
+	list []RequestT,
+) ([]bytes.Bytes, error) {
+	if len(list) == 0 {
+		return requests, nil
+	}
+	bz := []byte{requestType}
+	for _, r := range list {
+		enc, err := r.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		bz = append(bz, enc...)
+	}
+	return append(requests, bz), nil
+}
+
+// decodeRequests decodes a concatenated list of fixed size requests.
+func decodeRequests[
+	RequestT any,
+	RequestPtrT interface {
+		*RequestT
+		constraints.SSZUnmarshaler
+	},
+](bz []byte, size, limit int) ([]*RequestT, error) {
+	if len(bz)%size != 0 || len(bz)/size > limit {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequest,
+			"invalid request list of %d bytes", len(bz),
+		)
+	}
+	list := make([]*RequestT, 0, len(bz)/size)
+	for i := 0; i < len(bz); i += size {
+		r := RequestPtrT(new(RequestT))
+		if err := r.UnmarshalSSZ(bz[i : i+size]); err != nil {
+			return nil, err
+		}
+		list = append(list, r)
+	}
+	return list, nil
+}
+
+// hashRequestsWith ssz hashes the list of requests with a hasher.
+func hashRequestsWith[
+	RequestT interface {
+		HashTreeRootWith(hh fastssz.HashWalker) error
+	},
+](
+	hh fastssz.HashWalker,
+	requests []RequestT,
+	limit uint64,
+) error {
+	subIndx := hh.Index()
+	num := uint64(len(requests))
+	if num > limit {
+		return fastssz.ErrIncorrectListSize
+	}
+	for _, request := range requests {
+		if err := request.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, num, limit)
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRequestsSSZ(t *testing.T) {
+	requests := &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{Pubkey: [48]byte{1}, Amount: math.Gwei(32e9), Index: 7},
+		},
+		Withdrawals: []*engineprimitives.WithdrawalRequest{
+			{SourceAddress: [20]byte{2}, ValidatorPubkey: [48]byte{1}},
+		},
+		Consolidations: []*engineprimitives.ConsolidationRequest{},
+	}
+
+	data, err := requests.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(
+		t, data, 12+
+			engineprimitives.DepositRequestSize+
+			engineprimitives.WithdrawalRequestSize,
+	)
+
+	decoded := new(engineprimitives.ExecutionRequests)
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, requests.Deposits, decoded.Deposits)
+	require.Equal(t, requests.Withdrawals, decoded.Withdrawals)
+	require.Empty(t, decoded.Consolidations)
+	require.Equal(t, requests.HashTreeRoot(), decoded.HashTreeRoot())
+
+	// The FastSSZ hasher, used to build the proof trees, must agree.
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	require.NoError(t, requests.HashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(requests.HashTreeRoot()), root)
+}
+
+func TestExecutionRequestsEncode(t *testing.T) {
+	requests := &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{Pubkey: [48]byte{1}, Amount: math.Gwei(32e9), Index: 7},
+			{Pubkey: [48]byte{2}, Amount: math.Gwei(1e9), Index: 8},
+		},
+		Consolidations: []*engineprimitives.ConsolidationRequest{
+			{SourcePubkey: [48]byte{3}, TargetPubkey: [48]byte{4}},
+		},
+	}
+
+	encoded, err := requests.Encode()
+	require.NoError(t, err)
+	require.Len(t, encoded, 2)
+	require.Equal(t, engineprimitives.DepositRequestType, encoded[0][0])
+	require.Len(t, encoded[0], 1+2*engineprimitives.DepositRequestSize)
+	require.Equal(
+		t, engineprimitives.ConsolidationRequestType, encoded[1][0],
+	)
+
+	decoded, err := engineprimitives.DecodeExecutionRequests(encoded)
+	require.NoError(t, err)
+	require.Equal(t, requests.Deposits, decoded.Deposits)
+	require.Empty(t, decoded.Withdrawals)
+	require.Equal(t, requests.Consolidations, decoded.Consolidations)
+
+	encoded, err = (*engineprimitives.ExecutionRequests)(nil).Encode()
+	require.NoError(t, err)
+	require.NotNil(t, encoded)
+	require.Empty(t, encoded)
+}
+
+func TestDecodeExecutionRequestsInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		requests []bytes.Bytes
+	}{
+		{
+			name:     "empty request",
+			requests: []bytes.Bytes{{0x00}},
+		},
+		{
+			name:     "unknown type",
+			requests: []bytes.Bytes{{0x03, 0x01}},
+		},
+		{
+			name: "out of order",
+			requests: []bytes.Bytes{
+				append(
+					[]byte{engineprimitives.WithdrawalRequestType},
+					make([]byte, engineprimitives.WithdrawalRequestSize)...,
+				),
+				append(
+					[]byte{engineprimitives.DepositRequestType},
+					make([]byte, engineprimitives.DepositRequestSize)...,
+				),
+			},
+		},
+		{
+			name: "truncated list",
+			requests: []bytes.Bytes{
+				append(
+					[]byte{engineprimitives.DepositRequestType},
+					make([]byte, engineprimitives.DepositRequestSize-1)...,
+				),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := engineprimitives.DecodeExecutionRequests(tt.requests)
+			require.ErrorIs(
+				t, err, engineprimitives.ErrInvalidExecutionRequest,
+			)
+		})
+	}
+}
+
+func TestWithdrawalRequestIsFullExit(t *testing.T) {
+	require.True(t, (&engineprimitives.WithdrawalRequest{}).IsFullExit())
+	require.False(
+		t, (&engineprimitives.WithdrawalRequest{Amount: 1}).IsFullExit(),
+	)
+}
+
+func TestPendingConsolidationSSZ(t *testing.T) {
+	pending := (&engineprimitives.PendingConsolidation{}).New(3, 7)
+	require.Equal(t, math.ValidatorIndex(3), pending.GetSourceIndex())
+	require.Equal(t, math.ValidatorIndex(7), pending.GetTargetIndex())
+
+	data, err := pending.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, engineprimitives.PendingConsolidationSize)
+
+	decoded := pending.Empty()
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, pending, decoded)
+
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	require.NoError(t, pending.HashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(pending.HashTreeRoot()), root)
+}
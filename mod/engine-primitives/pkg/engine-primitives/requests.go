@@ -69,9 +69,14 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests is the EIP-7685 encoding of the execution requests
+	// of the payload. It is nil before Electra.
+	ExecutionRequests []bytes.Bytes
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
+	// ForkVersion is the fork version of the slot of the payload.
+	ForkVersion uint32
 }
 
 // BuildNewPayloadRequest builds a new payload request.
@@ -112,12 +117,14 @@ func BuildNewPayloadRequest[
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
 	optimistic bool,
+	forkVersion uint32,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
 		Optimistic:            optimistic,
+		ForkVersion:           forkVersion,
 	}
 }
 
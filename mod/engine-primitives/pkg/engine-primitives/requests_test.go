@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -108,6 +109,7 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 		versionedHashes,
 		&parentBeaconBlockRoot,
 		optimistic,
+		version.Deneb,
 	)
 
 	require.NotNil(t, request)
@@ -115,6 +117,7 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	require.Equal(t, versionedHashes, request.VersionedHashes)
 	require.Equal(t, &parentBeaconBlockRoot, request.ParentBeaconBlockRoot)
 	require.Equal(t, optimistic, request.Optimistic)
+	require.Equal(t, uint32(version.Deneb), request.ForkVersion)
 }
 
 func TestBuildForkchoiceUpdateRequest(t *testing.T) {
@@ -156,6 +159,7 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		versionedHashes,
 		&parentBeaconBlockRoot,
 		optimistic,
+		version.Deneb,
 	)
 
 	err := request.HasValidVersionedAndBlockHashes()
@@ -175,6 +179,7 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		versionedHashes,
 		&parentBeaconBlockRoot,
 		optimistic,
+		version.Deneb,
 	)
 
 	err := request.HasValidVersionedAndBlockHashes()
@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -87,6 +88,16 @@ func (w *Withdrawal) DefineSSZ(c *ssz.Codec) {
 	ssz.DefineUint64(c, &w.Amount)       // Field  (3) -    Amount -  8 bytes
 }
 
+// SSZSchema returns the SSZ schema of the Withdrawal.
+func (*Withdrawal) SSZSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("index", schema.U64()),
+		schema.NewField("validator_index", schema.U64()),
+		schema.NewField("address", schema.B20()),
+		schema.NewField("amount", schema.U64()),
+	)
+}
+
 // HashTreeRoot.
 func (w *Withdrawal) HashTreeRoot() common.Root {
 	return ssz.HashSequential(w)
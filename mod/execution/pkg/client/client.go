@@ -25,6 +25,7 @@ import (
 	"math/big"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -59,6 +60,9 @@ type EngineClient[
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
+	// healthy is whether the connection to the execution client is
+	// initialized.
+	healthy atomic.Bool
 }
 
 // New creates a new engine client EngineClient.
@@ -95,6 +99,14 @@ func (s *EngineClient[
 	return "engine-client"
 }
 
+// IsHealthy returns whether the engine client is connected to its execution
+// client.
+func (s *EngineClient[
+	_, _,
+]) IsHealthy() bool {
+	return s.healthy.Load()
+}
+
 // Start the engine client.
 func (s *EngineClient[
 	_, _,
@@ -212,6 +224,7 @@ func (s *EngineClient[
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+	s.healthy.Store(true)
 	return nil
 }
 
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+// EngineClients is a set of engine clients that are started together, e.g.
+// those of the execution clients following the primary one.
+type EngineClients[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT PayloadAttributes,
+] []*EngineClient[ExecutionPayloadT, PayloadAttributesT]
+
+// Name returns the name of the engine clients.
+func (c EngineClients[_, _]) Name() string {
+	return "engine-clients"
+}
+
+// Start connects each of the engine clients to its execution client in the
+// background, so that an unreachable execution client does not hold up the
+// node. A client is unhealthy until it is connected.
+func (c EngineClients[_, _]) Start(ctx context.Context) error {
+	for _, ec := range c {
+		go func() {
+			if err := ec.Start(ctx); err != nil &&
+				!errors.Is(err, context.Canceled) {
+				ec.logger.Error(
+					"Failed to connect to the execution client",
+					"dial_url", ec.cfg.RPCDialURL.String(),
+					"error", err,
+				)
+			}
+		}()
+	}
+	return nil
+}
@@ -67,4 +67,9 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// FollowerRPCDialURLs are the URLs of the JSON-RPC endpoints of other
+	// execution clients, which are kept in sync with the execution client
+	// and on which payloads are also built. They share the JWT secret and
+	// the settings of the execution client.
+	FollowerRPCDialURLs []*url.ConnectionURL `mapstructure:"follower-rpc-dial-urls"`
 }
@@ -28,7 +28,9 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -44,6 +46,8 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
@@ -52,9 +56,10 @@ func (s *EngineClient[
 	defer s.metrics.measureNewPayloadDuration(startTime)
 	defer cancel()
 
-	// Call the appropriate RPC method based on the payload version.
+	// Call the appropriate RPC method based on the fork version.
 	result, err := s.Eth1Client.NewPayload(
 		cctx, payload, versionedHashes, parentBeaconBlockRoot,
+		executionRequests, forkVersion,
 	)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -197,3 +202,73 @@ func (s *EngineClient[
 
 	return result, nil
 }
+
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// MaxPayloadBodiesPerRequest is the maximum number of payload bodies that
+// execution clients are required to serve per request.
+const MaxPayloadBodiesPerRequest = 1024
+
+// GetPayloadBodiesByHash calls the engine_getPayloadBodiesByHashV1 method via
+// JSON-RPC. It returns a body for each of the given block hashes, which is
+// nil if the execution client does not have the payload.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByHash(
+	ctx context.Context,
+	blockHashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	if len(blockHashes) > MaxPayloadBodiesPerRequest {
+		return nil, ErrTooManyPayloadBodies
+	}
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Eth1Client.GetPayloadBodiesByHashV1(cctx, blockHashes)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	if len(result) != len(blockHashes) {
+		return nil, errors.Wrapf(
+			ErrUnexpectedPayloadBodies,
+			"requested %d, got %d", len(blockHashes), len(result),
+		)
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRange calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC. It returns the bodies of the count canonical payloads from the
+// start block number, a body being nil if the execution client does not have
+// the payload. The result is truncated at the latest canonical payload.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start math.U64,
+	count uint64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	if count > MaxPayloadBodiesPerRequest {
+		return nil, ErrTooManyPayloadBodies
+	}
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Eth1Client.GetPayloadBodiesByRangeV1(
+		cctx, start, math.U64(count),
+	)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	if uint64(len(result)) > count {
+		return nil, errors.Wrapf(
+			ErrUnexpectedPayloadBodies,
+			"requested %d, got %d", count, len(result),
+		)
+	}
+	return result, nil
+}
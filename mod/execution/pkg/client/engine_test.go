@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bodiesByHashMethod  = "engine_getPayloadBodiesByHashV1"
+	bodiesByRangeMethod = "engine_getPayloadBodiesByRangeV1"
+	body                = `{"transactions":["0x01"],"withdrawals":[]}`
+)
+
+func TestGetPayloadBodiesByHash(t *testing.T) {
+	el := newMockEL(t)
+	ec := newEngineClient(t, el)
+	hashes := []common.ExecutionHash{{1}, {2}}
+
+	// The body of the unknown payload is nil.
+	el.setResult(bodiesByHashMethod, `[`+body+`,null]`)
+	bodies, err := ec.GetPayloadBodiesByHash(context.Background(), hashes)
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+	require.Equal(t, []bytes.Bytes{{1}}, bodies[0].Transactions)
+	require.Nil(t, bodies[1])
+	require.JSONEq(t,
+		`[["`+hashes[0].Hex()+`","`+hashes[1].Hex()+`"]]`,
+		el.lastParams(bodiesByHashMethod),
+	)
+
+	// A body is required for each of the hashes.
+	el.setResult(bodiesByHashMethod, `[`+body+`]`)
+	_, err = ec.GetPayloadBodiesByHash(context.Background(), hashes)
+	require.ErrorIs(t, err, client.ErrUnexpectedPayloadBodies)
+
+	_, err = ec.GetPayloadBodiesByHash(
+		context.Background(),
+		make([]common.ExecutionHash, client.MaxPayloadBodiesPerRequest+1),
+	)
+	require.ErrorIs(t, err, client.ErrTooManyPayloadBodies)
+	require.Equal(t, 2, el.calls(bodiesByHashMethod))
+}
+
+func TestGetPayloadBodiesByRange(t *testing.T) {
+	el := newMockEL(t)
+	ec := newEngineClient(t, el)
+
+	// The bodies are truncated at the latest payload.
+	el.setResult(bodiesByRangeMethod, `[`+body+`,null]`)
+	bodies, err := ec.GetPayloadBodiesByRange(context.Background(), 5, 3)
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+	require.NotNil(t, bodies[0])
+	require.Nil(t, bodies[1])
+	require.JSONEq(t, `["0x5","0x3"]`, el.lastParams(bodiesByRangeMethod))
+
+	// No more bodies than requested are accepted.
+	el.setResult(bodiesByRangeMethod, `[`+body+`,`+body+`]`)
+	_, err = ec.GetPayloadBodiesByRange(context.Background(), 5, 1)
+	require.ErrorIs(t, err, client.ErrUnexpectedPayloadBodies)
+
+	_, err = ec.GetPayloadBodiesByRange(
+		context.Background(), 5, client.MaxPayloadBodiesPerRequest+1,
+	)
+	require.ErrorIs(t, err, client.ErrTooManyPayloadBodies)
+	require.Equal(t, 2, el.calls(bodiesByRangeMethod))
+
+	// The errors of the execution client are mapped as for other methods.
+	el.setResult(bodiesByRangeMethod, "")
+	_, err = ec.GetPayloadBodiesByRange(context.Background(), 5, 1)
+	require.ErrorIs(t, err, jsonrpc.ErrMethodNotFound)
+}
+
+func newEngineClient(
+	t *testing.T,
+	el *mockEL,
+) *client.EngineClient[*testPayload, *testAttributes] {
+	t.Helper()
+	dialURL, err := url.NewFromRaw(el.URL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+
+	ec := client.New[*testPayload, *testAttributes](
+		&cfg, noop.NewLogger[any](), nil, noopSink{}, big.NewInt(1),
+	)
+	require.NoError(t, ec.Start(context.Background()))
+	return ec
+}
+
+// mockEL is an execution client serving JSON-RPC, which answers each method
+// with the result set for it and records the parameters of its calls.
+type mockEL struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	results map[string]string
+	params  map[string][]string
+}
+
+func newMockEL(t *testing.T) *mockEL {
+	t.Helper()
+	el := &mockEL{
+		results: map[string]string{
+			"eth_chainId":                 `"0x1"`,
+			"engine_exchangeCapabilities": `[]`,
+		},
+		params: make(map[string][]string),
+	}
+	el.Server = httptest.NewServer(http.HandlerFunc(el.serve))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func (el *mockEL) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	el.mu.Lock()
+	el.params[req.Method] = append(el.params[req.Method], string(req.Params))
+	result, ok := el.results[req.Method]
+	el.mu.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if ok {
+		resp["result"] = json.RawMessage(result)
+	} else {
+		resp["error"] = map[string]any{
+			"code": -32601, "message": "method not found",
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// setResult sets the JSON result of the method, or removes the method if
+// empty.
+func (el *mockEL) setResult(method, result string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	if result == "" {
+		delete(el.results, method)
+		return
+	}
+	el.results[method] = result
+}
+
+func (el *mockEL) calls(method string) int {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return len(el.params[method])
+}
+
+func (el *mockEL) lastParams(method string) string {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	params := el.params[method]
+	if len(params) == 0 {
+		return ""
+	}
+	return params[len(params)-1]
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+type testAttributes struct{}
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (*testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type testPayload struct{}
+
+func (*testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (*testPayload) Version() uint32 { return 0 }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (*testPayload) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+
+func (*testPayload) UnmarshalJSON([]byte) error { return nil }
@@ -54,6 +54,14 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrTooManyPayloadBodies is returned when more payload bodies are
+	// requested than the execution client is required to serve.
+	ErrTooManyPayloadBodies = errors.New("too many payload bodies requested")
+
+	// ErrUnexpectedPayloadBodies is returned when the execution client
+	// returns more payload bodies than requested.
+	ErrUnexpectedPayloadBodies = errors.New("unexpected payload bodies")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -77,3 +77,14 @@ func (s *Eth1Client[ExecutionPayloadT]) ExecutionBlockByNumber(
 		ctx, result, BlockByNumberMethod, num, withTxs)
 	return result, err
 }
+
+// ExecutionReceiptsByHash fetches the receipts of an execution engine block
+// by hash by calling eth_getBlockReceipts via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) ExecutionReceiptsByHash(
+	ctx context.Context, hash common.ExecutionHash,
+) ([]*gethprimitives.Receipt, error) {
+	var result []*gethprimitives.Receipt
+	err := s.Client.Client().CallContext(
+		ctx, &result, BlockReceiptsMethod, hash)
+	return result, err
+}
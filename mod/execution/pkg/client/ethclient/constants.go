@@ -25,8 +25,12 @@ package ethclient
 func BeaconKitSupportedCapabilities() []string {
 	return []string{
 		NewPayloadMethodV3,
+		NewPayloadMethodV4,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		GetPayloadMethodV4,
+		GetPayloadBodiesByHashMethodV1,
+		GetPayloadBodiesByRangeMethodV1,
 		GetClientVersionV1,
 	}
 }
@@ -35,14 +39,26 @@ func BeaconKitSupportedCapabilities() []string {
 const (
 	// NewPayloadMethodV3 for creating a new payload in Deneb.
 	NewPayloadMethodV3 = "engine_newPayloadV3"
+	// NewPayloadMethodV4 for creating a new payload in Electra.
+	NewPayloadMethodV4 = "engine_newPayloadV4"
 	// ForkchoiceUpdatedMethodV3 for updating fork choice in Deneb.
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// GetPayloadMethodV4 for retrieving a payload in Electra.
+	GetPayloadMethodV4 = "engine_getPayloadV4"
+	// GetPayloadBodiesByHashMethodV1 for retrieving the bodies of payloads by
+	// their block hashes.
+	GetPayloadBodiesByHashMethodV1 = "engine_getPayloadBodiesByHashV1"
+	// GetPayloadBodiesByRangeMethodV1 for retrieving the bodies of a range of
+	// canonical payloads by their block numbers.
+	GetPayloadBodiesByRangeMethodV1 = "engine_getPayloadBodiesByRangeV1"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
 	BlockByNumberMethod = "eth_getBlockByNumber"
+	// BlockReceiptsMethod for retrieving the receipts of a block.
+	BlockReceiptsMethod = "eth_getBlockReceipts"
 	// ExchangeCapabilities for exchanging capabilities with the peer.
 	ExchangeCapabilities = "engine_exchangeCapabilities"
 	// GetClientVersionV1 for retrieving the capabilities of the peer.
@@ -24,8 +24,10 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -33,18 +35,30 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadV3 method via JSON-RPC.
+// NewPayload calls the engine_newPayloadV3 method via JSON-RPC before
+// Electra, and engine_newPayloadV4 with the execution requests from Electra.
 func (s *Eth1Client[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*engineprimitives.PayloadStatusV1, error) {
-	switch payload.Version() {
+	switch forkVersion {
 	case version.Deneb, version.DenebPlus:
 		return s.NewPayloadV3(
 			ctx, payload, versionedHashes, parentBlockRoot,
 		)
+	case version.Electra:
+		// An Electra payload without requests still sends an empty list.
+		if executionRequests == nil {
+			executionRequests = make([]bytes.Bytes, 0)
+		}
+		return s.NewPayloadV4(
+			ctx, payload, versionedHashes, parentBlockRoot,
+			executionRequests,
+		)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -67,6 +81,25 @@ func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 is used to call the underlying JSON-RPC method for newPayload
+// with the EIP-7685 encoded execution requests.
+func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+) (*engineprimitives.PayloadStatusV1, error) {
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.Client.Client().CallContext(
+		ctx, result, NewPayloadMethodV4, payload, versionedHashes,
+		(*common.ExecutionHash)(parentBlockRoot), executionRequests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
@@ -80,7 +113,7 @@ func (s *Eth1Client[ExecutionPayloadT]) ForkchoiceUpdated(
 	forkVersion uint32,
 ) (*engineprimitives.ForkchoiceResponseV1, error) {
 	switch forkVersion {
-	case version.Deneb, version.DenebPlus:
+	case version.Deneb, version.DenebPlus, version.Electra:
 		return s.ForkchoiceUpdatedV3(ctx, state, attrs)
 	default:
 		return nil, ErrInvalidVersion
@@ -133,6 +166,8 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayload(
 	switch forkVersion {
 	case version.Deneb, version.DenebPlus:
 		return s.GetPayloadV3(ctx, payloadID)
+	case version.Electra:
+		return s.GetPayloadV4(ctx, payloadID)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -160,6 +195,69 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var t ExecutionPayloadT
+	result := &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: t.Empty(version.Electra),
+	}
+
+	if err := s.Client.Client().CallContext(
+		ctx, result, GetPayloadMethodV4, payloadID,
+	); err != nil {
+		return nil, err
+	}
+
+	// The execution requests are mandatory post Electra, an EL returning
+	// none must still return an empty list.
+	if result.ExecutionRequests == nil {
+		result.ExecutionRequests = make([]bytes.Bytes, 0)
+	}
+	return result, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByHashV1 calls the engine_getPayloadBodiesByHashV1 method
+// via JSON-RPC. The body of an unknown payload is nil.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadBodiesByHashV1(
+	ctx context.Context,
+	blockHashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.Client.Client().CallContext(
+		ctx, &result, GetPayloadBodiesByHashMethodV1, blockHashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRangeV1 calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC. The body of an unknown payload is nil, and the result is
+// truncated at the latest canonical payload.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadBodiesByRangeV1(
+	ctx context.Context,
+	start, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.Client.Client().CallContext(
+		ctx, &result, GetPayloadBodiesByRangeMethodV1, start, count,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
@@ -23,6 +23,8 @@ package engine
 import (
 	"bytes"
 	"context"
+	"math/big"
+	"sync"
 
 	broker "github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
@@ -32,6 +34,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 )
@@ -56,6 +59,17 @@ type Engine[
 	metrics *engineMetrics
 	// statusPublisher is the status publishder for the engine.
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]]
+	// mu protects the latest verified block hash.
+	mu sync.RWMutex
+	// latestVerifiedHash is the block hash of the latest payload that was
+	// verified as valid by the execution client.
+	latestVerifiedHash common.ExecutionHash
+	// followers are the engines of the other execution clients, which are
+	// sent the payloads and forkchoice updates of this engine so that they
+	// stay in sync with it.
+	followers []*Engine[
+		ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+	]
 }
 
 // New creates a new Engine.
@@ -100,6 +114,86 @@ func (ee *Engine[_, _, _, _]) Start(
 	return nil
 }
 
+// AttachFollowers sets the engines of the other execution clients that are
+// kept in sync with this engine.
+func (ee *Engine[
+	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+]) AttachFollowers(
+	followers ...*Engine[
+		ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+	],
+) {
+	ee.followers = followers
+}
+
+// Followers returns the engines of the other execution clients that are kept
+// in sync with this engine.
+func (ee *Engine[
+	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+]) Followers() []*Engine[
+	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+] {
+	return ee.followers
+}
+
+// HeadBlockHash returns the hash of the head block of the execution client.
+func (ee *Engine[_, _, _, _]) HeadBlockHash(
+	ctx context.Context,
+) (common.ExecutionHash, error) {
+	header, err := ee.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+	return common.ExecutionHash(header.Hash()), nil
+}
+
+// HeadBlockNumber returns the number of the head block of the execution
+// client.
+func (ee *Engine[_, _, _, _]) HeadBlockNumber(
+	ctx context.Context,
+) (math.U64, error) {
+	header, err := ee.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return math.U64(header.Number.Uint64()), nil
+}
+
+// SyncProgress returns the progress of the execution client syncing its
+// chain, which is nil if it is not syncing.
+func (ee *Engine[_, _, _, _]) SyncProgress(
+	ctx context.Context,
+) (*engineprimitives.SyncProgress, error) {
+	progress, err := ee.ec.SyncProgress(ctx)
+	if err != nil || progress == nil {
+		return nil, err
+	}
+	return &engineprimitives.SyncProgress{
+		StartingBlock: math.U64(progress.StartingBlock),
+		CurrentBlock:  math.U64(progress.CurrentBlock),
+		HighestBlock:  math.U64(progress.HighestBlock),
+	}, nil
+}
+
+// PayloadBodiesByRange returns the bodies of the count canonical payloads
+// from the start block number that the execution client has, a body being nil
+// if it does not have the payload.
+func (ee *Engine[_, _, _, _]) PayloadBodiesByRange(
+	ctx context.Context,
+	start math.U64,
+	count uint64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	return ee.ec.GetPayloadBodiesByRange(ctx, start, count)
+}
+
+// LatestVerifiedBlockHash returns the block hash of the latest payload that
+// was verified as valid by the execution client.
+func (ee *Engine[_, _, _, _]) LatestVerifiedBlockHash() common.ExecutionHash {
+	ee.mu.RLock()
+	defer ee.mu.RUnlock()
+	return ee.latestVerifiedHash
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine[
 	ExecutionPayloadT, _, _, _,
@@ -115,7 +209,7 @@ func (ee *Engine[
 
 // NotifyForkchoiceUpdate notifies the execution client of a forkchoice update.
 func (ee *Engine[
-	_, PayloadAttributesT, _, _,
+	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
 ]) NotifyForkchoiceUpdate(
 	ctx context.Context,
 	req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
@@ -124,6 +218,21 @@ func (ee *Engine[
 	hasPayloadAttributes := !req.PayloadAttributes.IsNil()
 	ee.metrics.markNotifyForkchoiceUpdateCalled(hasPayloadAttributes)
 
+	// Update the forkchoice of the followers alongside. Payloads are built
+	// on each of them by the payload builder, so forkchoice updates with
+	// attributes are not forwarded.
+	if !hasPayloadAttributes {
+		defer ee.notifyFollowers(
+			"forkchoice_updated",
+			func(follower *Engine[
+				ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+			]) error {
+				_, _, err := follower.NotifyForkchoiceUpdate(ctx, req)
+				return err
+			},
+		)()
+	}
+
 	// Notify the execution engine of the forkchoice update.
 	payloadID, latestValidHash, err := ee.ec.ForkchoiceUpdated(
 		ctx,
@@ -189,7 +298,7 @@ func (ee *Engine[
 // VerifyAndNotifyNewPayload verifies the new payload and notifies the
 // execution client.
 func (ee *Engine[
-	ExecutionPayloadT, _, _, WithdrawalsT,
+	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
 ]) VerifyAndNotifyNewPayload(
 	ctx context.Context,
 	req *engineprimitives.NewPayloadRequest[
@@ -203,6 +312,16 @@ func (ee *Engine[
 		req.Optimistic,
 	)
 
+	// Send the payload to the followers alongside.
+	defer ee.notifyFollowers(
+		"new_payload",
+		func(follower *Engine[
+			ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+		]) error {
+			return follower.VerifyAndNotifyNewPayload(ctx, req)
+		},
+	)()
+
 	// First we verify the block hash and versioned hashes are valid.
 	//
 	// TODO: is this required? Or will the EL handle this for us during
@@ -217,6 +336,8 @@ func (ee *Engine[
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		req.ExecutionRequests,
+		req.ForkVersion,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -281,6 +402,9 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.mu.Lock()
+		ee.latestVerifiedHash = req.ExecutionPayload.GetBlockHash()
+		ee.mu.Unlock()
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
@@ -303,3 +427,79 @@ func (ee *Engine[
 	}
 	return err
 }
+
+// PayloadValue computes the value of the payload with the given block hash,
+// i.e. the priority fees paid to its fee recipient, from the receipts of the
+// block. It matches the block value returned by engine_getPayload to the
+// proposer that built the payload.
+func (ee *Engine[
+	_, _, _, _,
+]) PayloadValue(
+	ctx context.Context,
+	blockHash common.ExecutionHash,
+) (*math.U256, error) {
+	header, err := ee.ec.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := ee.ec.ExecutionReceiptsByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		value = new(big.Int)
+		tip   = new(big.Int)
+	)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice == nil {
+			continue
+		}
+		tip.Sub(receipt.EffectiveGasPrice, header.BaseFee)
+		value.Add(
+			value, tip.Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)),
+		)
+	}
+	return math.NewU256FromBigInt(value), nil
+}
+
+// notifyFollowers calls fn with each of the healthy followers concurrently
+// and returns a function that waits for them to return, logging their errors
+// rather than failing the call to this engine. Followers which are not yet
+// connected to their execution client are skipped.
+func (ee *Engine[
+	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+]) notifyFollowers(
+	method string,
+	fn func(
+		follower *Engine[
+			ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalsT,
+		],
+	) error,
+) func() {
+	var wg sync.WaitGroup
+	for i, follower := range ee.followers {
+		if !follower.ec.IsHealthy() {
+			ee.logger.Debug(
+				"Skipping unhealthy follower execution client",
+				"method", method,
+				"follower", i,
+			)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(follower); err != nil {
+				ee.logger.Warn(
+					"Failed to notify follower execution client",
+					"method", method,
+					"follower", i,
+					"error", err,
+				)
+			}
+		}()
+	}
+	return wg.Wait
+}
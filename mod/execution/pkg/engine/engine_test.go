@@ -0,0 +1,355 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	newPayloadMethod        = "engine_newPayloadV3"
+	forkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV3"
+)
+
+type testEngine = engine.Engine[
+	*testPayload, *testAttributes, engineprimitives.PayloadID,
+	engineprimitives.Withdrawals,
+]
+
+func TestVerifyAndNotifyNewPayload_Followers(t *testing.T) {
+	leaderEL, followerEL, failingEL := newMockEL(t, false),
+		newMockEL(t, false), newMockEL(t, true)
+	leader := newEngine(t, leaderEL)
+	leader.AttachFollowers(newEngine(t, followerEL), newEngine(t, failingEL))
+
+	// The failing follower does not fail the payload of the leader.
+	root := common.Root{}
+	err := leader.VerifyAndNotifyNewPayload(
+		context.Background(),
+		engineprimitives.BuildNewPayloadRequest(
+			newTestPayload(&root), nil, &root, false, version.Deneb,
+		),
+	)
+	require.NoError(t, err)
+	for _, el := range []*mockEL{leaderEL, followerEL, failingEL} {
+		require.Equal(t, 1, el.calls(newPayloadMethod))
+	}
+}
+
+func TestVerifyAndNotifyNewPayload_UnhealthyFollower(t *testing.T) {
+	leaderEL, followerEL := newMockEL(t, false), newMockEL(t, false)
+	leader := newEngine(t, leaderEL)
+
+	// Starting an unreachable follower does not block, and it is skipped
+	// until it is connected.
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	unreachable := newEngineClient(t, "http://127.0.0.1:1")
+	require.NoError(t, client.EngineClients[*testPayload, *testAttributes]{
+		unreachable,
+	}.Start(ctx))
+	require.False(t, unreachable.IsHealthy())
+	leader.AttachFollowers(
+		newEngineWithClient(unreachable), newEngine(t, followerEL),
+	)
+
+	root := common.Root{}
+	err := leader.VerifyAndNotifyNewPayload(
+		context.Background(),
+		engineprimitives.BuildNewPayloadRequest(
+			newTestPayload(&root), nil, &root, false, version.Deneb,
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, leaderEL.calls(newPayloadMethod))
+	require.Equal(t, 1, followerEL.calls(newPayloadMethod))
+}
+
+func TestNotifyForkchoiceUpdate_Followers(t *testing.T) {
+	leaderEL, followerEL := newMockEL(t, false), newMockEL(t, false)
+	leader := newEngine(t, leaderEL)
+	leader.AttachFollowers(newEngine(t, followerEL))
+
+	// Forkchoice updates without attributes are forwarded to the followers.
+	_, _, err := leader.NotifyForkchoiceUpdate(
+		context.Background(),
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{}, (*testAttributes)(nil),
+			version.Deneb,
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, leaderEL.calls(forkchoiceUpdatedMethod))
+	require.Equal(t, 1, followerEL.calls(forkchoiceUpdatedMethod))
+
+	// Forkchoice updates with attributes start a payload build, which the
+	// payload builder does on each execution client itself.
+	payloadID, _, err := leader.NotifyForkchoiceUpdate(
+		context.Background(),
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{}, &testAttributes{},
+			version.Deneb,
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, mockPayloadID, *payloadID)
+	require.Equal(t, 2, leaderEL.calls(forkchoiceUpdatedMethod))
+	require.Equal(t, 1, followerEL.calls(forkchoiceUpdatedMethod))
+}
+
+func newEngine(t *testing.T, el *mockEL) *testEngine {
+	t.Helper()
+	ec := newEngineClient(t, el.URL)
+	require.NoError(t, ec.Start(context.Background()))
+	return newEngineWithClient(ec)
+}
+
+func newEngineClient(
+	t *testing.T, rawURL string,
+) *client.EngineClient[*testPayload, *testAttributes] {
+	t.Helper()
+	dialURL, err := url.NewFromRaw(rawURL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.RPCTimeout = time.Second
+	return client.New[*testPayload, *testAttributes](
+		&cfg, noop.NewLogger[any](), nil, noopSink{}, big.NewInt(1),
+	)
+}
+
+func newEngineWithClient(
+	ec *client.EngineClient[*testPayload, *testAttributes],
+) *testEngine {
+	return engine.New[
+		*testPayload, *testAttributes, engineprimitives.PayloadID,
+		engineprimitives.Withdrawals,
+	](
+		ec,
+		noop.NewLogger[any](),
+		broker.New[*asynctypes.Event[*service.StatusEvent]]("status"),
+		noopSink{},
+	)
+}
+
+var mockPayloadID = engineprimitives.PayloadID{1, 2, 3, 4, 5, 6, 7, 8}
+
+// mockEL is an execution client serving the engine API over JSON-RPC, which
+// counts the calls to each method and, if failing, rejects the calls made
+// once connected.
+type mockEL struct {
+	*httptest.Server
+	failing bool
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newMockEL(t *testing.T, failing bool) *mockEL {
+	t.Helper()
+	el := &mockEL{failing: failing, counts: make(map[string]int)}
+	el.Server = httptest.NewServer(http.HandlerFunc(el.serve))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func (el *mockEL) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	el.mu.Lock()
+	el.counts[req.Method]++
+	el.mu.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	switch {
+	case req.Method == "eth_chainId":
+		resp["result"] = "0x1"
+	case req.Method == "engine_exchangeCapabilities":
+		resp["result"] = []string{}
+	case el.failing:
+		resp["error"] = map[string]any{
+			"code": -32000, "message": "execution client failure",
+		}
+	case req.Method == newPayloadMethod:
+		resp["result"] = engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusValid,
+		}
+	case req.Method == forkchoiceUpdatedMethod:
+		result := engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{
+				Status: engineprimitives.PayloadStatusValid,
+			},
+		}
+		// A payload is only built if payload attributes are given.
+		if len(req.Params) > 1 && string(req.Params[1]) != "null" {
+			result.PayloadID = &mockPayloadID
+		}
+		resp["result"] = result
+	default:
+		resp["error"] = map[string]any{
+			"code": -32601, "message": "method not found",
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (el *mockEL) calls(method string) int {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return el.counts[method]
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+type testAttributes struct {
+	Timestamp math.U64 `json:"timestamp"`
+}
+
+func (*testAttributes) Version() uint32 { return version.Deneb }
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (*testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{1}
+}
+
+// testPayload is an empty Deneb execution payload with a valid block hash.
+type testPayload struct {
+	BlockHash common.ExecutionHash `json:"blockHash"`
+}
+
+func newTestPayload(parentBeaconRoot *common.Root) *testPayload {
+	var (
+		zero            uint64
+		withdrawalsHash = gethprimitives.DeriveSha(
+			engineprimitives.Withdrawals{}, gethprimitives.NewStackTrie(nil),
+		)
+	)
+	header := &gethprimitives.Header{
+		UncleHash: gethprimitives.EmptyUncleHash,
+		TxHash: gethprimitives.DeriveSha(
+			gethprimitives.Transactions{}, gethprimitives.NewStackTrie(nil),
+		),
+		Difficulty:       big.NewInt(0),
+		Number:           big.NewInt(0),
+		BaseFee:          big.NewInt(0),
+		Extra:            []byte{},
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    &zero,
+		BlobGasUsed:      &zero,
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(parentBeaconRoot),
+	}
+	return &testPayload{BlockHash: common.ExecutionHash(header.Hash())}
+}
+
+func (*testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (*testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(data []byte) error {
+	type payload testPayload
+	return json.Unmarshal(data, (*payload)(p))
+}
+
+func (*testPayload) GetPrevRandao() common.Bytes32 { return common.Bytes32{} }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash { return p.BlockHash }
+
+func (*testPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (*testPayload) GetNumber() math.U64 { return 0 }
+
+func (*testPayload) GetGasLimit() math.U64 { return 0 }
+
+func (*testPayload) GetGasUsed() math.U64 { return 0 }
+
+func (*testPayload) GetTimestamp() math.U64 { return 0 }
+
+func (*testPayload) GetExtraData() []byte { return []byte{} }
+
+func (*testPayload) GetBaseFeePerGas() *math.U256 { return math.NewU256(0) }
+
+func (*testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (*testPayload) GetStateRoot() common.Bytes32 { return common.Bytes32{} }
+
+func (*testPayload) GetReceiptsRoot() common.Bytes32 {
+	return common.Bytes32{}
+}
+
+func (*testPayload) GetLogsBloom() bytes.B256 { return bytes.B256{} }
+
+func (*testPayload) GetBlobGasUsed() math.U64 { return 0 }
+
+func (*testPayload) GetExcessBlobGas() math.U64 { return 0 }
+
+func (*testPayload) GetWithdrawals() engineprimitives.Withdrawals {
+	return engineprimitives.Withdrawals{}
+}
+
+func (*testPayload) GetTransactions() engineprimitives.Transactions {
+	return engineprimitives.Transactions{}
+}
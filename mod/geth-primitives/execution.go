@@ -40,6 +40,7 @@ type (
 	ExecutableData = engine.ExecutableData
 	Genesis        = core.Genesis
 	Block          = coretypes.Block
+	BlobTx         = coretypes.BlobTx
 	Body           = coretypes.Body
 	Log            = coretypes.Log
 	LogsBloom      = coretypes.Bloom
@@ -56,6 +57,7 @@ var (
 	HexToHash              = common.HexToHash
 	BlockToExecutableData  = engine.BlockToExecutableData
 	NewBlockWithHeader     = coretypes.NewBlockWithHeader
+	NewTx                  = coretypes.NewTx
 	DeriveSha              = coretypes.DeriveSha
 	EmptyUncleHash         = coretypes.EmptyUncleHash
 	NewStackTrie           = trie.NewStackTrie
@@ -26,6 +26,7 @@ type (
 	BlockNumber = rpc.BlockNumber
 	Client      = rpc.Client
 	DataError   = rpc.DataError
+	Server      = rpc.Server
 )
 
 //nolint:gochecknoglobals // its okay.
@@ -33,5 +34,6 @@ var (
 	DialOptions = rpc.DialOptions
 	DialContext = rpc.DialContext
 	DialIPC     = rpc.DialIPC
+	NewServer   = rpc.NewServer
 	WithHeaders = rpc.WithHeaders
 )
@@ -23,8 +23,11 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // Backend is the db access layer for the beacon node-api.
@@ -60,11 +63,13 @@ type Backend[
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
-	sb   StorageBackendT
-	cs   common.ChainSpec
-	node NodeT
+	sb    StorageBackendT
+	cs    common.ChainSpec
+	node  NodeT
+	comet CometClient
 
-	sp StateProcessor[BeaconStateT]
+	sp       StateProcessor[BeaconStateT]
+	replayer StateReplayer[BeaconStateT]
 }
 
 // New creates and returns a new Backend instance.
@@ -128,6 +133,22 @@ func (b *Backend[
 	b.node = node
 }
 
+// AttachCometClient sets the client the backend reads committed CometBFT
+// blocks with, e.g. to serve light clients.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttachCometClient(comet CometClient) {
+	b.comet = comet
+}
+
+// AttachStateReplayer sets the replayer the backend regenerates the states
+// of pruned heights with.
+func (b *Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttachStateReplayer(replayer StateReplayer[BeaconStateT]) {
+	b.replayer = replayer
+}
+
 // ChainSpec returns the chain spec from the backend.
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
@@ -176,17 +197,18 @@ func (b *Backend[
 
 // stateFromSlotRaw returns the state at the given slot using query context,
 // resolving an input slot of 0 to the latest slot. It does not process the
-// next slot on the beacon state.
+// next slot on the beacon state. The states of pruned heights are regenerated
+// by the state replayer, if attached.
 func (b *Backend[
 	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, error) {
-	var st BeaconStateT
-	//#nosec:G701 // not an issue in practice.
-	queryCtx, err := b.node.CreateQueryContext(int64(slot), false)
+	st, err := b.CommittedState(slot)
+	if err != nil && slot != 0 && b.replayer != nil {
+		st, err = b.replayer.StateAtSlot(slot)
+	}
 	if err != nil {
 		return st, slot, err
 	}
-	st = b.sb.StateFromContext(queryCtx)
 
 	// If using height 0 for the query context, make sure to return the latest
 	// slot.
@@ -198,3 +220,46 @@ func (b *Backend[
 	}
 	return st, slot, err
 }
+
+// CommittedState returns the state committed at the given slot, a slot of 0
+// referring to the latest one, which fails if its height has been pruned.
+func (b *Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) CommittedState(slot math.Slot) (BeaconStateT, error) {
+	var st BeaconStateT
+	//#nosec:G701 // not an issue in practice.
+	queryCtx, err := b.node.CreateQueryContext(int64(slot), false)
+	if err != nil {
+		return st, err
+	}
+	return b.sb.StateFromContext(queryCtx), nil
+}
+
+// CommitVoters returns the CometBFT addresses of the validators whose votes
+// for the previous block were committed in the last commit of the block at
+// the given slot, which the state transition of the block credits.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) CommitVoters(slot math.Slot) ([][]byte, error) {
+	if b.comet == nil {
+		return nil, errors.Wrap(
+			handlertypes.ErrNotImplemented, "no CometBFT client attached",
+		)
+	}
+	//#nosec:G701 // slots are bounded by heights.
+	blk, err := b.comet.Block(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+	if blk.LastCommit == nil {
+		return nil, nil
+	}
+
+	voters := make([][]byte, 0, len(blk.LastCommit.Signatures))
+	for _, sig := range blk.LastCommit.Signatures {
+		if sig.BlockIDFlag == cmttypes.BlockIDFlagCommit {
+			voters = append(voters, sig.ValidatorAddress)
+		}
+	}
+	return voters, nil
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// BlobSidecarsAtSlot returns the blob sidecars with the given indices of the
+// block at the given slot. Sidecars that have been pruned from the
+// availability store are served from the blob archive, if one is configured.
+func (b Backend[
+	_, _, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobSidecarsAtSlot(
+	slot math.Slot,
+	indices []uint64,
+) (BlobSidecarsT, error) {
+	// Only the head slot is resolved through the query context, since the
+	// state of archived slots is likely no longer available.
+	if slot == 0 {
+		var (
+			sidecars BlobSidecarsT
+			err      error
+		)
+		if _, slot, err = b.stateFromSlotRaw(slot); err != nil {
+			return sidecars, err
+		}
+	}
+	return b.sb.AvailabilityStore().GetBlobSidecars(slot, indices)
+}
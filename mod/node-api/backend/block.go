@@ -21,7 +21,9 @@
 package backend
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -56,16 +58,60 @@ func (b Backend[
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
-// TODO: Implement this.
+// SignedBlockAtSlot returns the signed block stored at the given slot.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SignedBlockAtSlot(slot math.Slot) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	// Only the head slot is resolved through the query context, since the
+	// blocks are kept after the state is pruned.
+	if slot == 0 {
+		var err error
+		if _, slot, err = b.stateFromSlotRaw(slot); err != nil {
+			return blk, err
+		}
+	}
+	blk, err := b.sb.BlockStore().Get(slot)
+	if err != nil {
+		return blk, errors.Wrapf(
+			handlertypes.ErrNotFound, "block at slot %d", slot,
+		)
+	}
+	return blk, nil
+}
+
+// BlockRewardsAtSlot returns the rewards of the block at the given slot, as
+// recorded by its state transition.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) BlockRewardsAtSlot(math.Slot) (*types.BlockRewardsData, error) {
+]) BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error) {
+	// Only the head slot is resolved through the query context, since the
+	// rewards are kept along with the blocks after the state is pruned.
+	if slot == 0 {
+		var err error
+		if _, slot, err = b.stateFromSlotRaw(slot); err != nil {
+			return nil, err
+		}
+	}
+	rewards, err := b.sb.BlockStore().GetRewards(slot)
+	if err != nil {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound, "rewards of block at slot %d", slot,
+		)
+	}
+
+	// The proposer is only rewarded for the attestations it includes, there
+	// are no sync committees and slashings are not processed from blocks.
 	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
+		ProposerIndex:        rewards.ProposerIndex.Unwrap(),
+		Total:                rewards.ProposerReward.Unwrap(),
+		Attestations:         rewards.ProposerReward.Unwrap(),
+		SyncAggregate:        0,
+		ProposerSlashings:    0,
+		AttesterSlashings:    0,
+		ExecutionValue:       rewards.ExecutionValue.Unwrap(),
+		AttestationRewards:   rewards.AttestationRewards.Unwrap(),
+		AttestationPenalties: rewards.AttestationPenalties.Unwrap(),
+		SlashingPenalties:    rewards.SlashingPenalties.Unwrap(),
 	}, nil
 }
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/duties"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ProposerDutiesAtEpoch returns the proposers of the slots of the given epoch,
+// which is at most the one after the current epoch. The proposers of the
+// committed slots are read from their CometBFT headers, and the ones of the
+// upcoming slots are predicted by simulating the proposer selection of
+// CometBFT from the latest validator set. The returned root is the one of the
+// block the duties depend on.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProposerDutiesAtEpoch(
+	epoch math.Epoch,
+) (common.Root, []*validatortypes.ProposerDutyData, error) {
+	latest, err := b.latestCommittedSlot()
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	if epoch > b.cs.SlotToEpoch(latest)+1 {
+		return common.Root{}, nil, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"epoch %d is beyond the next epoch", epoch,
+		)
+	}
+
+	st, _, err := b.stateFromSlot(0)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+
+	start := epoch * math.Slot(b.cs.SlotsPerEpoch())
+	end := start + math.Slot(b.cs.SlotsPerEpoch())
+	proposers := make([]cmttypes.Address, 0, b.cs.SlotsPerEpoch())
+	var schedule *duties.ProposerSchedule
+	for slot := start; slot < end; slot++ {
+		switch {
+		case slot == 0:
+			// There is no block at the genesis slot.
+			continue
+		case slot <= latest:
+			//#nosec:G701 // slots are bounded by heights.
+			sh, shErr := b.comet.SignedHeader(int64(slot))
+			if shErr != nil {
+				return common.Root{}, nil, shErr
+			}
+			proposers = append(proposers, sh.ProposerAddress)
+			continue
+		case schedule == nil:
+			//#nosec:G701 // slots are bounded by heights.
+			vals, valsErr := b.comet.Validators(int64(latest))
+			if valsErr != nil {
+				return common.Root{}, nil, valsErr
+			}
+			if schedule, err = duties.NewProposerSchedule(vals); err != nil {
+				return common.Root{}, nil, err
+			}
+			for range slot - latest - 1 {
+				schedule.Next()
+			}
+		}
+		proposers = append(proposers, schedule.Next().Address)
+	}
+
+	data := make([]*validatortypes.ProposerDutyData, 0, len(proposers))
+	slot := max(start, 1)
+	for _, address := range proposers {
+		index, indexErr := st.ValidatorIndexByCometBFTAddress(address)
+		if indexErr != nil {
+			return common.Root{}, nil, indexErr
+		}
+		val, valErr := st.ValidatorByIndex(index)
+		if valErr != nil {
+			return common.Root{}, nil, valErr
+		}
+		data = append(data, &validatortypes.ProposerDutyData{
+			Pubkey:         val.GetPubkey(),
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot.Unwrap(),
+		})
+		slot++
+	}
+
+	// The duties depend on the validator set of the block before the epoch,
+	// or of the latest block for the slots that are yet to be committed. The
+	// first block is at slot 1.
+	root, err := b.BlockRootAtSlot(min(max(start, 2)-1, latest))
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	return root, data, nil
+}
+
+// LivenessAtEpoch returns whether each of the validators with the given
+// indices signed the commit of any block of the given epoch, which is at most
+// the current one.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) LivenessAtEpoch(
+	epoch math.Epoch,
+	indices []math.ValidatorIndex,
+) ([]*validatortypes.LivenessData, error) {
+	latest, err := b.latestCommittedSlot()
+	if err != nil {
+		return nil, err
+	}
+	if epoch > b.cs.SlotToEpoch(latest) {
+		return nil, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"epoch %d is beyond the current epoch", epoch,
+		)
+	}
+
+	st, _, err := b.stateFromSlot(0)
+	if err != nil {
+		return nil, err
+	}
+
+	start := epoch * math.Slot(b.cs.SlotsPerEpoch())
+	end := min(start+math.Slot(b.cs.SlotsPerEpoch()), latest+1)
+	live := make(map[math.ValidatorIndex]bool)
+	for slot := max(start, 1); slot < end; slot++ {
+		//#nosec:G701 // slots are bounded by heights.
+		sh, shErr := b.comet.SignedHeader(int64(slot))
+		if shErr != nil {
+			return nil, shErr
+		}
+		for _, sig := range sh.Commit.Signatures {
+			if sig.BlockIDFlag != cmttypes.BlockIDFlagCommit {
+				continue
+			}
+			index, indexErr := st.ValidatorIndexByCometBFTAddress(
+				sig.ValidatorAddress,
+			)
+			if indexErr != nil {
+				return nil, indexErr
+			}
+			live[index] = true
+		}
+	}
+
+	data := make([]*validatortypes.LivenessData, len(indices))
+	for i, index := range indices {
+		data[i] = &validatortypes.LivenessData{
+			Index:  index.Unwrap(),
+			IsLive: live[index],
+		}
+	}
+	return data, nil
+}
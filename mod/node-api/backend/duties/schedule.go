@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package duties computes the duties of validators on a CometBFT chain, where
+// block proposers are selected by the weighted round-robin of CometBFT rather
+// than by the beacon state.
+package duties
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ErrEmptyValidatorSet is returned when the proposers of an empty validator
+// set are requested.
+var ErrEmptyValidatorSet = errors.New("empty validator set")
+
+// ProposerSchedule simulates the proposer selection of CometBFT over the
+// heights following the one of a validator set. Changes to the validator set
+// after that height, and the proposers of rounds other than the first, are
+// not accounted for, so the schedule is a prediction.
+type ProposerSchedule struct {
+	set *cmttypes.ValidatorSet
+}
+
+// NewProposerSchedule creates a schedule from the validator set of a height,
+// with the proposer priorities as of that height, as served by the validators
+// endpoint of the CometBFT RPC.
+func NewProposerSchedule(
+	vals []*cmttypes.Validator,
+) (*ProposerSchedule, error) {
+	if len(vals) == 0 {
+		return nil, ErrEmptyValidatorSet
+	}
+	set := &cmttypes.ValidatorSet{
+		Validators: make([]*cmttypes.Validator, len(vals)),
+	}
+	for i, val := range vals {
+		set.Validators[i] = val.Copy()
+	}
+	return &ProposerSchedule{set: set}, nil
+}
+
+// Next advances the schedule by a height and returns the validator expected
+// to propose at it.
+func (s *ProposerSchedule) Next() *cmttypes.Validator {
+	s.set.IncrementProposerPriority(1)
+	return s.set.GetProposer()
+}
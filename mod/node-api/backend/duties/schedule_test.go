@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package duties_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend/duties"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newValidators(powers ...int64) []*cmttypes.Validator {
+	vals := make([]*cmttypes.Validator, len(powers))
+	for i, power := range powers {
+		vals[i] = cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), power)
+	}
+	return vals
+}
+
+func TestProposerScheduleMatchesCometBFT(t *testing.T) {
+	// The validator set of a height, as CometBFT stores it.
+	set := cmttypes.NewValidatorSet(newValidators(10, 20, 30, 5, 1))
+	schedule, err := duties.NewProposerSchedule(set.Validators)
+	require.NoError(t, err)
+
+	for range 100 {
+		set = set.CopyIncrementProposerPriority(1)
+		require.Equal(
+			t, set.GetProposer().Address, schedule.Next().Address,
+		)
+	}
+}
+
+func TestProposerScheduleWeights(t *testing.T) {
+	vals := newValidators(1, 2, 1)
+	schedule, err := duties.NewProposerSchedule(vals)
+	require.NoError(t, err)
+
+	proposed := make(map[string]int)
+	for range 400 {
+		proposed[schedule.Next().Address.String()]++
+	}
+	require.Equal(t, 100, proposed[vals[0].Address.String()])
+	require.Equal(t, 200, proposed[vals[1].Address.String()])
+	require.Equal(t, 100, proposed[vals[2].Address.String()])
+
+	// The input validators are left untouched.
+	for _, val := range vals {
+		require.Zero(t, val.ProposerPriority)
+	}
+}
+
+func TestProposerScheduleEmpty(t *testing.T) {
+	_, err := duties.NewProposerSchedule(nil)
+	require.ErrorIs(t, err, duties.ErrEmptyValidatorSet)
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/lightclient"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// maxRequestLightClientUpdates is the maximum number of light client updates
+// served per request, as on Ethereum.
+const maxRequestLightClientUpdates = 128
+
+// LightClientBootstrap returns the light client bootstrap for the beacon
+// block with the given root.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
+]) LightClientBootstrap(
+	root common.Root,
+) (*lightclient.Bootstrap[BeaconBlockHeaderT], error) {
+	slot, err := b.GetSlotByRoot(root)
+	if err != nil {
+		return nil, errors.Wrapf(handlertypes.ErrNotFound, "block %s", root)
+	}
+	update, err := b.lightClientUpdateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if update.AttestedHeader.HashTreeRoot() != root {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound, "block %s is not canonical", root,
+		)
+	}
+	return &lightclient.Bootstrap[BeaconBlockHeaderT]{
+		Header:       update.AttestedHeader,
+		SignedHeader: update.SignedHeader,
+		Validators:   update.Validators,
+		BlockProof:   update.BlockProof,
+	}, nil
+}
+
+// LightClientUpdatesByRange returns the light client updates for count
+// periods from the start period. A period is an epoch, and its update
+// finalizes the last slot of the epoch, or the latest committed slot for the
+// current epoch.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
+]) LightClientUpdatesByRange(
+	startPeriod, count uint64,
+) ([]*lightclient.Update[BeaconBlockHeaderT], error) {
+	latest, err := b.latestCommittedSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerEpoch := b.cs.SlotsPerEpoch()
+	count = min(count, maxRequestLightClientUpdates)
+	updates := make([]*lightclient.Update[BeaconBlockHeaderT], 0, count)
+	for period := startPeriod; period <= latest.Unwrap()/slotsPerEpoch &&
+		uint64(len(updates)) < count; period++ {
+		slot := min(math.Slot((period+1)*slotsPerEpoch-1), latest)
+		if slot == 0 {
+			continue
+		}
+		update, updateErr := b.lightClientUpdateAtSlot(slot)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// LightClientFinalityUpdate returns the light client update that finalizes
+// the latest committed beacon block.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
+]) LightClientFinalityUpdate() (
+	*lightclient.Update[BeaconBlockHeaderT], error,
+) {
+	latest, err := b.latestCommittedSlot()
+	if err != nil {
+		return nil, err
+	}
+	return b.lightClientUpdateAtSlot(latest)
+}
+
+// LightClientOptimisticUpdate returns the optimistic light client update for
+// the latest committed beacon block.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
+]) LightClientOptimisticUpdate() (
+	*lightclient.OptimisticUpdate[BeaconBlockHeaderT], error,
+) {
+	latest, err := b.latestCommittedSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	update := new(lightclient.OptimisticUpdate[BeaconBlockHeaderT])
+	update.AttestedHeader, update.SignedHeader, update.BlockProof, err =
+		b.attestedBlockAtSlot(latest)
+	if err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// latestCommittedSlot returns the slot of the latest beacon block with a
+// CometBFT commit.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) latestCommittedSlot() (math.Slot, error) {
+	if b.comet == nil {
+		return 0, errors.Wrap(
+			handlertypes.ErrNotImplemented, "no CometBFT client attached",
+		)
+	}
+	sh, err := b.comet.SignedHeader(0)
+	if err != nil {
+		return 0, err
+	}
+	//#nosec:G701 // heights are never negative.
+	return math.Slot(sh.Height), nil
+}
+
+// lightClientUpdateAtSlot returns the light client update that finalizes the
+// beacon block at the given slot.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
+]) lightClientUpdateAtSlot(
+	slot math.Slot,
+) (*lightclient.Update[BeaconBlockHeaderT], error) {
+	var err error
+	update := new(lightclient.Update[BeaconBlockHeaderT])
+	update.AttestedHeader, update.SignedHeader, update.BlockProof, err =
+		b.attestedBlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // slots are bounded by heights.
+	vals, err := b.comet.Validators(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+	update.Validators = make([]*lightclient.Validator, len(vals))
+	for i, val := range vals {
+		if update.Validators[i], err = lightclient.NewValidatorFromComet(
+			val,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return update, nil
+}
+
+// attestedBlockAtSlot returns the beacon block header at the given slot,
+// together with the signed CometBFT header that finalizes it and the proof
+// that links them.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
+]) attestedBlockAtSlot(slot math.Slot) (
+	BeaconBlockHeaderT, *cmttypes.SignedHeader, *lightclient.BlockProof, error,
+) {
+	var header BeaconBlockHeaderT
+	if b.comet == nil {
+		return header, nil, nil, errors.Wrap(
+			handlertypes.ErrNotImplemented, "no CometBFT client attached",
+		)
+	}
+
+	//#nosec:G701 // slots are bounded by heights.
+	height := int64(slot)
+	sh, err := b.comet.SignedHeader(height)
+	if err != nil {
+		return header, nil, nil, err
+	}
+	blk, err := b.comet.Block(height)
+	if err != nil {
+		return header, nil, nil, err
+	}
+	if len(blk.Txs) <= lightclient.BeaconBlockTxIndex {
+		return header, nil, nil, errors.Wrapf(
+			handlertypes.ErrNotFound, "no beacon block at height %d", height,
+		)
+	}
+
+	if header, err = b.BlockHeaderAtSlot(slot); err != nil {
+		return header, nil, nil, err
+	}
+	return header, sh, &lightclient.BlockProof{
+		Block: bytes.Bytes(blk.Txs[lightclient.BeaconBlockTxIndex]),
+		Proof: blk.Txs.Proof(lightclient.BeaconBlockTxIndex).Proof,
+	}, nil
+}
@@ -6,6 +6,8 @@ import (
 	common "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 
+	transition "github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +24,180 @@ func (_m *BlockStore[BeaconBlockT]) EXPECT() *BlockStore_Expecter[BeaconBlockT]
 	return &BlockStore_Expecter[BeaconBlockT]{mock: &_m.Mock}
 }
 
+// Get provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) Get(slot math.U64) (BeaconBlockT, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 BeaconBlockT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (BeaconBlockT, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) BeaconBlockT); ok {
+		r0 = rf(slot)
+	} else {
+		r0 = ret.Get(0).(BeaconBlockT)
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type BlockStore_Get_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) Get(slot interface{}) *BlockStore_Get_Call[BeaconBlockT] {
+	return &BlockStore_Get_Call[BeaconBlockT]{Call: _e.mock.On("Get", slot)}
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) Return(_a0 BeaconBlockT, _a1 error) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (BeaconBlockT, error)) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProposerIncome provides a mock function with given fields: proposer, start, end
+func (_m *BlockStore[BeaconBlockT]) GetProposerIncome(proposer math.U64, start math.U64, end math.U64) (*transition.ProposerIncome, error) {
+	ret := _m.Called(proposer, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProposerIncome")
+	}
+
+	var r0 *transition.ProposerIncome
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64, math.U64, math.U64) (*transition.ProposerIncome, error)); ok {
+		return rf(proposer, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64, math.U64, math.U64) *transition.ProposerIncome); ok {
+		r0 = rf(proposer, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transition.ProposerIncome)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64, math.U64, math.U64) error); ok {
+		r1 = rf(proposer, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetProposerIncome_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProposerIncome'
+type BlockStore_GetProposerIncome_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// GetProposerIncome is a helper method to define mock.On call
+//   - proposer math.U64
+//   - start math.U64
+//   - end math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetProposerIncome(proposer interface{}, start interface{}, end interface{}) *BlockStore_GetProposerIncome_Call[BeaconBlockT] {
+	return &BlockStore_GetProposerIncome_Call[BeaconBlockT]{Call: _e.mock.On("GetProposerIncome", proposer, start, end)}
+}
+
+func (_c *BlockStore_GetProposerIncome_Call[BeaconBlockT]) Run(run func(proposer math.U64, start math.U64, end math.U64)) *BlockStore_GetProposerIncome_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(math.U64), args[2].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetProposerIncome_Call[BeaconBlockT]) Return(_a0 *transition.ProposerIncome, _a1 error) *BlockStore_GetProposerIncome_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetProposerIncome_Call[BeaconBlockT]) RunAndReturn(run func(math.U64, math.U64, math.U64) (*transition.ProposerIncome, error)) *BlockStore_GetProposerIncome_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRewards provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) GetRewards(slot math.U64) (*transition.BlockRewards, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRewards")
+	}
+
+	var r0 *transition.BlockRewards
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (*transition.BlockRewards, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) *transition.BlockRewards); ok {
+		r0 = rf(slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transition.BlockRewards)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetRewards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRewards'
+type BlockStore_GetRewards_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// GetRewards is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetRewards(slot interface{}) *BlockStore_GetRewards_Call[BeaconBlockT] {
+	return &BlockStore_GetRewards_Call[BeaconBlockT]{Call: _e.mock.On("GetRewards", slot)}
+}
+
+func (_c *BlockStore_GetRewards_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_GetRewards_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetRewards_Call[BeaconBlockT]) Return(_a0 *transition.BlockRewards, _a1 error) *BlockStore_GetRewards_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetRewards_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (*transition.BlockRewards, error)) *BlockStore_GetRewards_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByExecutionNumber provides a mock function with given fields: executionNumber
 func (_m *BlockStore[BeaconBlockT]) GetSlotByExecutionNumber(executionNumber math.U64) (math.U64, error) {
 	ret := _m.Called(executionNumber)
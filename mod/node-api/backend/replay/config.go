@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+const (
+	// defaultCacheSize is the default number of regenerated states to keep.
+	defaultCacheSize = 16
+	// defaultMaxReplaySlots is the default maximum number of blocks replayed
+	// to regenerate a state.
+	defaultMaxReplaySlots = 8192
+	// defaultCheckpointInterval is the default number of replayed slots
+	// between two checkpoints.
+	defaultCheckpointInterval = 256
+)
+
+// Config is the configuration for the regeneration of historical states.
+type Config struct {
+	// Enabled enables regenerating the states of pruned heights.
+	Enabled bool `mapstructure:"enabled"`
+	// CacheSize is the number of regenerated states and checkpoints to keep.
+	CacheSize int `mapstructure:"cache-size"`
+	// MaxReplaySlots is the maximum number of blocks replayed to regenerate
+	// a state, which bounds the cost of a single query.
+	MaxReplaySlots uint64 `mapstructure:"max-replay-slots"`
+	// CheckpointInterval is the number of replayed slots between two states
+	// cached and persisted to disk as checkpoints for later regenerations.
+	CheckpointInterval uint64 `mapstructure:"checkpoint-interval"`
+}
+
+// DefaultConfig returns the default configuration for the regeneration of
+// historical states.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:            true,
+		CacheSize:          defaultCacheSize,
+		MaxReplaySlots:     defaultMaxReplaySlots,
+		CheckpointInterval: defaultCheckpointInterval,
+	}
+}
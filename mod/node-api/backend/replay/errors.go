@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrReplayDisabled is returned when regenerating a state while the
+	// regeneration of historical states is disabled.
+	ErrReplayDisabled = errors.New("state replay is disabled")
+	// ErrNoBaseState is returned when neither a retained nor a checkpointed
+	// state is found within the replay distance of the requested slot, nor
+	// the genesis state if it is within that distance.
+	ErrNoBaseState = errors.New("no base state to replay from")
+	// ErrStateRootMismatch is returned when the root of a regenerated state
+	// does not match the state root of the block at its slot.
+	ErrStateRootMismatch = errors.New("regenerated state root mismatch")
+)
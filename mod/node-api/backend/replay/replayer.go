@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Replayer regenerates the states of pruned heights by replaying the stored
+// blocks on top of the nearest retained or checkpointed state, falling back
+// to the genesis state.
+type Replayer[
+	BeaconBlockT BeaconBlock,
+	BeaconStateT BeaconState[BeaconStateT],
+	BlockStoreT BlockStore[SignedBeaconBlockT],
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT],
+] struct {
+	// config is the configuration of the replayer.
+	config Config
+	// logger is used for logging information and errors.
+	logger log.Logger[any]
+	// blocks is the store of the blocks replayed.
+	blocks BlockStoreT
+	// sp is the state processor the blocks are replayed through.
+	sp StateProcessor[BeaconBlockT, BeaconStateT]
+	// source provides the committed states that are still retained.
+	source StateSource[BeaconStateT]
+	// checkpoints persists the checkpoints taken while replaying, so that
+	// they outlive the cache, and provides the genesis state.
+	checkpoints CheckpointStore[BeaconStateT]
+	// cache holds the regenerated states and the checkpoints taken while
+	// replaying, by slot. Cached states are never written to, as they are
+	// only handed out as copies.
+	cache *lru.Cache[math.Slot, BeaconStateT]
+}
+
+// New creates a new replayer.
+func New[
+	BeaconBlockT BeaconBlock,
+	BeaconStateT BeaconState[BeaconStateT],
+	BlockStoreT BlockStore[SignedBeaconBlockT],
+	SignedBeaconBlockT SignedBeaconBlock[BeaconBlockT],
+](
+	config Config,
+	logger log.Logger[any],
+	blocks BlockStoreT,
+	sp StateProcessor[BeaconBlockT, BeaconStateT],
+	source StateSource[BeaconStateT],
+	checkpoints CheckpointStore[BeaconStateT],
+) (*Replayer[BeaconBlockT, BeaconStateT, BlockStoreT, SignedBeaconBlockT],
+	error,
+) {
+	r := &Replayer[BeaconBlockT, BeaconStateT, BlockStoreT, SignedBeaconBlockT]{
+		config:      config,
+		logger:      logger,
+		blocks:      blocks,
+		sp:          sp,
+		source:      source,
+		checkpoints: checkpoints,
+	}
+	if !config.Enabled {
+		return r, nil
+	}
+
+	var err error
+	r.cache, err = lru.New[math.Slot, BeaconStateT](config.CacheSize)
+	return r, err
+}
+
+// StateAtSlot returns the state at the given slot, replaying at most
+// MaxReplaySlots blocks on top of the nearest retained or checkpointed state.
+func (r *Replayer[
+	_, BeaconStateT, _, SignedBeaconBlockT,
+]) StateAtSlot(slot math.Slot) (BeaconStateT, error) {
+	var (
+		st     BeaconStateT
+		blk    SignedBeaconBlockT
+		voters [][]byte
+	)
+	if !r.config.Enabled {
+		return st, ErrReplayDisabled
+	}
+
+	st, baseSlot, err := r.baseState(slot)
+	if err != nil || baseSlot == slot {
+		return st, err
+	}
+
+	r.logger.Info(
+		"Replaying blocks to regenerate state", "from", baseSlot, "to", slot,
+	)
+	for s := baseSlot + 1; s <= slot; s++ {
+		if blk, err = r.blocks.Get(s); err != nil {
+			return st, errors.Wrapf(err, "block at slot %d", s)
+		}
+		if voters, err = r.source.CommitVoters(s); err != nil {
+			return st, errors.Wrapf(err, "commit of block at slot %d", s)
+		}
+		// The replayed blocks were finalized, hence neither their payloads
+		// nor their randao reveals are verified again, and only the root of
+		// the regenerated state is checked. The participation they credit
+		// is read from their CometBFT commits.
+		if _, err = r.sp.Transition(
+			&transition.Context{
+				Context:                 context.Background(),
+				SkipPayloadVerification: true,
+				SkipValidateRandao:      true,
+				SkipValidateResult:      true,
+				CommitVoters:            voters,
+			},
+			st, blk.GetMessage(),
+		); err != nil {
+			return st, errors.Wrapf(err, "replaying block at slot %d", s)
+		}
+
+		// The checkpoint is frozen in the cache and persisted, and the replay
+		// carries on with a copy of it.
+		if s < slot && r.isCheckpoint(s) {
+			r.cache.Add(s, st)
+			if err = r.checkpoints.Set(s, st); err != nil {
+				r.logger.Error(
+					"Failed to persist checkpoint", "slot", s, "error", err,
+				)
+			}
+			st = st.Copy()
+		}
+	}
+
+	if root := st.HashTreeRoot(); root != blk.GetMessage().GetStateRoot() {
+		return st, errors.Wrapf(
+			ErrStateRootMismatch, "slot %d: expected %s, got %s",
+			slot, blk.GetMessage().GetStateRoot(), root,
+		)
+	}
+	r.cache.Add(slot, st)
+	return st.Copy(), nil
+}
+
+// baseState returns the nearest state at or below the given slot to replay
+// from, preferring a cached state to a retained one at the same slot, and a
+// retained state to a persisted checkpoint. The genesis state is the base of
+// last resort.
+func (r *Replayer[
+	_, BeaconStateT, _, _,
+]) baseState(slot math.Slot) (BeaconStateT, math.Slot, error) {
+	var lowest math.Slot
+	if slot.Unwrap() > r.config.MaxReplaySlots {
+		lowest = slot - math.Slot(r.config.MaxReplaySlots)
+	}
+
+	// A slot of 0 refers to the latest state for the state source, hence the
+	// genesis state is only read from the checkpoints.
+	for s := slot; s > 0 && s >= lowest; s-- {
+		if st, ok := r.cache.Get(s); ok {
+			return st.Copy(), s, nil
+		}
+		if st, err := r.source.CommittedState(s); err == nil {
+			return st, s, nil
+		}
+		if st, err := r.checkpoint(s); err == nil {
+			return st, s, nil
+		}
+	}
+
+	var st BeaconStateT
+	if lowest > 0 {
+		return st, 0, errors.Wrapf(
+			ErrNoBaseState, "within %d slots of slot %d",
+			r.config.MaxReplaySlots, slot,
+		)
+	}
+	st, err := r.checkpoint(0)
+	if err != nil {
+		return st, 0, errors.Wrapf(ErrNoBaseState, "genesis state: %v", err)
+	}
+	return st, 0, nil
+}
+
+// checkpoint returns a copy of the checkpoint persisted at the given slot,
+// which is the genesis state at slot 0, caching it.
+func (r *Replayer[
+	_, BeaconStateT, _, _,
+]) checkpoint(slot math.Slot) (BeaconStateT, error) {
+	var st BeaconStateT
+	if slot != 0 && !r.isCheckpoint(slot) {
+		return st, ErrNoBaseState
+	}
+	if cached, ok := r.cache.Get(slot); ok {
+		return cached.Copy(), nil
+	}
+
+	st, err := r.checkpoints.Get(slot)
+	if err != nil {
+		return st, err
+	}
+	r.cache.Add(slot, st)
+	return st.Copy(), nil
+}
+
+// isCheckpoint reports whether a checkpoint is taken at the given slot.
+func (r *Replayer[
+	_, _, _, _,
+]) isCheckpoint(slot math.Slot) bool {
+	return r.config.CheckpointInterval != 0 &&
+		slot.Unwrap()%r.config.CheckpointInterval == 0
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/replay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// state is a beacon state whose root is derived from its slot.
+type state struct{ slot math.Slot }
+
+func (s *state) Copy() *state { return &state{slot: s.slot} }
+
+func (s *state) GetSlot() (math.Slot, error) { return s.slot, nil }
+
+func (s *state) HashTreeRoot() common.Root { return rootAt(s.slot) }
+
+func rootAt(slot math.Slot) common.Root {
+	return common.Root{byte(slot), byte(slot >> 8)}
+}
+
+type block struct {
+	slot      math.Slot
+	stateRoot common.Root
+}
+
+func (b *block) GetStateRoot() common.Root { return b.stateRoot }
+
+type signedBlock struct{ msg *block }
+
+func (b *signedBlock) GetMessage() *block { return b.msg }
+
+type blockStore map[math.Slot]*signedBlock
+
+func (s blockStore) Get(slot math.Slot) (*signedBlock, error) {
+	blk, ok := s[slot]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	return blk, nil
+}
+
+// processor moves the state to the slot of the block and counts the blocks
+// it processed.
+type processor struct{ transitions int }
+
+func (p *processor) Transition(
+	ctx *transition.Context, st *state, blk *block,
+) (transition.ValidatorUpdates, error) {
+	if !ctx.GetSkipPayloadVerification() {
+		return nil, errors.New("payload verified")
+	}
+	p.transitions++
+	st.slot = blk.slot
+	return nil, nil
+}
+
+// source retains the states of the slots it holds.
+type source struct {
+	retained map[math.Slot]bool
+	queries  int
+}
+
+func (*source) CommitVoters(math.Slot) ([][]byte, error) {
+	return nil, nil
+}
+
+func (s *source) CommittedState(slot math.Slot) (*state, error) {
+	s.queries++
+	if !s.retained[slot] {
+		return nil, errors.New("pruned")
+	}
+	return &state{slot: slot}, nil
+}
+
+// checkpoints persists the checkpoints in memory, starting with the genesis
+// state.
+type checkpoints map[math.Slot]*state
+
+func (c checkpoints) Get(slot math.Slot) (*state, error) {
+	st, ok := c[slot]
+	if !ok {
+		return nil, errors.New("checkpoint not found")
+	}
+	return st.Copy(), nil
+}
+
+func (c checkpoints) Set(slot math.Slot, st *state) error {
+	c[slot] = st.Copy()
+	return nil
+}
+
+func newCheckpoints() checkpoints {
+	return checkpoints{0: &state{}}
+}
+
+func newReplayer(
+	t *testing.T,
+	cfg replay.Config,
+	blocks blockStore,
+	sp *processor,
+	src *source,
+	cps checkpoints,
+) *replay.Replayer[*block, *state, blockStore, *signedBlock] {
+	t.Helper()
+	r, err := replay.New[*block, *state, blockStore, *signedBlock](
+		cfg, noop.NewLogger[any](), blocks, sp, src, cps,
+	)
+	require.NoError(t, err)
+	return r
+}
+
+func newBlocks(start, end math.Slot) blockStore {
+	blocks := make(blockStore)
+	for slot := start; slot <= end; slot++ {
+		blocks[slot] = &signedBlock{
+			msg: &block{slot: slot, stateRoot: rootAt(slot)},
+		}
+	}
+	return blocks
+}
+
+func TestStateAtSlot(t *testing.T) {
+	cfg := replay.DefaultConfig()
+	cfg.CheckpointInterval = 4
+	sp := &processor{}
+	src := &source{retained: map[math.Slot]bool{10: true}}
+	cps := newCheckpoints()
+	r := newReplayer(t, cfg, newBlocks(1, 30), sp, src, cps)
+
+	// The state is replayed from the nearest retained state.
+	st, err := r.StateAtSlot(20)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(20), st.slot)
+	require.Equal(t, 10, sp.transitions)
+
+	// The regenerated state is cached, and handed out as a copy.
+	st.slot = 0
+	queries := src.queries
+	st, err = r.StateAtSlot(20)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(20), st.slot)
+	require.Equal(t, 10, sp.transitions)
+	require.Equal(t, queries, src.queries)
+
+	// The checkpoint at slot 12 is the nearest state of slot 14.
+	st, err = r.StateAtSlot(14)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(14), st.slot)
+	require.Equal(t, 12, sp.transitions)
+
+	// Retained states are returned as is.
+	st, err = r.StateAtSlot(10)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(10), st.slot)
+	require.Equal(t, 12, sp.transitions)
+
+	// The checkpoints are persisted, and outlive the cache.
+	require.Contains(t, cps, math.Slot(12))
+	require.Contains(t, cps, math.Slot(16))
+	r = newReplayer(t, cfg, newBlocks(1, 30), sp, src, cps)
+	st, err = r.StateAtSlot(18)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(18), st.slot)
+	require.Equal(t, 14, sp.transitions)
+}
+
+func TestStateAtSlotFromGenesis(t *testing.T) {
+	cfg := replay.DefaultConfig()
+	cfg.MaxReplaySlots = 8
+	sp := &processor{}
+	src := &source{retained: map[math.Slot]bool{}}
+	r := newReplayer(t, cfg, newBlocks(1, 30), sp, src, newCheckpoints())
+
+	// The genesis state is the base of last resort.
+	st, err := r.StateAtSlot(8)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(8), st.slot)
+	require.Equal(t, 8, sp.transitions)
+
+	st, err = r.StateAtSlot(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(0), st.slot)
+	require.Equal(t, 8, sp.transitions)
+
+	// The genesis state is out of reach of the later slots.
+	_, err = r.StateAtSlot(20)
+	require.ErrorIs(t, err, replay.ErrNoBaseState)
+}
+
+func TestStateAtSlotErrors(t *testing.T) {
+	src := &source{retained: map[math.Slot]bool{10: true}}
+
+	cfg := replay.DefaultConfig()
+	cfg.MaxReplaySlots = 5
+	r := newReplayer(
+		t, cfg, newBlocks(1, 30), &processor{}, src, newCheckpoints(),
+	)
+	_, err := r.StateAtSlot(16)
+	require.ErrorIs(t, err, replay.ErrNoBaseState)
+
+	// Without a genesis state, there is no base for the earliest slots.
+	r = newReplayer(
+		t, cfg, newBlocks(1, 30), &processor{}, src, make(checkpoints),
+	)
+	_, err = r.StateAtSlot(4)
+	require.ErrorIs(t, err, replay.ErrNoBaseState)
+
+	blocks := newBlocks(1, 30)
+	blocks[12].msg.stateRoot = common.Root{0xff}
+	r = newReplayer(
+		t, replay.DefaultConfig(), blocks, &processor{}, src, newCheckpoints(),
+	)
+	_, err = r.StateAtSlot(12)
+	require.ErrorIs(t, err, replay.ErrStateRootMismatch)
+
+	cfg = replay.DefaultConfig()
+	cfg.Enabled = false
+	r = newReplayer(
+		t, cfg, newBlocks(1, 30), &processor{}, src, newCheckpoints(),
+	)
+	_, err = r.StateAtSlot(10)
+	require.ErrorIs(t, err, replay.ErrReplayDisabled)
+}
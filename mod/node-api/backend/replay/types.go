@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock interface {
+	// GetStateRoot returns the root of the state after the block.
+	GetStateRoot() common.Root
+}
+
+// BeaconState is the interface for the beacon state.
+type BeaconState[T any] interface {
+	// Copy returns a copy of the state, which is written to without
+	// affecting the original.
+	Copy() T
+	// GetSlot returns the slot of the state.
+	GetSlot() (math.Slot, error)
+	// HashTreeRoot returns the root of the state.
+	HashTreeRoot() common.Root
+}
+
+// BlockStore is the interface for the store of the blocks replayed.
+type BlockStore[SignedBeaconBlockT any] interface {
+	// Get retrieves the block at a given slot from the store.
+	Get(slot math.Slot) (SignedBeaconBlockT, error)
+}
+
+// SignedBeaconBlock is the interface for a signed beacon block.
+type SignedBeaconBlock[BeaconBlockT any] interface {
+	// GetMessage returns the signed block.
+	GetMessage() BeaconBlockT
+}
+
+// StateProcessor is the interface for the processor the blocks are replayed
+// through.
+type StateProcessor[BeaconBlockT, BeaconStateT any] interface {
+	// Transition applies the given block to the given state.
+	Transition(
+		*transition.Context, BeaconStateT, BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
+}
+
+// CheckpointStore is the interface for the store the checkpoints taken while
+// replaying are persisted to.
+type CheckpointStore[BeaconStateT any] interface {
+	// Get returns the checkpoint persisted at the given slot. The checkpoint
+	// at slot 0 is the genesis state.
+	Get(slot math.Slot) (BeaconStateT, error)
+	// Set persists the state as the checkpoint at the given slot.
+	Set(slot math.Slot, st BeaconStateT) error
+}
+
+// StateSource is the interface for the source of the committed states that
+// are still retained, and of the commits the replayed blocks carry.
+type StateSource[BeaconStateT any] interface {
+	// CommittedState returns the state committed at the given slot, failing
+	// if its height has been pruned.
+	CommittedState(slot math.Slot) (BeaconStateT, error)
+	// CommitVoters returns the CometBFT addresses of the validators whose
+	// votes for the previous block were committed in the last commit of the
+	// block at the given slot.
+	CommitVoters(slot math.Slot) ([][]byte, error)
+}
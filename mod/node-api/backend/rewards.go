@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+)
+
+// AttestationRewardsAtEpoch returns the rewards and penalties of the
+// validators with the given IDs, or of the whole active validator set if no
+// ID is given, for their participation in the given epoch.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttestationRewardsAtEpoch(
+	epoch math.Epoch,
+	ids []string,
+) (*types.AttestationRewardsData, error) {
+	// The participation in an epoch is rewarded when processing the end of
+	// the next epoch, i.e. on top of the state of its last slot.
+	slot := (epoch+2)*math.Slot(b.cs.SlotsPerEpoch()) - 1
+	_, head, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	if slot > head {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound, "epoch %d is not rewarded yet", epoch,
+		)
+	}
+
+	st, _, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := b.sp.AttestationDeltas(st)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]math.ValidatorIndex, 0, len(ids))
+	for _, id := range ids {
+		var index math.ValidatorIndex
+		if index, err = utils.ValidatorIndexByID(st, id); err != nil {
+			return nil, err
+		}
+		indices = append(indices, index)
+	}
+
+	rewards := &types.AttestationRewardsData{
+		IdealRewards: make([]*types.IdealAttestationReward, 0),
+		TotalRewards: make([]*types.TotalAttestationReward, 0),
+	}
+	var effectiveBalances []math.Gwei
+	for _, delta := range deltas.Validators {
+		if !slices.Contains(effectiveBalances, delta.EffectiveBalance) {
+			effectiveBalances = append(
+				effectiveBalances, delta.EffectiveBalance,
+			)
+		}
+		if len(indices) > 0 && !slices.Contains(indices, delta.Index) {
+			continue
+		}
+		rewards.TotalRewards = append(
+			rewards.TotalRewards, &types.TotalAttestationReward{
+				ValidatorIndex: delta.Index.Unwrap(),
+				Head:           flagDelta(delta, core.TimelyHeadFlagIndex),
+				Target:         flagDelta(delta, core.TimelyTargetFlagIndex),
+				Source:         flagDelta(delta, core.TimelySourceFlagIndex),
+				//#nosec:G701 // penalties are far below the int64 limit.
+				Inactivity: -int64(delta.InactivityPenalty),
+			},
+		)
+	}
+
+	slices.Sort(effectiveBalances)
+	for _, effectiveBalance := range effectiveBalances {
+		ideal := deltas.IdealRewards(
+			effectiveBalance, b.cs.EffectiveBalanceIncrement(),
+		)
+		//#nosec:G701 // rewards are far below the int64 limit.
+		rewards.IdealRewards = append(
+			rewards.IdealRewards, &types.IdealAttestationReward{
+				EffectiveBalance: effectiveBalance.Unwrap(),
+				Head:             int64(ideal[core.TimelyHeadFlagIndex]),
+				Target:           int64(ideal[core.TimelyTargetFlagIndex]),
+				Source:           int64(ideal[core.TimelySourceFlagIndex]),
+			},
+		)
+	}
+	return rewards, nil
+}
+
+// flagDelta returns the reward, or the penalty as a negative value, of the
+// validator for the given participation flag.
+func flagDelta(delta *core.AttestationDelta, flag int) int64 {
+	//#nosec:G701 // rewards and penalties are far below the int64 limit.
+	return int64(delta.Rewards[flag]) - int64(delta.Penalties[flag])
+}
+
+// ProposerIncome returns the income of the validator with the given ID from
+// the blocks it proposed in the [startSlot, endSlot] range.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProposerIncome(
+	id string,
+	startSlot, endSlot math.Slot,
+) (*types.ProposerIncomeData, error) {
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	index, err := utils.ValidatorIndexByID(st, id)
+	if err != nil {
+		return nil, err
+	}
+
+	income, err := b.sb.BlockStore().GetProposerIncome(
+		index, startSlot, endSlot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ProposerIncomeData{
+		ValidatorIndex:   income.ProposerIndex.Unwrap(),
+		StartSlot:        income.StartSlot.Unwrap(),
+		EndSlot:          income.EndSlot.Unwrap(),
+		Blocks:           income.Blocks,
+		ConsensusRewards: income.ProposerRewards.Unwrap(),
+		ExecutionValue:   income.ExecutionValue.Unwrap(),
+		Total:            income.Total().Unwrap(),
+	}, nil
+}
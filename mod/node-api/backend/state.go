@@ -34,6 +34,14 @@ func (b *Backend[
 	return b.stateFromSlotRaw(slot)
 }
 
+// StateAtSlot returns the post state of the block at the given slot, a slot
+// of 0 referring to the latest state, along with its slot.
+func (b *Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) StateAtSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
+	return b.stateFromSlotRaw(slot)
+}
+
 // GetStateRoot returns the root of the state at the given slot.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -25,9 +25,11 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // The AvailabilityStore interface is responsible for validating and storing
@@ -42,6 +44,9 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns the blob sidecars with the given indices (or
+	// all of them if none are given) for the block at the given slot.
+	GetBlobSidecars(math.Slot, []uint64) (BlobSidecarsT, error)
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -79,11 +84,33 @@ type BeaconState[
 
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
+	// Get retrieves the block at a given slot from the store.
+	Get(slot math.Slot) (BeaconBlockT, error)
 	// GetSlotByRoot retrieves the slot by a given root from the store.
 	GetSlotByRoot(root common.Root) (math.Slot, error)
 	// GetSlotByExecutionNumber retrieves the slot by a given execution number
 	// from the store.
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+	// GetRewards retrieves the rewards of the block at a given slot from the
+	// store.
+	GetRewards(slot math.Slot) (*transition.BlockRewards, error)
+	// GetProposerIncome aggregates the rewards of the blocks proposed by the
+	// given validator in the [start, end] slot range.
+	GetProposerIncome(
+		proposer math.ValidatorIndex,
+		start, end math.Slot,
+	) (*transition.ProposerIncome, error)
+}
+
+// CometClient is the interface for reading committed blocks from CometBFT. A
+// height of 0 refers to the latest committed height.
+type CometClient interface {
+	// SignedHeader returns the header and commit at the given height.
+	SignedHeader(height int64) (*cmttypes.SignedHeader, error)
+	// Block returns the block at the given height.
+	Block(height int64) (*cmttypes.Block, error)
+	// Validators returns the validator set at the given height.
+	Validators(height int64) ([]*cmttypes.Validator, error)
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -105,6 +132,16 @@ type Node[ContextT any] interface {
 
 type StateProcessor[BeaconStateT any] interface {
 	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
+	// AttestationDeltas returns the rewards and penalties of the active
+	// validator set for its participation in the previous epoch.
+	AttestationDeltas(BeaconStateT) (*core.AttestationDeltas, error)
+}
+
+// StateReplayer is the interface for regenerating the states of pruned
+// heights.
+type StateReplayer[BeaconStateT any] interface {
+	// StateAtSlot returns the state at the given slot.
+	StateAtSlot(slot math.Slot) (BeaconStateT, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -121,6 +158,17 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetExitEpoch returns the epoch at which the validator exits.
+	GetExitEpoch() math.Epoch
+	// GetWithdrawableEpoch returns the epoch at which the validator can
+	// withdraw.
+	GetWithdrawableEpoch() math.Epoch
+	// IsSlashed returns true if the validator has been slashed.
+	IsSlashed() bool
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
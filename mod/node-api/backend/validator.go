@@ -21,8 +21,11 @@
 package backend
 
 import (
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -34,7 +37,7 @@ func (b Backend[
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +45,70 @@ func (b Backend[
 	if err != nil {
 		return nil, err
 	}
+	return b.validatorData(st, b.cs.SlotToEpoch(slot), index)
+}
+
+// ValidatorsByIDs returns the validators with the given IDs, or all the
+// validators if no ID is given, filtered by status if any is given.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) ValidatorsByIDs(
+	slot math.Slot, ids []string, statuses []string,
+) ([]*beacontypes.ValidatorData[ValidatorT], error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	var indices []math.ValidatorIndex
+	if len(ids) == 0 {
+		var total uint64
+		if total, err = st.GetTotalValidators(); err != nil {
+			return nil, err
+		}
+		for i := range total {
+			indices = append(indices, math.ValidatorIndex(i))
+		}
+	}
+	for _, id := range ids {
+		// TODO: we can probably optimize this via a getAllValidators
+		// query and then filtering but blocked by the fact that IDs
+		// can be indices and the hard type only holds its own pubkey.
+		var index math.ValidatorIndex
+		if index, err = utils.ValidatorIndexByID(st, id); err != nil {
+			return nil, err
+		}
+		indices = append(indices, index)
+	}
+
+	epoch := b.cs.SlotToEpoch(slot)
+	validatorsData := make([]*beacontypes.ValidatorData[ValidatorT], 0)
+	for _, index := range indices {
+		var validatorData *beacontypes.ValidatorData[ValidatorT]
+		if validatorData, err = b.validatorData(
+			st, epoch, index,
+		); err != nil {
+			return nil, err
+		}
+		if len(statuses) > 0 &&
+			!slices.Contains(statuses, validatorData.Status) {
+			continue
+		}
+		validatorsData = append(validatorsData, validatorData)
+	}
+	return validatorsData, nil
+}
+
+// validatorData returns the validator at the given index along with its
+// balance and status.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _,
+]) validatorData(
+	st BeaconStateT,
+	epoch math.Epoch,
+	index math.ValidatorIndex,
+) (*beacontypes.ValidatorData[ValidatorT], error) {
 	validator, err := st.ValidatorByIndex(index)
 	if err != nil {
 		return nil, err
@@ -50,34 +117,53 @@ func (b Backend[
 	if err != nil {
 		return nil, err
 	}
+	power, err := st.GetValidatorPower(index)
+	if err != nil {
+		return nil, err
+	}
 	return &beacontypes.ValidatorData[ValidatorT]{
 		ValidatorBalanceData: beacontypes.ValidatorBalanceData{
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active", // TODO: fix
+		Status:    validatorStatus(validator, epoch, balance, power),
 		Validator: validator,
 	}, nil
 }
 
-// TODO: filter by status
-func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
-]) ValidatorsByIDs(
-	slot math.Slot, ids []string, _ []string,
-) ([]*beacontypes.ValidatorData[ValidatorT], error) {
-	validatorsData := make([]*beacontypes.ValidatorData[ValidatorT], 0)
-	for _, id := range ids {
-		// TODO: we can probably optimize this via a getAllValidators
-		// query and then filtering but blocked by the fact that IDs
-		// can be indices and the hard type only holds its own pubkey.
-		validatorData, err := b.ValidatorByID(slot, id)
-		if err != nil {
-			return nil, err
-		}
-		validatorsData = append(validatorsData, validatorData)
+// validatorStatus returns the status of the validator at the given epoch as
+// defined by the Beacon API. Validators are only active while they are part
+// of the CometBFT validator set, those waiting to enter it are pending.
+func validatorStatus[
+	ValidatorT interface {
+		GetExitEpoch() math.Epoch
+		GetWithdrawableEpoch() math.Epoch
+		IsSlashed() bool
+	},
+](
+	validator ValidatorT,
+	epoch math.Epoch,
+	balance, power math.Gwei,
+) string {
+	exitEpoch := validator.GetExitEpoch()
+	switch {
+	case exitEpoch == math.Epoch(constants.FarFutureEpoch) && power == 0:
+		return beacontypes.ValidatorStatusPendingQueued
+	case exitEpoch == math.Epoch(constants.FarFutureEpoch):
+		return beacontypes.ValidatorStatusActiveOngoing
+	case epoch < exitEpoch && validator.IsSlashed():
+		return beacontypes.ValidatorStatusActiveSlashed
+	case epoch < exitEpoch:
+		return beacontypes.ValidatorStatusActiveExiting
+	case epoch < validator.GetWithdrawableEpoch() && validator.IsSlashed():
+		return beacontypes.ValidatorStatusExitedSlashed
+	case epoch < validator.GetWithdrawableEpoch():
+		return beacontypes.ValidatorStatusExitedUnslashed
+	case balance > 0:
+		return beacontypes.ValidatorStatusWithdrawalPossible
+	default:
+		return beacontypes.ValidatorStatusWithdrawalDone
 	}
-	return validatorsData, nil
 }
 
 func (b Backend[
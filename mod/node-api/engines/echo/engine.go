@@ -22,9 +22,10 @@ package echo
 
 import (
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/validation"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 )
 
 // Engine is an implementation of the API engine interface using Echo.
@@ -40,27 +41,21 @@ func New(e *echo.Echo) *Engine {
 	}
 }
 
-// NewDefaultEngine returns a new default Echo Engine instance.
+// NewDefaultEngine returns a new default Echo Engine instance. CORS, along
+// with the rest of the hardening of the API, is handled by the server.
 func NewDefaultEngine() *Engine {
 	engine := echo.New()
-	engine.Use(middleware.CORSWithConfig(
-		middleware.DefaultCORSConfig,
-	))
+	engine.Binder = &Binder{}
 	engine.Validator = &CustomValidator{
-		Validator: ConstructValidator(),
+		Validator: validation.ConstructValidator(),
 	}
 	engine.HideBanner = true
 	return New(engine)
 }
 
-// Run starts the Echo engine at the given address.
-func (e *Engine) Run(addr string) error {
-	return e.Echo.Start(addr)
-}
-
 // RegisterRoutes registers the given route set with the Echo engine.
 func (e *Engine) RegisterRoutes(
-	hs *handlers.RouteSet[Context],
+	hs *handlers.RouteSet[apicontext.Context],
 	logger log.Logger[any],
 ) {
 	e.logger = logger
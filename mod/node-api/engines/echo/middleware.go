@@ -21,57 +21,27 @@
 package echo
 
 import (
-	"net/http"
-
-	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
 	"github.com/labstack/echo/v4"
 )
 
-// ErrorResponse is a response that is returned when an error occurs.
-type ErrorResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
-
 // responseMiddleware is a middleware that converts errors to an HTTP status
-// code and response.
+// code and response, and encodes the response in the negotiated encoding.
 func responseMiddleware(
-	handler *handlers.Route[Context],
+	handler *handlers.Route[apicontext.Context],
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
-		code, response := responseFromError(data, err)
-		return c.JSON(code, response)
-	}
-}
-
-// responseFromErr converts an error to an HTTP status code and response. If
-// the error is nil, the response is returned as is.
-func responseFromError(data any, err error) (int, any) {
-	switch {
-	case err == nil:
-		return http.StatusOK, data
-	case errors.Is(err, types.ErrNotFound):
-		return http.StatusNotFound, ErrorResponse{
-			Code:    http.StatusNotFound,
-			Message: err.Error(),
-		}
-	case errors.Is(err, types.ErrInvalidRequest):
-		return http.StatusBadRequest, ErrorResponse{
-			Code:    http.StatusBadRequest,
-			Message: err.Error(),
-		}
-	case errors.Is(err, types.ErrNotImplemented):
-		return http.StatusNotImplemented, ErrorResponse{
-			Code:    http.StatusNotImplemented,
-			Message: err.Error(),
-		}
-	default:
-		return http.StatusInternalServerError, ErrorResponse{
-			Code:    http.StatusInternalServerError,
-			Message: err.Error(),
+		resp := types.EncodeResponse(
+			c.Request().Header.Get(echo.HeaderAccept), data, err,
+		)
+		for key, values := range resp.Header {
+			c.Response().Header()[key] = values
 		}
+		return c.Blob(
+			resp.Code, resp.Header.Get(echo.HeaderContentType), resp.Body,
+		)
 	}
 }
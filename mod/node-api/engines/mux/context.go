@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mux
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// paramTag is the tag of the request fields bound to path parameters.
+	paramTag = "param"
+	// queryTag is the tag of the request fields bound to query parameters.
+	queryTag = "query"
+)
+
+// Context is the context of a request served by the net/http engine.
+type Context struct {
+	// Request is the request being served.
+	Request   *http.Request
+	validator *validator.Validate
+}
+
+// Bind binds the path parameters, the query parameters of GET, HEAD and
+// DELETE requests and the JSON or SSZ body of the request to the given struct.
+func (c *Context) Bind(i any) error {
+	if err := bindValues(i, paramTag, func(name string) []string {
+		if value := c.Request.PathValue(name); value != "" {
+			return []string{value}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		query := c.Request.URL.Query()
+		if err := bindValues(i, queryTag, func(name string) []string {
+			return query[name]
+		}); err != nil {
+			return err
+		}
+	}
+
+	if c.Request.ContentLength == 0 {
+		return nil
+	}
+	if types.IsSSZContentType(c.Request.Header.Get("Content-Type")) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidRequest, "%v", err)
+		}
+		return types.UnmarshalSSZRequest(body, i)
+	}
+	err := json.NewDecoder(c.Request.Body).Decode(i)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return errors.Wrapf(types.ErrInvalidRequest, "%v", err)
+	}
+	return nil
+}
+
+// Validate validates the given struct against its validate tags.
+func (c *Context) Validate(i any) error {
+	err := c.validator.Struct(i)
+	if err == nil {
+		return nil
+	}
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) == 0 {
+		return nil
+	}
+	return errors.Wrapf(
+		types.ErrInvalidRequest, "invalid %s: %v",
+		validationErrors[0].Field(), validationErrors[0].Value(),
+	)
+}
+
+// bindValues sets the string and string slice fields of the given struct,
+// including the ones of its embedded structs, that have the given tag to the
+// values looked up by the tag value.
+func bindValues(i any, tag string, lookup func(string) []string) error {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	return bindStruct(v.Elem(), tag, lookup)
+}
+
+func bindStruct(
+	v reflect.Value,
+	tag string,
+	lookup func(string) []string,
+) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		field, value := t.Field(i), v.Field(i)
+		if !value.CanSet() {
+			continue
+		}
+		if field.Anonymous && value.Kind() == reflect.Struct {
+			if err := bindStruct(value, tag, lookup); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := field.Tag.Get(tag)
+		if name == "" {
+			continue
+		}
+		values := lookup(name)
+		if len(values) == 0 {
+			continue
+		}
+		switch {
+		case value.Kind() == reflect.String:
+			value.SetString(values[0])
+		case value.Kind() == reflect.Slice &&
+			value.Type().Elem().Kind() == reflect.String:
+			slice := reflect.MakeSlice(value.Type(), len(values), len(values))
+			for j, s := range values {
+				slice.Index(j).SetString(s)
+			}
+			value.Set(slice)
+		default:
+			return errors.Wrapf(
+				types.ErrInvalidRequest,
+				"unsupported %s field %s", tag, field.Name,
+			)
+		}
+	}
+	return nil
+}
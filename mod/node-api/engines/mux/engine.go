@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mux
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/validation"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/go-playground/validator/v10"
+)
+
+// Engine is an implementation of the API engine interface using the
+// http.ServeMux of the standard library.
+type Engine struct {
+	mux       *http.ServeMux
+	validator *validator.Validate
+	logger    log.Logger[any]
+}
+
+// New initializes a new API engine validating requests with the given
+// validator.
+func New(validator *validator.Validate) *Engine {
+	return &Engine{
+		mux:       http.NewServeMux(),
+		validator: validator,
+	}
+}
+
+// NewDefaultEngine returns a new default net/http Engine instance.
+func NewDefaultEngine() *Engine {
+	return New(validation.ConstructValidator())
+}
+
+// ServeHTTP serves the given request with the handler of its route.
+func (e *Engine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mux.ServeHTTP(w, r)
+}
+
+// RegisterRoutes registers the given route set with the engine.
+func (e *Engine) RegisterRoutes(
+	hs *handlers.RouteSet[apicontext.Context],
+	logger log.Logger[any],
+) {
+	e.logger = logger
+	for _, route := range hs.Routes {
+		route.DecorateWithLogs(e.logger)
+		e.mux.Handle(
+			route.Method+" "+pattern(hs.BasePath, route.Path),
+			e.handler(route),
+		)
+	}
+}
+
+// handler returns the handler of the given route, which writes the data or
+// the error returned by the route as JSON.
+func (e *Engine) handler(
+	route *handlers.Route[apicontext.Context],
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := route.Handler(&Context{
+			Request:   r,
+			validator: e.validator,
+		})
+		resp := types.EncodeResponse(r.Header.Get("Accept"), data, err)
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(resp.Code)
+		if _, err = w.Write(resp.Body); err != nil {
+			e.logger.Error("failed to write response", "error", err)
+		}
+	})
+}
+
+// pattern converts the path of a route, with its parameters prefixed by a
+// colon, to a http.ServeMux pattern.
+func pattern(basePath, path string) string {
+	segments := strings.Split(
+		strings.Trim(basePath, "/")+"/"+strings.Trim(path, "/"), "/",
+	)
+	for i, segment := range segments {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return "/" + strings.Trim(strings.Join(segments, "/"), "/")
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mux_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/mux"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/stretchr/testify/require"
+)
+
+type validatorsRequest struct {
+	types.StateIDRequest
+	IDs []string `query:"id" validate:"dive,validator_id"`
+}
+
+type postValidatorsRequest struct {
+	types.StateIDRequest
+	IDs []string `json:"ids" validate:"dive,validator_id"`
+}
+
+// UnmarshalSSZ decodes a comma separated list of IDs, standing in for SSZ.
+func (r *postValidatorsRequest) UnmarshalSSZ(buf []byte) error {
+	r.IDs = strings.Split(string(buf), ",")
+	return nil
+}
+
+// bindAndReturn returns the request bound by the route, as its response.
+func bindAndReturn[RequestT any](c apicontext.Context) (any, error) {
+	req := new(RequestT)
+	if err := c.Bind(req); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func newEngine() *mux.Engine {
+	engine := mux.NewDefaultEngine()
+	engine.RegisterRoutes(
+		handlers.NewRouteSet(
+			"",
+			&handlers.Route[apicontext.Context]{
+				Method:  http.MethodGet,
+				Path:    "/eth/v1/beacon/states/:state_id/validators",
+				Handler: bindAndReturn[validatorsRequest],
+			},
+			&handlers.Route[apicontext.Context]{
+				Method:  http.MethodPost,
+				Path:    "eth/v1/beacon/states/:state_id/validators",
+				Handler: bindAndReturn[postValidatorsRequest],
+			},
+			&handlers.Route[apicontext.Context]{
+				Method: http.MethodGet,
+				Path:   "/eth/v1/node/health",
+				Handler: func(apicontext.Context) (any, error) {
+					return nil, types.ErrNotImplemented
+				},
+			},
+		),
+		noop.NewLogger[any](),
+	)
+	return engine
+}
+
+func TestEngine(t *testing.T) {
+	engine := newEngine()
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		accept      string
+		body        string
+		wantCode    int
+		wantIDs     []string
+	}{
+		{
+			name:     "path and query parameters",
+			method:   http.MethodGet,
+			target:   "/eth/v1/beacon/states/head/validators?id=1&id=0x01",
+			wantCode: http.StatusOK,
+			wantIDs:  []string{"1", "0x01"},
+		},
+		{
+			name:     "json body",
+			method:   http.MethodPost,
+			target:   "/eth/v1/beacon/states/head/validators",
+			body:     `{"ids":["2"]}`,
+			wantCode: http.StatusOK,
+			wantIDs:  []string{"2"},
+		},
+		{
+			name:        "ssz body",
+			method:      http.MethodPost,
+			target:      "/eth/v1/beacon/states/head/validators",
+			contentType: "application/octet-stream",
+			body:        "3,4",
+			wantCode:    http.StatusOK,
+			wantIDs:     []string{"3", "4"},
+		},
+		{
+			name:        "unsupported ssz body",
+			method:      http.MethodGet,
+			target:      "/eth/v1/beacon/states/head/validators",
+			contentType: "application/octet-stream",
+			body:        "3,4",
+			wantCode:    http.StatusUnsupportedMediaType,
+		},
+		{
+			name:     "unsupported ssz response",
+			method:   http.MethodGet,
+			target:   "/eth/v1/beacon/states/head/validators",
+			accept:   "application/octet-stream",
+			wantCode: http.StatusNotAcceptable,
+		},
+		{
+			name:     "invalid parameter",
+			method:   http.MethodGet,
+			target:   "/eth/v1/beacon/states/head/validators?id=x",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid body",
+			method:   http.MethodPost,
+			target:   "/eth/v1/beacon/states/head/validators",
+			body:     `{"ids":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "handler error",
+			method:   http.MethodGet,
+			target:   "/eth/v1/node/health",
+			wantCode: http.StatusNotImplemented,
+		},
+		{
+			name:     "unknown route",
+			method:   http.MethodGet,
+			target:   "/eth/v1/node/version",
+			wantCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				tt.method, tt.target, strings.NewReader(tt.body),
+			)
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set("Accept", tt.accept)
+			engine.ServeHTTP(rec, req)
+			require.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var res struct {
+				StateID string   `json:"StateID"`
+				IDs     []string `json:"ids"`
+			}
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+			require.Equal(t, "head", res.StateID)
+			require.Equal(t, tt.wantIDs, res.IDs)
+		})
+	}
+}
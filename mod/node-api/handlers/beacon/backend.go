@@ -21,19 +21,25 @@
 package beacon
 
 import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/lightclient"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // Backend is the interface for backend of the beacon API.
-type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
+type Backend[
+	BlockHeaderT, BlobSidecarsT, ForkT, SignedBeaconBlockT, ValidatorT any,
+] interface {
 	GenesisBackend
-	BlockBackend[BlockHeaderT]
+	BlockBackend[BlockHeaderT, SignedBeaconBlockT]
+	BlobBackend[BlobSidecarsT]
 	RandaoBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
+	LightClientBackend[BlockHeaderT]
+	RewardsBackend
 	GetSlotByRoot(root common.Root) (math.Slot, error)
 }
 
@@ -46,14 +52,47 @@ type HistoricalBackend[ForkT any] interface {
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type LightClientBackend[BeaconBlockHeaderT any] interface {
+	LightClientBootstrap(
+		root common.Root,
+	) (*lightclient.Bootstrap[BeaconBlockHeaderT], error)
+	LightClientUpdatesByRange(
+		startPeriod, count uint64,
+	) ([]*lightclient.Update[BeaconBlockHeaderT], error)
+	LightClientFinalityUpdate() (
+		*lightclient.Update[BeaconBlockHeaderT], error,
+	)
+	LightClientOptimisticUpdate() (
+		*lightclient.OptimisticUpdate[BeaconBlockHeaderT], error,
+	)
+}
+
+type RewardsBackend interface {
+	AttestationRewardsAtEpoch(
+		epoch math.Epoch,
+		ids []string,
+	) (*types.AttestationRewardsData, error)
+	ProposerIncome(
+		id string,
+		startSlot, endSlot math.Slot,
+	) (*types.ProposerIncomeData, error)
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
-type BlockBackend[BeaconBlockHeaderT any] interface {
+type BlockBackend[BeaconBlockHeaderT, SignedBeaconBlockT any] interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	SignedBlockAtSlot(slot math.Slot) (SignedBeaconBlockT, error)
+}
+
+type BlobBackend[BlobSidecarsT any] interface {
+	BlobSidecarsAtSlot(
+		slot math.Slot, indices []uint64,
+	) (BlobSidecarsT, error)
 }
 
 type StateBackend[ForkT any] interface {
@@ -23,9 +23,39 @@ package beacon
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetBlock(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.SignedBlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.BlockResponse{
+		Version: version.Name(blk.Version()),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           false, // stubbed
+			Data:                blk,
+		},
+	}, nil
+}
+
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetBlockRewards(
+	c ContextT,
+) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
 	)
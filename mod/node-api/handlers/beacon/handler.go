@@ -29,24 +29,40 @@ import (
 // Handler is the handler for the beacon API.
 type Handler[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ForkT any,
+	SignedBeaconBlockT types.SignedBeaconBlock,
 	ValidatorT any,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	backend Backend[
+		BeaconBlockHeaderT, BlobSidecarsT, ForkT, SignedBeaconBlockT, ValidatorT,
+	]
 }
 
 // NewHandler creates a new handler for the beacon API.
 func NewHandler[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ForkT any,
+	SignedBeaconBlockT types.SignedBeaconBlock,
 	ValidatorT any,
 ](
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT],
-) *Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT] {
-	h := &Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT]{
+	backend Backend[
+		BeaconBlockHeaderT, BlobSidecarsT, ForkT, SignedBeaconBlockT, ValidatorT,
+	],
+) *Handler[
+	BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT, ForkT,
+	SignedBeaconBlockT, ValidatorT,
+] {
+	h := &Handler[
+		BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT, ForkT,
+		SignedBeaconBlockT, ValidatorT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
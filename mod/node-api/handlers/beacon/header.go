@@ -27,7 +27,7 @@ import (
 )
 
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	BeaconBlockHeaderT, _, _, ContextT, _, _, _,
 ]) GetBlockHeaders(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeadersRequest](
 		c, h.Logger(),
@@ -58,7 +58,7 @@ func (h *Handler[
 }
 
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	BeaconBlockHeaderT, _, _, ContextT, _, _, _,
 ]) GetBlockHeaderByID(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeaderRequest](
 		c, h.Logger(),
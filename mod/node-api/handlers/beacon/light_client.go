@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// GetLightClientBootstrap returns the light client bootstrap for the trusted
+// beacon block root, which contains the validator set that signs the
+// CometBFT block of the beacon block.
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetLightClientBootstrap(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[types.GetLightClientBootstrapRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	root, err := common.NewRootFromHex(req.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	bootstrap, err := h.backend.LightClientBootstrap(root)
+	if err != nil {
+		return nil, err
+	}
+	return handlertypes.Wrap(bootstrap), nil
+}
+
+// GetLightClientUpdates returns the light client updates for a range of
+// periods, each of which finalizes the last beacon block of an epoch.
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetLightClientUpdates(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[types.GetLightClientUpdatesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	startPeriod, err := strconv.ParseUint(req.StartPeriod, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.ParseUint(req.Count, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := h.backend.LightClientUpdatesByRange(startPeriod, count)
+	if err != nil {
+		return nil, err
+	}
+	return handlertypes.Wrap(updates), nil
+}
+
+// GetLightClientFinalityUpdate returns the light client update that
+// finalizes the latest committed beacon block.
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetLightClientFinalityUpdate(
+	ContextT,
+) (any, error) {
+	update, err := h.backend.LightClientFinalityUpdate()
+	if err != nil {
+		return nil, err
+	}
+	return handlertypes.Wrap(update), nil
+}
+
+// GetLightClientOptimisticUpdate returns the optimistic light client update
+// for the latest committed beacon block.
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetLightClientOptimisticUpdate(
+	ContextT,
+) (any, error) {
+	update, err := h.backend.LightClientOptimisticUpdate()
+	if err != nil {
+		return nil, err
+	}
+	return handlertypes.Wrap(update), nil
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/itsdevbear/comet-bls12-381/bls/blst"
+)
+
+// maxSigningMsgLen is the maximum length of a message CometBFT validators
+// sign as is. Longer messages, such as votes, are signed by their SHA-256
+// digest.
+const maxSigningMsgLen = 32
+
+// verifySignedHeader checks that the commit of the signed header commits to
+// its header on the given chain.
+func verifySignedHeader(chainID string, sh *cmttypes.SignedHeader) error {
+	if sh == nil || sh.Header == nil || sh.Commit == nil {
+		return ErrInvalidUpdate
+	}
+	if sh.ChainID != chainID {
+		return errors.Wrapf(
+			ErrChainIDMismatch, "expected %s, got %s", chainID, sh.ChainID,
+		)
+	}
+	if sh.Commit.Height != sh.Height ||
+		!bytes.Equal(sh.Commit.BlockID.Hash, sh.Hash()) {
+		return errors.Wrapf(ErrCommitMismatch, "height %d", sh.Height)
+	}
+	return nil
+}
+
+// verifyValidatorSet checks that the validator set hashes to the validators
+// hash of the CometBFT header.
+func verifyValidatorSet(sh *cmttypes.SignedHeader, vals []*Validator) error {
+	hash, err := ValidatorSetHash(vals)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, sh.ValidatorsHash) {
+		return errors.Wrapf(ErrValidatorSetMismatch, "height %d", sh.Height)
+	}
+	return nil
+}
+
+// verifyCommit verifies the signatures of the commit of the signed header
+// and returns the validators that signed for the block. The signatures are
+// ordered as the validator set.
+func verifyCommit(
+	chainID string,
+	sh *cmttypes.SignedHeader,
+	vals []*Validator,
+) ([]*Validator, error) {
+	commit := sh.Commit
+	if len(commit.Signatures) != len(vals) {
+		return nil, errors.Wrapf(
+			ErrCommitMismatch, "expected %d signatures, got %d",
+			len(vals), len(commit.Signatures),
+		)
+	}
+
+	signers := make([]*Validator, 0, len(vals))
+	for i, sig := range commit.Signatures {
+		// Absent and nil votes do not finalize the block.
+		if sig.BlockIDFlag != cmttypes.BlockIDFlagCommit {
+			continue
+		}
+		if !bytes.Equal(sig.ValidatorAddress, vals[i].Address()) {
+			return nil, errors.Wrapf(
+				ErrUnknownSigner, "signature %d from %s",
+				i, sig.ValidatorAddress,
+			)
+		}
+		//#nosec:G701 // the index is bounded by the validator set size.
+		if !blst.VerifySignaturePubkeyBytes(
+			vals[i].PubKey[:],
+			signingMessage(commit.VoteSignBytes(chainID, int32(i))),
+			sig.Signature,
+		) {
+			return nil, errors.Wrapf(
+				ErrInvalidSignature, "height %d, validator %d", sh.Height, i,
+			)
+		}
+		signers = append(signers, vals[i])
+	}
+	if len(signers) == 0 {
+		return nil, ErrInsufficientVotingPower
+	}
+	return signers, nil
+}
+
+// signingMessage returns the message a CometBFT validator signs for the
+// given sign bytes.
+func signingMessage(signBytes []byte) []byte {
+	if len(signBytes) > maxSigningMsgLen {
+		digest := sha256.Sum256(signBytes)
+		return digest[:]
+	}
+	return signBytes
+}
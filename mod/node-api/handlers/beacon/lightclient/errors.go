@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidValidator is returned when a validator has no BLS public key
+	// or no voting power.
+	ErrInvalidValidator = errors.New("invalid validator")
+
+	// ErrInvalidBootstrap is returned when a bootstrap is incomplete.
+	ErrInvalidBootstrap = errors.New("invalid bootstrap")
+
+	// ErrInvalidUpdate is returned when an update is incomplete.
+	ErrInvalidUpdate = errors.New("invalid update")
+
+	// ErrUntrustedBlockRoot is returned when a bootstrap is for another
+	// beacon block root than the trusted one.
+	ErrUntrustedBlockRoot = errors.New("untrusted beacon block root")
+
+	// ErrChainIDMismatch is returned when a CometBFT header is for another
+	// chain.
+	ErrChainIDMismatch = errors.New("chain id mismatch")
+
+	// ErrCommitMismatch is returned when a commit does not commit to the
+	// CometBFT header it is served with.
+	ErrCommitMismatch = errors.New("commit does not match header")
+
+	// ErrValidatorSetMismatch is returned when a validator set does not hash
+	// to the validators hash of the CometBFT header.
+	ErrValidatorSetMismatch = errors.New("validator set does not match header")
+
+	// ErrInvalidBlockProof is returned when a beacon block is not the beacon
+	// block transaction of the CometBFT block.
+	ErrInvalidBlockProof = errors.New("invalid beacon block proof")
+
+	// ErrBlockHeaderMismatch is returned when a beacon block header does not
+	// match the beacon block of the CometBFT block.
+	ErrBlockHeaderMismatch = errors.New(
+		"beacon block header does not match beacon block",
+	)
+
+	// ErrStaleUpdate is returned when an update is not newer than the header
+	// the light client already follows.
+	ErrStaleUpdate = errors.New("stale update")
+
+	// ErrUnknownSigner is returned when a commit signature is not from the
+	// validator at its position in the validator set.
+	ErrUnknownSigner = errors.New("unknown commit signer")
+
+	// ErrInvalidSignature is returned when a commit signature does not
+	// verify.
+	ErrInvalidSignature = errors.New("invalid commit signature")
+
+	// ErrInsufficientVotingPower is returned when the signers of a commit do
+	// not hold enough voting power.
+	ErrInsufficientVotingPower = errors.New("insufficient voting power")
+)
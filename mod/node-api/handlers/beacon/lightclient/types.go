@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// BeaconBlock is the interface for the signed beacon block that is carried as
+// the first transaction of every CometBFT block.
+type BeaconBlock[BeaconBlockT any] interface {
+	// NewFromSSZ decodes a beacon block of the given fork version.
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+	// GetSlot returns the slot of the beacon block.
+	GetSlot() math.Slot
+	// GetBlockRoot returns the root of the beacon block, which is the root of
+	// its header rather than of the signed envelope.
+	GetBlockRoot() common.Root
+}
+
+// BeaconBlockHeader is the interface for the beacon block header a light
+// client follows.
+type BeaconBlockHeader interface {
+	// GetSlot returns the slot of the beacon block header.
+	GetSlot() math.Slot
+	// HashTreeRoot returns the root of the beacon block header, which is the
+	// root of the beacon block it was derived from.
+	HashTreeRoot() common.Root
+}
+
+// ChainSpec is the part of the chain spec the verifier needs to decode beacon
+// blocks.
+type ChainSpec interface {
+	// ActiveForkVersionForSlot returns the active fork version for a slot.
+	ActiveForkVersionForSlot(slot math.Slot) uint32
+}
+
+// BlockProof proves that a beacon block is the beacon block transaction of a
+// CometBFT block, i.e. that it is committed to by the data hash of the
+// CometBFT header.
+type BlockProof struct {
+	// Block is the SSZ encoded beacon block.
+	Block bytes.Bytes `json:"block"`
+	// Proof is the merkle proof of the block transaction against the data
+	// hash of the CometBFT header.
+	Proof merkle.Proof `json:"proof"`
+}
+
+// Bootstrap is the starting point of a light client. It is served for a
+// trusted beacon block root and contains the validator set that signs the
+// CometBFT block of that beacon block.
+type Bootstrap[BeaconBlockHeaderT any] struct {
+	// Header is the beacon block header of the trusted beacon block root.
+	Header BeaconBlockHeaderT `json:"header"`
+	// SignedHeader is the CometBFT header and commit of the block height
+	// that carries the beacon block.
+	SignedHeader *cmttypes.SignedHeader `json:"signed_header"`
+	// Validators is the CometBFT validator set at the block height.
+	Validators []*Validator `json:"current_validators"`
+	// BlockProof links the beacon block header to the CometBFT header.
+	BlockProof *BlockProof `json:"block_proof"`
+}
+
+// Update finalizes a beacon block header. The header is finalized by the
+// CometBFT commit of the block height that carries it, which is signed by
+// the validator set of the update. Light clients follow validator set
+// changes by verifying that enough of their trusted validators signed the
+// commit as well.
+type Update[BeaconBlockHeaderT any] struct {
+	// AttestedHeader is the beacon block header finalized by the update.
+	AttestedHeader BeaconBlockHeaderT `json:"attested_header"`
+	// SignedHeader is the CometBFT header and commit of the block height
+	// that carries the beacon block.
+	SignedHeader *cmttypes.SignedHeader `json:"signed_header"`
+	// Validators is the CometBFT validator set that signed the commit.
+	Validators []*Validator `json:"validators"`
+	// BlockProof links the beacon block header to the CometBFT header.
+	BlockProof *BlockProof `json:"block_proof"`
+}
+
+// OptimisticUpdate is a lighter update that omits the validator set. It can
+// only be verified by light clients whose trusted validator set signed the
+// commit, and it only advances their optimistic header.
+type OptimisticUpdate[BeaconBlockHeaderT any] struct {
+	// AttestedHeader is the beacon block header attested by the update.
+	AttestedHeader BeaconBlockHeaderT `json:"attested_header"`
+	// SignedHeader is the CometBFT header and commit of the block height
+	// that carries the beacon block.
+	SignedHeader *cmttypes.SignedHeader `json:"signed_header"`
+	// BlockProof links the beacon block header to the CometBFT header.
+	BlockProof *BlockProof `json:"block_proof"`
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Validator is a member of a CometBFT validator set.
+type Validator struct {
+	// PubKey is the BLS public key of the validator.
+	PubKey crypto.BLSPubkey `json:"pubkey"`
+	// VotingPower is the voting power of the validator.
+	VotingPower math.U64 `json:"voting_power"`
+}
+
+// NewValidatorFromComet converts a CometBFT validator.
+func NewValidatorFromComet(val *cmttypes.Validator) (*Validator, error) {
+	if val == nil || val.PubKey == nil {
+		return nil, ErrInvalidValidator
+	}
+	pubkey := val.PubKey.Bytes()
+	if len(pubkey) != len(crypto.BLSPubkey{}) || val.VotingPower <= 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidValidator, "validator %s", val.Address,
+		)
+	}
+	return &Validator{
+		PubKey:      crypto.BLSPubkey(pubkey),
+		VotingPower: math.U64(val.VotingPower),
+	}, nil
+}
+
+// Address returns the CometBFT address of the validator, which is the
+// truncated SHA-256 hash of its public key.
+func (v *Validator) Address() []byte {
+	return tmhash.SumTruncated(v.PubKey[:])
+}
+
+// Bytes returns the encoding of the validator that CometBFT hashes into the
+// validator set hash.
+func (v *Validator) Bytes() ([]byte, error) {
+	//#nosec:G701 // voting powers are bounded by CometBFT.
+	return (&cmtproto.SimpleValidator{
+		PubKey: &cmtcrypto.PublicKey{
+			Sum: &cmtcrypto.PublicKey_Bls12381{Bls12381: v.PubKey[:]},
+		},
+		VotingPower: int64(v.VotingPower),
+	}).Marshal()
+}
+
+// ValidatorSetHash returns the hash of a validator set as it is committed to
+// by the validators hash of a CometBFT header.
+func ValidatorSetHash(vals []*Validator) ([]byte, error) {
+	var err error
+	bzs := make([][]byte, len(vals))
+	for i, val := range vals {
+		if bzs[i], err = val.Bytes(); err != nil {
+			return nil, err
+		}
+	}
+	return merkle.HashFromByteSlices(bzs), nil
+}
+
+// totalVotingPower returns the total voting power of a validator set.
+func totalVotingPower(vals []*Validator) math.U64 {
+	var total math.U64
+	for _, val := range vals {
+		total += val.VotingPower
+	}
+	return total
+}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// BeaconBlockTxIndex is the index of the beacon block transaction in a
+// CometBFT block.
+const BeaconBlockTxIndex = 0
+
+// Verifier follows the beacon chain from a trusted beacon block root. It only
+// accepts beacon block headers that are finalized by CometBFT commits, whose
+// signatures it checks with BLS aggregate verification.
+type Verifier[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconBlockHeaderT BeaconBlockHeader,
+] struct {
+	chainID string
+	cs      ChainSpec
+	// finalized is the latest finalized beacon block header.
+	finalized BeaconBlockHeaderT
+	// optimistic is the latest beacon block header attested by the trusted
+	// validator set.
+	optimistic BeaconBlockHeaderT
+	// trusted is the CometBFT header of the finalized beacon block header.
+	trusted *cmttypes.SignedHeader
+	// validators is the validator set of the trusted CometBFT header.
+	validators []*Validator
+}
+
+// NewVerifier creates a new verifier from a bootstrap for the trusted beacon
+// block root.
+func NewVerifier[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconBlockHeaderT BeaconBlockHeader,
+](
+	chainID string,
+	cs ChainSpec,
+	trustedRoot common.Root,
+	bootstrap *Bootstrap[BeaconBlockHeaderT],
+) (*Verifier[BeaconBlockT, BeaconBlockHeaderT], error) {
+	if bootstrap == nil || bootstrap.SignedHeader == nil {
+		return nil, ErrInvalidBootstrap
+	}
+	if root := bootstrap.Header.HashTreeRoot(); root != trustedRoot {
+		return nil, errors.Wrapf(
+			ErrUntrustedBlockRoot, "expected %s, got %s", trustedRoot, root,
+		)
+	}
+
+	v := &Verifier[BeaconBlockT, BeaconBlockHeaderT]{
+		chainID: chainID,
+		cs:      cs,
+	}
+	sh := bootstrap.SignedHeader
+	if err := verifySignedHeader(chainID, sh); err != nil {
+		return nil, err
+	}
+	if err := verifyValidatorSet(sh, bootstrap.Validators); err != nil {
+		return nil, err
+	}
+	if err := v.verifyBlock(
+		sh, bootstrap.Header, bootstrap.BlockProof,
+	); err != nil {
+		return nil, err
+	}
+
+	v.finalized = bootstrap.Header
+	v.optimistic = bootstrap.Header
+	v.trusted = sh
+	v.validators = bootstrap.Validators
+	return v, nil
+}
+
+// FinalizedHeader returns the latest finalized beacon block header.
+func (v *Verifier[_, BeaconBlockHeaderT]) FinalizedHeader() BeaconBlockHeaderT {
+	return v.finalized
+}
+
+// OptimisticHeader returns the latest beacon block header attested by the
+// trusted validator set.
+func (v *Verifier[
+	_, BeaconBlockHeaderT,
+]) OptimisticHeader() BeaconBlockHeaderT {
+	return v.optimistic
+}
+
+// Validators returns the trusted validator set.
+func (v *Verifier[_, _]) Validators() []*Validator {
+	return v.validators
+}
+
+// ProcessUpdate verifies an update and makes its header the finalized
+// header. More than two thirds of the validator set of the update must have
+// signed its commit. A validator set other than the trusted one is only
+// accepted if it is the next validator set of the trusted CometBFT header,
+// or if more than one third of the trusted validator set signed the commit.
+func (v *Verifier[_, BeaconBlockHeaderT]) ProcessUpdate(
+	update *Update[BeaconBlockHeaderT],
+) error {
+	if update == nil || update.SignedHeader == nil {
+		return ErrInvalidUpdate
+	}
+
+	sh := update.SignedHeader
+	if err := verifySignedHeader(v.chainID, sh); err != nil {
+		return err
+	}
+	if sh.Height <= v.trusted.Height {
+		return errors.Wrapf(
+			ErrStaleUpdate, "height %d, finalized height %d",
+			sh.Height, v.trusted.Height,
+		)
+	}
+	if err := verifyValidatorSet(sh, update.Validators); err != nil {
+		return err
+	}
+
+	signers, err := verifyCommit(v.chainID, sh, update.Validators)
+	if err != nil {
+		return err
+	}
+	if !hasQuorum(signers, update.Validators, 2, 3) {
+		return errors.Wrapf(
+			ErrInsufficientVotingPower, "height %d", sh.Height,
+		)
+	}
+	if !v.trustsValidatorSet(sh) &&
+		!hasQuorum(signers, v.validators, 1, 3) {
+		return errors.Wrapf(
+			ErrInsufficientVotingPower,
+			"trusted validators at height %d", sh.Height,
+		)
+	}
+
+	if err = v.verifyBlock(
+		sh, update.AttestedHeader, update.BlockProof,
+	); err != nil {
+		return err
+	}
+
+	v.finalized = update.AttestedHeader
+	if v.optimistic.GetSlot() < v.finalized.GetSlot() {
+		v.optimistic = update.AttestedHeader
+	}
+	v.trusted = sh
+	v.validators = update.Validators
+	return nil
+}
+
+// ProcessOptimisticUpdate verifies an optimistic update and makes its header
+// the optimistic header. More than two thirds of the trusted validator set
+// must have signed its commit.
+func (v *Verifier[_, BeaconBlockHeaderT]) ProcessOptimisticUpdate(
+	update *OptimisticUpdate[BeaconBlockHeaderT],
+) error {
+	if update == nil || update.SignedHeader == nil {
+		return ErrInvalidUpdate
+	}
+
+	sh := update.SignedHeader
+	if err := verifySignedHeader(v.chainID, sh); err != nil {
+		return err
+	}
+	//#nosec:G701 // heights are never negative.
+	if math.Slot(sh.Height) <= v.optimistic.GetSlot() {
+		return errors.Wrapf(
+			ErrStaleUpdate, "height %d, optimistic slot %d",
+			sh.Height, v.optimistic.GetSlot(),
+		)
+	}
+	if err := verifyValidatorSet(sh, v.validators); err != nil {
+		return err
+	}
+
+	signers, err := verifyCommit(v.chainID, sh, v.validators)
+	if err != nil {
+		return err
+	}
+	if !hasQuorum(signers, v.validators, 2, 3) {
+		return errors.Wrapf(
+			ErrInsufficientVotingPower, "height %d", sh.Height,
+		)
+	}
+
+	if err = v.verifyBlock(
+		sh, update.AttestedHeader, update.BlockProof,
+	); err != nil {
+		return err
+	}
+	v.optimistic = update.AttestedHeader
+	return nil
+}
+
+// trustsValidatorSet reports whether the validator set of the CometBFT
+// header is the trusted one, or the next one announced by the trusted
+// CometBFT header.
+func (v *Verifier[_, _]) trustsValidatorSet(sh *cmttypes.SignedHeader) bool {
+	return bytes.Equal(sh.ValidatorsHash, v.trusted.ValidatorsHash) ||
+		(sh.Height == v.trusted.Height+1 &&
+			bytes.Equal(sh.ValidatorsHash, v.trusted.NextValidatorsHash))
+}
+
+// verifyBlock checks that the beacon block header is the header of the beacon
+// block transaction of the CometBFT block.
+func (v *Verifier[BeaconBlockT, BeaconBlockHeaderT]) verifyBlock(
+	sh *cmttypes.SignedHeader,
+	header BeaconBlockHeaderT,
+	proof *BlockProof,
+) error {
+	if proof == nil {
+		return ErrInvalidBlockProof
+	}
+	if proof.Proof.Index != BeaconBlockTxIndex {
+		return errors.Wrapf(
+			ErrInvalidBlockProof, "transaction index %d", proof.Proof.Index,
+		)
+	}
+	if err := proof.Proof.Verify(
+		sh.DataHash, tmhash.Sum(proof.Block),
+	); err != nil {
+		return errors.Wrap(ErrInvalidBlockProof, err.Error())
+	}
+
+	var blk BeaconBlockT
+	//#nosec:G701 // heights are never negative.
+	slot := math.Slot(sh.Height)
+	blk, err := blk.NewFromSSZ(
+		proof.Block, v.cs.ActiveForkVersionForSlot(slot),
+	)
+	if err != nil {
+		return errors.Wrap(ErrInvalidBlockProof, err.Error())
+	}
+	if blk.GetSlot() != slot || header.GetSlot() != slot ||
+		blk.GetBlockRoot() != header.HashTreeRoot() {
+		return errors.Wrapf(ErrBlockHeaderMismatch, "slot %d", slot)
+	}
+	return nil
+}
+
+// hasQuorum reports whether the signers hold more than num/den of the voting
+// power of the validator set.
+func hasQuorum(signers, vals []*Validator, num, den math.U64) bool {
+	signed := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		signed[string(signer.PubKey[:])] = struct{}{}
+	}
+
+	var power math.U64
+	for _, val := range vals {
+		if _, ok := signed[string(val.PubKey[:])]; ok {
+			power += val.VotingPower
+		}
+	}
+	return power*den > totalVotingPower(vals)*num
+}
@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/lightclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/itsdevbear/comet-bls12-381/bls"
+	"github.com/itsdevbear/comet-bls12-381/bls/blst"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "beacond-test"
+
+// testBlock is a minimal beacon block whose SSZ encoding is its slot followed
+// by its body root.
+type testBlock struct {
+	slot     math.Slot
+	bodyRoot common.Root
+}
+
+func (*testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	if len(bz) != 40 {
+		return nil, errors.New("invalid block")
+	}
+	return &testBlock{
+		slot:     math.Slot(binary.LittleEndian.Uint64(bz)),
+		bodyRoot: common.Root(bz[8:]),
+	}, nil
+}
+
+func (b *testBlock) GetSlot() math.Slot { return b.slot }
+
+func (b *testBlock) GetBlockRoot() common.Root {
+	return b.header().HashTreeRoot()
+}
+
+func (b *testBlock) header() *testHeader {
+	return &testHeader{Slot: b.slot, BodyRoot: b.bodyRoot}
+}
+
+func (b *testBlock) marshal() []byte {
+	bz := binary.LittleEndian.AppendUint64(nil, b.slot.Unwrap())
+	return append(bz, b.bodyRoot[:]...)
+}
+
+type testHeader struct {
+	Slot     math.Slot   `json:"slot"`
+	BodyRoot common.Root `json:"body_root"`
+}
+
+func (h *testHeader) GetSlot() math.Slot { return h.Slot }
+
+func (h *testHeader) HashTreeRoot() common.Root {
+	return sha256.Sum256(
+		append(binary.LittleEndian.AppendUint64(nil, h.Slot.Unwrap()),
+			h.BodyRoot[:]...),
+	)
+}
+
+type testChainSpec struct{}
+
+func (testChainSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+type testValidator struct {
+	sk  bls.SecretKey
+	val *lightclient.Validator
+}
+
+func newTestValidators(t *testing.T, seed byte, n int) []*testValidator {
+	t.Helper()
+	vals := make([]*testValidator, n)
+	for i := range vals {
+		secret := make([]byte, 32)
+		secret[1], secret[2], secret[31] = seed, byte(i), 1
+		sk, err := blst.SecretKeyFromBytes(secret)
+		require.NoError(t, err)
+		vals[i] = &testValidator{
+			sk: sk,
+			val: &lightclient.Validator{
+				PubKey:      crypto.BLSPubkey(sk.PublicKey().Marshal()),
+				VotingPower: 10,
+			},
+		}
+	}
+	return vals
+}
+
+func validatorSet(vals []*testValidator) []*lightclient.Validator {
+	set := make([]*lightclient.Validator, len(vals))
+	for i, val := range vals {
+		set[i] = val.val
+	}
+	return set
+}
+
+// signedBlock builds the CometBFT block at the given height that carries a
+// beacon block, and has it signed by the given number of validators.
+func signedBlock(
+	t *testing.T,
+	height int64,
+	vals, nextVals []*testValidator,
+	signers int,
+) (*testHeader, *cmttypes.SignedHeader, *lightclient.BlockProof) {
+	t.Helper()
+	//#nosec:G701 // test heights are small.
+	blk := &testBlock{
+		slot:     math.Slot(height),
+		bodyRoot: common.Root{byte(height)},
+	}
+	txs := cmttypes.Txs{blk.marshal(), []byte("sidecars")}
+
+	valsHash, err := lightclient.ValidatorSetHash(validatorSet(vals))
+	require.NoError(t, err)
+	nextValsHash, err := lightclient.ValidatorSetHash(validatorSet(nextVals))
+	require.NoError(t, err)
+
+	header := &cmttypes.Header{
+		ChainID:            testChainID,
+		Height:             height,
+		Time:               time.Unix(height, 0).UTC(),
+		DataHash:           txs.Hash(),
+		ValidatorsHash:     valsHash,
+		NextValidatorsHash: nextValsHash,
+		ProposerAddress:    vals[0].val.Address(),
+	}
+	commit := &cmttypes.Commit{
+		Height: height,
+		BlockID: cmttypes.BlockID{
+			Hash: header.Hash(),
+			PartSetHeader: cmttypes.PartSetHeader{
+				Total: 1, Hash: make([]byte, 32),
+			},
+		},
+		Signatures: make([]cmttypes.CommitSig, len(vals)),
+	}
+	for i, val := range vals {
+		if i >= signers {
+			commit.Signatures[i] = cmttypes.NewCommitSigAbsent()
+			continue
+		}
+		commit.Signatures[i] = cmttypes.CommitSig{
+			BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+			ValidatorAddress: val.val.Address(),
+			Timestamp:        header.Time.Add(time.Duration(i)),
+		}
+	}
+	for i := range signers {
+		//#nosec:G701 // test validator sets are small.
+		msg := commit.VoteSignBytes(testChainID, int32(i))
+		if len(msg) > 32 {
+			digest := sha256.Sum256(msg)
+			msg = digest[:]
+		}
+		commit.Signatures[i].Signature = vals[i].sk.Sign(msg).Marshal()
+	}
+
+	return blk.header(),
+		&cmttypes.SignedHeader{Header: header, Commit: commit},
+		&lightclient.BlockProof{Block: blk.marshal(), Proof: txs.Proof(0).Proof}
+}
+
+func newUpdate(
+	t *testing.T,
+	height int64,
+	vals, nextVals []*testValidator,
+	signers int,
+) *lightclient.Update[*testHeader] {
+	t.Helper()
+	header, sh, proof := signedBlock(t, height, vals, nextVals, signers)
+	return &lightclient.Update[*testHeader]{
+		AttestedHeader: header,
+		SignedHeader:   sh,
+		Validators:     validatorSet(vals),
+		BlockProof:     proof,
+	}
+}
+
+func newVerifier(
+	t *testing.T,
+	vals []*testValidator,
+) *lightclient.Verifier[*testBlock, *testHeader] {
+	t.Helper()
+	header, sh, proof := signedBlock(t, 1, vals, vals, len(vals))
+	v, err := lightclient.NewVerifier[*testBlock](
+		testChainID, testChainSpec{}, header.HashTreeRoot(),
+		&lightclient.Bootstrap[*testHeader]{
+			Header:       header,
+			SignedHeader: sh,
+			Validators:   validatorSet(vals),
+			BlockProof:   proof,
+		},
+	)
+	require.NoError(t, err)
+	return v
+}
+
+func TestNewVerifier(t *testing.T) {
+	vals := newTestValidators(t, 1, 4)
+	v := newVerifier(t, vals)
+	require.Equal(t, math.Slot(1), v.FinalizedHeader().GetSlot())
+	require.Equal(t, math.Slot(1), v.OptimisticHeader().GetSlot())
+	require.Equal(t, validatorSet(vals), v.Validators())
+
+	header, sh, proof := signedBlock(t, 1, vals, vals, len(vals))
+	bootstrap := &lightclient.Bootstrap[*testHeader]{
+		Header:       header,
+		SignedHeader: sh,
+		Validators:   validatorSet(vals),
+		BlockProof:   proof,
+	}
+
+	// Another trusted root must not verify.
+	_, err := lightclient.NewVerifier[*testBlock](
+		testChainID, testChainSpec{}, common.Root{1}, bootstrap,
+	)
+	require.ErrorIs(t, err, lightclient.ErrUntrustedBlockRoot)
+
+	// Another chain must not verify.
+	_, err = lightclient.NewVerifier[*testBlock](
+		"other", testChainSpec{}, header.HashTreeRoot(), bootstrap,
+	)
+	require.ErrorIs(t, err, lightclient.ErrChainIDMismatch)
+
+	// Another validator set must not verify.
+	bootstrap.Validators = validatorSet(vals[1:])
+	_, err = lightclient.NewVerifier[*testBlock](
+		testChainID, testChainSpec{}, header.HashTreeRoot(), bootstrap,
+	)
+	require.ErrorIs(t, err, lightclient.ErrValidatorSetMismatch)
+}
+
+func TestProcessUpdate(t *testing.T) {
+	vals := newTestValidators(t, 1, 4)
+	v := newVerifier(t, vals)
+
+	// Three of four validators hold more than two thirds of the power.
+	update := newUpdate(t, 5, vals, vals, 3)
+	require.NoError(t, v.ProcessUpdate(update))
+	require.Equal(t, update.AttestedHeader, v.FinalizedHeader())
+	require.Equal(t, update.AttestedHeader, v.OptimisticHeader())
+
+	// The same or an older height is stale.
+	require.ErrorIs(
+		t, v.ProcessUpdate(update), lightclient.ErrStaleUpdate,
+	)
+
+	// Two of four validators do not.
+	require.ErrorIs(
+		t, v.ProcessUpdate(newUpdate(t, 6, vals, vals, 2)),
+		lightclient.ErrInsufficientVotingPower,
+	)
+}
+
+func TestProcessUpdateValidatorSetChange(t *testing.T) {
+	vals := newTestValidators(t, 1, 4)
+	v := newVerifier(t, vals)
+
+	// Half of the trusted validators remain in the new validator set, which
+	// is more than one third of the trusted power.
+	nextVals := append(
+		append([]*testValidator{}, vals[:2]...),
+		newTestValidators(t, 2, 2)...,
+	)
+	require.NoError(t, v.ProcessUpdate(newUpdate(t, 8, nextVals, nextVals, 4)))
+	require.Equal(t, validatorSet(nextVals), v.Validators())
+
+	// A validator set without trusted validators is rejected, even though
+	// all of its validators signed.
+	require.ErrorIs(
+		t, v.ProcessUpdate(newUpdate(
+			t, 9, newTestValidators(t, 3, 4), newTestValidators(t, 3, 4), 4,
+		)),
+		lightclient.ErrInsufficientVotingPower,
+	)
+}
+
+func TestProcessUpdateInvalid(t *testing.T) {
+	vals := newTestValidators(t, 1, 4)
+	v := newVerifier(t, vals)
+
+	// A tampered signature must not verify.
+	update := newUpdate(t, 2, vals, vals, 4)
+	update.SignedHeader.Commit.Signatures[1].Signature =
+		update.SignedHeader.Commit.Signatures[2].Signature
+	require.ErrorIs(t, v.ProcessUpdate(update), lightclient.ErrInvalidSignature)
+
+	// A beacon block header that is not the one of the CometBFT block must
+	// not verify.
+	update = newUpdate(t, 2, vals, vals, 4)
+	update.AttestedHeader = &testHeader{Slot: 2, BodyRoot: common.Root{3}}
+	require.ErrorIs(
+		t, v.ProcessUpdate(update), lightclient.ErrBlockHeaderMismatch,
+	)
+
+	// A beacon block that is not in the CometBFT block must not verify.
+	update = newUpdate(t, 2, vals, vals, 4)
+	update.BlockProof.Block = (&testBlock{slot: 2}).marshal()
+	require.ErrorIs(
+		t, v.ProcessUpdate(update), lightclient.ErrInvalidBlockProof,
+	)
+
+	// A commit for another CometBFT header must not verify.
+	update = newUpdate(t, 2, vals, vals, 4)
+	update.SignedHeader.Commit = newUpdate(t, 2, vals, vals, 4).
+		SignedHeader.Commit
+	update.SignedHeader.Time = update.SignedHeader.Time.Add(time.Second)
+	require.ErrorIs(
+		t, v.ProcessUpdate(update), lightclient.ErrCommitMismatch,
+	)
+	require.Equal(t, math.Slot(1), v.FinalizedHeader().GetSlot())
+}
+
+func TestProcessOptimisticUpdate(t *testing.T) {
+	vals := newTestValidators(t, 1, 4)
+	v := newVerifier(t, vals)
+
+	header, sh, proof := signedBlock(t, 3, vals, vals, 3)
+	require.NoError(t, v.ProcessOptimisticUpdate(
+		&lightclient.OptimisticUpdate[*testHeader]{
+			AttestedHeader: header,
+			SignedHeader:   sh,
+			BlockProof:     proof,
+		},
+	))
+	require.Equal(t, header, v.OptimisticHeader())
+	require.Equal(t, math.Slot(1), v.FinalizedHeader().GetSlot())
+
+	// An optimistic update signed by another validator set must not verify.
+	otherVals := newTestValidators(t, 2, 4)
+	header, sh, proof = signedBlock(t, 4, otherVals, otherVals, 4)
+	require.ErrorIs(t, v.ProcessOptimisticUpdate(
+		&lightclient.OptimisticUpdate[*testHeader]{
+			AttestedHeader: header,
+			SignedHeader:   sh,
+			BlockProof:     proof,
+		},
+	), lightclient.ErrValidatorSetMismatch)
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// PostAttestationsRewards returns the rewards and penalties of the
+// validators for their participation, recorded from the CometBFT commits,
+// in the given epoch.
+func (h *Handler[_, _, _, ContextT, _, _, _]) PostAttestationsRewards(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.PostAttestationsRewardsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := utils.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := h.backend.AttestationRewardsAtEpoch(epoch, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                rewards,
+	}, nil
+}
+
+// GetProposerIncome returns the income of the given validator from the blocks
+// it proposed in the given range of slots.
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetProposerIncome(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetProposerIncomeRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	startSlot, err := utils.U64FromString(req.StartSlot)
+	if err != nil {
+		return nil, err
+	}
+	endSlot, err := utils.U64FromString(req.EndSlot)
+	if err != nil {
+		return nil, err
+	}
+	if startSlot > endSlot {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRequest,
+			"start slot %d is after end slot %d", startSlot, endSlot,
+		)
+	}
+	income, err := h.backend.ProposerIncome(
+		req.ValidatorID, startSlot, endSlot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                income,
+	}, nil
+}
@@ -28,7 +28,7 @@ import (
 )
 
 //nolint:funlen // routes are long
-func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
+func (h *Handler[_, _, _, ContextT, _, _, _]) RegisterRoutes(
 	logger log.Logger[any],
 ) {
 	h.SetLogger(logger)
@@ -125,8 +125,8 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks/:block_id",
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,7 +141,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
 		},
 		{
 			Method:  http.MethodPost,
@@ -156,7 +156,17 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/attestation/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.PostAttestationsRewards,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/eth/v1/beacon/rewards/blocks/:block_id",
+			Handler: h.GetBlockRewards,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/beacon/rewards/income/:validator_id",
+			Handler: h.GetProposerIncome,
 		},
 		{
 			Method:  http.MethodGet,
@@ -166,22 +176,22 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/bootstrap/:block_root",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientBootstrap,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/updates",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientUpdates,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/finality_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/optimistic_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientOptimisticUpdate,
 		},
 		{
 			Method:  http.MethodGet,
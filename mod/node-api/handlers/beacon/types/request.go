@@ -142,6 +142,12 @@ type GetBlockRewardsRequest struct {
 	types.BlockIDRequest
 }
 
+type GetProposerIncomeRequest struct {
+	ValidatorID string `param:"validator_id" validate:"required,validator_id"`
+	StartSlot   string `query:"start_slot"   validate:"required,slot"`
+	EndSlot     string `query:"end_slot"     validate:"required,slot"`
+}
+
 type PostAttestationsRewardsRequest struct {
 	EpochRequest
 	IDs []string `validate:"dive,validator_id"`
@@ -176,3 +182,12 @@ type BlobSidecarRequest struct {
 	types.BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type GetLightClientBootstrapRequest struct {
+	BlockRoot string `param:"block_root" validate:"required,hex"`
+}
+
+type GetLightClientUpdatesRequest struct {
+	StartPeriod string `query:"start_period" validate:"required,uint64"`
+	Count       string `query:"count"        validate:"required,uint64"`
+}
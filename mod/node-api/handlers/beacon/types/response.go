@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 type ValidatorResponse struct {
@@ -31,11 +32,21 @@ type ValidatorResponse struct {
 	Data                any  `json:"data"`
 }
 
+// SSZData returns the data of the response.
+func (r ValidatorResponse) SSZData() any {
+	return r.Data
+}
+
 type BlockResponse struct {
 	Version string `json:"version"`
 	ValidatorResponse
 }
 
+// ConsensusVersion returns the fork of the block.
+func (r BlockResponse) ConsensusVersion() string {
+	return r.Version
+}
+
 type BlockHeaderResponse[BlockHeaderT any] struct {
 	Root      common.Root                `json:"root"`
 	Canonical bool                       `json:"canonical"`
@@ -47,6 +58,61 @@ type BlockHeader[BlockHeaderT any] struct {
 	Signature bytes.B48    `json:"signature"`
 }
 
+// BlobSidecarsResponse is the response of the blob sidecars of a block, as
+// per the beacon-API.
+type BlobSidecarsResponse[BeaconBlockHeaderT any] struct {
+	Data []*BlobSidecarData[BeaconBlockHeaderT] `json:"data"`
+	// sidecars are the blob sidecars the data is built from, which are
+	// served as the SSZ body.
+	sidecars any
+}
+
+// NewBlobSidecarsResponse builds the response of the given blob sidecars.
+func NewBlobSidecarsResponse[
+	BeaconBlockHeaderT any,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarT],
+](sidecars BlobSidecarsT) BlobSidecarsResponse[BeaconBlockHeaderT] {
+	data := make(
+		[]*BlobSidecarData[BeaconBlockHeaderT], 0,
+		len(sidecars.GetSidecars()),
+	)
+	for _, sidecar := range sidecars.GetSidecars() {
+		data = append(data, &BlobSidecarData[BeaconBlockHeaderT]{
+			Index:         sidecar.GetIndex(),
+			Blob:          sidecar.GetBlob(),
+			KzgCommitment: sidecar.GetKzgCommitment(),
+			KzgProof:      sidecar.GetKzgProof(),
+			SignedBlockHeader: &BlockHeader[BeaconBlockHeaderT]{
+				Message:   sidecar.GetBeaconBlockHeader(),
+				Signature: bytes.B48{}, // TODO: implement
+			},
+			KzgCommitmentInclusionProof: sidecar.GetInclusionProof(),
+		})
+	}
+	return BlobSidecarsResponse[BeaconBlockHeaderT]{
+		Data:     data,
+		sidecars: sidecars,
+	}
+}
+
+// SSZData returns the blob sidecars of the response.
+func (r BlobSidecarsResponse[_]) SSZData() any {
+	return r.sidecars
+}
+
+// BlobSidecarData is a blob sidecar as per the beacon-API.
+//
+//nolint:lll // tags get long
+type BlobSidecarData[BeaconBlockHeaderT any] struct {
+	Index                       uint64                           `json:"index,string"`
+	Blob                        *eip4844.Blob                    `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment            `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof                 `json:"kzg_proof"`
+	SignedBlockHeader           *BlockHeader[BeaconBlockHeaderT] `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Root                    `json:"kzg_commitment_inclusion_proof"`
+}
+
 type GenesisData struct {
 	GenesisTime           string      `json:"genesis_time"`
 	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
@@ -75,6 +141,27 @@ type CommitteeData struct {
 	Validators []uint64 `json:"validators,string"`
 }
 
+type AttestationRewardsData struct {
+	IdealRewards []*IdealAttestationReward `json:"ideal_rewards"`
+	TotalRewards []*TotalAttestationReward `json:"total_rewards"`
+}
+
+type IdealAttestationReward struct {
+	EffectiveBalance uint64 `json:"effective_balance,string"`
+	Head             int64  `json:"head,string"`
+	Target           int64  `json:"target,string"`
+	Source           int64  `json:"source,string"`
+	Inactivity       int64  `json:"inactivity,string"`
+}
+
+type TotalAttestationReward struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Head           int64  `json:"head,string"`
+	Target         int64  `json:"target,string"`
+	Source         int64  `json:"source,string"`
+	Inactivity     int64  `json:"inactivity,string"`
+}
+
 type BlockRewardsData struct {
 	ProposerIndex     uint64 `json:"proposer_index,string"`
 	Total             uint64 `json:"total,string"`
@@ -82,4 +169,21 @@ type BlockRewardsData struct {
 	SyncAggregate     uint64 `json:"sync_aggregate,string"`
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
+	// The fields below are not part of the Beacon API, they extend the
+	// consensus rewards of the proposer with the value of the payload and
+	// with the rewards and penalties applied to the other validators.
+	ExecutionValue       uint64 `json:"execution_value,string"`
+	AttestationRewards   uint64 `json:"attestation_rewards,string"`
+	AttestationPenalties uint64 `json:"attestation_penalties,string"`
+	SlashingPenalties    uint64 `json:"slashing_penalties,string"`
+}
+
+type ProposerIncomeData struct {
+	ValidatorIndex   uint64 `json:"validator_index,string"`
+	StartSlot        uint64 `json:"start_slot,string"`
+	EndSlot          uint64 `json:"end_slot,string"`
+	Blocks           uint64 `json:"blocks,string"`
+	ConsensusRewards uint64 `json:"consensus_rewards,string"`
+	ExecutionValue   uint64 `json:"execution_value,string"`
+	Total            uint64 `json:"total,string"`
 }
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+// header is a beacon block header holding its slot only.
+type header struct {
+	Slot uint64 `json:"slot,string"`
+}
+
+// sidecar is a blob sidecar of the block at slot 5.
+type sidecar struct {
+	index uint64
+	blob  eip4844.Blob
+}
+
+func (s *sidecar) GetIndex() uint64 {
+	return s.index
+}
+
+func (s *sidecar) GetBlob() *eip4844.Blob {
+	return &s.blob
+}
+
+func (s *sidecar) GetKzgCommitment() eip4844.KZGCommitment {
+	return eip4844.KZGCommitment{}
+}
+
+func (s *sidecar) GetKzgProof() eip4844.KZGProof {
+	return eip4844.KZGProof{}
+}
+
+func (s *sidecar) GetBeaconBlockHeader() *header {
+	return &header{Slot: 5}
+}
+
+func (s *sidecar) GetInclusionProof() []common.Root {
+	return []common.Root{{1}}
+}
+
+// sidecars are blob sidecars of which the SSZ encoding is their indices.
+type sidecars []*sidecar
+
+func (s sidecars) GetSidecars() []*sidecar {
+	return s
+}
+
+func (s sidecars) MarshalSSZ() ([]byte, error) {
+	bz := make([]byte, len(s))
+	for i, sc := range s {
+		bz[i] = byte(sc.index)
+	}
+	return bz, nil
+}
+
+func TestBlobSidecarsResponse(t *testing.T) {
+	resp := beacontypes.NewBlobSidecarsResponse[*header, *sidecar](
+		sidecars{{index: 1}, {index: 3}},
+	)
+
+	// The data is the list of the sidecars, as per the beacon-API.
+	bz, err := json.Marshal(resp)
+	require.NoError(t, err)
+	var body struct {
+		Data []map[string]json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &body))
+	require.Len(t, body.Data, 2)
+	zeros := "0x" + strings.Repeat("00", 48)
+	for i, data := range body.Data {
+		require.Len(t, data, 6)
+		require.JSONEq(t, []string{`"1"`, `"3"`}[i], string(data["index"]))
+		require.JSONEq(t, `"`+zeros+`"`, string(data["kzg_commitment"]))
+		require.JSONEq(t, `"`+zeros+`"`, string(data["kzg_proof"]))
+		require.JSONEq(t,
+			`{"message":{"slot":"5"},"signature":"`+zeros+`"}`,
+			string(data["signed_block_header"]),
+		)
+		require.Contains(t, data, "blob")
+		require.Contains(t, data, "kzg_commitment_inclusion_proof")
+	}
+
+	// The SSZ body is the encoding of the sidecars.
+	encoded := types.EncodeResponse(
+		types.MIMEApplicationOctetStream, resp, nil,
+	)
+	require.Equal(t, http.StatusOK, encoded.Code)
+	require.Equal(t, []byte{1, 3}, encoded.Body)
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// maxValidators is the VALIDATOR_REGISTRY_LIMIT bounding the validator
+	// lists.
+	maxValidators = 1 << 40
+	// maxValidatorStatusLength bounds the status of a validator, encoded as
+	// the bytes of its name.
+	maxValidatorStatusLength = 32
+	// validatorSize is the size of an SSZ encoded validator.
+	validatorSize = 121
+	// validatorBalanceDataSize is the size of an SSZ encoded
+	// ValidatorBalanceData.
+	validatorBalanceDataSize = 16
+	// validatorDataStaticSize is the size of the fixed part of an SSZ encoded
+	// ValidatorData: its index, balance, status offset and validator.
+	validatorDataStaticSize = 8 + 8 + 4 + validatorSize
+)
+
+// ErrInvalidValidatorSSZ is returned when a validator does not encode to an
+// SSZ validator.
+var ErrInvalidValidatorSSZ = errors.New("invalid SSZ validator")
+
+/* -------------------------------------------------------------------------- */
+/*                            ValidatorBalanceData                            */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ValidatorBalanceData in SSZ encoding.
+func (*ValidatorBalanceData) SizeSSZ() uint32 {
+	return validatorBalanceDataSize
+}
+
+// DefineSSZ defines the SSZ encoding of the ValidatorBalanceData.
+func (d *ValidatorBalanceData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &d.Index)
+	ssz.DefineUint64(codec, &d.Balance)
+}
+
+// ValidatorBalances are the balances of validators, served in SSZ as a
+// List[ValidatorBalanceData, VALIDATOR_REGISTRY_LIMIT].
+type ValidatorBalances []*ValidatorBalanceData
+
+// SizeSSZ returns the size of the ValidatorBalances in SSZ encoding.
+func (vb ValidatorBalances) SizeSSZ(bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(([]*ValidatorBalanceData)(vb))
+}
+
+// DefineSSZ defines the SSZ encoding of the ValidatorBalances.
+func (vb ValidatorBalances) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, (*[]*ValidatorBalanceData)(&vb), maxValidators,
+	)
+}
+
+// MarshalSSZ marshals the ValidatorBalances into SSZ format.
+func (vb ValidatorBalances) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(vb))
+	return buf, ssz.EncodeToBytes(buf, vb)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                ValidatorData                               */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZ marshals the ValidatorData into SSZ format, as the container
+// {index, balance, status, validator} of which the status is the bytes of
+// its name. The validator must be SSZ encodable.
+func (d *ValidatorData[_]) MarshalSSZ() ([]byte, error) {
+	c, err := d.sszContainer()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// sszContainer returns the SSZ container of the ValidatorData.
+func (d *ValidatorData[_]) sszContainer() (*validatorDataContainer, error) {
+	marshaler, ok := any(d.Validator).(constraints.SSZMarshaler)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidValidatorSSZ, "%T", d.Validator)
+	}
+	validator, err := marshaler.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	if len(validator) != validatorSize {
+		return nil, errors.Wrapf(
+			ErrInvalidValidatorSSZ, "%d bytes", len(validator),
+		)
+	}
+	return &validatorDataContainer{
+		Index:     d.Index,
+		Balance:   d.Balance,
+		Status:    []byte(d.Status),
+		Validator: validator,
+	}, nil
+}
+
+// ValidatorDataList are validators along with their balance and status,
+// served in SSZ as a List[ValidatorData, VALIDATOR_REGISTRY_LIMIT].
+type ValidatorDataList[ValidatorT any] []*ValidatorData[ValidatorT]
+
+// MarshalSSZ marshals the ValidatorDataList into SSZ format.
+func (vl ValidatorDataList[_]) MarshalSSZ() ([]byte, error) {
+	var err error
+	containers := make(validatorDataContainers, len(vl))
+	for i, d := range vl {
+		if containers[i], err = d.sszContainer(); err != nil {
+			return nil, err
+		}
+	}
+	buf := make([]byte, ssz.Size(containers))
+	return buf, ssz.EncodeToBytes(buf, containers)
+}
+
+// validatorDataContainer is the SSZ container of a ValidatorData, of which
+// the validator is already encoded.
+type validatorDataContainer struct {
+	Index     uint64
+	Balance   uint64
+	Status    []byte
+	Validator []byte
+}
+
+// SizeSSZ returns the size of the validatorDataContainer in SSZ encoding.
+func (c *validatorDataContainer) SizeSSZ(fixed bool) uint32 {
+	if fixed {
+		return validatorDataStaticSize
+	}
+	return validatorDataStaticSize + ssz.SizeDynamicBytes(c.Status)
+}
+
+// DefineSSZ defines the SSZ encoding of the validatorDataContainer.
+func (c *validatorDataContainer) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.Index)
+	ssz.DefineUint64(codec, &c.Balance)
+	ssz.DefineDynamicBytesOffset(codec, &c.Status, maxValidatorStatusLength)
+	ssz.DefineCheckedStaticBytes(codec, &c.Validator, validatorSize)
+	ssz.DefineDynamicBytesContent(codec, &c.Status, maxValidatorStatusLength)
+}
+
+// validatorDataContainers is the SSZ list of validatorDataContainer.
+type validatorDataContainers []*validatorDataContainer
+
+// SizeSSZ returns the size of the validatorDataContainers in SSZ encoding.
+func (cs validatorDataContainers) SizeSSZ(bool) uint32 {
+	return ssz.SizeSliceOfDynamicObjects(([]*validatorDataContainer)(cs))
+}
+
+// DefineSSZ defines the SSZ encoding of the validatorDataContainers.
+func (cs validatorDataContainers) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfDynamicObjectsContent(
+		codec, (*[]*validatorDataContainer)(&cs), maxValidators,
+	)
+}
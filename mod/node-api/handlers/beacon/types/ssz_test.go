@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// validator is a validator of which the SSZ encoding is its fill byte
+// repeated size times, a valid validator being 121 bytes.
+type validator struct {
+	fill byte
+	size int
+}
+
+func (v validator) MarshalSSZ() ([]byte, error) {
+	return bytes.Repeat([]byte{v.fill}, v.size), nil
+}
+
+func TestValidatorBalancesSSZ(t *testing.T) {
+	balances := beacontypes.ValidatorBalances{
+		{Index: 1, Balance: 32e9},
+		{Index: 7, Balance: 31e9},
+	}
+
+	bz, err := balances.MarshalSSZ()
+	require.NoError(t, err)
+
+	var want []byte
+	for _, b := range balances {
+		want = binary.LittleEndian.AppendUint64(want, b.Index)
+		want = binary.LittleEndian.AppendUint64(want, b.Balance)
+	}
+	require.Equal(t, want, bz)
+
+	empty, err := beacontypes.ValidatorBalances{}.MarshalSSZ()
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
+
+func TestValidatorDataListSSZ(t *testing.T) {
+	list := beacontypes.ValidatorDataList[validator]{
+		{
+			ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+				Index: 3, Balance: 32e9,
+			},
+			Status:    beacontypes.ValidatorStatusActiveOngoing,
+			Validator: validator{fill: 0xaa, size: 121},
+		},
+		{
+			ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+				Index: 4, Balance: 0,
+			},
+			Status:    beacontypes.ValidatorStatusWithdrawalDone,
+			Validator: validator{fill: 0xbb, size: 121},
+		},
+	}
+
+	bz, err := list.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The list of variable size containers starts with their offsets, each
+	// container holding the offset of its status after its fixed part.
+	var elements [][]byte
+	for _, d := range list {
+		var e []byte
+		e = binary.LittleEndian.AppendUint64(e, d.Index)
+		e = binary.LittleEndian.AppendUint64(e, d.Balance)
+		e = binary.LittleEndian.AppendUint32(e, 8+8+4+121)
+		e = append(e, bytes.Repeat([]byte{d.Validator.fill}, 121)...)
+		elements = append(elements, append(e, d.Status...))
+	}
+	want := binary.LittleEndian.AppendUint32(nil, 8)
+	want = binary.LittleEndian.AppendUint32(
+		want, uint32(8+len(elements[0])),
+	)
+	for _, e := range elements {
+		want = append(want, e...)
+	}
+	require.Equal(t, want, bz)
+
+	// A single validator encodes to the container alone.
+	single, err := list[1].MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, elements[1], single)
+}
+
+func TestValidatorDataListSSZ_InvalidValidator(t *testing.T) {
+	_, err := beacontypes.ValidatorDataList[validator]{
+		{Validator: validator{size: 120}},
+	}.MarshalSSZ()
+	require.ErrorIs(t, err, beacontypes.ErrInvalidValidatorSSZ)
+
+	_, err = beacontypes.ValidatorDataList[string]{
+		{Validator: "validator"},
+	}.MarshalSSZ()
+	require.ErrorIs(t, err, beacontypes.ErrInvalidValidatorSSZ)
+}
+
+func TestValidatorResponseSSZ(t *testing.T) {
+	balances := beacontypes.ValidatorBalances{{Index: 1, Balance: 32e9}}
+	want, err := balances.MarshalSSZ()
+	require.NoError(t, err)
+
+	resp := types.EncodeResponse(
+		types.MIMEApplicationOctetStream,
+		beacontypes.ValidatorResponse{Data: balances},
+		nil,
+	)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(
+		t, types.MIMEApplicationOctetStream, resp.Header.Get("Content-Type"),
+	)
+	require.Equal(t, want, resp.Body)
+}
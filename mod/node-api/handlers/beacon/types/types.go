@@ -20,9 +20,45 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
 
 // BeaconBlockHeader is the interface for the beacon block header.
 type BeaconBlockHeader interface {
 	GetBodyRoot() common.Root
 }
+
+// BlobSidecar is the interface for a blob sidecar.
+type BlobSidecar[BeaconBlockHeaderT any] interface {
+	GetIndex() uint64
+	GetBlob() *eip4844.Blob
+	GetKzgCommitment() eip4844.KZGCommitment
+	GetKzgProof() eip4844.KZGProof
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+	GetInclusionProof() []common.Root
+}
+
+// BlobSidecars is the interface for the blob sidecars of a block.
+type BlobSidecars[BlobSidecarT any] interface {
+	GetSidecars() []BlobSidecarT
+}
+
+// SignedBeaconBlock is the interface for the signed beacon block.
+type SignedBeaconBlock interface {
+	Version() uint32
+}
+
+// Validator statuses as defined by the Beacon Node API.
+const (
+	ValidatorStatusPendingInitialized = "pending_initialized"
+	ValidatorStatusPendingQueued      = "pending_queued"
+	ValidatorStatusActiveOngoing      = "active_ongoing"
+	ValidatorStatusActiveExiting      = "active_exiting"
+	ValidatorStatusActiveSlashed      = "active_slashed"
+	ValidatorStatusExitedUnslashed    = "exited_unslashed"
+	ValidatorStatusExitedSlashed      = "exited_slashed"
+	ValidatorStatusWithdrawalPossible = "withdrawal_possible"
+	ValidatorStatusWithdrawalDone     = "withdrawal_done"
+)
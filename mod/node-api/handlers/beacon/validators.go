@@ -26,7 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidators(
+func (h *Handler[_, _, _, ContextT, _, _, ValidatorT]) GetStateValidators(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateValidatorsRequest](
@@ -35,10 +35,6 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID)
 	if err != nil {
 		return nil, err
@@ -57,11 +53,11 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                validators,
+		Data:                beacontypes.ValidatorDataList[ValidatorT](validators),
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) PostStateValidators(
+func (h *Handler[_, _, _, ContextT, _, _, ValidatorT]) PostStateValidators(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.PostStateValidatorsRequest](
@@ -70,10 +66,6 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID)
 	if err != nil {
 		return nil, err
@@ -89,11 +81,11 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                validators,
+		Data:                beacontypes.ValidatorDataList[ValidatorT](validators),
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidator(
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetStateValidator(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateValidatorRequest](
@@ -116,7 +108,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidator(
 	return validator, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
+func (h *Handler[_, _, _, ContextT, _, _, _]) GetStateValidatorBalances(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetValidatorBalancesRequest](
@@ -139,11 +131,11 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                balances,
+		Data:                beacontypes.ValidatorBalances(balances),
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
+func (h *Handler[_, _, _, ContextT, _, _, _]) PostStateValidatorBalances(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.PostValidatorBalancesRequest](
@@ -166,6 +158,6 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                balances,
+		Data:                beacontypes.ValidatorBalances(balances),
 	}, nil
 }
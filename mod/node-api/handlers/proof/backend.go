@@ -21,18 +21,23 @@
 package proof
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // Backend is the interface for backend of the proof API.
-type Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT any] interface {
-	BlockBackend[BeaconBlockHeaderT]
+type Backend[
+	BeaconBlockHeaderT, BeaconStateT, SignedBeaconBlockT, ValidatorT any,
+] interface {
+	BlockBackend[BeaconBlockHeaderT, SignedBeaconBlockT]
 	StateBackend[BeaconStateT]
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+	ChainSpec() common.ChainSpec
 }
 
-type BlockBackend[BeaconBlockHeaderT any] interface {
+type BlockBackend[BeaconBlockHeaderT, SignedBeaconBlockT any] interface {
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	SignedBlockAtSlot(slot math.Slot) (SignedBeaconBlockT, error)
 }
 
 type StateBackend[BeaconStateT any] interface {
@@ -30,7 +30,7 @@ import (
 // payload header for the given block id, along with the proof that can be
 // verified against the beacon block root.
 func (h *Handler[
-	ContextT, BeaconBlockHeaderT, _, _, _, _,
+	ContextT, _, _, BeaconBlockHeaderT, _, _, _, _, _,
 ]) GetExecutionNumber(c ContextT) (any, error) {
 	params, err := utils.BindAndValidate[types.ExecutionNumberRequest](
 		c, h.Logger(),
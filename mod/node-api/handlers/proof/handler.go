@@ -31,37 +31,49 @@ import (
 // Handler is the handler for the proof API.
 type Handler[
 	ContextT context.Context,
+	BeaconBlockT types.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT types.BeaconBlockBody,
 	BeaconBlockHeaderT types.BeaconBlockHeader,
 	BeaconStateT types.BeaconState[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 	BeaconStateMarshallableT types.BeaconStateMarshallable,
 	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	SignedBeaconBlockT types.SignedBeaconBlock[BeaconBlockT],
 	ValidatorT types.Validator,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT]
+	backend Backend[
+		BeaconBlockHeaderT, BeaconStateT, SignedBeaconBlockT, ValidatorT,
+	]
 }
 
 // NewHandler creates a new handler for the proof API.
 func NewHandler[
 	ContextT context.Context,
+	BeaconBlockT types.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT types.BeaconBlockBody,
 	BeaconBlockHeaderT types.BeaconBlockHeader,
 	BeaconStateT types.BeaconState[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 	BeaconStateMarshallableT types.BeaconStateMarshallable,
 	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	SignedBeaconBlockT types.SignedBeaconBlock[BeaconBlockT],
 	ValidatorT types.Validator,
 ](
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT],
+	backend Backend[
+		BeaconBlockHeaderT, BeaconStateT, SignedBeaconBlockT, ValidatorT,
+	],
 ) *Handler[
-	ContextT, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-	ExecutionPayloadHeaderT, ValidatorT,
+	ContextT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+	SignedBeaconBlockT, ValidatorT,
 ] {
 	h := &Handler[
-		ContextT, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-		ExecutionPayloadHeaderT, ValidatorT,
+		ContextT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BeaconStateT, BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+		SignedBeaconBlockT, ValidatorT,
 	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
@@ -74,7 +86,7 @@ func NewHandler[
 // Get the slot from the given input of execution id, beacon state, and beacon
 // block header for the resolved slot.
 func (h *Handler[
-	ContextT, BeaconBlockHeaderT, BeaconStateT, _, _, _,
+	_, _, _, BeaconBlockHeaderT, BeaconStateT, _, _, _, _,
 ]) resolveExecutionID(executionID string) (
 	math.Slot, BeaconStateT, BeaconBlockHeaderT, error,
 ) {
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetHistoricalBlockRoot returns the root of the block at the requested
+// historical slot, along with a proof through the historical summary of its
+// period that can be verified against the beacon block root of the given
+// block id. Unlike the block roots of the beacon state, the historical
+// summaries are never overwritten, so any slot of a completed period can be
+// proven.
+func (h *Handler[
+	ContextT, _, _, BeaconBlockHeaderT, _, _, _, _, _,
+]) GetHistoricalBlockRoot(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.HistoricalBlockRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	historicalSlot, err := utils.U64FromString(params.Slot)
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveExecutionID(
+		params.ExecutionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The summary of a period is appended to the beacon state on the first
+	// slot of the next period.
+	slotsPerPeriod := h.backend.ChainSpec().SlotsPerHistoricalRoot()
+	summaryIndex := historicalSlot.Unwrap() / slotsPerPeriod
+	if summaryIndex >= slot.Unwrap()/slotsPerPeriod {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"historical summary of slot %d at slot %d", historicalSlot, slot,
+		)
+	}
+
+	// The block roots of the period are kept along with its summary.
+	blockRoots, err := beaconState.GetHistoricalBlockRoots(summaryIndex)
+	if err != nil {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"block roots of historical summary %d", summaryIndex,
+		)
+	}
+
+	// Generate the proof (along with the "correct" beacon block root to
+	// verify against) for the historical block root.
+	h.Logger().Info(
+		"Generating historical block root proof",
+		"slot", slot, "historical_slot", historicalSlot,
+	)
+	rootIndex := historicalSlot.Unwrap() % slotsPerPeriod
+	proof, gIndex, beaconBlockRoot, err := merkle.
+		ProveHistoricalBlockRootInBlock(
+			blockHeader, beaconState, summaryIndex, blockRoots, rootIndex,
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.HistoricalBlockRootResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader:        blockHeader,
+		BeaconBlockRoot:          beaconBlockRoot,
+		HistoricalSlot:           historicalSlot,
+		HistoricalBlockRoot:      blockRoots[rootIndex],
+		GeneralizedIndex:         gIndex,
+		HistoricalBlockRootProof: proof,
+	}, nil
+}
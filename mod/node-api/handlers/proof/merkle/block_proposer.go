@@ -45,8 +45,12 @@ func ProveProposerInBlock[
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the proposer pubkey in the beacon state.
 	proposerOffset := ValidatorPubkeyGIndexOffset * bbh.GetProposerIndex()
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
 	valPubkeyInStateProof, leaf, err := ProveProposerPubkeyInState(
-		bs, proposerOffset,
+		bsm, proposerOffset,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -63,7 +67,7 @@ func ProveProposerInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(valPubkeyInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyProposerInBlock(
-		bbh, proposerOffset, combinedProof, leaf,
+		bbh, bsm.Version(), proposerOffset, combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -73,28 +77,21 @@ func ProveProposerInBlock[
 }
 
 // ProveProposerPubkeyInState generates a proof for the proposer pubkey
-// in the beacon state. It uses the fastssz library to generate the proof.
-func ProveProposerPubkeyInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+// in the beacon state, at the generalized index of its fork version. It uses
+// the fastssz library to generate the proof.
+func ProveProposerPubkeyInState(
+	bsm types.BeaconStateMarshallable,
 	proposerOffset math.U64,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
 	//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
-	gIndex := ZeroValidatorPubkeyGIndexDenebState + int(proposerOffset)
+	gIndex := int(
+		ZeroValidatorPubkeyGIndexState(bsm.Version()) + proposerOffset.Unwrap(),
+	)
 	valPubkeyInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -113,13 +110,16 @@ func ProveProposerPubkeyInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyProposerInBlock(
 	bbh types.BeaconBlockHeader,
+	forkVersion uint32,
 	valOffset math.U64,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		merkle.GeneralizedIndex(ZeroValidatorPubkeyGIndexDenebBlock+valOffset),
+		merkle.GeneralizedIndex(
+			ZeroValidatorPubkeyGIndexBlock(forkVersion)+valOffset.Unwrap(),
+		),
 		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
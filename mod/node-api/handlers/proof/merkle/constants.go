@@ -20,6 +20,8 @@
 
 package merkle
 
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+
 const (
 	// StateGIndexDenebBlock is the generalized index of the beacon state in
 	// the beacon block in the Deneb fork.
@@ -64,4 +66,93 @@ const (
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
 	ExecutionFeeRecipientGIndexDenebBlock = 5889
+
+	// ZeroValidatorPubkeyGIndexElectraState is the generalized index of the 0
+	// validator's pubkey in the beacon state in the Electra fork, whose
+	// historical summaries field moved every field one level down.
+	ZeroValidatorPubkeyGIndexElectraState = 721279627821056
+
+	// ZeroValidatorPubkeyGIndexElectraBlock is the generalized index of the 0
+	// validator's pubkey in the beacon block in the Electra fork. This is
+	// calculated by concatenating the (ZeroValidatorPubkeyGIndexElectraState,
+	// StateGIndexDenebBlock) GIndices.
+	ZeroValidatorPubkeyGIndexElectraBlock = 6350779162034176
+
+	// ExecutionNumberGIndexElectraState is the generalized index of the
+	// number in the latest execution payload header in the beacon state in
+	// the Electra fork.
+	ExecutionNumberGIndexElectraState = 1286
+
+	// ExecutionNumberGIndexElectraBlock is the generalized index of the
+	// number in the latest execution payload header in the beacon block in
+	// the Electra fork.
+	ExecutionNumberGIndexElectraBlock = 11526
+
+	// ExecutionFeeRecipientGIndexElectraState is the generalized index of the
+	// fee recipient in the latest execution payload header in the beacon state
+	// in the Electra fork.
+	ExecutionFeeRecipientGIndexElectraState = 1281
+
+	// ExecutionFeeRecipientGIndexElectraBlock is the generalized index of the
+	// fee recipient in the latest execution payload header in the beacon block
+	// in the Electra fork.
+	ExecutionFeeRecipientGIndexElectraBlock = 11521
 )
+
+// ZeroValidatorPubkeyGIndexState returns the generalized index of the 0
+// validator's pubkey in the beacon state at the given fork version.
+func ZeroValidatorPubkeyGIndexState(forkVersion uint32) uint64 {
+	if forkVersion >= version.Electra {
+		return ZeroValidatorPubkeyGIndexElectraState
+	}
+	return ZeroValidatorPubkeyGIndexDenebState
+}
+
+// ZeroValidatorPubkeyGIndexBlock returns the generalized index of the 0
+// validator's pubkey in the beacon block at the given fork version.
+func ZeroValidatorPubkeyGIndexBlock(forkVersion uint32) uint64 {
+	if forkVersion >= version.Electra {
+		return ZeroValidatorPubkeyGIndexElectraBlock
+	}
+	return ZeroValidatorPubkeyGIndexDenebBlock
+}
+
+// ExecutionNumberGIndexState returns the generalized index of the number in
+// the latest execution payload header in the beacon state at the given fork
+// version.
+func ExecutionNumberGIndexState(forkVersion uint32) uint64 {
+	if forkVersion >= version.Electra {
+		return ExecutionNumberGIndexElectraState
+	}
+	return ExecutionNumberGIndexDenebState
+}
+
+// ExecutionNumberGIndexBlock returns the generalized index of the number in
+// the latest execution payload header in the beacon block at the given fork
+// version.
+func ExecutionNumberGIndexBlock(forkVersion uint32) uint64 {
+	if forkVersion >= version.Electra {
+		return ExecutionNumberGIndexElectraBlock
+	}
+	return ExecutionNumberGIndexDenebBlock
+}
+
+// ExecutionFeeRecipientGIndexState returns the generalized index of the fee
+// recipient in the latest execution payload header in the beacon state at the
+// given fork version.
+func ExecutionFeeRecipientGIndexState(forkVersion uint32) uint64 {
+	if forkVersion >= version.Electra {
+		return ExecutionFeeRecipientGIndexElectraState
+	}
+	return ExecutionFeeRecipientGIndexDenebState
+}
+
+// ExecutionFeeRecipientGIndexBlock returns the generalized index of the fee
+// recipient in the latest execution payload header in the beacon block at
+// the given fork version.
+func ExecutionFeeRecipientGIndexBlock(forkVersion uint32) uint64 {
+	if forkVersion >= version.Electra {
+		return ExecutionFeeRecipientGIndexElectraBlock
+	}
+	return ExecutionFeeRecipientGIndexDenebBlock
+}
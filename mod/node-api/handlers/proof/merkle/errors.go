@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoObjectPaths is returned when no object path is requested.
+	ErrNoObjectPaths = errors.New("no object paths")
+
+	// ErrInvalidObjectPath is returned when an object path does not resolve
+	// to an object.
+	ErrInvalidObjectPath = errors.New("invalid object path")
+
+	// ErrDuplicateObject is returned when several object paths resolve to the
+	// same leaf.
+	ErrDuplicateObject = errors.New("duplicate object")
+
+	// ErrBlockRootIndexOutOfRange is returned when the index of a block root
+	// is out of the block roots of a period.
+	ErrBlockRootIndexOutOfRange = errors.New("block root index out of range")
+
+	// ErrHistoricalSummaryNotFound is returned when the beacon state does not
+	// hold the historical summary at the requested index.
+	ErrHistoricalSummaryNotFound = errors.New("historical summary not found")
+
+	// ErrHistoricalSummaryMismatch is returned when the block roots of a
+	// period do not match the historical summary of the period.
+	ErrHistoricalSummaryMismatch = errors.New(
+		"block roots do not match the historical summary",
+	)
+)
@@ -45,7 +45,13 @@ func ProveExecutionFeeRecipientInBlock[
 	],
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the execution fee recipient in the beacon state.
-	feeRecipientInStateProof, leaf, err := ProveExecutionFeeRecipientInState(bs)
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	feeRecipientInStateProof, leaf, err := ProveExecutionFeeRecipientInState(
+		bsm,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -61,7 +67,7 @@ func ProveExecutionFeeRecipientInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(feeRecipientInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyExecutionFeeRecipientInBlock(
-		bbh, combinedProof, leaf,
+		bbh, bsm.Version(), combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -71,28 +77,19 @@ func ProveExecutionFeeRecipientInBlock[
 }
 
 // ProveExecutionFeeRecipientInState generates a proof for the execution fee
-// recipient in the beacon state. It uses the fastssz library to generate the
-// proof.
-func ProveExecutionFeeRecipientInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+// recipient in the beacon state, at the generalized index of its fork version.
+// It uses the fastssz library to generate the proof.
+func ProveExecutionFeeRecipientInState(
+	bsm types.BeaconStateMarshallable,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
+	//#nosec:G701 // generalized indices of the state fit in an int.
 	feeRecipientInStateProof, err := stateProofTree.Prove(
-		ExecutionFeeRecipientGIndexDenebState,
+		int(ExecutionFeeRecipientGIndexState(bsm.Version())),
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -112,12 +109,14 @@ func ProveExecutionFeeRecipientInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionFeeRecipientInBlock(
 	bbh types.BeaconBlockHeader,
+	forkVersion uint32,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionFeeRecipientGIndexDenebBlock, leaf, proof, beaconRoot,
+		merkle.GeneralizedIndex(ExecutionFeeRecipientGIndexBlock(forkVersion)),
+		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
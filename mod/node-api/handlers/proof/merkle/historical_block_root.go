@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ProveHistoricalBlockRootInBlock generates a proof for the block root at the
+// given index of a period, given all the block roots of the period, through
+// the historical summary of the period at the given index in the beacon state.
+// The proof chains the branch of the block root in the block summary root, the
+// branch of the block summary root in the beacon state and the branch of the
+// beacon state in the beacon block. It is then verified against the beacon
+// block root as a sanity check. Returns the proof and the generalized index of
+// the block root in the beacon block along with the beacon block root.
+func ProveHistoricalBlockRootInBlock[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	ValidatorT any,
+](
+	bbh BeaconBlockHeaderT,
+	bs types.BeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
+	],
+	summaryIndex uint64,
+	blockRoots []common.Root,
+	rootIndex uint64,
+) ([]common.Root, math.U64, common.Root, error) {
+	if rootIndex >= uint64(len(blockRoots)) {
+		return nil, 0, common.Root{}, errors.Wrapf(
+			ErrBlockRootIndexOutOfRange,
+			"index %d, length %d", rootIndex, len(blockRoots),
+		)
+	}
+
+	// Get the proof of the block root in the block summary root.
+	rootInSummaryProof, err := merkle.BuildProofFromLeaves(
+		blockRoots, rootIndex,
+	)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+	rootGIndex := merkle.NewGeneralizedIndex(
+		log.ILog2Ceil(uint64(len(blockRoots))), rootIndex,
+	)
+	blockSummaryRoot, err := merkle.CalculateRoot(
+		rootGIndex, blockRoots[rootIndex], rootInSummaryProof,
+	)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	// Then get the proof of the block summary root in the beacon state, which
+	// must be the root of the given block roots.
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+	summaryInStateProof, summaryGIndex, leaf, err :=
+		ProveBlockSummaryRootInState(bsm, summaryIndex)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+	if leaf != blockSummaryRoot {
+		return nil, 0, common.Root{}, errors.Wrapf(
+			ErrHistoricalSummaryMismatch, "historical summary %d", summaryIndex,
+		)
+	}
+
+	// Then get the proof of the beacon state in the beacon block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	// Sanity check that the combined proof verifies against our beacon root.
+	//
+	//nolint:gocritic // ok.
+	combinedProof := append(rootInSummaryProof, summaryInStateProof...)
+	combinedProof = append(combinedProof, stateInBlockProof...)
+	gIndex := merkle.GeneralizedIndices{
+		StateGIndexDenebBlock, summaryGIndex, rootGIndex,
+	}.Concat()
+	beaconRoot, err := verifyHistoricalBlockRootInBlock(
+		bbh, gIndex, combinedProof, blockRoots[rootIndex],
+	)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	return combinedProof, math.U64(gIndex), beaconRoot, nil
+}
+
+// ProveBlockSummaryRootInState generates a proof for the block summary root
+// of the historical summary at the given index in the beacon state. Returns
+// the proof and the generalized index of the block summary root in the beacon
+// state along with the block summary root. The historical summaries are part
+// of the beacon state from Electra onwards. It uses the fastssz library.
+func ProveBlockSummaryRootInState(
+	bsm types.BeaconStateMarshallable,
+	summaryIndex uint64,
+) ([]common.Root, merkle.GeneralizedIndex, common.Root, error) {
+	if bsm.Version() < version.Electra {
+		return nil, 0, common.Root{}, errors.Wrapf(
+			ErrHistoricalSummaryNotFound,
+			"no historical summaries before Electra",
+		)
+	}
+
+	stateSchema := bsm.SSZSchema(bsm.Version())
+	lenGIndex, _, err := resolvePath(
+		stateSchema, "historical_summaries/__len__",
+	)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+	gIndex, _, err := resolvePath(stateSchema, fmt.Sprintf(
+		"historical_summaries/%d/block_summary_root", summaryIndex,
+	))
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	// The tree holds no nodes past the end of the list, so check the index
+	// against the length mixed in the list root first.
+	//
+	//#nosec:G701 // generalized indices of the state fit in an int.
+	lenNode, err := stateProofTree.Get(int(lenGIndex))
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+	if numSummaries := binary.LittleEndian.Uint64(
+		lenNode.Hash(),
+	); summaryIndex >= numSummaries {
+		return nil, 0, common.Root{}, errors.Wrapf(
+			ErrHistoricalSummaryNotFound,
+			"index %d, length %d", summaryIndex, numSummaries,
+		)
+	}
+
+	//#nosec:G701 // generalized indices of the state fit in an int.
+	summaryInStateProof, err := stateProofTree.Prove(int(gIndex))
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	proof := make([]common.Root, len(summaryInStateProof.Hashes))
+	for i, hash := range summaryInStateProof.Hashes {
+		proof[i] = common.Root(hash)
+	}
+	return proof, gIndex, common.Root(summaryInStateProof.Leaf), nil
+}
+
+// verifyHistoricalBlockRootInBlock verifies the historical block root in the
+// beacon block, returning the beacon block root used to verify against.
+func verifyHistoricalBlockRootInBlock(
+	bbh types.BeaconBlockHeader,
+	gIndex merkle.GeneralizedIndex,
+	proof []common.Root,
+	leaf common.Root,
+) (common.Root, error) {
+	beaconRoot := bbh.HashTreeRoot()
+	if beaconRootVerified, err := merkle.VerifyProof(
+		gIndex, leaf, proof, beaconRoot,
+	); err != nil {
+		return common.Root{}, err
+	} else if !beaconRootVerified {
+		return common.Root{}, errors.Newf(
+			"proof failed to verify against beacon root: 0x%x", beaconRoot[:],
+		)
+	}
+
+	return beaconRoot, nil
+}
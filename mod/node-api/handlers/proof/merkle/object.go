@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// BodyRootPath is the path of the body root in the beacon block header, under
+// which the paths of the objects descend into the beacon block body.
+const BodyRootPath = "body_root"
+
+// ProveObjectsInBlock generates a multiproof for the objects at the given
+// paths in the beacon block, e.g. "proposer_index" or
+// "body_root/execution_payload/block_number". The paths are relative to the
+// beacon block header, those under the body root descending into the beacon
+// block body. The proof is then verified against the beacon block root as a
+// sanity check. Returns the proven objects and the proof along with the beacon
+// block root. It uses the fastssz library to generate the proof.
+func ProveObjectsInBlock[
+	BeaconBlockT types.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT types.BeaconBlockBody,
+](
+	bbh types.BeaconBlockHeader,
+	blk BeaconBlockT,
+	paths []string,
+) ([]types.ProvenObject, []common.Root, common.Root, error) {
+	headerSchema := bbh.SSZSchema()
+	bodySchema := blk.GetBody().SSZSchema(blk.Version())
+	objects, err := resolveObjects(
+		paths,
+		func(path string) (merkle.GeneralizedIndex, uint8, error) {
+			return ResolveBlockObjectPath(headerSchema, bodySchema, path)
+		},
+	)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+
+	// The tree of the beacon block is that of its header, with the tree of
+	// the body in place of the body root.
+	blockProofTree, err := blk.GetTree()
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	proof, err := proveObjects(blockProofTree, objects)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+
+	beaconRoot, err := verifyObjectsInBlock(bbh, objects, proof)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	return objects, proof, beaconRoot, nil
+}
+
+// ResolveBlockObjectPath resolves the generalized index and the offset of the
+// object at the given path in the beacon block, relative to the beacon block
+// header, the paths under the body root descending into the beacon block body.
+func ResolveBlockObjectPath(
+	headerSchema, bodySchema schema.SSZType,
+	path string,
+) (merkle.GeneralizedIndex, uint8, error) {
+	bodyPath, inBody := strings.CutPrefix(path, BodyRootPath+"/")
+	if !inBody {
+		return resolvePath(headerSchema, path)
+	}
+
+	bodyRootGIndex, _, err := resolvePath(headerSchema, BodyRootPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	gIndex, offset, err := resolvePath(bodySchema, bodyPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	return merkle.GeneralizedIndices{bodyRootGIndex, gIndex}.Concat(),
+		offset, nil
+}
+
+// ProveObjectsInStateInBlock generates a multiproof for the objects at the
+// given paths in the beacon state, e.g. "validators/3/pubkey", in the beacon
+// block. The generalized indices of the returned objects are relative to the
+// beacon block root, which the proof is verified against as a sanity check.
+// Returns the proven objects and the proof along with the beacon block root.
+// It uses the fastssz library to generate the proof.
+func ProveObjectsInStateInBlock[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	ValidatorT any,
+](
+	bbh BeaconBlockHeaderT,
+	bs types.BeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
+	],
+	paths []string,
+) ([]types.ProvenObject, []common.Root, common.Root, error) {
+	// Get the multiproof of the objects in the beacon state, at the paths
+	// in the schema of its fork version.
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	stateSchema := bsm.SSZSchema(bsm.Version())
+	objects, err := resolveObjects(
+		paths,
+		func(path string) (merkle.GeneralizedIndex, uint8, error) {
+			return resolvePath(stateSchema, path)
+		},
+	)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	objectsInStateProof, err := proveObjects(stateProofTree, objects)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+
+	// Then get the proof of the beacon state in the beacon block. As the
+	// objects all descend from the beacon state, the helper indices of the
+	// multiproof in the beacon block are those in the beacon state followed
+	// by the branch of the beacon state in the beacon block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	for i := range objects {
+		objects[i].GeneralizedIndex = math.U64(merkle.GeneralizedIndices{
+			StateGIndexDenebBlock,
+			merkle.GeneralizedIndex(objects[i].GeneralizedIndex),
+		}.Concat())
+	}
+
+	// Sanity check that the combined proof verifies against our beacon root.
+	//
+	//nolint:gocritic // ok.
+	combinedProof := append(objectsInStateProof, stateInBlockProof...)
+	beaconRoot, err := verifyObjectsInBlock(bbh, objects, combinedProof)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	return objects, combinedProof, beaconRoot, nil
+}
+
+// resolveObjects resolves the generalized index and the offset of the objects
+// at the given paths.
+func resolveObjects(
+	paths []string,
+	resolve func(path string) (merkle.GeneralizedIndex, uint8, error),
+) ([]types.ProvenObject, error) {
+	if len(paths) == 0 {
+		return nil, ErrNoObjectPaths
+	}
+
+	seen := make(map[merkle.GeneralizedIndex]struct{}, len(paths))
+	objects := make([]types.ProvenObject, len(paths))
+	for i, path := range paths {
+		gIndex, offset, err := resolve(path)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidObjectPath, "%s: %v", path, err)
+		}
+
+		// Objects packed in the same chunk share the same leaf.
+		if _, ok := seen[gIndex]; ok {
+			return nil, errors.Wrapf(
+				ErrDuplicateObject, "%s shares a leaf with another path", path,
+			)
+		}
+		seen[gIndex] = struct{}{}
+
+		objects[i] = types.ProvenObject{
+			Path:             path,
+			GeneralizedIndex: math.U64(gIndex),
+			Offset:           offset,
+		}
+	}
+	return objects, nil
+}
+
+// resolvePath resolves the generalized index and the offset of the object at
+// the given path in the SSZ type.
+func resolvePath(
+	typ schema.SSZType,
+	path string,
+) (merkle.GeneralizedIndex, uint8, error) {
+	_, gIndex, offset, err := merkle.ObjectPath[
+		merkle.GeneralizedIndex, common.Root,
+	](path).GetGeneralizedIndex(typ)
+	return gIndex, offset, err
+}
+
+// proveObjects fills in the leaves of the objects from the tree and returns
+// the multiproof of the objects, ordered by decreasing generalized index.
+func proveObjects(
+	tree *fastssz.Node,
+	objects []types.ProvenObject,
+) ([]common.Root, error) {
+	indices := make(merkle.GeneralizedIndices, len(objects))
+	for i, object := range objects {
+		//#nosec:G701 // generalized indices of the state fit in an int.
+		node, err := tree.Get(int(object.GeneralizedIndex))
+		if err != nil {
+			return nil, errors.Wrapf(
+				ErrInvalidObjectPath, "%s: %v", object.Path, err,
+			)
+		}
+		objects[i].Leaf = common.Root(node.Hash())
+		indices[i] = merkle.GeneralizedIndex(object.GeneralizedIndex)
+	}
+
+	helperIndices := indices.GetHelperIndices()
+	proof := make([]common.Root, len(helperIndices))
+	for i, helperIndex := range helperIndices {
+		//#nosec:G701 // generalized indices of the state fit in an int.
+		node, err := tree.Get(int(helperIndex))
+		if err != nil {
+			return nil, err
+		}
+		proof[i] = common.Root(node.Hash())
+	}
+	return proof, nil
+}
+
+// verifyObjectsInBlock verifies the multiproof of the objects in the beacon
+// block, returning the beacon block root used to verify against.
+func verifyObjectsInBlock(
+	bbh types.BeaconBlockHeader,
+	objects []types.ProvenObject,
+	proof []common.Root,
+) (common.Root, error) {
+	indices := make(merkle.GeneralizedIndices, len(objects))
+	leaves := make([]common.Root, len(objects))
+	for i, object := range objects {
+		indices[i] = merkle.GeneralizedIndex(object.GeneralizedIndex)
+		leaves[i] = object.Leaf
+	}
+
+	beaconRoot := bbh.HashTreeRoot()
+	if !merkle.VerifyMultiproof(indices, leaves, proof, beaconRoot) {
+		return common.Root{}, errors.Newf(
+			"proof failed to verify against beacon root: 0x%x", beaconRoot[:],
+		)
+	}
+	return beaconRoot, nil
+}
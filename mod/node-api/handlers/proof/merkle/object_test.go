@@ -0,0 +1,503 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	ssz "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// The schemas must agree with the hand computed generalized indices.
+func TestSchemaGeneralizedIndices(t *testing.T) {
+	cases := []struct {
+		path   string
+		gIndex uint64
+	}{
+		{"validators/0/pubkey", merkle.ZeroValidatorPubkeyGIndexDenebState},
+		{
+			"validators/1/pubkey",
+			merkle.ZeroValidatorPubkeyGIndexDenebState +
+				merkle.ValidatorPubkeyGIndexOffset,
+		},
+		{
+			"latest_execution_payload_header/block_number",
+			merkle.ExecutionNumberGIndexDenebState,
+		},
+		{
+			"latest_execution_payload_header/fee_recipient",
+			merkle.ExecutionFeeRecipientGIndexDenebState,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			_, gIndex, _, err := ssz.ObjectPath[uint64, common.Root](
+				tc.path,
+			).GetGeneralizedIndex(stateSchema(version.Deneb))
+			require.NoError(t, err)
+			require.Equal(t, tc.gIndex, gIndex)
+		})
+	}
+
+	// The historical summaries move every field of the Electra state one
+	// level down.
+	electra := []struct {
+		path        string
+		gIndex      uint64
+		blockGIndex uint64
+	}{
+		{
+			"validators/0/pubkey",
+			merkle.ZeroValidatorPubkeyGIndexState(version.Electra),
+			merkle.ZeroValidatorPubkeyGIndexBlock(version.Electra),
+		},
+		{
+			"latest_execution_payload_header/block_number",
+			merkle.ExecutionNumberGIndexState(version.Electra),
+			merkle.ExecutionNumberGIndexBlock(version.Electra),
+		},
+		{
+			"latest_execution_payload_header/fee_recipient",
+			merkle.ExecutionFeeRecipientGIndexState(version.Electra),
+			merkle.ExecutionFeeRecipientGIndexBlock(version.Electra),
+		},
+	}
+	for _, tc := range electra {
+		t.Run("electra/"+tc.path, func(t *testing.T) {
+			_, gIndex, _, err := ssz.ObjectPath[uint64, common.Root](
+				tc.path,
+			).GetGeneralizedIndex(stateSchema(version.Electra))
+			require.NoError(t, err)
+			require.Equal(t, tc.gIndex, gIndex)
+			require.Equal(t, ssz.GeneralizedIndex(tc.blockGIndex),
+				ssz.GeneralizedIndices{
+					merkle.StateGIndexDenebBlock, ssz.GeneralizedIndex(gIndex),
+				}.Concat(),
+			)
+		})
+	}
+	_, _, _, err := ssz.ObjectPath[uint64, common.Root](
+		"historical_summaries",
+	).GetGeneralizedIndex(stateSchema(version.Deneb))
+	require.Error(t, err)
+
+	_, gIndex, _, err := ssz.ObjectPath[uint64, common.Root](
+		"state_root",
+	).GetGeneralizedIndex((*ctypes.BeaconBlockHeader)(nil).SSZSchema())
+	require.NoError(t, err)
+	require.Equal(t, uint64(merkle.StateGIndexDenebBlock), gIndex)
+}
+
+func TestProveObjectsInBlock(t *testing.T) {
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Electra)
+	body.ExecutionPayload = &ctypes.ExecutionPayload{
+		Number:        7,
+		FeeRecipient:  common.ExecutionAddress{4},
+		BaseFeePerGas: math.NewU256(0),
+		Withdrawals: []*engineprimitives.Withdrawal{
+			{Index: 1, Amount: 5},
+		},
+	}
+	blk := &ctypes.BeaconBlock{
+		Slot:          10,
+		ProposerIndex: 3,
+		StateRoot:     common.Root{1},
+		Body:          body,
+	}
+	header := blk.GetHeader()
+
+	objects, proof, root, err := merkle.ProveObjectsInBlock(
+		header, blk, []string{"proposer_index", "body_root"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, header.HashTreeRoot(), root)
+	require.Len(t, objects, 2)
+	require.Equal(t, math.U64(9), objects[0].GeneralizedIndex)
+	require.Equal(t, common.Root{3}, objects[0].Leaf)
+	require.Equal(t, math.U64(12), objects[1].GeneralizedIndex)
+	require.Equal(t, header.BodyRoot, objects[1].Leaf)
+	require.True(t, verifyObjects(objects, proof, root))
+
+	// The proof of a single object is a regular Merkle proof.
+	objects, proof, root, err = merkle.ProveObjectsInBlock(
+		header, blk, []string{"state_root"},
+	)
+	require.NoError(t, err)
+	ok, err := ssz.VerifyProof(
+		merkle.StateGIndexDenebBlock, objects[0].Leaf, proof, root,
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The paths under the body root descend into the body.
+	objects, proof, root, err = merkle.ProveObjectsInBlock(
+		header, blk, []string{
+			"slot",
+			"body_root/execution_payload/block_number",
+			"body_root/execution_payload/fee_recipient",
+			"body_root/execution_payload/withdrawals/0/amount",
+			"body_root/execution_requests/deposits/__len__",
+			"body_root/attestations/__len__",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, header.HashTreeRoot(), root)
+	require.Equal(t, common.Root{7}, objects[1].Leaf)
+	require.Equal(t, common.Root{4}, objects[2].Leaf)
+	require.Equal(t, common.Root{5}, objects[3].Leaf)
+	require.Equal(t, common.Root{}, objects[4].Leaf)
+	require.True(t, verifyObjects(objects, proof, root))
+
+	for _, paths := range [][]string{
+		nil,
+		{"unknown"},
+		{"slot/__len__"},
+		{"slot", "slot"},
+		{"body_root/unknown"},
+		{"body_root/execution_payload/block_number/__len__"},
+	} {
+		_, _, _, err = merkle.ProveObjectsInBlock(header, blk, paths)
+		require.Error(t, err, paths)
+	}
+
+	// The Electra fields are not part of the Deneb body.
+	denebBody := (&ctypes.BeaconBlockBody{}).Empty(version.Deneb)
+	denebBody.ExecutionPayload = blk.Body.ExecutionPayload
+	blk.Body = denebBody
+	header = blk.GetHeader()
+	_, _, _, err = merkle.ProveObjectsInBlock(
+		header, blk, []string{"body_root/attestations/__len__"},
+	)
+	require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+	_, _, _, err = merkle.ProveObjectsInBlock(
+		header, blk, []string{"body_root/blob_kzg_commitments/__len__"},
+	)
+	require.NoError(t, err)
+
+	// The block must be that of the header.
+	header.ProposerIndex++
+	_, _, _, err = merkle.ProveObjectsInBlock(
+		header, blk, []string{"proposer_index"},
+	)
+	require.Error(t, err)
+}
+
+func TestProveObjectsInStateInBlock(t *testing.T) {
+	for forkVersion, first := range map[uint32]ssz.GeneralizedIndex{
+		version.Deneb:   16,
+		version.Electra: 32,
+	} {
+		state := &testState{
+			ForkVersion:      forkVersion,
+			Slot:             10,
+			Eth1DepositIndex: 4,
+		}
+		header := &testHeader{
+			Slot:      10,
+			StateRoot: state.HashTreeRoot(),
+		}
+
+		objects, proof, root, err := merkle.ProveObjectsInStateInBlock(
+			header, state, []string{"slot", "eth1_deposit_index"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, header.HashTreeRoot(), root)
+
+		// The generalized indices are relative to the beacon block root.
+		indices := make(ssz.GeneralizedIndices, len(objects))
+		leaves := make([]common.Root, len(objects))
+		for i, object := range objects {
+			indices[i] = ssz.GeneralizedIndex(object.GeneralizedIndex)
+			leaves[i] = object.Leaf
+		}
+		require.Equal(t, ssz.GeneralizedIndices{
+			ssz.GeneralizedIndices{
+				merkle.StateGIndexDenebBlock, first + 1,
+			}.Concat(),
+			ssz.GeneralizedIndices{
+				merkle.StateGIndexDenebBlock, first + 7,
+			}.Concat(),
+		}, indices)
+		require.Equal(t, common.Root{10}, leaves[0])
+		require.Equal(t, common.Root{4}, leaves[1])
+		require.True(t, ssz.VerifyMultiproof(indices, leaves, proof, root))
+	}
+}
+
+func TestProveHistoricalBlockRootInBlock(t *testing.T) {
+	blockRoots := make([]common.Root, 8)
+	for i := range blockRoots {
+		blockRoots[i] = common.Root{byte(i + 1)}
+	}
+	blockSummaryRoot, err := ssz.CalculateRoot(
+		ssz.NewGeneralizedIndex(3, 0), blockRoots[0], mustProof(t, blockRoots),
+	)
+	require.NoError(t, err)
+	state := &testState{
+		ForkVersion: version.Electra,
+		Slot:        24,
+		HistoricalSummaries: [][2]common.Root{
+			{{0xaa}, {0xbb}},
+			{blockSummaryRoot, {0xcc}},
+		},
+	}
+	header := &testHeader{
+		Slot:      24,
+		StateRoot: state.HashTreeRoot(),
+	}
+
+	proof, gIndex, root, err := merkle.ProveHistoricalBlockRootInBlock(
+		header, state, 1, blockRoots, 5,
+	)
+	require.NoError(t, err)
+	require.Equal(t, header.HashTreeRoot(), root)
+
+	// The generalized index descends from the block summary root.
+	_, summaryGIndex, _, err := ssz.ObjectPath[
+		ssz.GeneralizedIndex, common.Root,
+	]("historical_summaries/1/block_summary_root").GetGeneralizedIndex(
+		stateSchema(version.Electra),
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(ssz.GeneralizedIndices{
+		merkle.StateGIndexDenebBlock,
+		summaryGIndex,
+		ssz.NewGeneralizedIndex(3, 5),
+	}.Concat()), gIndex)
+	ok, err := ssz.VerifyProof(
+		ssz.GeneralizedIndex(gIndex), blockRoots[5], proof, root,
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The block roots must be those of the summarized period.
+	_, _, _, err = merkle.ProveHistoricalBlockRootInBlock(
+		header, state, 0, blockRoots, 5,
+	)
+	require.ErrorIs(t, err, merkle.ErrHistoricalSummaryMismatch)
+	_, _, _, err = merkle.ProveHistoricalBlockRootInBlock(
+		header, state, 2, blockRoots, 5,
+	)
+	require.ErrorIs(t, err, merkle.ErrHistoricalSummaryNotFound)
+	_, _, _, err = merkle.ProveHistoricalBlockRootInBlock(
+		header, state, 1, blockRoots, 8,
+	)
+	require.ErrorIs(t, err, merkle.ErrBlockRootIndexOutOfRange)
+
+	// The historical summaries are not part of the state before Electra.
+	state.ForkVersion = version.Deneb
+	header.StateRoot = state.HashTreeRoot()
+	_, _, _, err = merkle.ProveHistoricalBlockRootInBlock(
+		header, state, 1, blockRoots, 5,
+	)
+	require.ErrorIs(t, err, merkle.ErrHistoricalSummaryNotFound)
+}
+
+// verifyObjects verifies the multiproof of the objects against the root.
+func verifyObjects(
+	objects []types.ProvenObject,
+	proof []common.Root,
+	root common.Root,
+) bool {
+	indices := make(ssz.GeneralizedIndices, len(objects))
+	leaves := make([]common.Root, len(objects))
+	for i, object := range objects {
+		indices[i] = ssz.GeneralizedIndex(object.GeneralizedIndex)
+		leaves[i] = object.Leaf
+	}
+	return ssz.VerifyMultiproof(indices, leaves, proof, root)
+}
+
+// stateSchema returns the SSZ schema of the beacon state of the consensus
+// types at the given fork version.
+func stateSchema(forkVersion uint32) schema.SSZType {
+	return (*ctypes.BeaconState[
+		*ctypes.BeaconBlockHeader,
+		*ctypes.Eth1Data,
+		*ctypes.ExecutionPayloadHeader,
+		*ctypes.Fork,
+		*ctypes.Validator,
+		ctypes.BeaconBlockHeader,
+		ctypes.Eth1Data,
+		ctypes.ExecutionPayloadHeader,
+		ctypes.Fork,
+		ctypes.Validator,
+	])(nil).SSZSchema(forkVersion)
+}
+
+func mustProof(t *testing.T, leaves []common.Root) []common.Root {
+	t.Helper()
+	proof, err := ssz.BuildProofFromLeaves(leaves, 0)
+	require.NoError(t, err)
+	return proof
+}
+
+// testHeader is a beacon block header.
+type testHeader struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    common.Root
+	StateRoot     common.Root
+	BodyRoot      common.Root
+}
+
+func (h *testHeader) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(h.Slot)
+	hh.PutUint64(h.ProposerIndex)
+	hh.PutBytes(h.ParentRoot[:])
+	hh.PutBytes(h.StateRoot[:])
+	hh.PutBytes(h.BodyRoot[:])
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (h *testHeader) HashTreeRoot() common.Root {
+	return hashTreeRoot(h)
+}
+
+func (h *testHeader) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(h)
+}
+
+func (h *testHeader) GetProposerIndex() math.ValidatorIndex {
+	return math.ValidatorIndex(h.ProposerIndex)
+}
+
+func (*testHeader) SSZSchema() schema.SSZType {
+	return (*ctypes.BeaconBlockHeader)(nil).SSZSchema()
+}
+
+// testState is a beacon state with the 16 fields of the Deneb beacon state,
+// and the historical summaries from Electra, though all of them but the
+// historical summaries are merkleized as uint64s.
+type testState struct {
+	ForkVersion         uint32
+	Slot                uint64
+	Eth1DepositIndex    uint64
+	HistoricalSummaries [][2]common.Root
+}
+
+func (s *testState) HashTreeRootWith(hh fastssz.HashWalker) error {
+	numFields := 16
+	if s.ForkVersion >= version.Electra {
+		numFields++
+	}
+
+	indx := hh.Index()
+	for i := range numFields {
+		switch i {
+		case 1:
+			hh.PutUint64(s.Slot)
+		case 7:
+			hh.PutUint64(s.Eth1DepositIndex)
+		case 16:
+			subIndx := hh.Index()
+			for _, summary := range s.HistoricalSummaries {
+				elemIndx := hh.Index()
+				hh.PutBytes(summary[0][:])
+				hh.PutBytes(summary[1][:])
+				hh.Merkleize(elemIndx)
+			}
+			hh.MerkleizeWithMixin(
+				subIndx, uint64(len(s.HistoricalSummaries)), 16777216,
+			)
+		default:
+			hh.PutUint64(0)
+		}
+	}
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (s *testState) HashTreeRoot() common.Root {
+	return hashTreeRoot(s)
+}
+
+func (s *testState) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(s)
+}
+
+func (s *testState) Version() uint32 {
+	return s.ForkVersion
+}
+
+func (*testState) SSZSchema(forkVersion uint32) schema.SSZType {
+	return stateSchema(forkVersion)
+}
+
+func (s *testState) GetMarshallable() (*testState, error) {
+	return s, nil
+}
+
+func (*testState) GetLatestExecutionPayloadHeader() (
+	*testPayloadHeader, error,
+) {
+	return &testPayloadHeader{}, nil
+}
+
+func (*testState) ValidatorByIndex(
+	math.ValidatorIndex,
+) (*testValidator, error) {
+	return &testValidator{}, nil
+}
+
+func (*testState) GetHistoricalBlockRoots(uint64) ([]common.Root, error) {
+	return nil, nil
+}
+
+type testPayloadHeader struct{}
+
+func (*testPayloadHeader) GetNumber() math.U64 { return 0 }
+
+func (*testPayloadHeader) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type testValidator struct{}
+
+func (*testValidator) GetPubkey() crypto.BLSPubkey { return crypto.BLSPubkey{} }
+
+func hashTreeRoot(v interface {
+	HashTreeRootWith(hh fastssz.HashWalker) error
+}) common.Root {
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	if err := v.HashTreeRootWith(hh); err != nil {
+		panic(err)
+	}
+	root, err := hh.HashRoot()
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
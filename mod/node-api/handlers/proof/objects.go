@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetStateObjects returns a multiproof of the objects at the requested paths
+// in the beacon state for the given block id, e.g. `validators/3/pubkey`,
+// `balances/5` or `randao_mixes/2`, that can be verified against the beacon
+// block root.
+func (h *Handler[
+	ContextT, _, _, BeaconBlockHeaderT, _, _, _, _, _,
+]) GetStateObjects(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.ObjectsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveExecutionID(
+		params.ExecutionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate the proof (along with the "correct" beacon block root to
+	// verify against) for the requested objects.
+	paths := splitPaths(params.Paths)
+	h.Logger().Info(
+		"Generating beacon state objects proof", "slot", slot, "paths", paths,
+	)
+	objects, proof, beaconBlockRoot, err := merkle.ProveObjectsInStateInBlock(
+		blockHeader, beaconState, paths,
+	)
+	if err != nil {
+		return nil, wrapPathError(err)
+	}
+
+	return types.ObjectsResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   beaconBlockRoot,
+		Objects:           objects,
+		Proof:             proof,
+	}, nil
+}
+
+// GetBlockObjects returns a multiproof of the objects at the requested paths
+// in the beacon block for the given block id, e.g. `proposer_index` or
+// `body_root/execution_payload/block_number`, that can be verified against the
+// beacon block root. The paths under the body root descend into the beacon
+// block body.
+func (h *Handler[
+	ContextT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	_, _, _, _, _,
+]) GetBlockObjects(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.ObjectsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, _, blockHeader, err := h.resolveExecutionID(params.ExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.SignedBlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate the proof (along with the "correct" beacon block root to
+	// verify against) for the requested objects.
+	paths := splitPaths(params.Paths)
+	h.Logger().Info(
+		"Generating beacon block objects proof", "slot", slot, "paths", paths,
+	)
+	objects, proof, beaconBlockRoot, err := merkle.ProveObjectsInBlock[
+		BeaconBlockT, BeaconBlockBodyT,
+	](blockHeader, blk.GetMessage(), paths)
+	if err != nil {
+		return nil, wrapPathError(err)
+	}
+
+	return types.ObjectsResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   beaconBlockRoot,
+		Objects:           objects,
+		Proof:             proof,
+	}, nil
+}
+
+// splitPaths splits the comma separated paths.
+func splitPaths(params []string) []string {
+	paths := make([]string, 0, len(params))
+	for _, param := range params {
+		for _, path := range strings.Split(param, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// wrapPathError marks the errors caused by the requested paths as invalid
+// requests.
+func wrapPathError(err error) error {
+	if errors.Is(err, merkle.ErrNoObjectPaths) ||
+		errors.Is(err, merkle.ErrInvalidObjectPath) ||
+		errors.Is(err, merkle.ErrDuplicateObject) {
+		return errors.Wrap(handlertypes.ErrInvalidRequest, err.Error())
+	}
+	return err
+}
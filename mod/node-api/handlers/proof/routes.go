@@ -28,7 +28,7 @@ import (
 )
 
 func (
-	h *Handler[ContextT, _, _, _, _, _],
+	h *Handler[ContextT, _, _, _, _, _, _, _, _],
 ) RegisterRoutes(logger log.Logger[any]) {
 	h.SetLogger(logger)
 	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
@@ -47,5 +47,20 @@ func (
 			Path:    "bkit/v1/proof/execution_fee_recipient/:execution_id",
 			Handler: h.GetExecutionFeeRecipient,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/historical_block_root/:execution_id",
+			Handler: h.GetHistoricalBlockRoot,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/state/:execution_id",
+			Handler: h.GetStateObjects,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/block/:execution_id",
+			Handler: h.GetBlockObjects,
+		},
 	})
 }
@@ -39,3 +39,20 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.ExecutionIDRequest
 }
+
+// HistoricalBlockRootRequest is the request for the
+// `/proof/historical_block_root/{execution_id}` endpoint.
+type HistoricalBlockRootRequest struct {
+	types.ExecutionIDRequest
+	// Slot is the historical slot of which the block root is proven.
+	Slot string `query:"slot" validate:"required,slot"`
+}
+
+// ObjectsRequest is the request for the `/proof/state/{execution_id}` and
+// `/proof/block/{execution_id}` endpoints.
+type ObjectsRequest struct {
+	types.ExecutionIDRequest
+	// Paths are the paths of the objects to prove, e.g.
+	// `validators/3/pubkey`, either repeated or comma separated.
+	Paths []string `query:"paths" validate:"required,dive,required"`
+}
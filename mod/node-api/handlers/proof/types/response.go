@@ -42,7 +42,7 @@ type BlockProposerResponse[BeaconBlockHeaderT any] struct {
 	// ValidatorPubkeyProof can be verified against the beacon block root. Use
 	// a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator pubkey in the beacon block. In
-	// the Deneb fork, z is 3254554418216960.
+	// the Deneb fork, z is 6350779162034176.
 	ValidatorPubkeyProof []common.Root `json:"validator_pubkey_proof"`
 }
 
@@ -60,7 +60,7 @@ type ExecutionNumberResponse[BeaconBlockHeaderT any] struct {
 	ExecutionNumber math.U64 `json:"execution_number"`
 
 	// ExecutionNumberProof can be verified against the beacon block root using
-	// a Generalized Index of 5894 in the Deneb fork.
+	// a Generalized Index of 11526 in the Deneb fork.
 	ExecutionNumberProof []common.Root `json:"execution_number_proof"`
 }
 
@@ -80,6 +80,69 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	ExecutionFeeRecipient common.ExecutionAddress `json:"execution_fee_recipient"`
 
 	// ExecutionFeeRecipientProof can be verified against the beacon block root
-	// using a Generalized Index of 5894 in the Deneb fork.
+	// using a Generalized Index of 11521 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// HistoricalBlockRootResponse is the response for the
+// `/proof/historical_block_root/{execution_id}` endpoint.
+type HistoricalBlockRootResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// HistoricalSlot is the slot of the proven block root.
+	HistoricalSlot math.Slot `json:"historical_slot"`
+
+	// HistoricalBlockRoot is the root of the block at the historical slot.
+	HistoricalBlockRoot common.Root `json:"historical_block_root"`
+
+	// GeneralizedIndex is the generalized index of the historical block root
+	// in the beacon block, through the historical summary of its period.
+	GeneralizedIndex math.U64 `json:"generalized_index"`
+
+	// HistoricalBlockRootProof can be verified against the beacon block root
+	// using the generalized index.
+	HistoricalBlockRootProof []common.Root `json:"historical_block_root_proof"`
+}
+
+// ObjectsResponse is the response for the `/proof/state/{execution_id}` and
+// `/proof/block/{execution_id}` endpoints.
+type ObjectsResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Objects are the proven objects, in the order of the requested paths.
+	Objects []ProvenObject `json:"objects"`
+
+	// Proof is the multiproof of the objects, which can be verified against
+	// the beacon block root. It holds the helper nodes in decreasing order of
+	// generalized index, hence it is a regular Merkle proof when a single
+	// object is proven.
+	Proof []common.Root `json:"proof"`
+}
+
+// ProvenObject is an object proven by a multiproof.
+type ProvenObject struct {
+	// Path is the requested path of the object.
+	Path string `json:"path"`
+
+	// GeneralizedIndex is the generalized index of the leaf in the beacon
+	// block.
+	GeneralizedIndex math.U64 `json:"generalized_index"`
+
+	// Offset is the offset of the object in the leaf, for basic objects
+	// packed together in a single chunk.
+	Offset uint8 `json:"offset"`
+
+	// Leaf is the chunk holding the object, or the hash tree root of the
+	// object if it spans several chunks.
+	Leaf common.Root `json:"leaf"`
+}
@@ -24,13 +24,39 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 )
 
+// SignedBeaconBlock is the interface for a signed beacon block.
+type SignedBeaconBlock[BeaconBlockT any] interface {
+	// GetMessage returns the beacon block.
+	GetMessage() BeaconBlockT
+}
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	constraints.SSZRootable
+	// GetTree is kept for FastSSZ compatibility.
+	GetTree() (*fastssz.Node, error)
+	// GetBody returns the body of the beacon block.
+	GetBody() BeaconBlockBodyT
+	// Version returns the fork version the beacon block is encoded at.
+	Version() uint32
+}
+
+// BeaconBlockBody is the interface for a beacon block body.
+type BeaconBlockBody interface {
+	// SSZSchema returns the SSZ schema of the beacon block body at the given
+	// fork version.
+	SSZSchema(forkVersion uint32) schema.SSZType
+}
+
 // BeaconBlockHeader is the interface for a beacon block header.
 type BeaconBlockHeader interface {
 	constraints.SSZRootable
+	constraints.SSZSchemaDefiner
 	// GetTree is kept for FastSSZ compatibility.
 	GetTree() (*fastssz.Node, error)
 	// GetProposerIndex returns the proposer index.
@@ -46,6 +72,9 @@ type BeaconState[
 	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
 	// GetMarshallable returns the marshallable version of the beacon state.
 	GetMarshallable() (BeaconStateMarshallableT, error)
+	// GetHistoricalBlockRoots returns the block roots summarized by the
+	// historical summary at the given index.
+	GetHistoricalBlockRoots(index uint64) ([]common.Root, error)
 	// ValidatorByIndex retrieves the validator at the given index.
 	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
 }
@@ -55,6 +84,11 @@ type BeaconState[
 type BeaconStateMarshallable interface {
 	// GetTree is kept for FastSSZ compatibility.
 	GetTree() (*fastssz.Node, error)
+	// Version returns the fork version the beacon state is encoded at.
+	Version() uint32
+	// SSZSchema returns the SSZ schema of the beacon state at the given fork
+	// version.
+	SSZSchema(forkVersion uint32) schema.SSZType
 }
 
 // ExecutionPayloadHeader is the interface for an execution payload header.
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package verifier
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidProof is returned when a proof does not verify against the
+	// beacon block root.
+	ErrInvalidProof = errors.New("invalid proof")
+
+	// ErrIndexOutOfRange is returned when a validator index exceeds the
+	// validator registry limit.
+	ErrIndexOutOfRange = errors.New("validator index out of range")
+
+	// ErrUntrustedBlockRoot is returned when a proof response is for another
+	// beacon block root than the trusted one.
+	ErrUntrustedBlockRoot = errors.New("untrusted beacon block root")
+
+	// ErrBlockHeaderMismatch is returned when the beacon block header of a
+	// proof response does not hash to the beacon block root.
+	ErrBlockHeaderMismatch = errors.New(
+		"beacon block header does not match beacon block root",
+	)
+
+	// ErrObjectMismatch is returned when a proven object of a proof response
+	// is not at the generalized index its path resolves to.
+	ErrObjectMismatch = errors.New("object does not match its path")
+)
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package verifier
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// The leaves are computed as in `contracts/src/eip4788/SSZ.sol`.
+
+// ValidatorPubkeyHashTreeRoot returns the hash tree root of a validator
+// pubkey, which spans two chunks.
+func ValidatorPubkeyHashTreeRoot(pubkey crypto.BLSPubkey) common.Root {
+	var chunks [64]byte
+	copy(chunks[:], pubkey[:])
+	return sha256.Hash(chunks[:])
+}
+
+// Uint64HashTreeRoot returns the hash tree root of a uint64, which is its
+// little endian encoding padded to a chunk.
+func Uint64HashTreeRoot(v math.U64) common.Root {
+	var root common.Root
+	binary.LittleEndian.PutUint64(root[:], uint64(v))
+	return root
+}
+
+// AddressHashTreeRoot returns the hash tree root of an execution address,
+// which is the address padded to a chunk.
+func AddressHashTreeRoot(address common.ExecutionAddress) common.Root {
+	var root common.Root
+	copy(root[:], address[:])
+	return root
+}
@@ -0,0 +1,394 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package verifier verifies the proofs returned by the `bkit/v1/proof/*`
+// endpoints against a trusted beacon block root, e.g. one read from the
+// EIP-4788 beacon roots contract. It mirrors the BeaconVerifier contract in
+// `contracts/src/eip4788`, so that off-chain services accept exactly the
+// proofs the contract accepts.
+package verifier
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	ssz "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// validatorRegistryLimit is the maximum number of validators.
+const validatorRegistryLimit = 1 << 40
+
+// BeaconBlockHeader is the beacon block header of a proof response.
+type BeaconBlockHeader interface {
+	// HashTreeRoot returns the beacon block root.
+	HashTreeRoot() common.Root
+	// GetProposerIndex returns the proposer index.
+	GetProposerIndex() math.ValidatorIndex
+	// SSZSchema returns the SSZ schema of the beacon block header.
+	SSZSchema() schema.SSZType
+}
+
+// BeaconBlockBody is the beacon block body the objects of a block proof
+// response are resolved in.
+type BeaconBlockBody interface {
+	// SSZSchema returns the SSZ schema of the beacon block body at the given
+	// fork version.
+	SSZSchema(forkVersion uint32) schema.SSZType
+}
+
+// BeaconState is the beacon state the objects of a state proof response are
+// resolved in.
+type BeaconState interface {
+	// SSZSchema returns the SSZ schema of the beacon state at the given fork
+	// version.
+	SSZSchema(forkVersion uint32) schema.SSZType
+}
+
+// Config holds the generalized indices the proofs are verified at, as
+// configured in the BeaconVerifier contract.
+type Config struct {
+	// ZeroValidatorPubkeyGIndex is the generalized index of the pubkey of the
+	// 0 validator in the beacon block.
+	ZeroValidatorPubkeyGIndex ssz.GeneralizedIndex
+	// ExecutionNumberGIndex is the generalized index of the block number of
+	// the latest execution payload header in the beacon block.
+	ExecutionNumberGIndex ssz.GeneralizedIndex
+	// ExecutionFeeRecipientGIndex is the generalized index of the fee
+	// recipient of the latest execution payload header in the beacon block.
+	ExecutionFeeRecipientGIndex ssz.GeneralizedIndex
+}
+
+// DefaultConfig returns the generalized indices of the Deneb fork.
+func DefaultConfig() Config {
+	return ConfigForVersion(version.Deneb)
+}
+
+// ConfigForVersion returns the generalized indices of the given fork version,
+// which the contract must be reconfigured with at the fork.
+func ConfigForVersion(forkVersion uint32) Config {
+	return Config{
+		ZeroValidatorPubkeyGIndex: ssz.GeneralizedIndex(
+			merkle.ZeroValidatorPubkeyGIndexBlock(forkVersion),
+		),
+		ExecutionNumberGIndex: ssz.GeneralizedIndex(
+			merkle.ExecutionNumberGIndexBlock(forkVersion),
+		),
+		ExecutionFeeRecipientGIndex: ssz.GeneralizedIndex(
+			merkle.ExecutionFeeRecipientGIndexBlock(forkVersion),
+		),
+	}
+}
+
+// Verifier verifies proofs against trusted beacon block roots.
+type Verifier struct {
+	cfg Config
+}
+
+// New creates a new Verifier with the given generalized indices.
+func New(cfg Config) *Verifier {
+	return &Verifier{cfg: cfg}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Verifiers                                 */
+/* -------------------------------------------------------------------------- */
+
+// VerifyBeaconBlockProposer verifies the proposer pubkey is the pubkey of the
+// validator at the proposer index in the beacon block.
+func (v *Verifier) VerifyBeaconBlockProposer(
+	beaconBlockRoot common.Root,
+	proposerIndex math.ValidatorIndex,
+	proposerPubkey crypto.BLSPubkey,
+	proposerPubkeyProof []common.Root,
+) error {
+	if proposerIndex >= validatorRegistryLimit {
+		return ErrIndexOutOfRange
+	}
+	gIndex := v.cfg.ZeroValidatorPubkeyGIndex + ssz.GeneralizedIndex(
+		merkle.ValidatorPubkeyGIndexOffset*proposerIndex,
+	)
+	return verifyProof(
+		proposerPubkeyProof,
+		beaconBlockRoot,
+		ValidatorPubkeyHashTreeRoot(proposerPubkey),
+		gIndex,
+	)
+}
+
+// VerifyExecutionNumber verifies the block number of the latest execution
+// payload header in the beacon block.
+func (v *Verifier) VerifyExecutionNumber(
+	beaconBlockRoot common.Root,
+	executionNumber math.U64,
+	executionNumberProof []common.Root,
+) error {
+	return verifyProof(
+		executionNumberProof,
+		beaconBlockRoot,
+		Uint64HashTreeRoot(executionNumber),
+		v.cfg.ExecutionNumberGIndex,
+	)
+}
+
+// VerifyCoinbase verifies the fee recipient of the latest execution payload
+// header in the beacon block.
+func (v *Verifier) VerifyCoinbase(
+	beaconBlockRoot common.Root,
+	coinbase common.ExecutionAddress,
+	coinbaseProof []common.Root,
+) error {
+	return verifyProof(
+		coinbaseProof,
+		beaconBlockRoot,
+		AddressHashTreeRoot(coinbase),
+		v.cfg.ExecutionFeeRecipientGIndex,
+	)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Responses                                 */
+/* -------------------------------------------------------------------------- */
+
+// VerifyBlockProposerResponse verifies the response of the
+// `bkit/v1/proof/block_proposer` endpoint against the trusted beacon block
+// root.
+func VerifyBlockProposerResponse[BeaconBlockHeaderT BeaconBlockHeader](
+	v *Verifier,
+	trustedRoot common.Root,
+	resp types.BlockProposerResponse[BeaconBlockHeaderT],
+) error {
+	if err := verifyBlockRoot(
+		trustedRoot, resp.BeaconBlockRoot, resp.BeaconBlockHeader,
+	); err != nil {
+		return err
+	}
+	return v.VerifyBeaconBlockProposer(
+		trustedRoot,
+		resp.BeaconBlockHeader.GetProposerIndex(),
+		resp.ValidatorPubkey,
+		resp.ValidatorPubkeyProof,
+	)
+}
+
+// VerifyExecutionNumberResponse verifies the response of the
+// `bkit/v1/proof/execution_number` endpoint against the trusted beacon block
+// root.
+func VerifyExecutionNumberResponse[BeaconBlockHeaderT BeaconBlockHeader](
+	v *Verifier,
+	trustedRoot common.Root,
+	resp types.ExecutionNumberResponse[BeaconBlockHeaderT],
+) error {
+	if err := verifyBlockRoot(
+		trustedRoot, resp.BeaconBlockRoot, resp.BeaconBlockHeader,
+	); err != nil {
+		return err
+	}
+	return v.VerifyExecutionNumber(
+		trustedRoot, resp.ExecutionNumber, resp.ExecutionNumberProof,
+	)
+}
+
+// VerifyExecutionFeeRecipientResponse verifies the response of the
+// `bkit/v1/proof/execution_fee_recipient` endpoint against the trusted beacon
+// block root.
+func VerifyExecutionFeeRecipientResponse[
+	BeaconBlockHeaderT BeaconBlockHeader,
+](
+	v *Verifier,
+	trustedRoot common.Root,
+	resp types.ExecutionFeeRecipientResponse[BeaconBlockHeaderT],
+) error {
+	if err := verifyBlockRoot(
+		trustedRoot, resp.BeaconBlockRoot, resp.BeaconBlockHeader,
+	); err != nil {
+		return err
+	}
+	return v.VerifyCoinbase(
+		trustedRoot,
+		resp.ExecutionFeeRecipient,
+		resp.ExecutionFeeRecipientProof,
+	)
+}
+
+// VerifyStateObjectsResponse verifies the response of the
+// `bkit/v1/proof/state` endpoint against the trusted beacon block root, for a
+// beacon state at the given fork version. The generalized index of every
+// object is resolved from its path in the schema of the beacon state, under
+// the state root of the beacon block, rather than trusted from the response.
+// The caller is responsible for checking the leaves hold the expected
+// objects.
+func VerifyStateObjectsResponse[
+	BeaconStateT BeaconState,
+	BeaconBlockHeaderT BeaconBlockHeader,
+](
+	trustedRoot common.Root,
+	forkVersion uint32,
+	resp types.ObjectsResponse[BeaconBlockHeaderT],
+) error {
+	var st BeaconStateT
+	stateSchema := st.SSZSchema(forkVersion)
+	return verifyObjectsResponse(
+		trustedRoot, resp,
+		func(path string) (ssz.GeneralizedIndex, uint8, error) {
+			gIndex, offset, err := resolvePath(stateSchema, path)
+			if err != nil {
+				return 0, 0, err
+			}
+			return ssz.GeneralizedIndices{
+				merkle.StateGIndexDenebBlock, gIndex,
+			}.Concat(), offset, nil
+		},
+	)
+}
+
+// VerifyBlockObjectsResponse verifies the response of the
+// `bkit/v1/proof/block` endpoint against the trusted beacon block root, for a
+// beacon block at the given fork version. The generalized index of every
+// object is resolved from its path in the schema of the beacon block header,
+// descending into the schema of the beacon block body under the body root,
+// rather than trusted from the response. The caller is responsible for
+// checking the leaves hold the expected objects.
+func VerifyBlockObjectsResponse[
+	BeaconBlockBodyT BeaconBlockBody,
+	BeaconBlockHeaderT BeaconBlockHeader,
+](
+	trustedRoot common.Root,
+	forkVersion uint32,
+	resp types.ObjectsResponse[BeaconBlockHeaderT],
+) error {
+	var body BeaconBlockBodyT
+	headerSchema := resp.BeaconBlockHeader.SSZSchema()
+	bodySchema := body.SSZSchema(forkVersion)
+	return verifyObjectsResponse(
+		trustedRoot, resp,
+		func(path string) (ssz.GeneralizedIndex, uint8, error) {
+			gIndex, offset, err := merkle.ResolveBlockObjectPath(
+				headerSchema, bodySchema, path,
+			)
+			if err != nil {
+				return 0, 0, errors.Wrapf(
+					ErrObjectMismatch, "%s: %v", path, err,
+				)
+			}
+			return gIndex, offset, nil
+		},
+	)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// verifyBlockRoot checks the beacon block root of a response is the trusted
+// one, and that the returned beacon block header hashes to it.
+func verifyBlockRoot(
+	trustedRoot, beaconBlockRoot common.Root,
+	header BeaconBlockHeader,
+) error {
+	if beaconBlockRoot != trustedRoot {
+		return errors.Wrapf(
+			ErrUntrustedBlockRoot, "expected %s, got %s",
+			trustedRoot, beaconBlockRoot,
+		)
+	}
+	if header.HashTreeRoot() != trustedRoot {
+		return ErrBlockHeaderMismatch
+	}
+	return nil
+}
+
+// verifyObjectsResponse verifies the multiproof of an objects response
+// against the trusted beacon block root, at the generalized indices the paths
+// of the objects resolve to.
+func verifyObjectsResponse[BeaconBlockHeaderT BeaconBlockHeader](
+	trustedRoot common.Root,
+	resp types.ObjectsResponse[BeaconBlockHeaderT],
+	resolve func(path string) (ssz.GeneralizedIndex, uint8, error),
+) error {
+	if err := verifyBlockRoot(
+		trustedRoot, resp.BeaconBlockRoot, resp.BeaconBlockHeader,
+	); err != nil {
+		return err
+	}
+	if len(resp.Objects) == 0 {
+		return ErrInvalidProof
+	}
+
+	indices := make(ssz.GeneralizedIndices, len(resp.Objects))
+	leaves := make([]common.Root, len(resp.Objects))
+	for i, object := range resp.Objects {
+		gIndex, offset, err := resolve(object.Path)
+		if err != nil {
+			return err
+		}
+		if gIndex != ssz.GeneralizedIndex(object.GeneralizedIndex) ||
+			offset != object.Offset {
+			return errors.Wrapf(
+				ErrObjectMismatch,
+				"%s: expected generalized index %d and offset %d, "+
+					"got %d and %d",
+				object.Path, gIndex, offset,
+				object.GeneralizedIndex, object.Offset,
+			)
+		}
+		indices[i] = gIndex
+		leaves[i] = object.Leaf
+	}
+	if !ssz.VerifyMultiproof(indices, leaves, resp.Proof, trustedRoot) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// resolvePath resolves the generalized index and the offset of the object at
+// the given path in the SSZ type.
+func resolvePath(
+	typ schema.SSZType,
+	path string,
+) (ssz.GeneralizedIndex, uint8, error) {
+	_, gIndex, offset, err := ssz.ObjectPath[
+		ssz.GeneralizedIndex, common.Root,
+	](path).GetGeneralizedIndex(typ)
+	if err != nil {
+		return 0, 0, errors.Wrapf(ErrObjectMismatch, "%s: %v", path, err)
+	}
+	return gIndex, offset, nil
+}
+
+// verifyProof verifies the proof of the leaf at the generalized index.
+func verifyProof(
+	proof []common.Root,
+	root, leaf common.Root,
+	gIndex ssz.GeneralizedIndex,
+) error {
+	ok, err := ssz.VerifyProof(gIndex, leaf, proof, root)
+	if err != nil {
+		return errors.Wrap(ErrInvalidProof, err.Error())
+	}
+	if !ok {
+		return ErrInvalidProof
+	}
+	return nil
+}
@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package verifier_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/verifier"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// fixturesDir holds the fixtures the BeaconVerifier contract is tested
+// against, so that both verifiers are checked to accept the same proofs.
+const fixturesDir = "../../../../../contracts/test/eip4788/fixtures"
+
+type blockProposerFixture struct {
+	BeaconBlockRoot     common.Root      `json:"$0__beaconBlockRoot"`
+	ProposerIndex       uint64           `json:"$1__proposerIndex"`
+	ProposerPubkey      crypto.BLSPubkey `json:"$2__proposerPubkey"`
+	ProposerPubkeyProof []common.Root    `json:"$3__proposerPubkeyProof"`
+}
+
+type coinbaseFixture struct {
+	BeaconBlockRoot common.Root             `json:"$0__beaconBlockRoot"`
+	Coinbase        common.ExecutionAddress `json:"$1__coinbase"`
+	CoinbaseProof   []common.Root           `json:"$3__coinbaseProof"`
+}
+
+type executionNumberFixture struct {
+	BeaconBlockRoot      common.Root   `json:"$0__beaconBlockRoot"`
+	ExecutionNumber      uint64        `json:"$1__executionNumber"`
+	ExecutionNumberProof []common.Root `json:"$3__executionNumberProof"`
+}
+
+func readFixture[T any](t *testing.T, name string) T {
+	t.Helper()
+	var fixture T
+	bz, err := os.ReadFile(filepath.Join(fixturesDir, name))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+	return fixture
+}
+
+func TestVerifyBeaconBlockProposer(t *testing.T) {
+	v := verifier.New(verifier.DefaultConfig())
+	f := readFixture[blockProposerFixture](t, "block_proposer_proof.json")
+
+	require.NoError(t, v.VerifyBeaconBlockProposer(
+		f.BeaconBlockRoot,
+		math.ValidatorIndex(f.ProposerIndex),
+		f.ProposerPubkey,
+		f.ProposerPubkeyProof,
+	))
+
+	// Another validator index, pubkey or root must not verify.
+	require.ErrorIs(t, v.VerifyBeaconBlockProposer(
+		f.BeaconBlockRoot,
+		math.ValidatorIndex(f.ProposerIndex+1),
+		f.ProposerPubkey,
+		f.ProposerPubkeyProof,
+	), verifier.ErrInvalidProof)
+	require.ErrorIs(t, v.VerifyBeaconBlockProposer(
+		f.BeaconBlockRoot,
+		math.ValidatorIndex(f.ProposerIndex),
+		crypto.BLSPubkey{},
+		f.ProposerPubkeyProof,
+	), verifier.ErrInvalidProof)
+	require.ErrorIs(t, v.VerifyBeaconBlockProposer(
+		common.Root{},
+		math.ValidatorIndex(f.ProposerIndex),
+		f.ProposerPubkey,
+		f.ProposerPubkeyProof,
+	), verifier.ErrInvalidProof)
+	require.ErrorIs(t, v.VerifyBeaconBlockProposer(
+		f.BeaconBlockRoot,
+		1<<40,
+		f.ProposerPubkey,
+		f.ProposerPubkeyProof,
+	), verifier.ErrIndexOutOfRange)
+
+	// As the contract, branches with a missing item are rejected.
+	require.ErrorIs(t, v.VerifyBeaconBlockProposer(
+		f.BeaconBlockRoot,
+		math.ValidatorIndex(f.ProposerIndex),
+		f.ProposerPubkey,
+		f.ProposerPubkeyProof[1:],
+	), verifier.ErrInvalidProof)
+}
+
+func TestVerifyCoinbase(t *testing.T) {
+	v := verifier.New(verifier.DefaultConfig())
+	f := readFixture[coinbaseFixture](t, "coinbase_proof.json")
+
+	require.NoError(t, v.VerifyCoinbase(
+		f.BeaconBlockRoot, f.Coinbase, f.CoinbaseProof,
+	))
+	require.ErrorIs(t, v.VerifyCoinbase(
+		f.BeaconBlockRoot,
+		common.ExecutionAddress{1},
+		f.CoinbaseProof,
+	), verifier.ErrInvalidProof)
+	require.ErrorIs(t, v.VerifyCoinbase(
+		f.BeaconBlockRoot,
+		f.Coinbase,
+		append(f.CoinbaseProof, common.Root{}),
+	), verifier.ErrInvalidProof)
+}
+
+func TestVerifyExecutionNumber(t *testing.T) {
+	v := verifier.New(verifier.DefaultConfig())
+	f := readFixture[executionNumberFixture](t, "execution_number_proof.json")
+
+	require.NoError(t, v.VerifyExecutionNumber(
+		f.BeaconBlockRoot,
+		math.U64(f.ExecutionNumber),
+		f.ExecutionNumberProof,
+	))
+	require.ErrorIs(t, v.VerifyExecutionNumber(
+		f.BeaconBlockRoot,
+		math.U64(f.ExecutionNumber+1),
+		f.ExecutionNumberProof,
+	), verifier.ErrInvalidProof)
+
+	// The generalized index is configurable, as in the contract.
+	cfg := verifier.DefaultConfig()
+	cfg.ExecutionNumberGIndex = cfg.ExecutionFeeRecipientGIndex
+	require.ErrorIs(t, verifier.New(cfg).VerifyExecutionNumber(
+		f.BeaconBlockRoot,
+		math.U64(f.ExecutionNumber),
+		f.ExecutionNumberProof,
+	), verifier.ErrInvalidProof)
+}
+
+// The Electra fixtures are proven against the beacon state carrying the
+// historical summaries, and only verify at the Electra generalized indices.
+func TestVerifyElectraFixtures(t *testing.T) {
+	electra := verifier.New(verifier.ConfigForVersion(version.Electra))
+	deneb := verifier.New(verifier.DefaultConfig())
+
+	proposer := readFixture[blockProposerFixture](
+		t, filepath.Join("electra", "block_proposer_proof.json"),
+	)
+	coinbase := readFixture[coinbaseFixture](
+		t, filepath.Join("electra", "coinbase_proof.json"),
+	)
+	number := readFixture[executionNumberFixture](
+		t, filepath.Join("electra", "execution_number_proof.json"),
+	)
+	for v, verify := range map[*verifier.Verifier]func(error){
+		electra: func(err error) {
+			require.NoError(t, err)
+		},
+		deneb: func(err error) {
+			require.ErrorIs(t, err, verifier.ErrInvalidProof)
+		},
+	} {
+		verify(v.VerifyBeaconBlockProposer(
+			proposer.BeaconBlockRoot,
+			math.ValidatorIndex(proposer.ProposerIndex),
+			proposer.ProposerPubkey,
+			proposer.ProposerPubkeyProof,
+		))
+		verify(v.VerifyCoinbase(
+			coinbase.BeaconBlockRoot, coinbase.Coinbase, coinbase.CoinbaseProof,
+		))
+		verify(v.VerifyExecutionNumber(
+			number.BeaconBlockRoot,
+			math.U64(number.ExecutionNumber),
+			number.ExecutionNumberProof,
+		))
+	}
+}
+
+func TestVerifyExecutionNumberResponse(t *testing.T) {
+	v := verifier.New(verifier.DefaultConfig())
+	f := readFixture[executionNumberFixture](t, "execution_number_proof.json")
+	resp := types.ExecutionNumberResponse[testHeader]{
+		BeaconBlockHeader:    testHeader{root: f.BeaconBlockRoot},
+		BeaconBlockRoot:      f.BeaconBlockRoot,
+		ExecutionNumber:      math.U64(f.ExecutionNumber),
+		ExecutionNumberProof: f.ExecutionNumberProof,
+	}
+
+	require.NoError(t, verifier.VerifyExecutionNumberResponse(
+		v, f.BeaconBlockRoot, resp,
+	))
+	require.ErrorIs(t, verifier.VerifyExecutionNumberResponse(
+		v, common.Root{1}, resp,
+	), verifier.ErrUntrustedBlockRoot)
+
+	resp.BeaconBlockHeader = testHeader{}
+	require.ErrorIs(t, verifier.VerifyExecutionNumberResponse(
+		v, f.BeaconBlockRoot, resp,
+	), verifier.ErrBlockHeaderMismatch)
+}
+
+func TestVerifyObjectsResponses(t *testing.T) {
+	for _, forkVersion := range []uint32{version.Deneb, version.Electra} {
+		state := &proofState{forkVersion: forkVersion, slot: 10}
+		header := &proofHeader{slot: 10, stateRoot: state.HashTreeRoot()}
+		root := header.HashTreeRoot()
+
+		// State objects are at the generalized indices of the fork version.
+		objects, proof, _, err := merkle.ProveObjectsInStateInBlock(
+			header, state, []string{"slot", "eth1_deposit_index"},
+		)
+		require.NoError(t, err)
+		resp := types.ObjectsResponse[*proofHeader]{
+			BeaconBlockHeader: header,
+			BeaconBlockRoot:   root,
+			Objects:           objects,
+			Proof:             proof,
+		}
+		require.NoError(t, verifier.VerifyStateObjectsResponse[*beaconState](
+			root, forkVersion, resp,
+		))
+		require.ErrorIs(t, verifier.VerifyStateObjectsResponse[*beaconState](
+			root, forkVersion^(version.Deneb^version.Electra), resp,
+		), verifier.ErrObjectMismatch)
+
+		// A valid proof of another object than the one at the path is
+		// rejected.
+		resp.Objects[0].Path = "next_withdrawal_index"
+		require.ErrorIs(t, verifier.VerifyStateObjectsResponse[*beaconState](
+			root, forkVersion, resp,
+		), verifier.ErrObjectMismatch)
+		resp.Objects[0].Path = "not_a_field"
+		require.ErrorIs(t, verifier.VerifyStateObjectsResponse[*beaconState](
+			root, forkVersion, resp,
+		), verifier.ErrObjectMismatch)
+	}
+
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Electra)
+	body.ExecutionPayload = &ctypes.ExecutionPayload{
+		Number:        7,
+		BaseFeePerGas: math.NewU256(0),
+	}
+	blk := &ctypes.BeaconBlock{Slot: 10, ProposerIndex: 3, Body: body}
+	header := blk.GetHeader()
+	root := header.HashTreeRoot()
+	objects, proof, _, err := merkle.ProveObjectsInBlock(
+		header, blk, []string{
+			"proposer_index",
+			"body_root/execution_payload/block_number",
+			"body_root/attestations/__len__",
+		},
+	)
+	require.NoError(t, err)
+	resp := types.ObjectsResponse[*ctypes.BeaconBlockHeader]{
+		BeaconBlockHeader: header,
+		BeaconBlockRoot:   root,
+		Objects:           objects,
+		Proof:             proof,
+	}
+	verify := func(forkVersion uint32) error {
+		return verifier.VerifyBlockObjectsResponse[*ctypes.BeaconBlockBody](
+			root, forkVersion, resp,
+		)
+	}
+	require.NoError(t, verify(version.Electra))
+
+	// The attestations are not part of the body before Electra.
+	require.ErrorIs(t, verify(version.Deneb), verifier.ErrObjectMismatch)
+
+	resp.Objects[0].Path = "slot"
+	require.ErrorIs(t, verify(version.Electra), verifier.ErrObjectMismatch)
+	resp.Objects[0].Path = "body_root/execution_payload/gas_limit"
+	require.ErrorIs(t, verify(version.Electra), verifier.ErrObjectMismatch)
+	resp.Objects = nil
+	require.ErrorIs(t, verify(version.Electra), verifier.ErrInvalidProof)
+}
+
+func TestLeaves(t *testing.T) {
+	require.Equal(
+		t,
+		common.Root{0xde},
+		verifier.Uint64HashTreeRoot(222),
+	)
+	require.Equal(
+		t,
+		common.Root{1, 2},
+		verifier.AddressHashTreeRoot(common.ExecutionAddress{1, 2}),
+	)
+}
+
+// testHeader is a beacon block header with a preset root.
+type testHeader struct {
+	root          common.Root
+	proposerIndex math.ValidatorIndex
+}
+
+func (h testHeader) HashTreeRoot() common.Root {
+	return h.root
+}
+
+func (h testHeader) GetProposerIndex() math.ValidatorIndex {
+	return h.proposerIndex
+}
+
+func (testHeader) SSZSchema() schema.SSZType {
+	return (*ctypes.BeaconBlockHeader)(nil).SSZSchema()
+}
+
+// proofHeader is a beacon block header that can be proven.
+type proofHeader struct {
+	slot          uint64
+	proposerIndex uint64
+	stateRoot     common.Root
+}
+
+func (h *proofHeader) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(h.slot)
+	hh.PutUint64(h.proposerIndex)
+	hh.PutBytes(make([]byte, 32))
+	hh.PutBytes(h.stateRoot[:])
+	hh.PutBytes(make([]byte, 32))
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (h *proofHeader) HashTreeRoot() common.Root {
+	return hashTreeRoot(h)
+}
+
+func (h *proofHeader) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(h)
+}
+
+func (h *proofHeader) GetProposerIndex() math.ValidatorIndex {
+	return math.ValidatorIndex(h.proposerIndex)
+}
+
+func (*proofHeader) SSZSchema() schema.SSZType {
+	return (*ctypes.BeaconBlockHeader)(nil).SSZSchema()
+}
+
+// beaconState is the beacon state of the consensus types.
+type beaconState = ctypes.BeaconState[
+	*ctypes.BeaconBlockHeader,
+	*ctypes.Eth1Data,
+	*ctypes.ExecutionPayloadHeader,
+	*ctypes.Fork,
+	*ctypes.Validator,
+	ctypes.BeaconBlockHeader,
+	ctypes.Eth1Data,
+	ctypes.ExecutionPayloadHeader,
+	ctypes.Fork,
+	ctypes.Validator,
+]
+
+// proofState is a beacon state with the fields of the given fork version,
+// all of them merkleized as uint64s.
+type proofState struct {
+	forkVersion uint32
+	slot        uint64
+}
+
+func (s *proofState) HashTreeRootWith(hh fastssz.HashWalker) error {
+	numFields := 16
+	if s.forkVersion >= version.Electra {
+		numFields++
+	}
+	indx := hh.Index()
+	for i := range numFields {
+		if i == 1 {
+			hh.PutUint64(s.slot)
+			continue
+		}
+		hh.PutUint64(0)
+	}
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (s *proofState) HashTreeRoot() common.Root {
+	return hashTreeRoot(s)
+}
+
+func (s *proofState) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(s)
+}
+
+func (s *proofState) Version() uint32 {
+	return s.forkVersion
+}
+
+func (*proofState) SSZSchema(forkVersion uint32) schema.SSZType {
+	return (*beaconState)(nil).SSZSchema(forkVersion)
+}
+
+func (s *proofState) GetMarshallable() (*proofState, error) {
+	return s, nil
+}
+
+func (s *proofState) GetLatestExecutionPayloadHeader() (
+	*proofPayloadHeader, error,
+) {
+	return &proofPayloadHeader{}, nil
+}
+
+func (s *proofState) ValidatorByIndex(math.ValidatorIndex) (any, error) {
+	return nil, nil
+}
+
+func (s *proofState) GetHistoricalBlockRoots(uint64) ([]common.Root, error) {
+	return nil, nil
+}
+
+type proofPayloadHeader struct{}
+
+func (*proofPayloadHeader) GetNumber() math.U64 { return 0 }
+
+func (*proofPayloadHeader) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func hashTreeRoot(v interface {
+	HashTreeRootWith(hh fastssz.HashWalker) error
+}) common.Root {
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	if err := v.HashTreeRootWith(hh); err != nil {
+		panic(err)
+	}
+	root, err := hh.HashRoot()
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
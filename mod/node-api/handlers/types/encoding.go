@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+const (
+	// MIMEApplicationJSON is the media type of JSON bodies.
+	MIMEApplicationJSON = "application/json"
+	// MIMEApplicationOctetStream is the media type of SSZ bodies.
+	MIMEApplicationOctetStream = "application/octet-stream"
+	// ConsensusVersionHeader is the header carrying the fork of versioned
+	// consensus objects.
+	ConsensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// SSZResponse is a response whose data can be served as an SSZ body in place
+// of its JSON envelope.
+type SSZResponse interface {
+	// SSZData returns the object the SSZ body is the encoding of.
+	SSZData() any
+}
+
+// VersionedResponse is a response whose data is of a specific fork, which is
+// advertised in the Eth-Consensus-Version header.
+type VersionedResponse interface {
+	// ConsensusVersion returns the name of the fork of the data.
+	ConsensusVersion() string
+}
+
+// SSZData returns the data of the response.
+func (r DataResponse) SSZData() any {
+	return r.Data
+}
+
+// Response is an encoded response of the node API.
+type Response struct {
+	// Code is the HTTP status code of the response.
+	Code int
+	// Header holds the headers to set on the response.
+	Header http.Header
+	// Body is the encoded body of the response.
+	Body []byte
+}
+
+// EncodeResponse encodes the data or error returned by a handler in the
+// encoding negotiated from the Accept header of the request. Errors are always
+// encoded as JSON.
+func EncodeResponse(accept string, data any, err error) *Response {
+	if err != nil {
+		return encodeJSON(ResponseFromError(nil, err))
+	}
+
+	marshaler, _ := sszMarshaler(data)
+	contentType, err := NegotiateContentType(accept, marshaler != nil)
+	if err != nil {
+		return encodeJSON(http.StatusNotAcceptable, NewErrorResponse(
+			http.StatusNotAcceptable, err,
+		))
+	}
+
+	var resp *Response
+	if contentType == MIMEApplicationOctetStream {
+		body, mErr := marshaler.MarshalSSZ()
+		if mErr != nil {
+			return encodeJSON(ResponseFromError(nil, mErr))
+		}
+		resp = &Response{
+			Code:   http.StatusOK,
+			Header: http.Header{},
+			Body:   body,
+		}
+		resp.Header.Set("Content-Type", MIMEApplicationOctetStream)
+	} else {
+		resp = encodeJSON(http.StatusOK, data)
+	}
+
+	if versioned, ok := data.(VersionedResponse); ok &&
+		resp.Code == http.StatusOK {
+		resp.Header.Set(ConsensusVersionHeader, versioned.ConsensusVersion())
+	}
+	return resp
+}
+
+// NegotiateContentType returns the media type to encode a response in given
+// the Accept header of its request, preferring JSON when the client accepts
+// both. SSZ is only negotiated if the response supports it.
+func NegotiateContentType(accept string, sszSupported bool) (string, error) {
+	if strings.TrimSpace(accept) == "" {
+		return MIMEApplicationJSON, nil
+	}
+	for _, r := range parseAccept(accept) {
+		switch r {
+		case MIMEApplicationJSON, "application/*", "*/*":
+			return MIMEApplicationJSON, nil
+		case MIMEApplicationOctetStream:
+			if sszSupported {
+				return MIMEApplicationOctetStream, nil
+			}
+		}
+	}
+	return "", ErrNotAcceptable
+}
+
+// UnmarshalSSZRequest decodes an SSZ request body into the given target.
+func UnmarshalSSZRequest(body []byte, i any) error {
+	unmarshaler, ok := i.(constraints.SSZUnmarshaler)
+	if !ok {
+		return ErrUnsupportedMediaType
+	}
+	if err := unmarshaler.UnmarshalSSZ(body); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+	return nil
+}
+
+// IsSSZContentType returns whether the given Content-Type header denotes an
+// SSZ body.
+func IsSSZContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == MIMEApplicationOctetStream
+}
+
+// encodeJSON encodes the given response as JSON.
+func encodeJSON(code int, response any) *Response {
+	body, err := json.Marshal(response)
+	if err != nil {
+		code = http.StatusInternalServerError
+		//#nosec:G104 // the error response always marshals.
+		body, _ = json.Marshal(NewErrorResponse(code, err))
+	}
+	header := http.Header{}
+	header.Set("Content-Type", MIMEApplicationJSON)
+	return &Response{Code: code, Header: header, Body: body}
+}
+
+// sszMarshaler returns the SSZ marshaler of the data of the response, if any.
+func sszMarshaler(data any) (constraints.SSZMarshaler, bool) {
+	if resp, ok := data.(SSZResponse); ok {
+		data = resp.SSZData()
+	}
+	marshaler, ok := data.(constraints.SSZMarshaler)
+	return marshaler, ok
+}
+
+// parseAccept returns the media ranges of the given Accept header in order of
+// preference, omitting the ones that are not acceptable.
+func parseAccept(accept string) []string {
+	type mediaRange struct {
+		mediaType string
+		quality   float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{mediaType, quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	mediaTypes := make([]string, len(ranges))
+	for i, r := range ranges {
+		mediaTypes[i] = r.mediaType
+	}
+	return mediaTypes
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// sszObject is an object with a fixed SSZ encoding.
+type sszObject struct {
+	Value string `json:"value"`
+}
+
+func (o *sszObject) MarshalSSZ() ([]byte, error) {
+	return []byte(o.Value), nil
+}
+
+func (o *sszObject) UnmarshalSSZ(buf []byte) error {
+	if len(buf) == 0 {
+		return errors.New("empty buffer")
+	}
+	o.Value = string(buf)
+	return nil
+}
+
+// versionedResponse is a versioned response of an SSZ object.
+type versionedResponse struct {
+	types.DataResponse
+}
+
+func (versionedResponse) ConsensusVersion() string {
+	return "deneb"
+}
+
+func TestNegotiateContentType(t *testing.T) {
+	tests := []struct {
+		name         string
+		accept       string
+		sszSupported bool
+		want         string
+		wantErr      error
+	}{
+		{"no accept", "", true, types.MIMEApplicationJSON, nil},
+		{"any", "*/*", true, types.MIMEApplicationJSON, nil},
+		{
+			"ssz", "application/octet-stream", true,
+			types.MIMEApplicationOctetStream, nil,
+		},
+		{
+			"ssz preferred",
+			"application/json;q=0.9,application/octet-stream", true,
+			types.MIMEApplicationOctetStream, nil,
+		},
+		{
+			"json preferred",
+			"application/octet-stream;q=0.5,application/json", true,
+			types.MIMEApplicationJSON, nil,
+		},
+		{
+			"ssz unsupported, json fallback",
+			"application/octet-stream,application/json;q=0.1", false,
+			types.MIMEApplicationJSON, nil,
+		},
+		{
+			"ssz unsupported", "application/octet-stream", false,
+			"", types.ErrNotAcceptable,
+		},
+		{"json refused", "application/json;q=0", true, "", types.ErrNotAcceptable},
+		{"unknown", "text/html", true, "", types.ErrNotAcceptable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := types.NegotiateContentType(tt.accept, tt.sszSupported)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncodeResponse(t *testing.T) {
+	data := versionedResponse{types.Wrap(&sszObject{Value: "block"})}
+
+	resp := types.EncodeResponse("application/octet-stream", data, nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(
+		t, types.MIMEApplicationOctetStream, resp.Header.Get("Content-Type"),
+	)
+	require.Equal(t, "deneb", resp.Header.Get(types.ConsensusVersionHeader))
+	require.Equal(t, []byte("block"), resp.Body)
+
+	resp = types.EncodeResponse("application/json", data, nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, types.MIMEApplicationJSON, resp.Header.Get("Content-Type"))
+	require.Equal(t, "deneb", resp.Header.Get(types.ConsensusVersionHeader))
+	require.JSONEq(t, `{"data":{"value":"block"}}`, string(resp.Body))
+
+	resp = types.EncodeResponse(
+		"application/octet-stream", types.Wrap([]string{"a"}), nil,
+	)
+	require.Equal(t, http.StatusNotAcceptable, resp.Code)
+	require.Equal(t, types.MIMEApplicationJSON, resp.Header.Get("Content-Type"))
+
+	resp = types.EncodeResponse(
+		"application/octet-stream", nil, types.ErrNotFound,
+	)
+	require.Equal(t, http.StatusNotFound, resp.Code)
+	require.Equal(t, types.MIMEApplicationJSON, resp.Header.Get("Content-Type"))
+	require.Empty(t, resp.Header.Get(types.ConsensusVersionHeader))
+
+	resp = types.EncodeResponse("", nil, types.ErrSyncing)
+	require.Equal(t, http.StatusPartialContent, resp.Code)
+}
+
+func TestUnmarshalSSZRequest(t *testing.T) {
+	require.True(t, types.IsSSZContentType("application/octet-stream"))
+	require.False(t, types.IsSSZContentType("application/json"))
+
+	var obj sszObject
+	require.NoError(t, types.UnmarshalSSZRequest([]byte("block"), &obj))
+	require.Equal(t, "block", obj.Value)
+	require.ErrorIs(
+		t, types.UnmarshalSSZRequest(nil, &obj), types.ErrInvalidRequest,
+	)
+	require.ErrorIs(
+		t, types.UnmarshalSSZRequest([]byte("block"), &struct{}{}),
+		types.ErrUnsupportedMediaType,
+	)
+}
@@ -23,7 +23,11 @@ package types
 import "errors"
 
 var (
-	ErrNotFound       = errors.New("not found")
-	ErrNotImplemented = errors.New("not implemented")
-	ErrInvalidRequest = errors.New("invalid request")
+	ErrNotFound             = errors.New("not found")
+	ErrNotImplemented       = errors.New("not implemented")
+	ErrInvalidRequest       = errors.New("invalid request")
+	ErrNotAcceptable        = errors.New("not acceptable")
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	ErrSyncing              = errors.New("node is syncing")
+	ErrServiceUnavailable   = errors.New("service unavailable")
 )
@@ -20,6 +20,11 @@
 
 package types
 
+import (
+	"errors"
+	"net/http"
+)
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
@@ -29,3 +34,56 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// ErrorResponse is a response that is returned when an error occurs.
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewErrorResponse returns the response of an error with the given code.
+func NewErrorResponse(code int, err error) ErrorResponse {
+	return ErrorResponse{
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+// ResponseFromError converts an error to an HTTP status code and response. If
+// the error is nil, the response is returned as is.
+func ResponseFromError(data any, err error) (int, any) {
+	switch {
+	case err == nil:
+		return http.StatusOK, data
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound, NewErrorResponse(http.StatusNotFound, err)
+	case errors.Is(err, ErrInvalidRequest):
+		return http.StatusBadRequest, NewErrorResponse(
+			http.StatusBadRequest, err,
+		)
+	case errors.Is(err, ErrNotAcceptable):
+		return http.StatusNotAcceptable, NewErrorResponse(
+			http.StatusNotAcceptable, err,
+		)
+	case errors.Is(err, ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType, NewErrorResponse(
+			http.StatusUnsupportedMediaType, err,
+		)
+	case errors.Is(err, ErrSyncing):
+		return http.StatusPartialContent, NewErrorResponse(
+			http.StatusPartialContent, err,
+		)
+	case errors.Is(err, ErrServiceUnavailable):
+		return http.StatusServiceUnavailable, NewErrorResponse(
+			http.StatusServiceUnavailable, err,
+		)
+	case errors.Is(err, ErrNotImplemented):
+		return http.StatusNotImplemented, NewErrorResponse(
+			http.StatusNotImplemented, err,
+		)
+	default:
+		return http.StatusInternalServerError, NewErrorResponse(
+			http.StatusInternalServerError, err,
+		)
+	}
+}
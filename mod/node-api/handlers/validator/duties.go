@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetProposerDuties returns the validators expected to propose the blocks of
+// the given epoch, as selected by CometBFT.
+func (h *Handler[ContextT]) GetProposerDuties(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.GetProposerDutiesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := utils.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	root, duties, err := h.backend.ProposerDutiesAtEpoch(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return types.DutiesResponse{
+		DependentRoot:       root,
+		ExecutionOptimistic: false, // stubbed
+		Data:                duties,
+	}, nil
+}
+
+// PostLiveness returns whether each of the given validators signed the commit
+// of a block of the given epoch.
+func (h *Handler[ContextT]) PostLiveness(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.PostLivenessRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := utils.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]math.ValidatorIndex, len(req.Indices))
+	for i, index := range req.Indices {
+		if indices[i], err = utils.U64FromString(index); err != nil {
+			return nil, err
+		}
+	}
+	liveness, err := h.backend.LivenessAtEpoch(epoch, indices)
+	if err != nil {
+		return nil, err
+	}
+	return handlertypes.Wrap(liveness), nil
+}
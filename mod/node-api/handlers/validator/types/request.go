@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// validatorIndexSize is the size of an SSZ encoded validator index.
+const validatorIndexSize = 8
+
+// ErrInvalidIndicesSSZ is returned when an SSZ body is not a list of
+// validator indices.
+var ErrInvalidIndicesSSZ = errors.New("invalid SSZ validator indices")
+
+type GetProposerDutiesRequest struct {
+	Epoch string `param:"epoch" validate:"required,epoch"`
+}
+
+type PostLivenessRequest struct {
+	Epoch   string   `param:"epoch" validate:"required,epoch"`
+	Indices []string `validate:"required,dive,uint64"`
+}
+
+// UnmarshalJSON decodes the body of the request, which is a bare array of
+// validator indices.
+func (r *PostLivenessRequest) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.Indices)
+}
+
+// UnmarshalSSZ decodes the SSZ body of the request, which is a
+// List[ValidatorIndex, VALIDATOR_REGISTRY_LIMIT].
+func (r *PostLivenessRequest) UnmarshalSSZ(data []byte) error {
+	if len(data)%validatorIndexSize != 0 {
+		return errors.Wrapf(ErrInvalidIndicesSSZ, "%d bytes", len(data))
+	}
+	r.Indices = make([]string, len(data)/validatorIndexSize)
+	for i := range r.Indices {
+		r.Indices[i] = strconv.FormatUint(
+			binary.LittleEndian.Uint64(data[i*validatorIndexSize:]), 10,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostLivenessRequest_UnmarshalSSZ(t *testing.T) {
+	var body []byte
+	for _, index := range []uint64{0, 5, 1 << 40} {
+		body = binary.LittleEndian.AppendUint64(body, index)
+	}
+
+	var req validatortypes.PostLivenessRequest
+	require.NoError(t, types.UnmarshalSSZRequest(body, &req))
+	require.Equal(t, []string{"0", "5", "1099511627776"}, req.Indices)
+
+	// The SSZ and JSON bodies decode to the same request.
+	var fromJSON validatortypes.PostLivenessRequest
+	require.NoError(t, json.Unmarshal(
+		[]byte(`["0","5","1099511627776"]`), &fromJSON,
+	))
+	require.Equal(t, fromJSON, req)
+}
+
+func TestPostLivenessRequest_UnmarshalSSZInvalid(t *testing.T) {
+	var req validatortypes.PostLivenessRequest
+	err := types.UnmarshalSSZRequest(make([]byte, 12), &req)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.ErrorIs(t, err, validatortypes.ErrInvalidIndicesSSZ)
+}
@@ -20,8 +20,22 @@
 
 package server
 
+import "time"
+
+const (
+	// EngineEcho is the name of the API engine built on Echo.
+	EngineEcho = "echo"
+	// EngineMux is the name of the API engine built on the http.ServeMux of
+	// the standard library.
+	EngineMux = "mux"
+)
+
 const (
-	defaultAddress = "0.0.0.0:3500"
+	defaultAddress        = "0.0.0.0:3500"
+	defaultEngine         = EngineEcho
+	defaultRateLimitBurst = 100
+	defaultRequestTimeout = 30 * time.Second
+	defaultIdleTimeout    = 120 * time.Second
 )
 
 // Config is the configuration for the node API server.
@@ -30,15 +44,55 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// Address is the address to bind the node API server to.
 	Address string `mapstructure:"address"`
+	// Engine is the name of the engine serving the routes of the node API,
+	// either EngineEcho or EngineMux.
+	Engine string `mapstructure:"engine"`
+	// GRPCAddress is the address to bind the gRPC gateway to, which serves
+	// the routes of the node API over gRPC. The gateway is disabled if it is
+	// empty.
+	GRPCAddress string `mapstructure:"grpc-address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// TLSCertPath is the path to the TLS certificate, the API being served
+	// over HTTPS if it is set along with TLSKeyPath.
+	TLSCertPath string `mapstructure:"tls-cert-path"`
+	// TLSKeyPath is the path to the private key of the TLS certificate.
+	TLSKeyPath string `mapstructure:"tls-key-path"`
+	// JWTSecretPath is the path to the secret the bearer JWTs of the write
+	// requests, i.e. all but GET, HEAD and OPTIONS requests, are verified
+	// with. Write requests are not authenticated if it is empty.
+	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// CORSAllowedOrigins is the comma-separated list of the origins allowed
+	// to make cross-origin requests, "*" allowing any origin. CORS is
+	// disabled if it is empty, which it is by default.
+	CORSAllowedOrigins string `mapstructure:"cors-allowed-origins"`
+	// RateLimit is the number of requests per second allowed from a single
+	// IP address, 0 disabling rate limiting.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst is the number of requests allowed at once from a single
+	// IP address.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// Compression is the flag to enable the gzip compression of responses.
+	Compression bool `mapstructure:"compression"`
+	// RequestTimeout is the maximum duration of reading and handling a
+	// request, 0 disabling the timeout. The event stream and the debug
+	// routes are not bounded by the timeout once their request is read.
+	RequestTimeout time.Duration `mapstructure:"request-timeout"`
+	// IdleTimeout is the maximum duration to wait for the next request on a
+	// keep-alive connection.
+	IdleTimeout time.Duration `mapstructure:"idle-timeout"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:        false,
+		Address:        defaultAddress,
+		Engine:         defaultEngine,
+		Logging:        false,
+		RateLimitBurst: defaultRateLimitBurst,
+		Compression:    true,
+		RequestTimeout: defaultRequestTimeout,
+		IdleTimeout:    defaultIdleTimeout,
 	}
 }
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrIncompleteTLSConfig is returned when only one of the TLS
+	// certificate and key is configured.
+	ErrIncompleteTLSConfig = errors.New(
+		"both the TLS certificate and key must be set")
+	// ErrMissingBearerToken is returned when a write request does not carry
+	// a bearer token.
+	ErrMissingBearerToken = errors.New("missing bearer token")
+	// ErrRateLimitExceeded is returned when a client exceeds the rate limit.
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+	// ErrUnknownEngine is returned when the configured API engine is
+	// neither EngineEcho nor EngineMux.
+	ErrUnknownEngine = errors.New("unknown node API engine")
+)
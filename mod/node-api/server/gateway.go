@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// GatewayServiceName is the name of the gRPC service of the gateway.
+	GatewayServiceName = "beaconkit.nodeapi.v1.Gateway"
+	// GatewayCallMethod is the full name of the gRPC method calling a route
+	// of the node API through the gateway.
+	GatewayCallMethod = "/" + GatewayServiceName + "/Call"
+	// GatewayMethodKey is the metadata key of the HTTP method of the route
+	// called through the gateway, GET if it is not set.
+	GatewayMethodKey = "x-http-method"
+	// GatewayPathKey is the metadata key of the path, along with the query,
+	// of the route called through the gateway.
+	GatewayPathKey = "x-http-path"
+	// eventsPath is the path of the event stream, which cannot be served by
+	// a unary call.
+	eventsPath = "/eth/v1/events"
+)
+
+// gatewayServer is the interface of the gRPC service of the gateway.
+type gatewayServer interface {
+	Call(context.Context, *httpbody.HttpBody) (*httpbody.HttpBody, error)
+}
+
+// gateway serves the routes of the node API over gRPC. A call carries the
+// body of the request in a google.api.HttpBody and its route in the
+// GatewayMethodKey and GatewayPathKey metadata, the rest of the metadata
+// being passed on as headers. The request goes through the same middlewares
+// as over HTTP, so the JWT auth and the rate limits apply alike.
+type gateway struct {
+	handler http.Handler
+}
+
+// registerGateway registers the gateway serving the given handler with the
+// given gRPC server.
+func registerGateway(srv *grpc.Server, handler http.Handler) {
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: GatewayServiceName,
+		HandlerType: (*gatewayServer)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Call",
+			Handler:    gatewayCallHandler,
+		}},
+	}, &gateway{handler: handler})
+}
+
+// gatewayCallHandler decodes the request of a gateway call and passes it on
+// to the gateway through the interceptor of the gRPC server, if any.
+func gatewayCallHandler(
+	srv any,
+	ctx context.Context,
+	dec func(any) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(httpbody.HttpBody)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	//nolint:errcheck // registered with a gateway only.
+	gw := srv.(gatewayServer)
+	if interceptor == nil {
+		return gw.Call(ctx, in)
+	}
+	return interceptor(
+		ctx, in, &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: GatewayCallMethod,
+		},
+		func(ctx context.Context, req any) (any, error) {
+			//nolint:errcheck // decoded above.
+			return gw.Call(ctx, req.(*httpbody.HttpBody))
+		},
+	)
+}
+
+// Call serves the route of the given call with the HTTP handler of the node
+// API and returns its response, or the status matching its error code.
+func (g *gateway) Call(
+	ctx context.Context,
+	in *httpbody.HttpBody,
+) (*httpbody.HttpBody, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	method, path := http.MethodGet, ""
+	if values := md.Get(GatewayMethodKey); len(values) > 0 {
+		method = strings.ToUpper(values[0])
+	}
+	if values := md.Get(GatewayPathKey); len(values) > 0 {
+		path = values[0]
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "invalid path %q", path,
+		)
+	}
+	if strings.HasPrefix(path, eventsPath) {
+		return nil, status.Error(
+			codes.Unimplemented, "the event stream is only served over HTTP",
+		)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, path, bytes.NewReader(in.GetData()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for key, values := range md {
+		if !isForwardedMetadata(key) {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if contentType := in.GetContentType(); contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		req.RemoteAddr = p.Addr.String()
+	}
+
+	rec := newGatewayRecorder()
+	g.handler.ServeHTTP(rec, req)
+	if rec.code >= http.StatusBadRequest {
+		return nil, status.Error(gatewayCode(rec.code), rec.errorMessage())
+	}
+	return &httpbody.HttpBody{
+		ContentType: rec.header.Get("Content-Type"),
+		Data:        rec.body.Bytes(),
+	}, nil
+}
+
+// isForwardedMetadata returns whether the metadata with the given key is
+// passed on to the request as a header, i.e. it is neither a route key nor
+// a gRPC or HTTP/2 transport one.
+func isForwardedMetadata(key string) bool {
+	switch key {
+	case GatewayMethodKey, GatewayPathKey, "content-type", "te":
+		return false
+	default:
+		return !strings.HasPrefix(key, ":") &&
+			!strings.HasPrefix(key, "grpc-")
+	}
+}
+
+// gatewayCode returns the gRPC code matching the given HTTP error code.
+func gatewayCode(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest, http.StatusUnsupportedMediaType:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return codes.NotFound
+	case http.StatusNotAcceptable:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
+
+// gatewayRecorder is the response writer collecting the response of a route
+// called through the gateway.
+type gatewayRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+// newGatewayRecorder returns a new recorder of a successful response.
+func newGatewayRecorder() *gatewayRecorder {
+	return &gatewayRecorder{
+		header: make(http.Header),
+		code:   http.StatusOK,
+	}
+}
+
+// Header returns the headers of the response.
+func (r *gatewayRecorder) Header() http.Header {
+	return r.header
+}
+
+// WriteHeader records the status code of the response.
+func (r *gatewayRecorder) WriteHeader(code int) {
+	r.code = code
+}
+
+// Write appends the given bytes to the body of the response.
+func (r *gatewayRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// errorMessage returns the message of the JSON error response recorded, or
+// its whole body if it is not one.
+func (r *gatewayRecorder) errorMessage() string {
+	var resp types.ErrorResponse
+	if err := json.Unmarshal(r.body.Bytes(), &resp); err == nil &&
+		resp.Message != "" {
+		return resp.Message
+	}
+	return strings.TrimSpace(r.body.String())
+}
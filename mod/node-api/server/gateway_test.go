@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newGatewayClient returns a client of a gateway serving the given handler.
+func newGatewayClient(t *testing.T, handler http.Handler) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	registerGateway(srv, handler)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///gateway",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// call calls the route of the given method and path through the gateway.
+func call(
+	conn *grpc.ClientConn,
+	in *httpbody.HttpBody,
+	kv ...string,
+) (*httpbody.HttpBody, error) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), kv...)
+	out := new(httpbody.HttpBody)
+	return out, conn.Invoke(ctx, GatewayCallMethod, in, out)
+}
+
+func TestGateway(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	token, err := jwt.BuildSignedJWT(secret)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /eth/v1/node/version", func(
+		w http.ResponseWriter, r *http.Request,
+	) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":"` + r.URL.Query().Get("q") + `"}`))
+	})
+	mux.HandleFunc("POST /eth/v1/beacon/blocks", func(
+		w http.ResponseWriter, r *http.Request,
+	) {
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, _ = w.Write([]byte("posted"))
+	})
+	conn := newGatewayClient(t, authMiddleware(secret, mux))
+
+	// Routes are GET by default and keep their query.
+	out, err := call(
+		conn, &httpbody.HttpBody{},
+		GatewayPathKey, "/eth/v1/node/version?q=v1",
+	)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", out.GetContentType())
+	require.JSONEq(t, `{"data":"v1"}`, string(out.GetData()))
+
+	// Write requests go through the JWT auth of the node API.
+	in := &httpbody.HttpBody{
+		ContentType: "application/octet-stream",
+		Data:        []byte{0x01},
+	}
+	_, err = call(
+		conn, in,
+		GatewayMethodKey, http.MethodPost,
+		GatewayPathKey, "/eth/v1/beacon/blocks",
+	)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	out, err = call(
+		conn, in,
+		GatewayMethodKey, http.MethodPost,
+		GatewayPathKey, "/eth/v1/beacon/blocks",
+		"authorization", "Bearer "+token,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "application/octet-stream", out.GetContentType())
+	require.Equal(t, "posted", string(out.GetData()))
+
+	// Errors map to their gRPC code.
+	for path, code := range map[string]codes.Code{
+		"/eth/v1/node/unknown": codes.NotFound,
+		"eth/v1/node/version":  codes.InvalidArgument,
+		"/eth/v1/events":       codes.Unimplemented,
+	} {
+		_, err = call(conn, &httpbody.HttpBody{}, GatewayPathKey, path)
+		require.Equal(t, code, status.Code(err), path)
+	}
+}
@@ -0,0 +1,294 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// corsAllowedMethods are the methods allowed in cross-origin requests.
+	corsAllowedMethods = "GET, HEAD, PUT, PATCH, POST, DELETE"
+	// rateLimitClientTTL is the duration after which the rate limit of an
+	// idle client is forgotten.
+	rateLimitClientTTL = 3 * time.Minute
+)
+
+// untimedPathPrefixes are the path prefixes of the routes exempt from the
+// request timeout: the event stream, which is long-lived, and the debug
+// routes, which serve whole beacon states.
+//
+//nolint:gochecknoglobals // constant list.
+var untimedPathPrefixes = []string{
+	"/eth/v1/events",
+	"/eth/v1/debug/",
+	"/eth/v2/debug/",
+}
+
+// writeError writes the JSON response of the given error with the given
+// status code.
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	//#nosec:G104 // the client is gone if the response fails to be written.
+	_ = json.NewEncoder(w).Encode(types.NewErrorResponse(code, err))
+}
+
+// isWriteRequest returns whether the request may change the state of the
+// node, i.e. it is neither a GET, HEAD nor OPTIONS request.
+func isWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// authMiddleware rejects the write requests that do not carry a bearer JWT
+// signed with the given secret.
+func authMiddleware(secret *jwt.Secret, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWriteRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		token, ok := strings.CutPrefix(
+			r.Header.Get("Authorization"), "Bearer ",
+		)
+		if !ok || token == "" {
+			writeError(w, http.StatusUnauthorized, ErrMissingBearerToken)
+			return
+		}
+		if err := jwt.VerifySignedJWT(secret, token); err != nil {
+			writeError(w, http.StatusUnauthorized, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// corsMiddleware allows the given origins to make cross-origin requests,
+// answering their preflight requests.
+func corsMiddleware(origins []string, next http.Handler) http.Handler {
+	allowAll := slices.Contains(origins, "*")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+
+		preflight := r.Method == http.MethodOptions &&
+			r.Header.Get("Access-Control-Request-Method") != ""
+		allowed := allowAll || slices.Contains(origins, origin)
+		switch {
+		case !allowed && preflight:
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case !allowed:
+			next.ServeHTTP(w, r)
+			return
+		case allowAll:
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		default:
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if !preflight {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+		headers := r.Header.Get("Access-Control-Request-Headers")
+		if headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// gzipResponseWriter compresses the response body written to it.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz *gzip.Writer
+}
+
+// WriteHeader drops the length of the uncompressed body before writing the
+// header.
+func (w *gzipResponseWriter) WriteHeader(code int) {
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write compresses the given bytes into the response body.
+func (w *gzipResponseWriter) Write(b []byte) (int, error) {
+	return w.gz.Write(b)
+}
+
+// Flush sends the bytes compressed so far to the client.
+func (w *gzipResponseWriter) Flush() {
+	if err := w.gz.Flush(); err != nil {
+		return
+	}
+	//#nosec:G104 // not every response writer can be flushed.
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying response writer, for the response
+// controllers to reach it.
+func (w *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// compressionMiddleware compresses the responses to the clients accepting
+// gzip encoded bodies.
+func compressionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if r.Method == http.MethodHead ||
+			!strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		next.ServeHTTP(&gzipResponseWriter{ResponseWriter: w, gz: gz}, r)
+	})
+}
+
+// timeoutMiddleware bounds the handling of the requests to the given
+// duration by cancelling their context and setting the write deadline of
+// their connection, outside of the untimed routes. Unlike http.TimeoutHandler
+// it neither buffers the response nor hides the flusher of the response
+// writer.
+func timeoutMiddleware(timeout time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isUntimedRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		// The deadline outlives the request on a keep-alive connection, hence
+		// is cleared once it is handled.
+		rc := http.NewResponseController(w)
+		if rc.SetWriteDeadline(time.Now().Add(timeout)) == nil {
+			//#nosec:G104 // the deadline was set on the same connection.
+			defer func() { _ = rc.SetWriteDeadline(time.Time{}) }()
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// isUntimedRequest returns whether the request is to a route exempt from the
+// request timeout.
+func isUntimedRequest(r *http.Request) bool {
+	return slices.ContainsFunc(untimedPathPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(r.URL.Path, prefix)
+	})
+}
+
+// rateLimiter limits the rate of the requests of each client IP address.
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	clients   map[string]*rateLimitClient
+	lastSweep time.Time
+}
+
+// rateLimitClient is the rate limit of a client IP address.
+type rateLimitClient struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newRateLimiter creates a rate limiter allowing the given number of requests
+// per second from each client, in bursts of at most the given size.
+func newRateLimiter(limit float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		limit:     rate.Limit(limit),
+		burst:     max(burst, 1),
+		clients:   make(map[string]*rateLimitClient),
+		lastSweep: time.Now(),
+	}
+}
+
+// allow reports whether a request from the given IP address is allowed,
+// forgetting the clients that have been idle for too long.
+func (rl *rateLimiter) allow(ip string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(rl.lastSweep) > rateLimitClientTTL {
+		for addr, client := range rl.clients {
+			if now.Sub(client.lastSeen) > rateLimitClientTTL {
+				delete(rl.clients, addr)
+			}
+		}
+		rl.lastSweep = now
+	}
+
+	client, ok := rl.clients[ip]
+	if !ok {
+		client = &rateLimitClient{
+			limiter: rate.NewLimiter(rl.limit, rl.burst),
+		}
+		rl.clients[ip] = client
+	}
+	client.lastSeen = now
+	return client.limiter.AllowN(now, 1)
+}
+
+// middleware rejects the requests of the clients exceeding the rate limit.
+func (rl *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+		if !rl.allow(ip) {
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusTooManyRequests, ErrRateLimitExceeded)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("ok"))
+})
+
+func serve(h http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	return rec
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	token, err := jwt.BuildSignedJWT(secret)
+	require.NoError(t, err)
+	h := authMiddleware(secret, okHandler)
+
+	// Read requests are not authenticated.
+	rec := serve(h, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	require.Equal(t, http.StatusUnauthorized, serve(h, r).Code)
+
+	r.Header.Set("Authorization", "Bearer invalid")
+	require.Equal(t, http.StatusUnauthorized, serve(h, r).Code)
+
+	r.Header.Set("Authorization", "Bearer "+token)
+	require.Equal(t, http.StatusOK, serve(h, r).Code)
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	h := corsMiddleware([]string{"https://a.io"}, okHandler)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Origin", "https://a.io")
+	rec := serve(h, r)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(
+		t, "https://a.io", rec.Header().Get("Access-Control-Allow-Origin"),
+	)
+
+	r = httptest.NewRequest(http.MethodOptions, "/", nil)
+	r.Header.Set("Origin", "https://a.io")
+	r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	rec = serve(h, r)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(
+		t, corsAllowedMethods, rec.Header().Get("Access-Control-Allow-Methods"),
+	)
+
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Origin", "https://b.io")
+	rec = serve(h, r)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	h := compressionMiddleware(okHandler)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Equal(t, "ok", serve(h, r).Body.String())
+
+	r.Header.Set("Accept-Encoding", "gzip")
+	rec := serve(h, r)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	gz, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(body))
+}
+
+func TestRateLimiter(t *testing.T) {
+	h := newRateLimiter(1, 2).middleware(okHandler)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	require.Equal(t, http.StatusOK, serve(h, r).Code)
+	require.Equal(t, http.StatusOK, serve(h, r).Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(h, r).Code)
+
+	// The limit applies per IP address.
+	r.RemoteAddr = "10.0.0.2:1234"
+	require.Equal(t, http.StatusOK, serve(h, r).Code)
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	var deadline bool
+	h := timeoutMiddleware(time.Minute, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, deadline = r.Context().Deadline()
+			_, _ = w.Write([]byte("ok"))
+			require.NoError(t, http.NewResponseController(w).Flush())
+		},
+	))
+
+	r := httptest.NewRequest(http.MethodGet, "/eth/v1/node/health", nil)
+	rec := serve(h, r)
+	require.True(t, deadline)
+	require.True(t, rec.Flushed)
+	require.Equal(t, "ok", rec.Body.String())
+
+	// Streams and debug routes are not bounded.
+	for _, path := range []string{
+		"/eth/v1/events", "/eth/v2/debug/beacon/states/head",
+	} {
+		rec = serve(h, httptest.NewRequest(http.MethodGet, path, nil))
+		require.False(t, deadline, path)
+		require.True(t, rec.Flushed, path)
+	}
+}
+
+func TestTimeoutMiddleware_CompressedFlush(t *testing.T) {
+	h := compressionMiddleware(timeoutMiddleware(
+		time.Minute, http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("event"))
+				require.NoError(t, http.NewResponseController(w).Flush())
+			},
+		),
+	))
+
+	r := httptest.NewRequest(http.MethodGet, "/eth/v1/node/health", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	rec := serve(h, r)
+	require.True(t, rec.Flushed)
+	gz, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, "event", string(body))
+}
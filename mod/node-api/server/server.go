@@ -22,14 +22,31 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
-// Server is the API Server service.
+const (
+	// shutdownTimeout is the maximum duration to wait for the requests in
+	// flight when the server stops.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server is the API Server service. It serves the REST routes of the node
+// API over HTTP, and over gRPC through the gateway if it is enabled.
 type Server[
 	ContextT apicontext.Context,
 	EngineT Engine[ContextT, EngineT],
@@ -71,26 +88,151 @@ func (s *Server[_, _]) Start(ctx context.Context) error {
 	if !s.config.Enabled {
 		return nil
 	}
-	go s.start(ctx)
+	if (s.config.TLSCertPath == "") != (s.config.TLSKeyPath == "") {
+		return ErrIncompleteTLSConfig
+	}
+	handler, err := s.handler()
+	if err != nil {
+		return err
+	}
+	if s.config.GRPCAddress != "" {
+		if err = s.startGateway(ctx, handler); err != nil {
+			return err
+		}
+	}
+
+	srv := &http.Server{
+		Addr:              s.config.Address,
+		Handler:           handler,
+		ReadHeaderTimeout: s.config.RequestTimeout,
+		ReadTimeout:       s.config.RequestTimeout,
+		IdleTimeout:       s.config.IdleTimeout,
+		TLSConfig:         &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+	go s.start(ctx, srv)
 	return nil
 }
 
-func (s *Server[_, _]) start(ctx context.Context) {
-	errCh := make(chan error)
+// handler wraps the engine with the middlewares enabled by the config, the
+// rate limit being applied first and the compression last.
+func (s *Server[_, _]) handler() (http.Handler, error) {
+	var handler http.Handler = s.engine
+	if s.config.RequestTimeout > 0 {
+		handler = timeoutMiddleware(s.config.RequestTimeout, handler)
+	}
+	if s.config.Compression {
+		handler = compressionMiddleware(handler)
+	}
+	if s.config.JWTSecretPath != "" {
+		secret, err := loadJWTSecret(s.config.JWTSecretPath)
+		if err != nil {
+			return nil, err
+		}
+		handler = authMiddleware(secret, handler)
+	}
+	if origins := s.corsAllowedOrigins(); len(origins) > 0 {
+		handler = corsMiddleware(origins, handler)
+	}
+	if s.config.RateLimit > 0 {
+		handler = newRateLimiter(
+			s.config.RateLimit, s.config.RateLimitBurst,
+		).middleware(handler)
+	}
+	return handler, nil
+}
+
+// corsAllowedOrigins returns the origins allowed to make cross-origin
+// requests.
+func (s *Server[_, _]) corsAllowedOrigins() []string {
+	var origins []string
+	for _, origin := range strings.Split(s.config.CORSAllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+func (s *Server[_, _]) start(ctx context.Context, srv *http.Server) {
+	errCh := make(chan error, 1)
 	go func() {
-		errCh <- s.engine.Run(s.config.Address)
-	}()
-	for {
-		select {
-		case err := <-errCh:
-			s.logger.Error(err.Error())
-		case <-ctx.Done():
+		if s.config.TLSCertPath != "" {
+			errCh <- srv.ListenAndServeTLS(
+				s.config.TLSCertPath, s.config.TLSKeyPath,
+			)
 			return
 		}
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		s.logger.Error(err.Error())
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(), shutdownTimeout,
+		)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			s.logger.Error("failed to shut down node API server", "error", err)
+		}
 	}
 }
 
+// startGateway starts the gRPC gateway serving the given handler at the
+// configured gRPC address, over TLS if it is configured.
+func (s *Server[_, _]) startGateway(
+	ctx context.Context,
+	handler http.Handler,
+) error {
+	var opts []grpc.ServerOption
+	if s.config.TLSCertPath != "" {
+		creds, err := credentials.NewServerTLSFromFile(
+			s.config.TLSCertPath, s.config.TLSKeyPath,
+		)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	lis, err := net.Listen("tcp", s.config.GRPCAddress)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer(opts...)
+	registerGateway(srv, handler)
+	go func() {
+		if serveErr := srv.Serve(lis); serveErr != nil {
+			s.logger.Error("gRPC gateway stopped", "error", serveErr)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		stopped := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			srv.Stop()
+		}
+	}()
+	return nil
+}
+
 // Name returns the name of the API server service.
 func (s *Server[_, _]) Name() string {
 	return "node-api-server"
 }
+
+// loadJWTSecret reads the hex encoded JWT secret at the given path.
+func loadJWTSecret(path string) (*jwt.Secret, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading JWT secret at %s", path)
+	}
+	return jwt.NewFromHex(strings.TrimSpace(string(data)))
+}
@@ -21,13 +21,16 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
-// Engine is a generic interface for an API engine.
+// Engine is a generic interface for an API engine, which serves the requests
+// to the routes registered with it.
 type Engine[ContextT context.Context, T any] interface {
-	Run(addr string) error
+	http.Handler
 	RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger[any])
 }
@@ -69,6 +69,25 @@ func WithProcessProposal(
 	}
 }
 
+// WithExtendVote sets the extend vote handler to the baseapp.
+func WithExtendVote(
+	handler sdk.ExtendVoteHandler,
+) func(bApp *baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetExtendVoteHandler(handler)
+	}
+}
+
+// WithVerifyVoteExtension sets the verify vote extension handler to the
+// baseapp.
+func WithVerifyVoteExtension(
+	handler sdk.VerifyVoteExtensionHandler,
+) func(bApp *baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetVerifyVoteExtensionHandler(handler)
+	}
+}
+
 // WithPreBlocker sets the pre-blocker to the baseapp.
 func WithPreBlocker(
 	preBlocker sdk.PreBlocker,
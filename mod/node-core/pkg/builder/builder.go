@@ -82,6 +82,7 @@ func (nb *NodeBuilder[NodeT]) Build(
 		abciMiddleware  *components.ABCIMiddleware
 		serviceRegistry *service.Registry
 		consensusEngine *components.ConsensusEngine
+		voteExtender    *components.VoteExtender
 		apiBackend      *components.NodeAPIBackend
 	)
 
@@ -105,6 +106,7 @@ func (nb *NodeBuilder[NodeT]) Build(
 		&abciMiddleware,
 		&serviceRegistry,
 		&consensusEngine,
+		&voteExtender,
 		&apiBackend,
 	); err != nil {
 		panic(err)
@@ -119,6 +121,8 @@ func (nb *NodeBuilder[NodeT]) Build(
 				WithCometParamStore(chainSpec),
 				WithPrepareProposal(consensusEngine.PrepareProposal),
 				WithProcessProposal(consensusEngine.ProcessProposal),
+				WithExtendVote(voteExtender.ExtendVote),
+				WithVerifyVoteExtension(voteExtender.VerifyVoteExtension),
 				WithPreBlocker(consensusEngine.PreBlock),
 			)...,
 		),
@@ -24,9 +24,12 @@ import (
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/replay"
 	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/mux"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
@@ -34,23 +37,44 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *NodeAPIEngine {
-	return echo.NewDefaultEngine()
+type NodeAPIEngineInput struct {
+	depinject.In
+
+	Config *config.Config
+}
+
+// ProvideNodeAPIEngine provides the node API engine selected by the config.
+func ProvideNodeAPIEngine(in NodeAPIEngineInput) (NodeAPIEngine, error) {
+	switch in.Config.NodeAPI.Engine {
+	case server.EngineEcho:
+		return echo.NewDefaultEngine(), nil
+	case server.EngineMux:
+		return mux.NewDefaultEngine(), nil
+	default:
+		return nil, errors.Wrapf(
+			server.ErrUnknownEngine, "%q", in.Config.NodeAPI.Engine,
+		)
+	}
 }
 
 type NodeAPIBackendInput struct {
 	depinject.In
 
-	ChainSpec      common.ChainSpec
-	StateProcessor *StateProcessor
-	StorageBackend *StorageBackend
+	ChainSpec        common.ChainSpec
+	CometClient      *CometClient
+	Config           *config.Config
+	Logger           log.AdvancedLogger[any, sdklog.Logger]
+	StateCheckpoints *StateCheckpoints
+	StateProcessor   *StateProcessor
+	StorageBackend   *StorageBackend
 }
 
-func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
-	return backend.New[
+func ProvideNodeAPIBackend(
+	in NodeAPIBackendInput,
+) (*NodeAPIBackend, error) {
+	b := backend.New[
 		*AvailabilityStore,
-		*BeaconBlock,
+		*SignedBeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
 		*BeaconState,
@@ -75,12 +99,38 @@ func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
 		in.ChainSpec,
 		in.StateProcessor,
 	)
+	b.AttachCometClient(in.CometClient)
+
+	// The backend is the source of the retained states the replayer
+	// regenerates the states of pruned heights from, and of the latest state
+	// the persisted checkpoints are restored on top of.
+	in.StateCheckpoints.AttachLatestState(func() (*BeaconState, error) {
+		return b.CommittedState(0)
+	})
+	replayer, err := replay.New[
+		*BeaconBlock,
+		*BeaconState,
+		*BlockStore,
+		*SignedBeaconBlock,
+	](
+		in.Config.StateReplay,
+		in.Logger.With("service", "state-replay"),
+		in.StorageBackend.BlockStore(),
+		in.StateProcessor,
+		b,
+		in.StateCheckpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+	b.AttachStateReplayer(replayer)
+	return b, nil
 }
 
 type NodeAPIServerInput struct {
 	depinject.In
 
-	Engine   *NodeAPIEngine
+	Engine   NodeAPIEngine
 	Config   *config.Config
 	Handlers []handlers.Handlers[NodeAPIContext]
 	Logger   log.AdvancedLogger[any, sdklog.Logger]
@@ -91,7 +141,7 @@ func ProvideNodeAPIServer(in NodeAPIServerInput) *NodeAPIServer {
 		log.Blue)
 	return server.New[
 		NodeAPIContext,
-		*NodeAPIEngine,
+		NodeAPIEngine,
 	](
 		in.Config.NodeAPI,
 		in.Engine,
@@ -105,5 +155,7 @@ func DefaultNodeAPIComponents() []any {
 		ProvideNodeAPIServer,
 		ProvideNodeAPIEngine,
 		ProvideNodeAPIBackend,
+		ProvideStateCheckpoints,
+		ProvideCometClient,
 	}
 }
@@ -30,18 +30,20 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
 )
 
 type NodeAPIHandlersInput struct {
 	depinject.In
 
-	BeaconAPIHandler  *BeaconAPIHandler
-	BuilderAPIHandler *BuilderAPIHandler
-	ConfigAPIHandler  *ConfigAPIHandler
-	DebugAPIHandler   *DebugAPIHandler
-	EventsAPIHandler  *EventsAPIHandler
-	NodeAPIHandler    *NodeAPIHandler
-	ProofAPIHandler   *ProofAPIHandler
+	BeaconAPIHandler    *BeaconAPIHandler
+	BuilderAPIHandler   *BuilderAPIHandler
+	ConfigAPIHandler    *ConfigAPIHandler
+	DebugAPIHandler     *DebugAPIHandler
+	EventsAPIHandler    *EventsAPIHandler
+	NodeAPIHandler      *NodeAPIHandler
+	ProofAPIHandler     *ProofAPIHandler
+	ValidatorAPIHandler *ValidatorAPIHandler
 }
 
 func ProvideNodeAPIHandlers(
@@ -55,14 +57,18 @@ func ProvideNodeAPIHandlers(
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
 func ProvideNodeAPIBeaconHandler(b *NodeAPIBackend) *BeaconAPIHandler {
 	return beaconapi.NewHandler[
 		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
 		NodeAPIContext,
 		*Fork,
+		*SignedBeaconBlock,
 		*Validator,
 	](b)
 }
@@ -75,22 +81,28 @@ func ProvideNodeAPIConfigHandler() *ConfigAPIHandler {
 	return configapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPIDebugHandler() *DebugAPIHandler {
-	return debugapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPIDebugHandler(b *NodeAPIBackend) *DebugAPIHandler {
+	return debugapi.NewHandler[
+		*BeaconState, *BeaconStateMarshallable, NodeAPIContext,
+	](b)
 }
 
 func ProvideNodeAPIEventsHandler() *EventsAPIHandler {
 	return eventsapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPINodeHandler() *NodeAPIHandler {
-	return nodeapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPINodeHandler(m *ExecutionSyncMonitor) *NodeAPIHandler {
+	return nodeapi.NewHandler[NodeAPIContext](m)
 }
 
 func ProvideNodeAPIProofHandler(b *NodeAPIBackend) *ProofAPIHandler {
 	return proofapi.NewHandler[NodeAPIContext](b)
 }
 
+func ProvideNodeAPIValidatorHandler(b *NodeAPIBackend) *ValidatorAPIHandler {
+	return validatorapi.NewHandler[NodeAPIContext](b)
+}
+
 func DefaultNodeAPIHandlers() []any {
 	return []any{
 		ProvideNodeAPIHandlers,
@@ -101,5 +113,6 @@ func DefaultNodeAPIHandlers() []any {
 		ProvideNodeAPIEventsHandler,
 		ProvideNodeAPINodeHandler,
 		ProvideNodeAPIProofHandler,
+		ProvideNodeAPIValidatorHandler,
 	}
 }
@@ -23,9 +23,12 @@ package components
 import (
 	"errors"
 	"os"
+	"path/filepath"
 
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -34,6 +37,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
 
@@ -43,6 +47,7 @@ type AvailabilityStoreInput struct {
 	depinject.In
 	AppOpts   servertypes.AppOptions
 	ChainSpec common.ChainSpec
+	Config    *config.Config
 	Logger    log.AdvancedLogger[any, sdklog.Logger]
 }
 
@@ -50,6 +55,11 @@ type AvailabilityStoreInput struct {
 func ProvideAvailibilityStore(
 	in AvailabilityStoreInput,
 ) (*AvailabilityStore, error) {
+	blobArchive, err := provideBlobArchive(in)
+	if err != nil {
+		return nil, err
+	}
+
 	return dastore.New[*BeaconBlockBody](
 		filedb.NewRangeDB(
 			filedb.NewDB(
@@ -65,6 +75,34 @@ func ProvideAvailibilityStore(
 		),
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
+		blobArchive,
+	), nil
+}
+
+// provideBlobArchive provides the archive of the expired blob sidecars, which
+// is nil if archiving is disabled.
+func provideBlobArchive(
+	in AvailabilityStoreInput,
+) (dastore.BlobArchive, error) {
+	cfg := in.Config.BlobArchive
+	if !cfg.Enabled {
+		//nolint:nilnil // archiving is optional.
+		return nil, nil
+	}
+
+	path := cfg.Path
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)), path,
+		)
+	}
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	return archive.NewFileArchive(
+		afero.NewBasePathFs(afero.NewOsFs(), path),
+		in.ChainSpec.SlotsPerEpoch(),
 	), nil
 }
 
@@ -95,6 +133,17 @@ func ProvideAvailabilityPruner(
 		return nil, err
 	}
 
+	// archive the expired sidecars before pruning them if enabled.
+	var prunable pruner.Prunable = rangeDB
+	if blobArchive := in.AvailabilityStore.Archive(); blobArchive != nil {
+		prunable = dastore.NewArchiver(
+			rangeDB,
+			blobArchive,
+			in.ChainSpec,
+			in.Logger.With("service", "blob-archiver"),
+		)
+	}
+
 	// build the availability pruner if IndexDB is available.
 	return pruner.NewPruner[
 		*BeaconBlock,
@@ -102,7 +151,7 @@ func ProvideAvailabilityPruner(
 		*IndexDB,
 	](
 		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		prunable,
 		manager.AvailabilityPrunerName,
 		subCh,
 		dastore.BuildPruneRangeFn[
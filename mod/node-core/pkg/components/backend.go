@@ -43,7 +43,7 @@ func ProvideStorageBackend(
 ) *StorageBackend {
 	return storage.NewBackend[
 		*AvailabilityStore,
-		*BeaconBlock,
+		*SignedBeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
 		*BeaconState,
@@ -55,6 +55,7 @@ func ProvideStorageBackend(
 		*Eth1Data,
 		*ExecutionPayloadHeader,
 		*Fork,
+		*HistoricalSummary,
 		*KVStore,
 		*Validator,
 		Validators,
@@ -21,6 +21,8 @@
 package components
 
 import (
+	"crypto/sha256"
+
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
@@ -30,8 +32,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/cast"
 )
 
@@ -40,7 +43,7 @@ import (
 type BlobProofVerifierInput struct {
 	depinject.In
 	AppOpts          servertypes.AppOptions
-	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
+	JSONTrustedSetup *goethkzg.JSONTrustedSetup
 }
 
 // ProvideBlobProofVerifier is a function that provides the module to the
@@ -88,17 +91,41 @@ func ProvideBlobProcessor(in BlobProcessorIn) *BlobProcessor {
 		in.ChainSpec,
 		in.BlobVerifier,
 		types.BlockBodyKZGOffset,
+		types.BlockBodyKZGPosition,
 		in.TelemetrySink,
 	)
 }
 
+// CustodyIn is the input for the data column Custody.
+type CustodyIn struct {
+	depinject.In
+
+	ChainSpec common.ChainSpec
+	Signer    crypto.BLSSigner
+}
+
+// ProvideCustody is a function that provides the data column Custody to the
+// depinject framework. The custody columns are derived from the public key
+// of the node.
+func ProvideCustody(in CustodyIn) *da.Custody {
+	pubkey := in.Signer.PublicKey()
+	return da.NewCustody(
+		sha256.Sum256(pubkey[:]),
+		in.ChainSpec.CustodyRequirement(),
+		in.ChainSpec.SamplesPerSlot(),
+	)
+}
+
 // DAServiceIn is the input for the BlobService.
 type DAServiceIn struct {
 	depinject.In
 
 	AvailabilityStore *AvailabilityStore
 	SidecarsBroker    *SidecarsBroker
+	ColumnsBroker     *ColumnsBroker
 	BlobProcessor     *BlobProcessor
+	ChainSpec         common.ChainSpec
+	Custody           *da.Custody
 	Logger            log.Logger
 }
 
@@ -115,6 +142,9 @@ func ProvideDAService(in DAServiceIn) *DAService {
 		in.AvailabilityStore,
 		in.BlobProcessor,
 		in.SidecarsBroker,
+		in.ColumnsBroker,
+		in.ChainSpec,
+		in.Custody,
 		in.Logger.With("service", "da"),
 	)
 }
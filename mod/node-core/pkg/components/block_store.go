@@ -53,7 +53,7 @@ func ProvideBlockStore(
 		return nil, err
 	}
 
-	return block.NewStore[*BeaconBlock](storage.NewKVStoreProvider(kvp)), nil
+	return block.NewStore[*SignedBeaconBlock](storage.NewKVStoreProvider(kvp)), nil
 }
 
 // BlockPrunerInput is the input for the block pruner.
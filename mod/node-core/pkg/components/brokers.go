@@ -21,48 +21,80 @@
 package components
 
 import (
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 )
 
+// BrokerInput is the input for the broker providers.
+type BrokerInput struct {
+	depinject.In
+	TelemetrySink *metrics.TelemetrySink
+}
+
 // ProvideBlobBroker provides a blob feed for the depinject framework.
-func ProvideBlobBroker() *SidecarsBroker {
+func ProvideBlobBroker(in BrokerInput) *SidecarsBroker {
 	return broker.New[*SidecarEvent](
 		"blob-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideBlockBroker provides a block feed for the depinject framework.
-func ProvideBlockBroker() *BlockBroker {
+func ProvideBlockBroker(in BrokerInput) *BlockBroker {
 	return broker.New[*BlockEvent](
 		"blk-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
+	)
+}
+
+// ProvideColumnsBroker provides a data column sidecars feed for the
+// depinject framework.
+func ProvideColumnsBroker(in BrokerInput) *ColumnsBroker {
+	return broker.New[*ColumnsEvent](
+		"columns-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideGenesisBroker provides a genesis feed for the depinject framework.
-func ProvideGenesisBroker() *GenesisBroker {
+func ProvideGenesisBroker(in BrokerInput) *GenesisBroker {
 	return broker.New[*GenesisEvent](
 		"genesis-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
+	)
+}
+
+// ProvideSignedBlockBroker provides a signed block feed for the depinject
+// framework.
+func ProvideSignedBlockBroker(in BrokerInput) *SignedBlockBroker {
+	return broker.New[*SignedBlockEvent](
+		"signed-blk-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideSlotBroker provides a slot feed for the depinject framework.
-func ProvideSlotBroker() *SlotBroker {
+func ProvideSlotBroker(in BrokerInput) *SlotBroker {
 	return broker.New[*SlotEvent](
 		"slot-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideStatusBroker provides a status feed.
-func ProvideStatusBroker() *StatusBroker {
+func ProvideStatusBroker(in BrokerInput) *StatusBroker {
 	return broker.New[*StatusEvent](
 		"status-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideValidatorUpdateBroker provides a validator updates feed.
-func ProvideValidatorUpdateBroker() *ValidatorUpdateBroker {
+func ProvideValidatorUpdateBroker(in BrokerInput) *ValidatorUpdateBroker {
 	return broker.New[*ValidatorUpdateEvent](
 		"validator-updates-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
@@ -71,7 +103,9 @@ func DefaultBrokerProviders() []interface{} {
 	return []interface{}{
 		ProvideBlobBroker,
 		ProvideBlockBroker,
+		ProvideColumnsBroker,
 		ProvideGenesisBroker,
+		ProvideSignedBlockBroker,
 		ProvideSlotBroker,
 		ProvideStatusBroker,
 		ProvideValidatorUpdateBroker,
@@ -36,8 +36,10 @@ type ChainServiceInput struct {
 	depinject.In
 
 	BlockBroker           *BlockBroker
+	BlockStore            *BlockStore
 	ChainSpec             common.ChainSpec
 	Cfg                   *config.Config
+	DAService             *DAService
 	DepositService        *DepositService
 	EngineClient          *EngineClient
 	ExecutionEngine       *ExecutionEngine
@@ -45,6 +47,7 @@ type ChainServiceInput struct {
 	LocalBuilder          *LocalBuilder
 	Logger                log.AdvancedLogger[any, sdklog.Logger]
 	Signer                crypto.BLSSigner
+	SignedBlockBroker     *SignedBlockBroker
 	StateProcessor        *StateProcessor
 	StorageBackend        *StorageBackend
 	TelemetrySink         *metrics.TelemetrySink
@@ -66,6 +69,8 @@ func ProvideChainService(
 		*ExecutionPayloadHeader,
 		*Genesis,
 		*PayloadAttributes,
+		*SignedBeaconBlock,
+		*Validator,
 		*Withdrawal,
 	](
 		in.StorageBackend,
@@ -74,9 +79,13 @@ func ProvideChainService(
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.DAService,
+		in.BlockStore,
+		in.Signer,
 		in.TelemetrySink,
 		in.GenesisBrocker,
 		in.BlockBroker,
+		in.SignedBlockBroker,
 		in.ValidatorUpdateBroker,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
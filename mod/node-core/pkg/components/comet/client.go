@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"context"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// maxValidatorsPerPage is the maximum page size of the validators endpoint of
+// the CometBFT RPC.
+const maxValidatorsPerPage = 100
+
+// Client reads committed blocks from the CometBFT RPC of the node. A height
+// of 0 refers to the latest committed height.
+type Client struct {
+	rpc *rpchttp.HTTP
+}
+
+// NewClient creates a new client for the CometBFT RPC at the given address.
+// The RPC is only dialed on the first request, so the client can be created
+// before CometBFT is started.
+func NewClient(remote string) (*Client, error) {
+	rpc, err := rpchttp.New(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{rpc: rpc}, nil
+}
+
+// SignedHeader returns the header and commit at the given height.
+func (c *Client) SignedHeader(height int64) (*cmttypes.SignedHeader, error) {
+	res, err := c.rpc.Commit(context.Background(), heightOrLatest(height))
+	if err != nil {
+		return nil, err
+	}
+	return &res.SignedHeader, nil
+}
+
+// Block returns the block at the given height.
+func (c *Client) Block(height int64) (*cmttypes.Block, error) {
+	res, err := c.rpc.Block(context.Background(), heightOrLatest(height))
+	if err != nil {
+		return nil, err
+	}
+	return res.Block, nil
+}
+
+// Validators returns the validator set at the given height.
+func (c *Client) Validators(height int64) ([]*cmttypes.Validator, error) {
+	var (
+		vals    []*cmttypes.Validator
+		perPage = maxValidatorsPerPage
+	)
+	for page := 1; ; page++ {
+		res, err := c.rpc.Validators(
+			context.Background(), heightOrLatest(height), &page, &perPage,
+		)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, res.Validators...)
+		if len(vals) >= res.Total || res.Count == 0 {
+			return vals, nil
+		}
+	}
+}
+
+// heightOrLatest returns the height parameter of a CometBFT RPC request.
+func heightOrLatest(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/comet"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// cometRPCListenAddress is the config key of the listen address of the
+	// CometBFT RPC.
+	cometRPCListenAddress = "rpc.laddr"
+	// defaultCometRPCListenAddress is the default listen address of the
+	// CometBFT RPC.
+	defaultCometRPCListenAddress = "tcp://127.0.0.1:26657"
+)
+
+// CometClientInput is the input for the ProvideCometClient function.
+type CometClientInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+}
+
+// ProvideCometClient provides a client for the CometBFT RPC of the node,
+// which the node API uses to serve committed blocks to light clients.
+func ProvideCometClient(in CometClientInput) (*CometClient, error) {
+	remote := cast.ToString(in.AppOpts.Get(cometRPCListenAddress))
+	if remote == "" {
+		remote = defaultCometRPCListenAddress
+	}
+	return comet.NewClient(remote)
+}
@@ -23,12 +23,16 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 // ConsensusEngineInput is the input for the consensus engine.
 type ConsensusEngineInput struct {
 	depinject.In
+	ChainSpec           common.ChainSpec
 	ConsensusMiddleware *ABCIMiddleware
+	Signer              crypto.BLSSigner
 	StorageBackend      *StorageBackend
 }
 
@@ -38,13 +42,33 @@ func ProvideConsensusEngine(
 ) (*ConsensusEngine, error) {
 	return cometbft.NewConsensusEngine[
 		*AttestationData,
+		*SignedBeaconBlock,
 		*BeaconState,
 		*SlashingInfo,
 		*SlotData,
 		*StorageBackend,
+		*Validator,
 		*ValidatorUpdate,
+		*VoteExtension,
 	](
 		in.ConsensusMiddleware,
+		in.ChainSpec,
 		in.StorageBackend,
+		in.Signer,
 	), nil
 }
+
+// VoteExtenderInput is the input for the vote extender.
+type VoteExtenderInput struct {
+	depinject.In
+	DepositStore    *DepositStore
+	ExecutionEngine *ExecutionEngine
+}
+
+// ProvideVoteExtender is a depinject provider for the vote extender.
+func ProvideVoteExtender(in VoteExtenderInput) *VoteExtender {
+	return cometbft.NewVoteExtender[*VoteExtension](
+		in.ExecutionEngine,
+		in.DepositStore,
+	)
+}
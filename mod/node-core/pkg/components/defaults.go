@@ -38,13 +38,17 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideChainSpec,
 		ProvideConfig,
 		ProvideConsensusEngine,
+		ProvideCustody,
 		ProvideDAService,
 		ProvideDBManager,
 		ProvideDepositPruner,
 		ProvideDepositService,
 		ProvideDepositStore,
 		ProvideEngineClient,
+		ProvideExecutionBackfillService,
 		ProvideExecutionEngine,
+		ProvideExecutionSyncMonitor,
+		ProvideFollowerEngineClients,
 		ProvideJWTSecret,
 		ProvideLocalBuilder,
 		ProvideReportingService,
@@ -55,6 +59,7 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideTelemetrySink,
 		ProvideTrustedSetup,
 		ProvideValidatorService,
+		ProvideVoteExtender,
 	}
 	components = append(components, DefaultNodeAPIComponents()...)
 	components = append(components, DefaultNodeAPIHandlers()...)
@@ -62,19 +62,66 @@ func ProvideEngineClient(
 	)
 }
 
+// ProvideFollowerEngineClients creates the engine clients of the execution
+// clients following the primary one.
+func ProvideFollowerEngineClients(in EngineClientInputs) EngineClients {
+	urls := in.Config.GetEngine().FollowerRPCDialURLs
+	clients := make(EngineClients, len(urls))
+	for i, url := range urls {
+		// The followers share the settings of the primary execution client.
+		cfg := in.Config.GetEngine()
+		cfg.RPCDialURL = url
+		clients[i] = client.New[
+			*ExecutionPayload,
+			*PayloadAttributes,
+		](
+			cfg,
+			in.Logger.With("service", "engine.client", "follower", i),
+			in.JWTSecret,
+			in.TelemetrySink,
+			new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		)
+	}
+	return clients
+}
+
 // EngineClientInputs is the input for the EngineClient.
 type ExecutionEngineInputs struct {
 	depinject.In
-	EngineClient  *EngineClient
-	Logger        log.AdvancedLogger[any, sdklog.Logger]
-	StatusBroker  *StatusBroker
-	TelemetrySink *metrics.TelemetrySink
+	EngineClient          *EngineClient
+	FollowerEngineClients EngineClients
+	Logger                log.AdvancedLogger[any, sdklog.Logger]
+	StatusBroker          *StatusBroker
+	TelemetrySink         *metrics.TelemetrySink
 }
 
 // ProvideExecutionEngine provides the execution engine to the depinject
 // framework.
 func ProvideExecutionEngine(
 	in ExecutionEngineInputs,
+) *ExecutionEngine {
+	followers := make([]*ExecutionEngine, len(in.FollowerEngineClients))
+	for i, ec := range in.FollowerEngineClients {
+		followers[i] = newExecutionEngine(
+			ec,
+			in.Logger.With("service", "execution-engine", "follower", i),
+			in,
+		)
+	}
+	ee := newExecutionEngine(
+		in.EngineClient,
+		in.Logger.With("service", "execution-engine"),
+		in,
+	)
+	ee.AttachFollowers(followers...)
+	return ee
+}
+
+// newExecutionEngine creates the execution engine of the given engine client.
+func newExecutionEngine(
+	ec *EngineClient,
+	logger log.Logger[any],
+	in ExecutionEngineInputs,
 ) *ExecutionEngine {
 	return engine.New[
 		*ExecutionPayload,
@@ -82,8 +129,8 @@ func ProvideExecutionEngine(
 		PayloadID,
 		engineprimitives.Withdrawals,
 	](
-		in.EngineClient,
-		in.Logger.With("service", "execution-engine"),
+		ec,
+		logger,
 		in.StatusBroker,
 		in.TelemetrySink,
 	)
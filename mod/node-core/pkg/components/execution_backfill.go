@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/backfill"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// ExecutionBackfillServiceInput is the input for the backfill service of the
+// execution client.
+type ExecutionBackfillServiceInput struct {
+	depinject.In
+
+	BlockStore      *BlockStore
+	Config          *config.Config
+	ExecutionEngine *ExecutionEngine
+	Logger          log.AdvancedLogger[any, sdklog.Logger]
+}
+
+// ProvideExecutionBackfillService provides the backfill service of the
+// execution client.
+func ProvideExecutionBackfillService(
+	in ExecutionBackfillServiceInput,
+) *ExecutionBackfillService {
+	return backfill.NewService[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BlockStore,
+		*ExecutionEngine,
+		*ExecutionPayload,
+		*PayloadAttributes,
+		*SignedBeaconBlock,
+	](
+		in.Config.ExecutionBackfill,
+		in.Logger.With("service", "execution-backfill"),
+		in.BlockStore,
+		in.ExecutionEngine,
+	)
+}
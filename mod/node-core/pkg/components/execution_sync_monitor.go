@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/beacon/syncmonitor"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// ExecutionSyncMonitorInput is the input for the monitor of the execution
+// client sync.
+type ExecutionSyncMonitorInput struct {
+	depinject.In
+
+	BlockBroker     *BlockBroker
+	Config          *config.Config
+	ExecutionEngine *ExecutionEngine
+	Logger          log.AdvancedLogger[any, sdklog.Logger]
+	NodeAPIBackend  *NodeAPIBackend
+	StatusBroker    *StatusBroker
+}
+
+// ProvideExecutionSyncMonitor provides the monitor of the execution client
+// sync.
+func ProvideExecutionSyncMonitor(
+	in ExecutionSyncMonitorInput,
+) (*ExecutionSyncMonitor, error) {
+	// Only the latest finalized block matters to the monitor, so older ones
+	// are dropped rather than holding up the publisher.
+	blkSubscription, err := in.BlockBroker.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyDropOldest),
+	)
+	if err != nil {
+		in.Logger.Error("failed to subscribe to block feed", "err", err)
+		return nil, err
+	}
+	return syncmonitor.NewService[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		*ExecutionEngine,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+		*PayloadAttributes,
+	](
+		in.Config.ExecutionSync,
+		in.Logger.With("service", "execution-sync-monitor"),
+		in.ExecutionEngine,
+		in.NodeAPIBackend,
+		blkSubscription,
+		in.StatusBroker,
+	), nil
+}
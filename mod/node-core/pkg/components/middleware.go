@@ -31,8 +31,9 @@ import (
 // ABCIMiddlewareInput is the input for the validator middleware provider.
 type ABCIMiddlewareInput struct {
 	depinject.In
-	BeaconBlockFeed       *BlockBroker
+	BeaconBlockFeed       *SignedBlockBroker
 	ChainSpec             common.ChainSpec
+	ColumnsFeed           *ColumnsBroker
 	GenesisBroker         *GenesisBroker
 	Logger                log.Logger[any]
 	SidecarsFeed          *SidecarsBroker
@@ -51,8 +52,8 @@ func ProvideABCIMiddleware(
 		return nil, err
 	}
 	return middleware.NewABCIMiddleware[
-		*AvailabilityStore, *BeaconBlock, *BlobSidecars,
-		*Deposit, *ExecutionPayload, *Genesis, *SlotData,
+		*AvailabilityStore, *SignedBeaconBlock, *BlobSidecars,
+		*DataColumnSidecars, *Deposit, *ExecutionPayload, *Genesis, *SlotData,
 	](
 		in.ChainSpec,
 		in.Logger,
@@ -60,6 +61,7 @@ func ProvideABCIMiddleware(
 		in.GenesisBroker,
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
+		in.ColumnsFeed,
 		in.SlotBroker,
 		validatorUpdatesSub,
 	), nil
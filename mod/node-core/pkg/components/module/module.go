@@ -122,6 +122,7 @@ func (am AppModule) InitGenesis(
 ) ([]appmodule.ValidatorUpdate, error) {
 	return cometbft.NewConsensusEngine[
 		*types.AttestationData,
+		*types.SignedBeaconBlock,
 		*components.BeaconState,
 		*types.SlashingInfo,
 		*consruntimetypes.SlotData[
@@ -129,10 +130,14 @@ func (am AppModule) InitGenesis(
 			*types.SlashingInfo,
 		],
 		components.StorageBackend,
+		*types.Validator,
 		appmodule.ValidatorUpdate,
+		*types.VoteExtension,
 	](
 		am.ABCIMiddleware,
+		nil,
 		*am.StorageBackend,
+		nil,
 	).InitGenesis(ctx, bz)
 }
 
@@ -142,6 +147,7 @@ func (am AppModule) EndBlock(
 ) ([]appmodule.ValidatorUpdate, error) {
 	return cometbft.NewConsensusEngine[
 		*types.AttestationData,
+		*types.SignedBeaconBlock,
 		*components.BeaconState,
 		*types.SlashingInfo,
 		*consruntimetypes.SlotData[
@@ -149,9 +155,13 @@ func (am AppModule) EndBlock(
 			*types.SlashingInfo,
 		],
 		components.StorageBackend,
+		*types.Validator,
 		appmodule.ValidatorUpdate,
+		*types.VoteExtension,
 	](
 		am.ABCIMiddleware,
+		nil,
 		*am.StorageBackend,
+		nil,
 	).EndBlock(ctx)
 }
@@ -26,9 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
-	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // LocalBuilderInput is an input for the dep inject framework.
@@ -52,11 +50,29 @@ func ProvideLocalBuilder(
 		&in.Cfg.PayloadBuilder,
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
-		in.ExecutionEngine,
-		cache.NewPayloadIDCache[
-			PayloadID,
-			[32]byte, math.Slot,
-		](),
+		append(
+			[]payloadbuilder.ExecutionEngine[
+				*ExecutionPayload, *PayloadAttributes, PayloadID,
+			]{in.ExecutionEngine},
+			followerEngines(in.ExecutionEngine)...,
+		),
 		in.AttributesFactory,
 	)
 }
+
+// followerEngines returns the engines of the execution clients following the
+// given one, on which payloads are built as well.
+func followerEngines(
+	ee *ExecutionEngine,
+) []payloadbuilder.ExecutionEngine[
+	*ExecutionPayload, *PayloadAttributes, PayloadID,
+] {
+	followers := ee.Followers()
+	ees := make([]payloadbuilder.ExecutionEngine[
+		*ExecutionPayload, *PayloadAttributes, PayloadID,
+	], len(followers))
+	for i, follower := range followers {
+		ees[i] = follower
+	}
+	return ees
+}
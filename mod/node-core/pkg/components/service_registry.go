@@ -34,15 +34,20 @@ type ServiceRegistryInput struct {
 	BlockBroker           *BlockBroker
 	BlockStoreService     *BlockStoreService
 	ChainService          *ChainService
+	ColumnsBroker         *ColumnsBroker
 	DAService             *DAService
 	DBManager             *DBManager
 	DepositService        *DepositService
 	EngineClient          *EngineClient
+	ExecutionBackfill     *ExecutionBackfillService
+	ExecutionSyncMonitor  *ExecutionSyncMonitor
+	FollowerEngineClients EngineClients
 	GenesisBroker         *GenesisBroker
 	Logger                log.Logger
 	NodeAPIServer         *NodeAPIServer
 	ReportingService      *ReportingService
 	SidecarsBroker        *SidecarsBroker
+	SignedBlockBroker     *SignedBlockBroker
 	SlotBroker            *SlotBroker
 	TelemetrySink         *metrics.TelemetrySink
 	ValidatorService      *ValidatorService
@@ -66,9 +71,14 @@ func ProvideServiceRegistry(
 		service.WithService(in.DBManager),
 		service.WithService(in.GenesisBroker),
 		service.WithService(in.BlockBroker),
+		service.WithService(in.SignedBlockBroker),
 		service.WithService(in.SlotBroker),
 		service.WithService(in.SidecarsBroker),
+		service.WithService(in.ColumnsBroker),
 		service.WithService(in.ValidatorUpdateBroker),
 		service.WithService(in.EngineClient),
+		service.WithService(in.FollowerEngineClients),
+		service.WithService(in.ExecutionBackfill),
+		service.WithService(in.ExecutionSyncMonitor),
 	)
 }
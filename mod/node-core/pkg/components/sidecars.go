@@ -24,14 +24,16 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 type SidecarFactoryInput struct {
 	depinject.In
-	ChainSpec     common.ChainSpec
-	TelemetrySink *metrics.TelemetrySink
+	ChainSpec         common.ChainSpec
+	BlobProofVerifier kzg.BlobProofVerifier
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 func ProvideSidecarFactory(in SidecarFactoryInput) *SidecarFactory {
@@ -41,6 +43,7 @@ func ProvideSidecarFactory(in SidecarFactoryInput) *SidecarFactory {
 	](
 		in.ChainSpec,
 		types.KZGPositionDeneb,
+		in.BlobProofVerifier,
 		in.TelemetrySink,
 	)
 }
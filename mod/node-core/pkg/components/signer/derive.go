@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/itsdevbear/comet-bls12-381/bls/blst"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// eip2334Purpose is the purpose field of an EIP-2334 key path.
+	eip2334Purpose = 12381
+	// eip2334CoinType is the coin type of an EIP-2334 key path for Ethereum.
+	eip2334CoinType = 3600
+
+	// eip2333MinSeedLen is the minimum length of the seed of a master key.
+	eip2333MinSeedLen = 32
+	// eip2333OKMLen is the length of the output keying material reduced
+	// into a secret key, ceil((3 * ceil(log2(r))) / 16).
+	eip2333OKMLen = 48
+	// eip2333LamportChunks is the number of chunks of a Lamport secret key.
+	eip2333LamportChunks = 255
+	// eip2333KeySalt is the initial salt of HKDF_mod_r.
+	eip2333KeySalt = "BLS-SIG-KEYGEN-SALT-"
+)
+
+// curveOrder is the order r of the BLS12-381 subgroups.
+//
+//nolint:gochecknoglobals,lll // constant.
+var curveOrder, _ = new(big.Int).SetString(
+	"52435875175126190479447740508185965837690552500527637822603658699938581184513",
+	10,
+)
+
+// ValidatorSigningKeyPath returns the EIP-2334 path of the signing key for the
+// validator at the given index, i.e. m/12381/3600/index/0/0.
+func ValidatorSigningKeyPath(index uint32) string {
+	return fmt.Sprintf(
+		"m/%d/%d/%d/0/0", eip2334Purpose, eip2334CoinType, index,
+	)
+}
+
+// LegacyKeyFromMnemonic derives a BLS12-381 secret key from a BIP-39 mnemonic
+// and passphrase following the EIP-2333 tree along the given EIP-2334 path.
+func LegacyKeyFromMnemonic(
+	mnemonic, passphrase, path string,
+) (LegacyKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	return LegacyKeyFromSeed(seed, path)
+}
+
+// LegacyKeyFromSeed derives a BLS12-381 secret key from a seed following the
+// EIP-2333 tree along the given EIP-2334 path.
+func LegacyKeyFromSeed(seed []byte, path string) (LegacyKey, error) {
+	indices, err := parseKeyPath(path)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if len(seed) < eip2333MinSeedLen {
+		return LegacyKey{}, ErrInvalidSeedLength
+	}
+
+	sk, err := hkdfModR(seed)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	for _, index := range indices {
+		var lamportPK []byte
+		if lamportPK, err = parentToLamportPK(sk, index); err != nil {
+			return LegacyKey{}, err
+		}
+		if sk, err = hkdfModR(lamportPK); err != nil {
+			return LegacyKey{}, err
+		}
+	}
+
+	// The derived key is checked to be a valid BLS12-381 secret key.
+	if _, err = blst.SecretKeyFromBytes(sk[:]); err != nil {
+		return LegacyKey{}, err
+	}
+	return sk, nil
+}
+
+// hkdfModR derives a secret key from the input keying material as per the
+// HKDF_mod_r function of EIP-2333.
+func hkdfModR(ikm []byte) (LegacyKey, error) {
+	var (
+		salt = []byte(eip2333KeySalt)
+		sk   = new(big.Int)
+		okm  = make([]byte, eip2333OKMLen)
+	)
+	// IKM || I2OSP(0, 1)
+	ikm = append(ikm[:len(ikm):len(ikm)], 0)
+	for sk.Sign() == 0 {
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+
+		prk := hkdf.Extract(sha256.New, ikm, salt)
+		//nolint:mnd // I2OSP(L, 2) with an empty key info.
+		info := []byte{0, eip2333OKMLen}
+		if _, err := io.ReadFull(
+			hkdf.Expand(sha256.New, prk, info), okm,
+		); err != nil {
+			return LegacyKey{}, err
+		}
+		sk.Mod(new(big.Int).SetBytes(okm), curveOrder)
+	}
+
+	var key LegacyKey
+	sk.FillBytes(key[:])
+	return key, nil
+}
+
+// parentToLamportPK computes the compressed Lamport public key of the child
+// at the given index of the parent secret key, as per the
+// parent_SK_to_lamport_PK function of EIP-2333.
+func parentToLamportPK(parent LegacyKey, index uint32) ([]byte, error) {
+	salt := []byte{
+		byte(index >> 24), byte(index >> 16), byte(index >> 8), byte(index),
+	}
+	notIKM := make([]byte, len(parent))
+	for i, b := range parent {
+		notIKM[i] = ^b
+	}
+
+	lamportPK := make([]byte, 0, 2*eip2333LamportChunks*sha256.Size)
+	for _, ikm := range [][]byte{parent[:], notIKM} {
+		okm := make([]byte, eip2333LamportChunks*sha256.Size)
+		if _, err := io.ReadFull(
+			hkdf.New(sha256.New, ikm, salt, nil), okm,
+		); err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(okm); i += sha256.Size {
+			chunk := sha256.Sum256(okm[i : i+sha256.Size])
+			lamportPK = append(lamportPK, chunk[:]...)
+		}
+	}
+
+	compressed := sha256.Sum256(lamportPK)
+	return compressed[:], nil
+}
+
+// parseKeyPath parses an EIP-2334 path of the form m/a/b/c into its child
+// indices.
+func parseKeyPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, ErrInvalidKeyPath
+	}
+
+	indices := make([]uint32, len(segments)-1)
+	for i, segment := range segments[1:] {
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKeyPath, err)
+		}
+		indices[i] = uint32(index)
+	}
+	return indices, nil
+}
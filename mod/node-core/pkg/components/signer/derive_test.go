@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from EIP-2333.
+func TestLegacyKeyFromSeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     string
+		path     string
+		expected string
+	}{
+		{
+			name: "master key",
+			seed: "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5" +
+				"3495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f0016" +
+				"98e7463b04",
+			path: "m",
+			expected: "6083874454709270928345386274498605044986640685124978" +
+				"867557563392430687146096",
+		},
+		{
+			name: "child key",
+			seed: "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5" +
+				"3495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f0016" +
+				"98e7463b04",
+			path: "m/0",
+			expected: "2039778985973665094231741226247255810787539217244407" +
+				"6792671091975210932703118",
+		},
+		{
+			name: "child key with large index",
+			seed: "31415926535897932384626433832795028841971693993751058209" +
+				"74944592",
+			path: "m/3141592653",
+			expected: "2545720168885069194772762938519170451674479611492589" +
+				"7962676248250929345014287",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed, err := hex.DecodeString(tt.seed)
+			require.NoError(t, err)
+
+			key, err := signer.LegacyKeyFromSeed(seed, tt.path)
+			require.NoError(t, err)
+
+			expected, ok := new(big.Int).SetString(tt.expected, 10)
+			require.True(t, ok)
+			require.Equal(t, expected, new(big.Int).SetBytes(key[:]))
+		})
+	}
+}
+
+func TestLegacyKeyFromSeedInvalidPath(t *testing.T) {
+	seed := make([]byte, 32)
+	for _, path := range []string{"", "0/1", "m/a", "m/-1", "m/4294967296"} {
+		_, err := signer.LegacyKeyFromSeed(seed, path)
+		require.ErrorIs(t, err, signer.ErrInvalidKeyPath, path)
+	}
+}
+
+func TestValidatorSigningKeyPath(t *testing.T) {
+	require.Equal(t, "m/12381/3600/7/0/0", signer.ValidatorSigningKeyPath(7))
+}
@@ -38,4 +38,29 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrInvalidKeyPath is returned when an EIP-2334 key path is malformed.
+	ErrInvalidKeyPath = errors.New("invalid key path")
+
+	// ErrInvalidSeedLength is returned when the seed used to derive a key is
+	// too short.
+	ErrInvalidSeedLength = errors.New("invalid seed length")
+
+	// ErrUnsupportedKeystore is returned when a keystore uses a version or
+	// crypto module that is not supported.
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+	// ErrInvalidKeystoreParams is returned when the parameters of a keystore
+	// crypto module are malformed or out of the accepted bounds.
+	ErrInvalidKeystoreParams = errors.New("invalid keystore parameters")
+
+	// ErrInvalidKeystorePassword is returned when a keystore cannot be
+	// decrypted with the given password.
+	ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+
+	// ErrUnsupportedPrivValKey is returned when a private validator key file
+	// does not hold a BLS12-381 key.
+	ErrUnsupportedPrivValKey = errors.New(
+		"private validator key is not a BLS12-381 key",
+	)
 )
@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// keystoreVersion is the EIP-2335 keystore version.
+	keystoreVersion = 4
+
+	// kdfScrypt is the name of the scrypt key derivation function.
+	kdfScrypt = "scrypt"
+	// kdfPBKDF2 is the name of the PBKDF2 key derivation function.
+	kdfPBKDF2 = "pbkdf2"
+	// checksumSHA256 is the name of the SHA-256 checksum function.
+	checksumSHA256 = "sha256"
+	// cipherAES128CTR is the name of the AES-128-CTR cipher.
+	cipherAES128CTR = "aes-128-ctr"
+	// prfHMACSHA256 is the name of the HMAC-SHA256 pseudo-random function.
+	prfHMACSHA256 = "hmac-sha256"
+
+	// Default scrypt parameters recommended by EIP-2335.
+	scryptN     = 262144
+	scryptR     = 8
+	scryptP     = 1
+	kdfKeyLen   = 32
+	kdfSaltLen  = 32
+	aesKeyLen   = 16
+	aesIVLength = 16
+
+	// Bounds of the kdf parameters accepted when decrypting a keystore, so
+	// that a crafted keystore cannot exhaust the memory or the CPU.
+	minKDFKeyLen = 32
+	maxKDFKeyLen = 64
+	maxScryptN   = 1 << 20
+	maxScryptR   = 16
+	maxScryptP   = 16
+	maxPBKDF2C   = 1 << 22
+)
+
+// Keystore is an EIP-2335 keystore holding an encrypted BLS12-381 secret key.
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	Pubkey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     int            `json:"version"`
+}
+
+// KeystoreCrypto holds the modules used to encrypt the secret key.
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a single EIP-2335 crypto module.
+type KeystoreModule struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+// scryptParams are the parameters of the scrypt kdf module.
+type scryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+// pbkdf2Params are the parameters of the pbkdf2 kdf module.
+type pbkdf2Params struct {
+	DKLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+// cipherParams are the parameters of the aes-128-ctr cipher module.
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKeystore encrypts the given key into an EIP-2335 keystore using
+// scrypt as the key derivation function. The path is the EIP-2334 path the
+// key was derived from and may be empty.
+func EncryptKeystore(
+	key LegacyKey,
+	password string,
+	path string,
+) (*Keystore, error) {
+	blsSigner, err := NewLegacySigner(key)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := blsSigner.PublicKey()
+
+	salt := make([]byte, kdfSaltLen)
+	if _, err = rand.Read(salt); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aesIVLength)
+	if _, err = rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	decryptionKey, err := scrypt.Key(
+		normalizePassword(password), salt,
+		scryptN, scryptR, scryptP, kdfKeyLen,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := aes128CTR(decryptionKey[:aesKeyLen], iv, key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams, err := json.Marshal(scryptParams{
+		DKLen: kdfKeyLen,
+		N:     scryptN,
+		P:     scryptP,
+		R:     scryptR,
+		Salt:  hex.EncodeToString(salt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivParams, err := json.Marshal(cipherParams{IV: hex.EncodeToString(iv)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF: KeystoreModule{
+				Function: kdfScrypt,
+				Params:   kdfParams,
+			},
+			Checksum: KeystoreModule{
+				Function: checksumSHA256,
+				Params:   json.RawMessage("{}"),
+				Message: hex.EncodeToString(
+					keystoreChecksum(decryptionKey, cipherText),
+				),
+			},
+			Cipher: KeystoreModule{
+				Function: cipherAES128CTR,
+				Params:   ivParams,
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Pubkey:  hex.EncodeToString(pubkey[:]),
+		Path:    path,
+		UUID:    uuid.NewString(),
+		Version: keystoreVersion,
+	}, nil
+}
+
+// Decrypt decrypts the secret key held by the keystore with the given
+// password.
+func (k *Keystore) Decrypt(password string) (LegacyKey, error) {
+	if k.Version != keystoreVersion {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "version %d", k.Version,
+		)
+	}
+
+	decryptionKey, err := k.decryptionKey(normalizePassword(password))
+	if err != nil {
+		return LegacyKey{}, err
+	}
+
+	cipherText, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+
+	if k.Crypto.Checksum.Function != checksumSHA256 {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore,
+			"checksum function %s", k.Crypto.Checksum.Function,
+		)
+	}
+	checksum, err := hex.DecodeString(k.Crypto.Checksum.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if subtle.ConstantTimeCompare(
+		checksum, keystoreChecksum(decryptionKey, cipherText),
+	) != 1 {
+		return LegacyKey{}, ErrInvalidKeystorePassword
+	}
+
+	if k.Crypto.Cipher.Function != cipherAES128CTR {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore,
+			"cipher function %s", k.Crypto.Cipher.Function,
+		)
+	}
+	var params cipherParams
+	if err = json.Unmarshal(k.Crypto.Cipher.Params, &params); err != nil {
+		return LegacyKey{}, err
+	}
+	iv, err := hex.DecodeString(params.IV)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if len(iv) != aes.BlockSize {
+		return LegacyKey{}, errors.Wrapf(
+			ErrInvalidKeystoreParams, "iv length %d", len(iv),
+		)
+	}
+
+	plainText, err := aes128CTR(decryptionKey[:aesKeyLen], iv, cipherText)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if len(plainText) != len(LegacyKey{}) {
+		return LegacyKey{}, ErrInvalidValidatorPrivateKeyLength
+	}
+	return LegacyKey(plainText), nil
+}
+
+// decryptionKey derives the decryption key from the password using the kdf
+// module of the keystore.
+func (k *Keystore) decryptionKey(password []byte) ([]byte, error) {
+	switch k.Crypto.KDF.Function {
+	case kdfScrypt:
+		var params scryptParams
+		if err := json.Unmarshal(k.Crypto.KDF.Params, &params); err != nil {
+			return nil, err
+		}
+		if err := params.validate(); err != nil {
+			return nil, err
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, err
+		}
+		return scrypt.Key(
+			password, salt, params.N, params.R, params.P, params.DKLen,
+		)
+	case kdfPBKDF2:
+		var params pbkdf2Params
+		if err := json.Unmarshal(k.Crypto.KDF.Params, &params); err != nil {
+			return nil, err
+		}
+		if params.PRF != prfHMACSHA256 {
+			return nil, errors.Wrapf(
+				ErrUnsupportedKeystore, "prf %s", params.PRF,
+			)
+		}
+		if err := params.validate(); err != nil {
+			return nil, err
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(
+			password, salt, params.C, params.DKLen, sha256.New,
+		), nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "kdf function %s", k.Crypto.KDF.Function,
+		)
+	}
+}
+
+// validate checks the scrypt parameters are within the accepted bounds, N
+// being a power of two as required by scrypt.
+func (p *scryptParams) validate() error {
+	switch {
+	case p.DKLen < minKDFKeyLen || p.DKLen > maxKDFKeyLen:
+		return errors.Wrapf(ErrInvalidKeystoreParams, "dklen %d", p.DKLen)
+	case p.N <= 1 || p.N > maxScryptN || p.N&(p.N-1) != 0:
+		return errors.Wrapf(ErrInvalidKeystoreParams, "scrypt n %d", p.N)
+	case p.R < 1 || p.R > maxScryptR:
+		return errors.Wrapf(ErrInvalidKeystoreParams, "scrypt r %d", p.R)
+	case p.P < 1 || p.P > maxScryptP:
+		return errors.Wrapf(ErrInvalidKeystoreParams, "scrypt p %d", p.P)
+	default:
+		return nil
+	}
+}
+
+// validate checks the pbkdf2 parameters are within the accepted bounds.
+func (p *pbkdf2Params) validate() error {
+	switch {
+	case p.DKLen < minKDFKeyLen || p.DKLen > maxKDFKeyLen:
+		return errors.Wrapf(ErrInvalidKeystoreParams, "dklen %d", p.DKLen)
+	case p.C < 1 || p.C > maxPBKDF2C:
+		return errors.Wrapf(ErrInvalidKeystoreParams, "pbkdf2 c %d", p.C)
+	default:
+		return nil
+	}
+}
+
+// keystoreChecksum computes the checksum of the cipher text as defined by
+// EIP-2335.
+func keystoreChecksum(decryptionKey, cipherText []byte) []byte {
+	//nolint:mnd // the second half of the decryption key.
+	preImage := append(
+		append([]byte{}, decryptionKey[16:32]...), cipherText...,
+	)
+	checksum := sha256.Sum256(preImage)
+	return checksum[:]
+}
+
+// aes128CTR encrypts or decrypts the given text with AES-128-CTR.
+func aes128CTR(key, iv, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(text))
+	cipher.NewCTR(block, iv).XORKeyStream(out, text)
+	return out, nil
+}
+
+// normalizePassword applies the EIP-2335 password processing: NFKD
+// normalization followed by the removal of the C0, C1 and Delete control
+// codes.
+func normalizePassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		//nolint:mnd // control code ranges.
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
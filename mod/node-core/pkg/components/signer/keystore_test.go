@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Test vectors from EIP-2335.
+	testKeystorePassword = "\U0001d531\U0001d522\U0001d530\U0001d531" +
+		"\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c" +
+		"\U0001d52f\U0001d521\U0001f511"
+	testKeystoreSecret = "000000000019d6689c085ae165831e934ff763ae46a2a6c1" +
+		"72b3f1b60a8ce26f"
+
+	scryptKeystore = `{
+		"crypto": {
+			"kdf": {
+				"function": "scrypt",
+				"params": {
+					"dklen": 32,
+					"n": 262144,
+					"p": 1,
+					"r": 8,
+					"salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+				},
+				"message": ""
+			},
+			"checksum": {
+				"function": "sha256",
+				"params": {},
+				"message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+			},
+			"cipher": {
+				"function": "aes-128-ctr",
+				"params": {"iv": "264daa3f303d7259501c93d997d84fe6"},
+				"message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+			}
+		},
+		"pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+		"path": "m/12381/60/3141592653/589793238",
+		"uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+		"version": 4
+	}`
+
+	pbkdf2Keystore = `{
+		"crypto": {
+			"kdf": {
+				"function": "pbkdf2",
+				"params": {
+					"dklen": 32,
+					"c": 262144,
+					"prf": "hmac-sha256",
+					"salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+				},
+				"message": ""
+			},
+			"checksum": {
+				"function": "sha256",
+				"params": {},
+				"message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+			},
+			"cipher": {
+				"function": "aes-128-ctr",
+				"params": {"iv": "264daa3f303d7259501c93d997d84fe6"},
+				"message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+			}
+		},
+		"pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+		"path": "m/12381/60/0/0",
+		"uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+		"version": 4
+	}`
+)
+
+func TestKeystoreDecrypt(t *testing.T) {
+	for name, raw := range map[string]string{
+		"scrypt": scryptKeystore,
+		"pbkdf2": pbkdf2Keystore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var keystore signer.Keystore
+			require.NoError(t, json.Unmarshal([]byte(raw), &keystore))
+
+			key, err := keystore.Decrypt(testKeystorePassword)
+			require.NoError(t, err)
+			require.Equal(t, testKeystoreSecret, hex.EncodeToString(key[:]))
+
+			_, err = keystore.Decrypt("wrong password")
+			require.ErrorIs(t, err, signer.ErrInvalidKeystorePassword)
+		})
+	}
+}
+
+func TestKeystoreDecryptInvalidParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		keystore string
+		old, new string
+	}{
+		{"short dklen", scryptKeystore, `"dklen": 32`, `"dklen": 16`},
+		{"long dklen", pbkdf2Keystore, `"dklen": 32`, `"dklen": 4096`},
+		{"huge scrypt n", scryptKeystore, `"n": 262144`, `"n": 1073741824`},
+		{"scrypt n not a power of two", scryptKeystore, `"n": 262144`, `"n": 3`},
+		{"huge scrypt r", scryptKeystore, `"r": 8`, `"r": 1024`},
+		{"zero scrypt p", scryptKeystore, `"p": 1`, `"p": 0`},
+		{"huge pbkdf2 c", pbkdf2Keystore, `"c": 262144`, `"c": 1073741824`},
+		{
+			"short iv", scryptKeystore,
+			`"iv": "264daa3f303d7259501c93d997d84fe6"`, `"iv": "264daa3f"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var keystore signer.Keystore
+			require.NoError(t, json.Unmarshal(
+				[]byte(strings.Replace(tt.keystore, tt.old, tt.new, 1)),
+				&keystore,
+			))
+
+			_, err := keystore.Decrypt(testKeystorePassword)
+			require.ErrorIs(t, err, signer.ErrInvalidKeystoreParams)
+		})
+	}
+}
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	secret, err := hex.DecodeString(testKeystoreSecret)
+	require.NoError(t, err)
+	key := signer.LegacyKey(secret)
+
+	keystore, err := signer.EncryptKeystore(
+		key, testKeystorePassword, signer.ValidatorSigningKeyPath(0),
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		"9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27"+
+			"f4ae4040902382ae2910c15e2b420d07",
+		keystore.Pubkey,
+	)
+
+	bz, err := json.Marshal(keystore)
+	require.NoError(t, err)
+	var decoded signer.Keystore
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+
+	decrypted, err := decoded.Decrypt(testKeystorePassword)
+	require.NoError(t, err)
+	require.Equal(t, key, decrypted)
+}
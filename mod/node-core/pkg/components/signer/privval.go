@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// LegacyKeyFromPrivValFile reads the BLS12-381 secret key from a CometBFT
+// priv_validator_key.json file.
+func LegacyKeyFromPrivValFile(keyFilePath string) (LegacyKey, error) {
+	//#nosec:G304 // the path is provided by the operator.
+	bz, err := os.ReadFile(keyFilePath)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+
+	var pvKey privval.FilePVKey
+	if err = cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return LegacyKey{}, errors.Wrapf(
+			err, "failed to unmarshal private validator key %s", keyFilePath,
+		)
+	}
+	if pvKey.PrivKey == nil ||
+		pvKey.PrivKey.Type() != bls12381.KeyType {
+		return LegacyKey{}, ErrUnsupportedPrivValKey
+	}
+
+	keyBz := pvKey.PrivKey.Bytes()
+	if len(keyBz) != len(LegacyKey{}) {
+		return LegacyKey{}, ErrInvalidValidatorPrivateKeyLength
+	}
+	return LegacyKey(keyBz), nil
+}
+
+// WritePrivValFiles writes the given key to a CometBFT
+// priv_validator_key.json file. The sign state file is only created if it
+// does not exist yet, so that the double signing protection of an existing
+// node is preserved.
+func WritePrivValFiles(
+	key LegacyKey,
+	keyFilePath string,
+	stateFilePath string,
+) error {
+	privKey, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return err
+	}
+
+	filePV := privval.NewFilePV(privKey, keyFilePath, stateFilePath)
+	filePV.Key.Save()
+
+	if _, err = os.Stat(stateFilePath); os.IsNotExist(err) {
+		filePV.LastSignState.Save()
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cast"
+)
+
+// genesisAppStateKey is the key of the beacon genesis in the app state.
+const genesisAppStateKey = "beacon"
+
+// StateCheckpoints persists the checkpoints taken by the state replayer as
+// snapshots of the beacon store, and builds the genesis state, the checkpoint
+// at slot 0, from the genesis file.
+type StateCheckpoints struct {
+	db          *filedb.DB
+	kvStore     *KVStore
+	sb          *StorageBackend
+	sp          *StateProcessor
+	genesisPath string
+	// latest returns the latest state, on top of which the checkpoints are
+	// restored.
+	latest func() (*BeaconState, error)
+}
+
+// StateCheckpointsInput is the input for the ProvideStateCheckpoints function.
+type StateCheckpointsInput struct {
+	depinject.In
+	AppOpts        servertypes.AppOptions
+	KVStore        *KVStore
+	Logger         log.AdvancedLogger[any, sdklog.Logger]
+	StateProcessor *StateProcessor
+	StorageBackend *StorageBackend
+}
+
+// ProvideStateCheckpoints provides the store of the state replay checkpoints.
+func ProvideStateCheckpoints(in StateCheckpointsInput) *StateCheckpoints {
+	home := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	return &StateCheckpoints{
+		db: filedb.NewDB(
+			filedb.WithRootDirectory(home+"/data/state-checkpoints"),
+			filedb.WithFileExtension("snapshot"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(in.Logger),
+		),
+		kvStore:     in.KVStore,
+		sb:          in.StorageBackend,
+		sp:          in.StateProcessor,
+		genesisPath: filepath.Join(home, "config", "genesis.json"),
+	}
+}
+
+// AttachLatestState sets the source of the latest state, on top of which the
+// checkpoints are restored.
+func (c *StateCheckpoints) AttachLatestState(
+	latest func() (*BeaconState, error),
+) {
+	c.latest = latest
+}
+
+// Get returns the checkpoint persisted at the given slot, building the
+// genesis state at slot 0 if it has not been persisted yet.
+func (c *StateCheckpoints) Get(slot math.Slot) (*BeaconState, error) {
+	bz, err := c.db.Get(checkpointKey(slot))
+	switch {
+	case err == nil:
+	case slot == 0 && errors.Is(err, os.ErrNotExist):
+		return c.genesisState()
+	default:
+		return nil, err
+	}
+
+	st, err := c.emptyState()
+	if err != nil {
+		return nil, err
+	}
+	if err = c.kvStore.WithContext(st.Context()).Restore(bz); err != nil {
+		return nil, errors.Wrapf(err, "checkpoint at slot %d", slot)
+	}
+	return st, nil
+}
+
+// Set persists a snapshot of the state as the checkpoint at the given slot.
+func (c *StateCheckpoints) Set(slot math.Slot, st *BeaconState) error {
+	bz, err := c.kvStore.WithContext(st.Context()).Snapshot()
+	if err != nil {
+		return err
+	}
+	return c.db.Set(checkpointKey(slot), bz)
+}
+
+// genesisState builds the genesis state from the genesis file, and persists
+// it as the checkpoint at slot 0.
+func (c *StateCheckpoints) genesisState() (*BeaconState, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(c.genesisPath)
+	if err != nil {
+		return nil, err
+	}
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return nil, err
+	}
+	genesis := new(Genesis)
+	if err = json.Unmarshal(
+		appState[genesisAppStateKey], genesis,
+	); err != nil {
+		return nil, err
+	}
+
+	st, err := c.emptyState()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = c.sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genesis.GetDeposits(),
+		genesis.GetExecutionPayloadHeader(),
+		genesis.GetForkVersion(),
+	); err != nil {
+		return nil, errors.Wrap(err, "genesis state")
+	}
+	return st, c.Set(0, st)
+}
+
+// emptyState returns an empty state, on a cache of the latest state that is
+// never written.
+func (c *StateCheckpoints) emptyState() (*BeaconState, error) {
+	if c.latest == nil {
+		return nil, errors.New("no latest state attached")
+	}
+	latest, err := c.latest()
+	if err != nil {
+		return nil, err
+	}
+	cctx, _ := sdk.UnwrapSDKContext(latest.Context()).CacheContext()
+	if err = c.kvStore.WithContext(cctx).Restore(nil); err != nil {
+		return nil, err
+	}
+	return c.sb.StateFromContext(cctx), nil
+}
+
+// checkpointKey returns the key of the checkpoint at the given slot.
+func checkpointKey(slot math.Slot) []byte {
+	return []byte(slot.Base10())
+}
@@ -43,6 +43,7 @@ func ProvideStateProcessor(
 	in StateProcessorInput,
 ) *StateProcessor {
 	return core.NewStateProcessor[
+		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
@@ -44,7 +44,7 @@ type Backend[
 	],
 	BeaconStateMarshallableT state.BeaconStateMarshallable[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, Eth1DataT,
-		ExecutionPayloadHeaderT, ForkT, ValidatorT,
+		ExecutionPayloadHeaderT, ForkT, HistoricalSummaryT, ValidatorT,
 	],
 	BlobSidecarsT any,
 	BlockStoreT BlockStore[BeaconBlockT],
@@ -52,7 +52,8 @@ type Backend[
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
-	ForkT any,
+	ForkT,
+	HistoricalSummaryT any,
 	KVStoreT KVStore[
 		KVStoreT, BeaconBlockHeaderT, Eth1DataT,
 		ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT,
@@ -82,7 +83,7 @@ func NewBackend[
 	],
 	BeaconStateMarshallableT state.BeaconStateMarshallable[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, Eth1DataT,
-		ExecutionPayloadHeaderT, ForkT, ValidatorT,
+		ExecutionPayloadHeaderT, ForkT, HistoricalSummaryT, ValidatorT,
 	],
 	BlobSidecarsT any,
 	BlockStoreT BlockStore[BeaconBlockT],
@@ -90,7 +91,8 @@ func NewBackend[
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
-	ForkT any,
+	ForkT,
+	HistoricalSummaryT any,
 	KVStoreT KVStore[
 		KVStoreT, BeaconBlockHeaderT, Eth1DataT,
 		ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT,
@@ -109,13 +111,15 @@ func NewBackend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	KVStoreT, ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+	HistoricalSummaryT, KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
+	WithdrawalCredentialsT,
 ] {
 	return &Backend[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 		DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+		HistoricalSummaryT, KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
+		WithdrawalCredentialsT,
 	]{
 		cs:  cs,
 		as:  as,
@@ -128,7 +132,7 @@ func NewBackend[
 // AvailabilityStore returns the availability store struct initialized with a
 // given context.
 func (k Backend[
-	AvailabilityStoreT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	AvailabilityStoreT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) AvailabilityStore() AvailabilityStoreT {
 	return k.as
 }
@@ -139,7 +143,8 @@ func (k Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	KVStoreT, ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+	HistoricalSummaryT, KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) StateFromContext(
 	ctx context.Context,
 ) BeaconStateT {
@@ -149,20 +154,20 @@ func (k Backend[
 
 // BeaconStore returns the beacon store struct.
 func (k Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, KVStoreT, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, KVStoreT, _, _, _, _,
 ]) BeaconStore() KVStoreT {
 	return k.kvs
 }
 
 func (k Backend[
-	_, _, _, _, _, _, _, BlockStoreT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, BlockStoreT, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockStore() BlockStoreT {
 	return k.bs
 }
 
 // DepositStore returns the deposit store struct initialized with a.
 func (k Backend[
-	_, _, _, _, _, _, _, _, _, DepositStoreT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, DepositStoreT, _, _, _, _, _, _, _, _, _,
 ]) DepositStore() DepositStoreT {
 	return k.ds
 }
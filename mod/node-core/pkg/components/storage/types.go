@@ -187,6 +187,32 @@ type KVStore[
 	// GetValidatorsByEffectiveBalance retrieves validators by effective
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	// GetValidatorPower retrieves the CometBFT voting power of a validator.
+	GetValidatorPower(idx math.ValidatorIndex) (math.Gwei, error)
+	// SetValidatorPower sets the CometBFT voting power of a validator.
+	SetValidatorPower(idx math.ValidatorIndex, power math.Gwei) error
+	// GetActiveValidatorIndices retrieves the indices of the validators in
+	// the active validator set.
+	GetActiveValidatorIndices() ([]math.ValidatorIndex, error)
+	// GetPreviousEpochParticipation retrieves the participation flags of a
+	// validator for the previous epoch.
+	GetPreviousEpochParticipation(idx math.ValidatorIndex) (byte, error)
+	// SetPreviousEpochParticipation sets the participation flags of a
+	// validator for the previous epoch.
+	SetPreviousEpochParticipation(idx math.ValidatorIndex, flags byte) error
+	// GetCurrentEpochParticipation retrieves the participation flags of a
+	// validator for the current epoch.
+	GetCurrentEpochParticipation(idx math.ValidatorIndex) (byte, error)
+	// SetCurrentEpochParticipation sets the participation flags of a
+	// validator for the current epoch.
+	SetCurrentEpochParticipation(idx math.ValidatorIndex, flags byte) error
+	// RotateEpochParticipation moves the participation flags of the current
+	// epoch to the previous epoch.
+	RotateEpochParticipation() error
+	// GetInactivityScore retrieves the inactivity score of a validator.
+	GetInactivityScore(idx math.ValidatorIndex) (math.U64, error)
+	// SetInactivityScore sets the inactivity score of a validator.
+	SetInactivityScore(idx math.ValidatorIndex, score math.U64) error
 }
 
 // Validator represents an interface for a validator with generic withdrawal
@@ -41,6 +41,8 @@ func ProvideKVStore(in KVStoreInput) *KVStore {
 		*Eth1Data,
 		*ExecutionPayloadHeader,
 		*Fork,
+		*HistoricalSummary,
+		*PendingConsolidation,
 		*Validator,
 		Validators,
 	](in.Environment.KVStoreService, payloadCodec)
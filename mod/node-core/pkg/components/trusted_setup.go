@@ -25,7 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
@@ -39,23 +39,23 @@ type TrustedSetupInput struct {
 // ProvideTrustedSetup provides the module to the application.
 func ProvideTrustedSetup(
 	in TrustedSetupInput,
-) (*gokzg4844.JSONTrustedSetup, error) {
+) (*goethkzg.JSONTrustedSetup, error) {
 	return ReadTrustedSetup(
 		cast.ToString(in.AppOpts.Get(flags.KZGTrustedSetupPath)),
 	)
 }
 
 // ReadTrustedSetup reads the trusted setup from the file system.
-func ReadTrustedSetup(filePath string) (*gokzg4844.JSONTrustedSetup, error) {
+func ReadTrustedSetup(filePath string) (*goethkzg.JSONTrustedSetup, error) {
 	config, err := afero.ReadFile(afero.NewOsFs(), filePath)
 	if err != nil {
 		return nil, err
 	}
-	params := new(gokzg4844.JSONTrustedSetup)
+	params := new(goethkzg.JSONTrustedSetup)
 	if err = json.Unmarshal(config, params); err != nil {
 		return nil, err
 	}
-	if err = gokzg4844.CheckTrustedSetupIsWellFormed(params); err != nil {
+	if err = goethkzg.CheckTrustedSetupIsWellFormed(params); err != nil {
 		return nil, err
 	}
 	return params, nil
@@ -24,8 +24,10 @@ import (
 	"cosmossdk.io/core/appmodule/v2"
 	broker "github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/beacon/backfill"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/syncmonitor"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
@@ -39,7 +41,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
-	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/mod/node-api/handlers/config"
@@ -47,7 +48,10 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/comet"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
@@ -72,8 +76,9 @@ type (
 	// ABCIMiddleware is a type alias for the ABCIMiddleware.
 	ABCIMiddleware = middleware.ABCIMiddleware[
 		*AvailabilityStore,
-		*BeaconBlock,
+		*SignedBeaconBlock,
 		*BlobSidecars,
+		*DataColumnSidecars,
 		*Deposit,
 		*ExecutionPayload,
 		*Genesis,
@@ -105,6 +110,7 @@ type (
 		*Eth1Data,
 		*ExecutionPayloadHeader,
 		*Fork,
+		*HistoricalSummary,
 		*KVStore,
 		*Validator,
 		Validators,
@@ -132,6 +138,9 @@ type (
 		*BeaconBlockBody,
 	]
 
+	// BlobSidecar is a type alias for the blob sidecar.
+	BlobSidecar = datypes.BlobSidecar
+
 	// BlobSidecars is a type alias for the blob sidecars.
 	BlobSidecars = datypes.BlobSidecars
 
@@ -139,10 +148,10 @@ type (
 	BlobVerifier = dablob.Verifier
 
 	// BlockStoreService is a type alias for the block store service.
-	BlockStoreService = blockstore.Service[*BeaconBlock, *BlockStore]
+	BlockStoreService = blockstore.Service[*SignedBeaconBlock, *BlockStore]
 
 	// BlockStore is a type alias for the block store.
-	BlockStore = block.KVStore[*BeaconBlock]
+	BlockStore = block.KVStore[*SignedBeaconBlock]
 
 	// ChainService is a type alias for the chain service.
 	ChainService = blockchain.Service[
@@ -156,17 +165,25 @@ type (
 		*ExecutionPayloadHeader,
 		*Genesis,
 		*PayloadAttributes,
+		*SignedBeaconBlock,
+		*Validator,
 		*Withdrawal,
 	]
 
+	// CometClient is a type alias for the CometBFT RPC client.
+	CometClient = comet.Client
+
 	// ConsensusEngine is a type alias for the consensus engine.
 	ConsensusEngine = cometbft.ConsensusEngine[
 		*AttestationData,
+		*SignedBeaconBlock,
 		*BeaconState,
 		*SlashingInfo,
 		*SlotData,
 		*StorageBackend,
+		*Validator,
 		*ValidatorUpdate,
+		*VoteExtension,
 	]
 
 	// ConsensusMiddleware is a type alias for the consensus middleware.
@@ -188,6 +205,9 @@ type (
 		*ExecutionPayload,
 	]
 
+	// DataColumnSidecars is a type alias for the data column sidecars.
+	DataColumnSidecars = datypes.DataColumnSidecars
+
 	// DBManager is a type alias for the database manager.
 	DBManager = manager.DBManager
 
@@ -222,6 +242,38 @@ type (
 		*PayloadAttributes,
 	]
 
+	// EngineClients is a type alias for the engine clients of the execution
+	// clients following the primary one.
+	EngineClients = engineclient.EngineClients[
+		*ExecutionPayload,
+		*PayloadAttributes,
+	]
+
+	// ExecutionBackfillService is a type alias for the backfill service of
+	// the execution client.
+	ExecutionBackfillService = backfill.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BlockStore,
+		*ExecutionEngine,
+		*ExecutionPayload,
+		*PayloadAttributes,
+		*SignedBeaconBlock,
+		engineprimitives.Withdrawals,
+	]
+
+	// ExecutionSyncMonitor is a type alias for the monitor of the execution
+	// client sync.
+	ExecutionSyncMonitor = syncmonitor.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		*ExecutionEngine,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+		*PayloadAttributes,
+	]
+
 	// EngineClient is a type alias for the engine client.
 	ExecutionEngine = execution.Engine[
 		*ExecutionPayload,
@@ -246,6 +298,9 @@ type (
 		*ExecutionPayloadHeader,
 	]
 
+	// HistoricalSummary is a type alias for the historical summary.
+	HistoricalSummary = types.HistoricalSummary
+
 	// SlotData is a type alias for the incoming slot.
 	SlotData = consruntimetypes.SlotData[
 		*types.AttestationData,
@@ -261,6 +316,8 @@ type (
 		*Eth1Data,
 		*ExecutionPayloadHeader,
 		*Fork,
+		*HistoricalSummary,
+		*PendingConsolidation,
 		*Validator,
 		Validators,
 	]
@@ -281,7 +338,7 @@ type (
 	// NodeAPIBackend is a type alias for the node API backend.
 	NodeAPIBackend = backend.Backend[
 		*AvailabilityStore,
-		*BeaconBlock,
+		*SignedBeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
 		*BeaconState,
@@ -303,16 +360,18 @@ type (
 		WithdrawalCredentials,
 	]
 
-	// NodeAPIContext is a type alias for the node API context.
-	NodeAPIContext = echo.Context
+	// NodeAPIContext is a type alias for the node API context, which is
+	// shared by the engines.
+	NodeAPIContext = apicontext.Context
 
-	// NodeAPIEngine is a type alias for the node API engine.
-	NodeAPIEngine = echo.Engine
+	// NodeAPIEngine is a type alias for the node API engine, either engine
+	// being selected by the config.
+	NodeAPIEngine = server.Engine[NodeAPIContext, any]
 
 	// NodeAPIServer is a type alias for the node API server.
 	NodeAPIServer = server.Server[
 		NodeAPIContext,
-		*NodeAPIEngine,
+		NodeAPIEngine,
 	]
 
 	// PayloadAttributes is a type alias for the payload attributes.
@@ -321,6 +380,9 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// PendingConsolidation is a type alias for the pending consolidation.
+	PendingConsolidation = engineprimitives.PendingConsolidation
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -330,11 +392,15 @@ type (
 		*BeaconBlockBody,
 	]
 
+	// SignedBeaconBlock is a type alias for the signed beacon block.
+	SignedBeaconBlock = types.SignedBeaconBlock
+
 	// SlashingInfo is a type alias for the slashing info.
 	SlashingInfo = types.SlashingInfo
 
 	// StateProcessor is the type alias for the state processor interface.
 	StateProcessor = core.StateProcessor[
+		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
@@ -357,7 +423,7 @@ type (
 	// StorageBackend is the type alias for the storage backend interface.
 	StorageBackend = storage.Backend[
 		*AvailabilityStore,
-		*BeaconBlock,
+		*SignedBeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
 		*BeaconState,
@@ -369,6 +435,7 @@ type (
 		*Eth1Data,
 		*ExecutionPayloadHeader,
 		*Fork,
+		*HistoricalSummary,
 		*KVStore,
 		*Validator,
 		Validators,
@@ -389,12 +456,14 @@ type (
 		*BeaconBlockBody,
 		*BeaconState,
 		*BlobSidecars,
+		*DataColumnSidecars,
 		*Deposit,
 		*DepositStore,
 		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*ForkData,
+		*SignedBeaconBlock,
 		*SlashingInfo,
 		*SlotData,
 	]
@@ -402,6 +471,12 @@ type (
 	// ValidatorUpdate is a type alias for the validator update.
 	ValidatorUpdate = appmodule.ValidatorUpdate
 
+	// VoteExtender is a type alias for the vote extender.
+	VoteExtender = cometbft.VoteExtender[*VoteExtension]
+
+	// VoteExtension is a type alias for the vote extension.
+	VoteExtension = types.VoteExtension
+
 	// Withdrawal is a type alias for the engineprimitives withdrawal.
 	Withdrawal = engineprimitives.Withdrawal
 
@@ -417,12 +492,18 @@ type (
 	// BlockEvent is a type alias for the block event.
 	BlockEvent = asynctypes.Event[*BeaconBlock]
 
+	// ColumnsEvent is a type alias for the data column sidecars event.
+	ColumnsEvent = asynctypes.Event[*DataColumnSidecars]
+
 	// GenesisEvent is a type alias for the genesis event.
 	GenesisEvent = asynctypes.Event[*Genesis]
 
 	// SidecarEvent is a type alias for the sidecar event.
 	SidecarEvent = asynctypes.Event[*BlobSidecars]
 
+	// SignedBlockEvent is a type alias for the signed block event.
+	SignedBlockEvent = asynctypes.Event[*SignedBeaconBlock]
+
 	// SlotEvent is a type alias for the slot event.
 	SlotEvent = asynctypes.Event[*SlotData]
 
@@ -444,9 +525,15 @@ type (
 	// SidecarsBroker is a type alias for the blob feed.
 	SidecarsBroker = broker.Broker[*SidecarEvent]
 
+	// ColumnsBroker is a type alias for the data column sidecars feed.
+	ColumnsBroker = broker.Broker[*ColumnsEvent]
+
 	// BlockBroker is a type alias for the block feed.
 	BlockBroker = broker.Broker[*BlockEvent]
 
+	// SignedBlockBroker is a type alias for the signed block feed.
+	SignedBlockBroker = broker.Broker[*SignedBlockEvent]
+
 	// SlotBroker is a type alias for the slot feed.
 	SlotBroker = broker.Broker[*SlotEvent]
 
@@ -479,7 +566,13 @@ type (
 type (
 	// BeaconAPIHandler is a type alias for the beacon handler.
 	BeaconAPIHandler = beaconapi.Handler[
-		*BeaconBlockHeader, NodeAPIContext, *Fork, *Validator,
+		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
+		NodeAPIContext,
+		*Fork,
+		*SignedBeaconBlock,
+		*Validator,
 	]
 
 	// BuilderAPIHandler is a type alias for the builder handler.
@@ -489,7 +582,9 @@ type (
 	ConfigAPIHandler = configapi.Handler[NodeAPIContext]
 
 	// DebugAPIHandler is a type alias for the debug handler.
-	DebugAPIHandler = debugapi.Handler[NodeAPIContext]
+	DebugAPIHandler = debugapi.Handler[
+		*BeaconState, *BeaconStateMarshallable, NodeAPIContext,
+	]
 
 	// EventsAPIHandler is a type alias for the events handler.
 	EventsAPIHandler = eventsapi.Handler[NodeAPIContext]
@@ -499,7 +594,11 @@ type (
 
 	// ProofAPIHandler is a type alias for the proof handler.
 	ProofAPIHandler = proofapi.Handler[
-		NodeAPIContext, *BeaconBlockHeader, *BeaconState,
-		*BeaconStateMarshallable, *ExecutionPayloadHeader, *Validator,
+		NodeAPIContext, *BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+		*BeaconState, *BeaconStateMarshallable, *ExecutionPayloadHeader,
+		*SignedBeaconBlock, *Validator,
 	]
+
+	// ValidatorAPIHandler is a type alias for the validator handler.
+	ValidatorAPIHandler = validatorapi.Handler[NodeAPIContext]
 )
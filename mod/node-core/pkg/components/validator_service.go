@@ -34,10 +34,12 @@ import (
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
-	BeaconBlockFeed *BlockBroker
+	BeaconBlockFeed *SignedBlockBroker
 	BlobProcessor   *BlobProcessor
 	Cfg             *config.Config
 	ChainSpec       common.ChainSpec
+	ColumnsFeed     *ColumnsBroker
+	ExecutionSync   *ExecutionSyncMonitor
 	LocalBuilder    *LocalBuilder
 	Logger          log.AdvancedLogger[any, sdklog.Logger]
 	StateProcessor  *StateProcessor
@@ -65,12 +67,14 @@ func ProvideValidatorService(
 		*BeaconBlockBody,
 		*BeaconState,
 		*BlobSidecars,
+		*DataColumnSidecars,
 		*Deposit,
 		*DepositStore,
 		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*ForkData,
+		*SignedBeaconBlock,
 		*SlashingInfo,
 		*SlotData,
 	](
@@ -85,9 +89,11 @@ func ProvideValidatorService(
 		[]validator.PayloadBuilder[*BeaconState, *ExecutionPayload]{
 			in.LocalBuilder,
 		},
+		in.ExecutionSync,
 		in.TelemetrySink,
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
+		in.ColumnsFeed,
 		slotSubscription,
 	), nil
 }
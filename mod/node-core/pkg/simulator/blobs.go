@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+)
+
+// fieldElementLength is the length of a field element of a blob.
+const fieldElementLength = 32
+
+// blobBundle holds the blobs of a payload along with their KZG commitments,
+// proofs and versioned hashes.
+type blobBundle struct {
+	blobs           []*eip4844.Blob
+	commitments     []eip4844.KZGCommitment
+	proofs          []eip4844.KZGProof
+	versionedHashes []common.ExecutionHash
+}
+
+// blobFactory builds deterministic blobs and their KZG commitments and
+// proofs. The KZG context is shared by the execution clients of a network
+// and only loaded from the trusted setup once blobs are first requested.
+type blobFactory struct {
+	trustedSetupPath string
+
+	once sync.Once
+	ctx  *goethkzg.Context
+	err  error
+}
+
+// newBlobFactory returns a blob factory for the given trusted setup.
+func newBlobFactory(trustedSetupPath string) *blobFactory {
+	return &blobFactory{trustedSetupPath: trustedSetupPath}
+}
+
+// context returns the KZG context, loading it on first use.
+func (f *blobFactory) context() (*goethkzg.Context, error) {
+	f.once.Do(func() {
+		var setup *goethkzg.JSONTrustedSetup
+		if setup, f.err = components.ReadTrustedSetup(
+			f.trustedSetupPath,
+		); f.err != nil {
+			return
+		}
+		f.ctx, f.err = goethkzg.NewContext4096(setup)
+	})
+	return f.ctx, f.err
+}
+
+// build returns count blobs derived from the given seed.
+func (f *blobFactory) build(
+	seed common.ExecutionHash,
+	count int,
+) (*blobBundle, error) {
+	bundle := &blobBundle{
+		blobs:           make([]*eip4844.Blob, 0, count),
+		commitments:     make([]eip4844.KZGCommitment, 0, count),
+		proofs:          make([]eip4844.KZGProof, 0, count),
+		versionedHashes: make([]common.ExecutionHash, 0, count),
+	}
+	if count == 0 {
+		return bundle, nil
+	}
+
+	ctx, err := f.context()
+	if err != nil {
+		return nil, err
+	}
+	for i := range count {
+		//#nosec:G701 // the number of blobs is small.
+		blob := newBlob(seed, uint32(i))
+		commitment, err := ctx.BlobToKZGCommitment(
+			(*goethkzg.Blob)(blob), 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := ctx.ComputeBlobKZGProof(
+			(*goethkzg.Blob)(blob), commitment, 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		bundle.blobs = append(bundle.blobs, blob)
+		bundle.commitments = append(
+			bundle.commitments, eip4844.KZGCommitment(commitment),
+		)
+		bundle.proofs = append(bundle.proofs, eip4844.KZGProof(proof))
+		bundle.versionedHashes = append(
+			bundle.versionedHashes,
+			common.ExecutionHash(
+				eip4844.KZGCommitment(commitment).ToVersionedHash(),
+			),
+		)
+	}
+	return bundle, nil
+}
+
+// newBlob fills a blob with the hash chain of the seed and the index. The
+// first byte of every field element is cleared so that each of them is below
+// the modulus of the BLS12-381 scalar field.
+func newBlob(seed common.ExecutionHash, index uint32) *eip4844.Blob {
+	var (
+		blob   = new(eip4844.Blob)
+		digest = seed
+	)
+	binary.BigEndian.PutUint32(digest[:4], index)
+	for i := 0; i < len(blob); i += fieldElementLength {
+		digest = sha256.Sum256(digest[:])
+		copy(blob[i+1:i+fieldElementLength], digest[1:])
+	}
+	return blob
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidNumNodes is returned when a network is created without any
+	// nodes.
+	ErrInvalidNumNodes = errors.New("network must have at least one node")
+	// ErrUnknownNode is returned when a node index is out of range.
+	ErrUnknownNode = errors.New("unknown node")
+	// ErrProposerOffline is returned when the proposer of a round is offline
+	// and the round times out.
+	ErrProposerOffline = errors.New("proposer is offline")
+	// ErrNoQuorum is returned when a proposal does not receive more than two
+	// thirds of the voting power.
+	ErrNoQuorum = errors.New("proposal did not reach +2/3 of voting power")
+	// ErrRoundsExhausted is returned when no proposal could be committed
+	// within the maximum number of rounds for a height.
+	ErrRoundsExhausted = errors.New("no proposal committed within max rounds")
+	// ErrEmptyProposal is returned when the proposer of a round fails to
+	// build a block and proposes an empty block instead.
+	ErrEmptyProposal = errors.New("proposer failed to build a block")
+	// ErrProposalRejected is returned when a node rejects a proposal in
+	// ProcessProposal.
+	ErrProposalRejected = errors.New("proposal rejected")
+	// ErrInvalidVoteExtension is returned when a node rejects the vote
+	// extension of another node.
+	ErrInvalidVoteExtension = errors.New("vote extension rejected")
+	// ErrDataNotAvailable is returned when a node has not received the blob
+	// sidecars of a proposal.
+	ErrDataNotAvailable = errors.New("blob sidecars not available")
+	// ErrUnknownPayload is returned by an execution client when asked for a
+	// payload it was not requested to build.
+	ErrUnknownPayload = errors.New("unknown payload")
+	// ErrExecutionClientBehind is returned when the execution client of a
+	// node does not follow the committed chain in time.
+	ErrExecutionClientBehind = errors.New("execution client is behind")
+	// ErrUnsupportedKeyType is returned when a validator update carries a
+	// public key that is not a BLS key.
+	ErrUnsupportedKeyType = errors.New("unsupported validator key type")
+	// ErrStateMismatch is returned when online nodes disagree on the beacon
+	// state.
+	ErrStateMismatch = errors.New("beacon state mismatch between nodes")
+)
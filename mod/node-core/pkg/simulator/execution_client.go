@@ -0,0 +1,708 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	gethrpc "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// gasPerBlob is the blob gas used by a single blob.
+	gasPerBlob = 1 << 17
+	// readHeaderTimeout is the timeout for reading the headers of a request
+	// to the execution client.
+	readHeaderTimeout = 5 * time.Second
+)
+
+type (
+	// payloadAttributes are the attributes of a payload to build, as sent in
+	// a forkchoice update.
+	payloadAttributes = components.PayloadAttributes
+
+	// payloadEnvelope is the envelope of a built payload.
+	payloadEnvelope = engineprimitives.ExecutionPayloadEnvelope[
+		*components.ExecutionPayload,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]
+)
+
+// executionBlock is a block imported by the execution client.
+type executionBlock struct {
+	payload *components.ExecutionPayload
+	header  *gethprimitives.Header
+}
+
+// payloadJob is a payload requested by a forkchoice update, along with the
+// envelopes built for it.
+type payloadJob struct {
+	parent *executionBlock
+	attrs  *payloadAttributes
+	// built holds the envelope built for each variant of the payload.
+	built map[byte]*payloadEnvelope
+}
+
+// ExecutionClient is an in-memory execution client, serving over JSON-RPC
+// the part of the Engine API and of the eth namespace a beacon node relies
+// on. Its payloads carry no transactions other than the blob transaction of
+// the configured number of blobs, hence do not change the execution state,
+// and their timestamps are derived from the genesis time and the block time
+// rather than from the wall clock so that the chain is deterministic.
+//
+// The authentication of the Engine API is not enforced.
+type ExecutionClient struct {
+	eth1ChainID uint64
+	blockTime   math.U64
+	blobs       *blobFactory
+
+	listener net.Listener
+	server   *http.Server
+
+	mu sync.Mutex
+	// blocks holds the imported blocks by hash.
+	blocks map[common.ExecutionHash]*executionBlock
+	// head is the hash of the head of the canonical chain.
+	head common.ExecutionHash
+	// jobs holds the requested payloads by ID.
+	jobs map[engineprimitives.PayloadID]*payloadJob
+	// invalid is the set of block hashes the client rejects.
+	invalid map[common.ExecutionHash]struct{}
+	// payloads is the ordered list of the hashes of the imported blocks.
+	payloads []common.ExecutionHash
+	// variant is written into the extra data of the built payloads, so that
+	// a proposer can build a different payload for the same attributes.
+	variant byte
+	// blobsPerBlock is the number of blobs of each built payload.
+	blobsPerBlock int
+}
+
+// newExecutionClient starts an execution client whose chain starts at the
+// given genesis payload, listening on a random local port.
+func newExecutionClient(
+	genesis *components.ExecutionPayload,
+	eth1ChainID uint64,
+	blockTime math.U64,
+	blobs *blobFactory,
+	blobsPerBlock int,
+) (*ExecutionClient, error) {
+	header, err := executionHeader(genesis, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ExecutionClient{
+		eth1ChainID: eth1ChainID,
+		blockTime:   blockTime,
+		blobs:       blobs,
+		blocks: map[common.ExecutionHash]*executionBlock{
+			genesis.GetBlockHash(): {payload: genesis, header: header},
+		},
+		head:          genesis.GetBlockHash(),
+		jobs:          make(map[engineprimitives.PayloadID]*payloadJob),
+		invalid:       make(map[common.ExecutionHash]struct{}),
+		blobsPerBlock: blobsPerBlock,
+	}
+
+	if blobsPerBlock > 0 {
+		// The KZG context is loaded ahead of the payload requests.
+		if _, err = blobs.context(); err != nil {
+			return nil, err
+		}
+	}
+
+	srv := gethrpc.NewServer()
+	if err = srv.RegisterName("eth", &ethAPI{c: c}); err != nil {
+		return nil, err
+	}
+	if err = srv.RegisterName("engine", &engineAPI{c: c}); err != nil {
+		return nil, err
+	}
+
+	if c.listener, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		return nil, err
+	}
+	c.server = &http.Server{
+		Handler:           srv,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if serveErr := c.server.Serve(
+			c.listener,
+		); !errors.Is(serveErr, http.ErrServerClosed) {
+			srv.Stop()
+		}
+	}()
+	return c, nil
+}
+
+// URL returns the URL the execution client is served at.
+func (c *ExecutionClient) URL() string {
+	return "http://" + c.listener.Addr().String()
+}
+
+// Close stops serving the execution client.
+func (c *ExecutionClient) Close() error {
+	return c.server.Close()
+}
+
+// MarkInvalid makes the client reject the payload with the given block hash.
+func (c *ExecutionClient) MarkInvalid(blockHash common.ExecutionHash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalid[blockHash] = struct{}{}
+}
+
+// Payloads returns the block hashes of the payloads imported by the client,
+// in the order they were received.
+func (c *ExecutionClient) Payloads() []common.ExecutionHash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]common.ExecutionHash(nil), c.payloads...)
+}
+
+// Head returns the block hash of the head of the canonical chain.
+func (c *ExecutionClient) Head() common.ExecutionHash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head
+}
+
+// setVariant sets the variant of the payloads built from now on, and builds
+// the variant of the payloads requested on top of the head.
+func (c *ExecutionClient) setVariant(variant byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.variant = variant
+	for _, job := range c.jobs {
+		if job.parent.payload.GetBlockHash() != c.head {
+			continue
+		}
+		if _, err := c.build(job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setBlobsPerBlock sets the number of blobs of the payloads built from now
+// on, discarding the payloads built so far.
+func (c *ExecutionClient) setBlobsPerBlock(count int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.blobsPerBlock = count
+	for _, job := range c.jobs {
+		clear(job.built)
+	}
+	if count == 0 {
+		return nil
+	}
+	// The KZG context is loaded ahead of the payload requests.
+	_, err := c.blobs.context()
+	return err
+}
+
+// newPayload imports the payload if its parent is known and its block hash
+// is correct.
+func (c *ExecutionClient) newPayload(
+	payload *components.ExecutionPayload,
+	parentBeaconRoot *common.ExecutionHash,
+) (*engineprimitives.PayloadStatusV1, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	blockHash := payload.GetBlockHash()
+	if _, ok := c.invalid[blockHash]; ok {
+		return invalidStatus(payload.GetParentHash(), "invalid payload"), nil
+	}
+	if _, ok := c.blocks[blockHash]; ok {
+		return validStatus(blockHash), nil
+	}
+	if _, ok := c.blocks[payload.GetParentHash()]; !ok {
+		return &engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusSyncing,
+		}, nil
+	}
+
+	header, err := executionHeader(payload, parentBeaconRoot)
+	if err != nil {
+		return invalidStatus(payload.GetParentHash(), err.Error()), nil
+	}
+	if common.ExecutionHash(header.Hash()) != blockHash {
+		return invalidStatus(
+			payload.GetParentHash(), "invalid block hash",
+		), nil
+	}
+
+	c.blocks[blockHash] = &executionBlock{payload: payload, header: header}
+	c.payloads = append(c.payloads, blockHash)
+	return validStatus(blockHash), nil
+}
+
+// forkchoiceUpdated sets the head of the canonical chain and, if attributes
+// are given, starts building a payload on top of it.
+func (c *ExecutionClient) forkchoiceUpdated(
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs *payloadAttributes,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	head, ok := c.blocks[state.HeadBlockHash]
+	if !ok {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{
+				Status: engineprimitives.PayloadStatusSyncing,
+			},
+		}, nil
+	}
+	c.head = state.HeadBlockHash
+
+	resp := &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: *validStatus(state.HeadBlockHash),
+	}
+	if attrs == nil {
+		return resp, nil
+	}
+
+	id := payloadID(state.HeadBlockHash, attrs)
+	job, ok := c.jobs[id]
+	if !ok {
+		job = &payloadJob{
+			parent: head,
+			attrs:  attrs,
+			built:  make(map[byte]*payloadEnvelope),
+		}
+		c.jobs[id] = job
+	}
+	// The payload is built right away, since the payload builder of the
+	// beacon node only waits for it for a short time.
+	if _, err := c.build(job); err != nil {
+		return nil, err
+	}
+	resp.PayloadID = &id
+	return resp, nil
+}
+
+// getPayload returns the payload built for the given ID.
+func (c *ExecutionClient) getPayload(
+	id engineprimitives.PayloadID,
+) (*payloadEnvelope, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	job, ok := c.jobs[id]
+	if !ok {
+		return nil, ErrUnknownPayload
+	}
+	return c.build(job)
+}
+
+// build returns the envelope of the job for the current variant, building it
+// if it was not yet. It must be called with the lock held.
+func (c *ExecutionClient) build(job *payloadJob) (*payloadEnvelope, error) {
+	if envelope, ok := job.built[c.variant]; ok {
+		return envelope, nil
+	}
+
+	parent := job.parent.payload
+	bundle, err := c.blobs.build(parent.GetBlockHash(), c.blobsPerBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &components.ExecutionPayload{
+		ParentHash:    parent.GetBlockHash(),
+		FeeRecipient:  job.attrs.SuggestedFeeRecipient,
+		StateRoot:     parent.GetStateRoot(),
+		ReceiptsRoot:  parent.GetReceiptsRoot(),
+		Random:        job.attrs.PrevRandao,
+		Number:        parent.GetNumber() + 1,
+		GasLimit:      parent.GetGasLimit(),
+		Timestamp:     parent.GetTimestamp() + c.blockTime,
+		ExtraData:     bytes.Bytes{},
+		BaseFeePerGas: parent.GetBaseFeePerGas(),
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   job.attrs.Withdrawals,
+		//#nosec:G701 // the number of blobs is small.
+		BlobGasUsed: math.U64(len(bundle.blobs) * gasPerBlob),
+	}
+	if payload.Withdrawals == nil {
+		payload.Withdrawals = make([]*engineprimitives.Withdrawal, 0)
+	}
+	if c.variant != 0 {
+		payload.ExtraData = bytes.Bytes{c.variant}
+	}
+	if len(bundle.blobs) > 0 {
+		var tx []byte
+		if tx, err = c.blobTransaction(bundle); err != nil {
+			return nil, err
+		}
+		payload.Transactions = append(payload.Transactions, tx)
+	}
+
+	parentBeaconRoot := common.ExecutionHash(job.attrs.ParentBeaconBlockRoot)
+	header, err := executionHeader(payload, &parentBeaconRoot)
+	if err != nil {
+		return nil, err
+	}
+	payload.BlockHash = common.ExecutionHash(header.Hash())
+
+	envelope := &payloadEnvelope{
+		ExecutionPayload: payload,
+		BlockValue:       math.NewU256(0),
+		BlobsBundle: &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{
+			Commitments: bundle.commitments,
+			Proofs:      bundle.proofs,
+			Blobs:       bundle.blobs,
+		},
+		ExecutionRequests: make([]bytes.Bytes, 0),
+	}
+	job.built[c.variant] = envelope
+	return envelope, nil
+}
+
+// blobTransaction returns the encoded transaction carrying the blobs of the
+// bundle. It is left unsigned since the client does not execute it.
+func (c *ExecutionClient) blobTransaction(
+	bundle *blobBundle,
+) ([]byte, error) {
+	hashes := make([]gethprimitives.ExecutionHash, len(bundle.versionedHashes))
+	for i, hash := range bundle.versionedHashes {
+		hashes[i] = gethprimitives.ExecutionHash(hash)
+	}
+	return gethprimitives.NewTx(&gethprimitives.BlobTx{
+		ChainID:    math.NewU256(c.eth1ChainID),
+		GasTipCap:  math.NewU256(0),
+		GasFeeCap:  math.NewU256(0),
+		Value:      math.NewU256(0),
+		BlobFeeCap: math.NewU256(0),
+		BlobHashes: hashes,
+		V:          math.NewU256(0),
+		R:          math.NewU256(0),
+		S:          math.NewU256(0),
+	}).MarshalBinary()
+}
+
+// blockByNumber returns the canonical block with the given number, the head
+// if the number is negative.
+func (c *ExecutionClient) blockByNumber(
+	number gethrpc.BlockNumber,
+) *executionBlock {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	blk := c.blocks[c.head]
+	if number < 0 {
+		return blk
+	}
+	for blk != nil && blk.payload.GetNumber() > math.U64(number) {
+		blk = c.blocks[blk.payload.GetParentHash()]
+	}
+	if blk == nil || blk.payload.GetNumber() != math.U64(number) {
+		return nil
+	}
+	return blk
+}
+
+// blockByHash returns the block with the given hash, nil if unknown.
+func (c *ExecutionClient) blockByHash(
+	hash common.ExecutionHash,
+) *executionBlock {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blocks[hash]
+}
+
+// executionHeader returns the header of the execution block of the payload,
+// whose hash is the block hash of the payload.
+func executionHeader(
+	payload *components.ExecutionPayload,
+	parentBeaconRoot *common.ExecutionHash,
+) (*gethprimitives.Header, error) {
+	txs := make(gethprimitives.Transactions, len(payload.GetTransactions()))
+	for i, bz := range payload.GetTransactions() {
+		txs[i] = new(gethprimitives.Transaction)
+		if err := txs[i].UnmarshalBinary(bz); err != nil {
+			return nil, err
+		}
+	}
+
+	withdrawalsHash := gethprimitives.DeriveSha(
+		payload.GetWithdrawals(), gethprimitives.NewStackTrie(nil),
+	)
+	return &gethprimitives.Header{
+		ParentHash: gethprimitives.ExecutionHash(payload.GetParentHash()),
+		UncleHash:  gethprimitives.EmptyUncleHash,
+		Coinbase: gethprimitives.ExecutionAddress(
+			payload.GetFeeRecipient(),
+		),
+		Root: gethprimitives.ExecutionHash(payload.GetStateRoot()),
+		TxHash: gethprimitives.DeriveSha(
+			txs, gethprimitives.NewStackTrie(nil),
+		),
+		ReceiptHash: gethprimitives.ExecutionHash(payload.GetReceiptsRoot()),
+		Bloom:       gethprimitives.LogsBloom(payload.GetLogsBloom()),
+		Difficulty:  big.NewInt(0),
+		Number: new(big.Int).SetUint64(
+			payload.GetNumber().Unwrap(),
+		),
+		GasLimit:         payload.GetGasLimit().Unwrap(),
+		GasUsed:          payload.GetGasUsed().Unwrap(),
+		Time:             payload.GetTimestamp().Unwrap(),
+		BaseFee:          payload.GetBaseFeePerGas().ToBig(),
+		Extra:            payload.GetExtraData(),
+		MixDigest:        gethprimitives.ExecutionHash(payload.GetPrevRandao()),
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    payload.GetExcessBlobGas().UnwrapPtr(),
+		BlobGasUsed:      payload.GetBlobGasUsed().UnwrapPtr(),
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(parentBeaconRoot),
+	}, nil
+}
+
+// payloadID derives the ID of the payload built on top of the given head
+// with the given attributes. The timestamp of the attributes is left out,
+// since the client derives the timestamps of its payloads.
+func payloadID(
+	head common.ExecutionHash,
+	attrs *payloadAttributes,
+) engineprimitives.PayloadID {
+	withdrawalsRoot := engineprimitives.Withdrawals(
+		attrs.Withdrawals,
+	).HashTreeRoot()
+
+	h := sha256.New()
+	h.Write(head[:])
+	h.Write(attrs.PrevRandao[:])
+	h.Write(attrs.SuggestedFeeRecipient[:])
+	h.Write(attrs.ParentBeaconBlockRoot[:])
+	h.Write(withdrawalsRoot[:])
+
+	var id engineprimitives.PayloadID
+	copy(id[:], h.Sum(nil))
+	return id
+}
+
+// validStatus returns the status of a valid payload.
+func validStatus(
+	blockHash common.ExecutionHash,
+) *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &blockHash,
+	}
+}
+
+// invalidStatus returns the status of an invalid payload.
+func invalidStatus(
+	latestValidHash common.ExecutionHash,
+	reason string,
+) *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusInvalid,
+		LatestValidHash: &latestValidHash,
+		ValidationError: &reason,
+	}
+}
+
+// ethAPI serves the eth namespace of the execution client.
+type ethAPI struct {
+	c *ExecutionClient
+}
+
+// ChainId returns the chain ID of the execution chain.
+//
+//nolint:revive,stylecheck // the name is the method of the eth namespace.
+func (api *ethAPI) ChainId() math.U64 {
+	return math.U64(api.c.eth1ChainID)
+}
+
+// BlockNumber returns the number of the head of the canonical chain.
+func (api *ethAPI) BlockNumber() math.U64 {
+	return api.c.blockByNumber(-1).payload.GetNumber()
+}
+
+// Syncing reports that the client is never syncing.
+func (api *ethAPI) Syncing() bool {
+	return false
+}
+
+// GetBlockByNumber returns the header of the canonical block with the given
+// number.
+func (api *ethAPI) GetBlockByNumber(
+	number gethrpc.BlockNumber,
+	_ bool,
+) *gethprimitives.Header {
+	if blk := api.c.blockByNumber(number); blk != nil {
+		return blk.header
+	}
+	return nil
+}
+
+// GetBlockByHash returns the header of the block with the given hash.
+func (api *ethAPI) GetBlockByHash(
+	hash common.ExecutionHash,
+	_ bool,
+) *gethprimitives.Header {
+	if blk := api.c.blockByHash(hash); blk != nil {
+		return blk.header
+	}
+	return nil
+}
+
+// GetBlockReceipts returns the receipts of a block, of which there are none
+// since no transaction is executed.
+func (api *ethAPI) GetBlockReceipts(
+	common.ExecutionHash,
+) []*gethprimitives.Receipt {
+	return make([]*gethprimitives.Receipt, 0)
+}
+
+// GetLogs returns the logs matching a filter, of which there are none since
+// no transaction is executed.
+func (api *ethAPI) GetLogs(map[string]any) []gethprimitives.Log {
+	return make([]gethprimitives.Log, 0)
+}
+
+// engineAPI serves the engine namespace of the execution client.
+type engineAPI struct {
+	c *ExecutionClient
+}
+
+// ExchangeCapabilities returns the capabilities of the consensus client.
+func (api *engineAPI) ExchangeCapabilities(capabilities []string) []string {
+	return capabilities
+}
+
+// GetClientVersionV1 returns the version of the client.
+func (api *engineAPI) GetClientVersionV1(
+	*engineprimitives.ClientVersionV1,
+) []engineprimitives.ClientVersionV1 {
+	return []engineprimitives.ClientVersionV1{{
+		Code: "SM",
+		Name: "simulator",
+	}}
+}
+
+// NewPayloadV3 imports the payload.
+func (api *engineAPI) NewPayloadV3(
+	payload *components.ExecutionPayload,
+	_ []common.ExecutionHash,
+	parentBeaconRoot *common.ExecutionHash,
+) (*engineprimitives.PayloadStatusV1, error) {
+	return api.c.newPayload(payload, parentBeaconRoot)
+}
+
+// NewPayloadV4 imports the payload, ignoring its execution requests.
+func (api *engineAPI) NewPayloadV4(
+	payload *components.ExecutionPayload,
+	_ []common.ExecutionHash,
+	parentBeaconRoot *common.ExecutionHash,
+	_ []bytes.Bytes,
+) (*engineprimitives.PayloadStatusV1, error) {
+	return api.c.newPayload(payload, parentBeaconRoot)
+}
+
+// ForkchoiceUpdatedV3 updates the forkchoice and requests a payload.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	_ context.Context,
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs *payloadAttributes,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	return api.c.forkchoiceUpdated(state, attrs)
+}
+
+// GetPayloadV3 returns the payload built for the given ID.
+func (api *engineAPI) GetPayloadV3(
+	id engineprimitives.PayloadID,
+) (*payloadEnvelope, error) {
+	return api.c.getPayload(id)
+}
+
+// GetPayloadV4 returns the payload built for the given ID.
+func (api *engineAPI) GetPayloadV4(
+	id engineprimitives.PayloadID,
+) (*payloadEnvelope, error) {
+	return api.c.getPayload(id)
+}
+
+// GetPayloadBodiesByHashV1 returns the bodies of the payloads with the given
+// block hashes, nil for the unknown ones.
+func (api *engineAPI) GetPayloadBodiesByHashV1(
+	hashes []common.ExecutionHash,
+) []*engineprimitives.ExecutionPayloadBodyV1 {
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, len(hashes))
+	for i, hash := range hashes {
+		if blk := api.c.blockByHash(hash); blk != nil {
+			bodies[i] = payloadBody(blk.payload)
+		}
+	}
+	return bodies
+}
+
+// GetPayloadBodiesByRangeV1 returns the bodies of the canonical payloads in
+// the given range, truncated at the head.
+func (api *engineAPI) GetPayloadBodiesByRangeV1(
+	start, count math.U64,
+) []*engineprimitives.ExecutionPayloadBodyV1 {
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, 0, count)
+	for number := start; number < start+count; number++ {
+		//#nosec:G701 // the chain is short.
+		blk := api.c.blockByNumber(gethrpc.BlockNumber(number))
+		if blk == nil {
+			break
+		}
+		bodies = append(bodies, payloadBody(blk.payload))
+	}
+	return bodies
+}
+
+// payloadBody returns the body of the payload.
+func payloadBody(
+	payload *components.ExecutionPayload,
+) *engineprimitives.ExecutionPayloadBodyV1 {
+	txs := make([]bytes.Bytes, len(payload.GetTransactions()))
+	for i, tx := range payload.GetTransactions() {
+		txs[i] = tx
+	}
+	return &engineprimitives.ExecutionPayloadBodyV1{
+		Transactions: txs,
+		Withdrawals:  payload.GetWithdrawals(),
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+
+// Faults describes the misbehaviour injected into the first round of a
+// height. Later rounds are always proposed honestly, which lets tests assert
+// that the network recovers from the fault. Offline proposers are simulated
+// with Network.SetOnline instead.
+type Faults struct {
+	// InvalidPayload makes the execution clients of all nodes reject the
+	// execution payload of the proposal.
+	InvalidPayload bool
+	// WithholdBlobs lists the nodes that never receive the blob sidecars of
+	// the proposal, which then commits to at least one blob. Since the
+	// sidecars travel along with the block in the proposal, this models
+	// their unavailability at the network layer: the listed nodes do not
+	// process the proposal and vote against it.
+	WithholdBlobs []int
+	// Equivocate makes the proposer sign two conflicting blocks, sending one
+	// to the first half of the nodes and the other to the rest.
+	Equivocate bool
+}
+
+// Round is the outcome of a single consensus round.
+type Round struct {
+	// Number is the round number within the height.
+	Number int
+	// Proposer is the index of the node that proposed in this round.
+	Proposer int
+	// Rejections maps the index of each node that voted against the proposal
+	// it received to the reason it was rejected.
+	Rejections map[int]error
+	// Equivocation is true if the proposer sent conflicting blocks.
+	Equivocation bool
+	// Err is the reason the round did not commit, nil if it did.
+	Err error
+}
+
+// Commit is the outcome of a height that was committed by the network.
+type Commit struct {
+	// Block is the committed beacon block.
+	Block *components.BeaconBlock
+	// Proposer is the index of the node whose proposal was committed.
+	Proposer int
+	// Rounds holds every round attempted at this height, the last of which
+	// committed the block.
+	Rounds []Round
+}
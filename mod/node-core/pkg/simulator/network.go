@@ -0,0 +1,813 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package simulator is a deterministic, in-process network of beacon nodes
+// for integration tests. Each node runs the beacon-kit ABCI application and
+// the node-core services, wired as in beacond, on an in-memory database and
+// against its own in-memory execution client. The network stands in for
+// CometBFT: it drives the ABCI methods of the nodes in lock-step, picks the
+// proposers by the weighted round robin of CometBFT, tallies the votes by
+// voting power and carries the signed vote extensions into the next height.
+//
+// The simulator leaves out the CometBFT reactors, hence the gossip of blocks
+// and votes, timeouts and the handling of evidence, which are covered by the
+// e2e tests. Faults are injected at the boundaries the network controls:
+// offline nodes, payloads rejected by the execution clients, blob sidecars
+// withheld from some nodes and proposers equivocating.
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	byteslib "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+const (
+	// defaultChainID is the CometBFT chain ID of the network.
+	defaultChainID = "beacon-kit-simulator"
+	// maxSigningMsgLen is the maximum length of a message CometBFT validators
+	// sign as is. Longer messages are signed by their SHA-256 digest.
+	maxSigningMsgLen = 32
+	// headTimeout is the time the execution client of a node is given to
+	// follow a committed block.
+	headTimeout = 10 * time.Second
+	// headPollInterval is the interval at which the head of an execution
+	// client is polled while waiting for it to follow a committed block.
+	headPollInterval = 5 * time.Millisecond
+)
+
+// defaultSeed is the seed the validator keys are derived from when none is
+// configured.
+var defaultSeed = []byte("beacon-kit in-process network simulator")
+
+// Network is a deterministic, in-process network of beacon nodes. At every
+// height the proposer of the round prepares a proposal, every online node
+// processes it, and once more than two thirds of the voting power accepted
+// it, the online nodes extend their votes and finalize and commit the block.
+type Network struct {
+	cs               common.ChainSpec
+	chainID          string
+	seed             []byte
+	genesisTime      math.U64
+	blockTime        math.U64
+	maxRounds        int
+	blobsPerBlock    int
+	trustedSetupPath string
+	depositAmounts   []math.Gwei
+	logOutput        io.Writer
+
+	blobs *blobFactory
+	// verifier is the blob proof verifier shared by the nodes.
+	verifier kzg.BlobProofVerifier
+	nodes    []*Node
+	// byAddress maps the CometBFT address of each validator to its node.
+	byAddress map[string]*Node
+	vals      *validatorSets
+
+	// height is the height of the last committed block.
+	height int64
+	// lastCommit holds the extended votes for the last committed block.
+	lastCommit abci.ExtendedCommitInfo
+	// requests holds the finalized blocks, indexed by height - 1, for the
+	// nodes to replay when they come back online.
+	requests []*abci.FinalizeBlockRequest
+	// blocks is the committed chain, indexed by slot - 1.
+	blocks []*components.BeaconBlock
+	// faults holds the faults scheduled for each slot.
+	faults map[math.Slot]Faults
+}
+
+// proposal is the proposal of a round, as delivered to each node.
+type proposal struct {
+	proposer []byte
+	// txs holds the transactions of the proposal delivered to each node.
+	txs [][][]byte
+	// voters holds the nodes that voted for the proposal with the given
+	// hash.
+	voters map[string][]*Node
+}
+
+// NewNetwork creates a network of numNodes validators, each running its own
+// node, and initializes the chain on all of them from the same genesis.
+func NewNetwork(numNodes int, opts ...Option) (*Network, error) {
+	if numNodes < 1 {
+		return nil, ErrInvalidNumNodes
+	}
+
+	n := &Network{
+		cs:               spec.DevnetChainSpec(),
+		chainID:          defaultChainID,
+		seed:             defaultSeed,
+		blockTime:        2, //nolint:mnd // default block time.
+		maxRounds:        numNodes,
+		trustedSetupPath: kzg.DefaultConfig().TrustedSetupPath,
+		logOutput:        io.Discard,
+		byAddress:        make(map[string]*Node, numNodes),
+		faults:           make(map[math.Slot]Faults),
+	}
+	for _, opt := range opts {
+		if err := opt(n); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if n.trustedSetupPath, err = filepath.Abs(n.trustedSetupPath); err != nil {
+		return nil, err
+	}
+	n.blobs = newBlobFactory(n.trustedSetupPath)
+
+	if err = n.start(numNodes); err != nil {
+		n.Close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// start starts the nodes and initializes the chain on all of them.
+func (n *Network) start(numNodes int) error {
+	setup, err := components.ReadTrustedSetup(n.trustedSetupPath)
+	if err != nil {
+		return err
+	}
+	if n.verifier, err = kzg.NewBlobProofVerifier(
+		kzg.DefaultConfig().Implementation, setup,
+	); err != nil {
+		return err
+	}
+
+	payload, err := genesisPayload(n.genesisTime)
+	if err != nil {
+		return err
+	}
+	header, err := payload.ToHeader(0, n.cs.DepositEth1ChainID())
+	if err != nil {
+		return err
+	}
+	genesis := types.DefaultGenesisDeneb()
+	genesis.ExecutionPayloadHeader = header
+
+	for i := range numNodes {
+		//#nosec:G701 // the number of nodes is small.
+		key, err := signer.LegacyKeyFromSeed(
+			n.seed, signer.ValidatorSigningKeyPath(uint32(i)),
+		)
+		if err != nil {
+			return err
+		}
+
+		node, err := newNode(n, i, key, payload)
+		if err != nil {
+			return errors.Wrapf(err, "node %d", i)
+		}
+		n.nodes = append(n.nodes, node)
+		n.byAddress[string(node.cometAddress())] = node
+
+		deposit, err := n.genesisDeposit(node)
+		if err != nil {
+			return err
+		}
+		genesis.Deposits = append(genesis.Deposits, deposit)
+	}
+	return n.initChain(genesis)
+}
+
+// initChain initializes the chain on every node from the given genesis.
+func (n *Network) initChain(genesis *components.Genesis) error {
+	genesisBz, err := json.Marshal(genesis)
+	if err != nil {
+		return err
+	}
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		beacon.ModuleName: genesisBz,
+	})
+	if err != nil {
+		return err
+	}
+
+	var validators []abci.ValidatorUpdate
+	for _, node := range n.nodes {
+		resp, err := node.app.InitChain(&abci.InitChainRequest{
+			Time:          n.Time(0),
+			ChainId:       n.chainID,
+			InitialHeight: 1,
+			AppStateBytes: appState,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "node %d genesis", node.index)
+		}
+		validators = resp.Validators
+	}
+	n.vals, err = newValidatorSets(validators)
+	return err
+}
+
+// Nodes returns all nodes of the network.
+func (n *Network) Nodes() []*Node {
+	return n.nodes
+}
+
+// Node returns the node at the given index.
+func (n *Network) Node(index int) (*Node, error) {
+	if index < 0 || index >= len(n.nodes) {
+		return nil, errors.Wrapf(ErrUnknownNode, "index %d", index)
+	}
+	return n.nodes[index], nil
+}
+
+// Blocks returns the committed chain, the block at slot s at index s - 1.
+func (n *Network) Blocks() []*components.BeaconBlock {
+	return n.blocks
+}
+
+// Time returns the time of the block at the given height.
+func (n *Network) Time(height int64) time.Time {
+	//#nosec:G701 // the times of the simulated chain fit in an int64.
+	return time.Unix(
+		int64(n.genesisTime)+height*int64(n.blockTime), 0,
+	).UTC()
+}
+
+// Proposer returns the index of the node proposing in the given round of the
+// next height.
+func (n *Network) Proposer(round int) int {
+	return n.byAddress[string(n.vals.proposer(round))].index
+}
+
+// InjectFaults schedules faults for the first round of the given slot.
+func (n *Network) InjectFaults(slot math.Slot, faults Faults) {
+	n.faults[slot] = faults
+}
+
+// SetOnline takes a node offline or brings it back online. An offline node
+// neither proposes nor votes and does not receive blocks; when it comes
+// back online it finalizes every block committed in the meantime.
+func (n *Network) SetOnline(
+	ctx context.Context,
+	index int,
+	online bool,
+) error {
+	node, err := n.Node(index)
+	if err != nil {
+		return err
+	}
+	if online && !node.online {
+		if err = n.catchUp(ctx, node); err != nil {
+			return err
+		}
+	}
+	node.online = online
+	return nil
+}
+
+// ProduceBlocks produces count consecutive blocks, stopping at the first
+// height that cannot be committed.
+func (n *Network) ProduceBlocks(
+	ctx context.Context,
+	count int,
+) ([]*Commit, error) {
+	commits := make([]*Commit, 0, count)
+	for range count {
+		commit, err := n.ProduceBlock(ctx)
+		if err != nil {
+			return commits, err
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// ProduceBlock runs consensus rounds for the next height until a block is
+// committed or the maximum number of rounds is reached.
+func (n *Network) ProduceBlock(ctx context.Context) (*Commit, error) {
+	height := n.height + 1
+	commit := &Commit{}
+	for r := range n.maxRounds {
+		round, prop, hash := n.runRound(ctx, height, r)
+		commit.Rounds = append(commit.Rounds, round)
+		if round.Err != nil {
+			continue
+		}
+
+		blk, err := n.commit(ctx, height, r, prop, hash)
+		if err != nil {
+			return commit, err
+		}
+		commit.Block = blk
+		commit.Proposer = round.Proposer
+		return commit, nil
+	}
+	return commit, errors.Wrapf(ErrRoundsExhausted, "height %d", height)
+}
+
+// AssertConsistent returns an error if the online nodes do not agree on the
+// app hash or the beacon state root.
+func (n *Network) AssertConsistent() error {
+	var reference *Node
+	for _, node := range n.nodes {
+		if !node.online {
+			continue
+		}
+		if reference == nil {
+			reference = node
+			continue
+		}
+		if !bytes.Equal(node.AppHash(), reference.AppHash()) {
+			return errors.Wrapf(
+				ErrStateMismatch, "app hash of node %d differs from node %d",
+				node.index, reference.index,
+			)
+		}
+		if n.height == 0 {
+			continue
+		}
+
+		root, err := node.StateRoot()
+		if err != nil {
+			return err
+		}
+		referenceRoot, err := reference.StateRoot()
+		if err != nil {
+			return err
+		}
+		if root != referenceRoot {
+			return errors.Wrapf(
+				ErrStateMismatch, "state root of node %d differs from node %d",
+				node.index, reference.index,
+			)
+		}
+	}
+	return nil
+}
+
+// Close stops all nodes of the network.
+func (n *Network) Close() {
+	for _, node := range n.nodes {
+		node.Close()
+	}
+}
+
+// runRound runs a single consensus round, returning the proposal and the
+// hash of the block that reached quorum, if any.
+func (n *Network) runRound(
+	ctx context.Context,
+	height int64,
+	number int,
+) (Round, *proposal, string) {
+	proposer := n.byAddress[string(n.vals.proposer(number))]
+	round := Round{
+		Number:     number,
+		Proposer:   proposer.index,
+		Rejections: make(map[int]error),
+	}
+	if !proposer.online {
+		round.Err = ErrProposerOffline
+		return round, nil, ""
+	}
+
+	var faults Faults
+	if number == 0 {
+		faults = n.faults[math.Slot(height)]
+	}
+
+	prop, err := n.propose(proposer, height, faults, &round)
+	if err != nil {
+		round.Err = err
+		return round, nil, ""
+	}
+
+	withheld := make(map[int]bool, len(faults.WithholdBlobs))
+	for _, index := range faults.WithholdBlobs {
+		withheld[index] = true
+	}
+
+	// Deliver the proposal and tally the votes for each block proposed.
+	votes := make(map[string]int64)
+	for _, node := range n.nodes {
+		if !node.online {
+			continue
+		}
+		if withheld[node.index] {
+			round.Rejections[node.index] = ErrDataNotAvailable
+			continue
+		}
+
+		txs := prop.txs[node.index]
+		resp, err := node.app.ProcessProposal(&abci.ProcessProposalRequest{
+			Txs:                txs,
+			ProposedLastCommit: n.lastCommitInfo(),
+			Hash:               proposalHash(txs),
+			Height:             height,
+			Time:               n.Time(height),
+			ProposerAddress:    prop.proposer,
+		})
+		if err == nil &&
+			resp.Status != abci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+			err = ErrProposalRejected
+		}
+		if err != nil {
+			round.Rejections[node.index] = err
+			continue
+		}
+
+		hash := string(proposalHash(txs))
+		prop.voters[hash] = append(prop.voters[hash], node)
+		_, val := n.vals.current.GetByAddress(node.cometAddress())
+		votes[hash] += val.VotingPower
+	}
+
+	total := n.vals.current.TotalVotingPower()
+	for hash, power := range votes {
+		if power*3 > total*2 {
+			return round, prop, hash
+		}
+	}
+	round.Err = ErrNoQuorum
+	return round, nil, ""
+}
+
+// propose prepares the proposal of the round, injecting the given faults.
+func (n *Network) propose(
+	proposer *Node,
+	height int64,
+	faults Faults,
+	round *Round,
+) (*proposal, error) {
+	el := proposer.el
+	if len(faults.WithholdBlobs) > 0 && n.blobsPerBlock == 0 {
+		if err := el.setBlobsPerBlock(1); err != nil {
+			return nil, err
+		}
+		//nolint:errcheck // no blobs to load the KZG context for.
+		defer el.setBlobsPerBlock(0)
+	}
+
+	txs, err := n.prepareProposal(proposer, height)
+	if err != nil {
+		return nil, err
+	}
+
+	prop := &proposal{
+		proposer: proposer.cometAddress(),
+		txs:      make([][][]byte, len(n.nodes)),
+		voters:   make(map[string][]*Node),
+	}
+	for i := range prop.txs {
+		prop.txs[i] = txs
+	}
+
+	if faults.InvalidPayload {
+		blk, err := n.decodeBlock(height, txs)
+		if err != nil {
+			return nil, err
+		}
+		blockHash := blk.GetBody().GetExecutionPayload().GetBlockHash()
+		for _, node := range n.nodes {
+			node.el.MarkInvalid(blockHash)
+		}
+	}
+
+	if faults.Equivocate {
+		// The proposer builds a conflicting payload for the same slot, hence
+		// signs a conflicting block.
+		if err = el.setVariant(1); err != nil {
+			return nil, err
+		}
+		conflicting, err := n.prepareProposal(proposer, height)
+		if err != nil {
+			return nil, err
+		}
+		if err = el.setVariant(0); err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(conflicting[0], txs[0]) {
+			round.Equivocation = true
+			for i := len(n.nodes) / 2; i < len(n.nodes); i++ {
+				prop.txs[i] = conflicting
+			}
+		}
+	}
+	return prop, nil
+}
+
+// prepareProposal requests a proposal for the given height from the node.
+func (n *Network) prepareProposal(
+	proposer *Node,
+	height int64,
+) ([][]byte, error) {
+	resp, err := proposer.app.PrepareProposal(&abci.PrepareProposalRequest{
+		LocalLastCommit: n.lastCommit,
+		Height:          height,
+		Time:            n.Time(height),
+		ProposerAddress: proposer.cometAddress(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The application proposes no transactions when it fails to build a
+	// block.
+	if len(resp.Txs) == 0 {
+		return nil, ErrEmptyProposal
+	}
+	return resp.Txs, nil
+}
+
+// commit extends the votes for the block with the given hash, which reached
+// quorum in the given round, and finalizes and commits it on every online
+// node.
+func (n *Network) commit(
+	ctx context.Context,
+	height int64,
+	round int,
+	prop *proposal,
+	hash string,
+) (*components.BeaconBlock, error) {
+	voters := prop.voters[hash]
+	txs := prop.txs[voters[0].index]
+	blk, err := n.decodeBlock(height, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // the number of rounds is small.
+	extCommit := abci.ExtendedCommitInfo{Round: int32(round)}
+	for _, val := range n.vals.current.Validators {
+		node := n.byAddress[string(val.Address)]
+		vote := abci.ExtendedVoteInfo{
+			Validator: abci.Validator{
+				Address: val.Address,
+				Power:   val.VotingPower,
+			},
+			BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+		}
+		switch {
+		case !node.online:
+		case !containsNode(voters, node):
+			vote.BlockIdFlag = cmtproto.BlockIDFlagNil
+		default:
+			vote.BlockIdFlag = cmtproto.BlockIDFlagCommit
+			if vote.VoteExtension, vote.ExtensionSignature, err = n.extendVote(
+				ctx, node, height, round, prop, txs,
+			); err != nil {
+				return nil, err
+			}
+		}
+		extCommit.Votes = append(extCommit.Votes, vote)
+	}
+
+	req := &abci.FinalizeBlockRequest{
+		Txs:               txs,
+		DecidedLastCommit: n.lastCommitInfo(),
+		Hash:              []byte(hash),
+		Height:            height,
+		Time:              n.Time(height),
+		ProposerAddress:   prop.proposer,
+	}
+	var updates []abci.ValidatorUpdate
+	for _, node := range n.nodes {
+		if !node.online {
+			continue
+		}
+		resp, err := node.finalizeBlock(ctx, req, blk)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err, "node %d failed to finalize block", node.index,
+			)
+		}
+		updates = resp.ValidatorUpdates
+	}
+	if err = n.vals.commit(updates); err != nil {
+		return nil, err
+	}
+
+	n.height = height
+	n.lastCommit = extCommit
+	n.requests = append(n.requests, req)
+	n.blocks = append(n.blocks, blk)
+	return blk, nil
+}
+
+// extendVote extends the vote of the node for the proposal, signs the vote
+// extension and has the other online nodes verify it.
+func (n *Network) extendVote(
+	ctx context.Context,
+	node *Node,
+	height int64,
+	round int,
+	prop *proposal,
+	txs [][]byte,
+) ([]byte, []byte, error) {
+	hash := proposalHash(txs)
+	resp, err := node.app.ExtendVote(ctx, &abci.ExtendVoteRequest{
+		Hash:               hash,
+		Height:             height,
+		Time:               n.Time(height),
+		Txs:                txs,
+		ProposedLastCommit: n.lastCommitInfo(),
+		ProposerAddress:    prop.proposer,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signBytes := cmttypes.VoteExtensionSignBytes(n.chainID, &cmtproto.Vote{
+		Extension: resp.VoteExtension,
+		Height:    height,
+		//#nosec:G701 // the number of rounds is small.
+		Round: int32(round),
+	})
+	if len(signBytes) > maxSigningMsgLen {
+		digest := sha256.Sum256(signBytes)
+		signBytes = digest[:]
+	}
+	signature, err := node.signer.Sign(signBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, verifier := range n.nodes {
+		if !verifier.online || verifier == node {
+			continue
+		}
+		verifyResp, err := verifier.app.VerifyVoteExtension(
+			&abci.VerifyVoteExtensionRequest{
+				Hash:             hash,
+				ValidatorAddress: node.cometAddress(),
+				Height:           height,
+				VoteExtension:    resp.VoteExtension,
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if verifyResp.Status !=
+			abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT {
+			return nil, nil, errors.Wrapf(
+				ErrInvalidVoteExtension,
+				"node %d rejected the vote extension of node %d",
+				verifier.index, node.index,
+			)
+		}
+	}
+	return resp.VoteExtension, signature[:], nil
+}
+
+// catchUp finalizes on the node the blocks it missed while it was offline.
+func (n *Network) catchUp(ctx context.Context, node *Node) error {
+	for _, req := range n.requests[node.height:] {
+		blk, err := n.decodeBlock(req.Height, req.Txs)
+		if err != nil {
+			return err
+		}
+		if _, err = node.finalizeBlock(ctx, req, blk); err != nil {
+			return errors.Wrapf(
+				err, "node %d failed to replay block", node.index,
+			)
+		}
+	}
+	return nil
+}
+
+// lastCommitInfo returns the votes for the last committed block.
+func (n *Network) lastCommitInfo() abci.CommitInfo {
+	info := abci.CommitInfo{
+		Round: n.lastCommit.Round,
+		Votes: make([]abci.VoteInfo, len(n.lastCommit.Votes)),
+	}
+	for i, vote := range n.lastCommit.Votes {
+		info.Votes[i] = abci.VoteInfo{
+			Validator:   vote.Validator,
+			BlockIdFlag: vote.BlockIdFlag,
+		}
+	}
+	return info
+}
+
+// decodeBlock decodes the beacon block of the proposal at the given height.
+func (n *Network) decodeBlock(
+	height int64,
+	txs [][]byte,
+) (*components.BeaconBlock, error) {
+	var signed *components.SignedBeaconBlock
+	//#nosec:G701 // the height is positive.
+	signed, err := signed.NewFromSSZ(
+		txs[0], n.cs.ActiveForkVersionForSlot(math.Slot(height)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return signed.GetMessage(), nil
+}
+
+// genesisDeposit creates the signed genesis deposit of the node's validator.
+func (n *Network) genesisDeposit(node *Node) (*components.Deposit, error) {
+	amount := math.Gwei(n.cs.MaxEffectiveBalance())
+	if node.index < len(n.depositAmounts) {
+		amount = n.depositAmounts[node.index]
+	}
+
+	genesisVersion := version.FromUint32[common.Version](version.Deneb)
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(genesisVersion, common.Root{}),
+		n.cs.DomainTypeDeposit(),
+		node.signer,
+		types.NewCredentialsFromExecutionAddress(node.address),
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Deposit{
+		Pubkey:      depositMsg.Pubkey,
+		Amount:      depositMsg.Amount,
+		Signature:   signature,
+		Credentials: depositMsg.Credentials,
+		//#nosec:G701 // the number of nodes is small.
+		Index: uint64(node.index),
+	}, nil
+}
+
+// genesisPayload returns the genesis execution payload of the network, with
+// the values of the default genesis execution payload header.
+func genesisPayload(
+	genesisTime math.U64,
+) (*components.ExecutionPayload, error) {
+	header, err := types.DefaultGenesisExecutionPayloadHeaderDeneb()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &components.ExecutionPayload{
+		StateRoot:     header.GetStateRoot(),
+		ReceiptsRoot:  header.GetReceiptsRoot(),
+		GasLimit:      header.GetGasLimit(),
+		Timestamp:     genesisTime,
+		ExtraData:     byteslib.Bytes{},
+		BaseFeePerGas: header.GetBaseFeePerGas(),
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   make([]*engineprimitives.Withdrawal, 0),
+	}
+	executionHeader, err := executionHeader(payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	payload.BlockHash = common.ExecutionHash(executionHeader.Hash())
+	return payload, nil
+}
+
+// proposalHash returns the hash CometBFT identifies a proposal by.
+func proposalHash(txs [][]byte) []byte {
+	h := sha256.New()
+	for _, tx := range txs {
+		h.Write(tx)
+	}
+	return h.Sum(nil)
+}
+
+// containsNode reports whether the node is in the list.
+func containsNode(nodes []*Node, node *Node) bool {
+	for _, candidate := range nodes {
+		if candidate == node {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/simulator"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	numNodes         = 4
+	trustedSetupPath = "../../../../testing/files/kzg-trusted-setup.json"
+)
+
+func newNetwork(
+	t *testing.T,
+	opts ...simulator.Option,
+) *simulator.Network {
+	t.Helper()
+	network, err := simulator.NewNetwork(
+		numNodes,
+		append(
+			[]simulator.Option{simulator.WithTrustedSetupPath(trustedSetupPath)},
+			opts...,
+		)...,
+	)
+	require.NoError(t, err)
+	t.Cleanup(network.Close)
+	require.NoError(t, network.AssertConsistent())
+	return network
+}
+
+func TestHappyPath(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+
+	for i := range 2 * numNodes {
+		proposer := network.Proposer(0)
+		commit, err := network.ProduceBlock(ctx)
+		require.NoError(t, err)
+		require.Len(t, commit.Rounds, 1)
+		require.Equal(t, proposer, commit.Proposer)
+		require.Equal(t, math.Slot(i+1), commit.Block.GetSlot())
+	}
+	require.NoError(t, network.AssertConsistent())
+
+	for _, node := range network.Nodes() {
+		slot, err := node.Slot()
+		require.NoError(t, err)
+		require.Equal(t, math.Slot(2*numNodes), slot)
+		require.Len(t, node.ExecutionClient().Payloads(), 2*numNodes)
+	}
+}
+
+func TestWeightedProposer(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t, simulator.WithDepositAmounts(
+		32e9, 8e9, 8e9, 8e9,
+	))
+
+	// Node 0 holds four of the seven units of voting power, hence proposes
+	// four of every seven blocks.
+	proposed := make(map[int]int)
+	commits, err := network.ProduceBlocks(ctx, 7)
+	require.NoError(t, err)
+	for _, commit := range commits {
+		require.Len(t, commit.Rounds, 1)
+		proposed[commit.Proposer]++
+	}
+	require.Equal(t, 4, proposed[0])
+	require.NoError(t, network.AssertConsistent())
+}
+
+func TestDeterminism(t *testing.T) {
+	ctx := context.Background()
+	a, b := newNetwork(t), newNetwork(t)
+
+	_, err := a.ProduceBlocks(ctx, numNodes)
+	require.NoError(t, err)
+	_, err = b.ProduceBlocks(ctx, numNodes)
+	require.NoError(t, err)
+
+	rootA, err := a.Nodes()[0].StateRoot()
+	require.NoError(t, err)
+	rootB, err := b.Nodes()[0].StateRoot()
+	require.NoError(t, err)
+	require.Equal(t, rootA, rootB)
+	require.Equal(t, a.Nodes()[0].AppHash(), b.Nodes()[0].AppHash())
+}
+
+func TestOfflineProposer(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+	_, err := network.ProduceBlock(ctx)
+	require.NoError(t, err)
+
+	offline := network.Proposer(0)
+	next := network.Proposer(1)
+	require.NoError(t, network.SetOnline(ctx, offline, false))
+
+	commit, err := network.ProduceBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, commit.Rounds, 2)
+	require.ErrorIs(t, commit.Rounds[0].Err, simulator.ErrProposerOffline)
+	require.Equal(t, next, commit.Proposer)
+
+	_, err = network.ProduceBlocks(ctx, numNodes)
+	require.NoError(t, err)
+	require.NoError(t, network.AssertConsistent())
+
+	// Bringing the node back online replays the blocks it missed.
+	require.NoError(t, network.SetOnline(ctx, offline, true))
+	require.NoError(t, network.AssertConsistent())
+}
+
+func TestTooManyOffline(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+	require.NoError(t, network.SetOnline(ctx, 2, false))
+	require.NoError(t, network.SetOnline(ctx, 3, false))
+
+	commit, err := network.ProduceBlock(ctx)
+	require.ErrorIs(t, err, simulator.ErrRoundsExhausted)
+	for _, round := range commit.Rounds {
+		require.Error(t, round.Err)
+	}
+	require.Empty(t, network.Blocks())
+}
+
+func TestInvalidPayload(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+	network.InjectFaults(1, simulator.Faults{InvalidPayload: true})
+
+	next := network.Proposer(1)
+	commit, err := network.ProduceBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, commit.Rounds, 2)
+	require.ErrorIs(t, commit.Rounds[0].Err, simulator.ErrNoQuorum)
+	require.Len(t, commit.Rounds[0].Rejections, numNodes)
+	require.Equal(t, next, commit.Proposer)
+	require.NoError(t, network.AssertConsistent())
+}
+
+func TestBlobWithholding(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+	network.InjectFaults(1, simulator.Faults{WithholdBlobs: []int{1, 2}})
+
+	commit, err := network.ProduceBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, commit.Rounds, 2)
+	require.ErrorIs(t, commit.Rounds[0].Err, simulator.ErrNoQuorum)
+	for _, index := range []int{1, 2} {
+		require.ErrorIs(
+			t, commit.Rounds[0].Rejections[index],
+			simulator.ErrDataNotAvailable,
+		)
+	}
+	require.NoError(t, network.AssertConsistent())
+}
+
+func TestBlobs(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t, simulator.WithBlobsPerBlock(2))
+
+	commits, err := network.ProduceBlocks(ctx, 2)
+	require.NoError(t, err)
+	for _, commit := range commits {
+		require.Len(t, commit.Block.GetBody().GetBlobKzgCommitments(), 2)
+	}
+	require.NoError(t, network.AssertConsistent())
+}
+
+func TestEquivocation(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+	network.InjectFaults(1, simulator.Faults{Equivocate: true})
+
+	commit, err := network.ProduceBlock(ctx)
+	require.NoError(t, err)
+	require.Len(t, commit.Rounds, 2)
+	require.True(t, commit.Rounds[0].Equivocation)
+	require.ErrorIs(t, commit.Rounds[0].Err, simulator.ErrNoQuorum)
+	require.NoError(t, network.AssertConsistent())
+}
+
+func TestEpochTransition(t *testing.T) {
+	ctx := context.Background()
+	network := newNetwork(t)
+
+	slotsPerEpoch := int(network.Nodes()[0].ChainSpec().SlotsPerEpoch())
+	_, err := network.ProduceBlocks(ctx, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.NoError(t, network.AssertConsistent())
+}
@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"text/template"
+	"time"
+
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"github.com/berachain/beacon-kit/mod/config"
+	cfgtemplate "github.com/berachain/beacon-kit/mod/config/pkg/template"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/viper"
+)
+
+const (
+	// payloadTimeout is the time the payload builder of a node waits for
+	// its execution client to build a payload. The execution clients of the
+	// network build their payloads as soon as they are requested.
+	payloadTimeout = 100 * time.Millisecond
+	// syncPollInterval is the interval at which a node polls the sync status
+	// of its execution client. The network itself waits for the execution
+	// clients to follow the committed chain.
+	syncPollInterval = time.Hour
+	// jwtSecretFile is the name of the file holding the JWT secret of the
+	// Engine API in the home directory of a node.
+	jwtSecretFile = "jwt.hex"
+)
+
+// Node is a single simulated beacon node. It runs the beacon-kit ABCI
+// application and the node-core services, as wired for beacond, on an
+// in-memory database, against its own in-memory execution client.
+type Node struct {
+	index  int
+	cs     common.ChainSpec
+	signer *signer.LegacySigner
+	// address is the fee recipient and withdrawal address of the node.
+	address common.ExecutionAddress
+	// home is the temporary home directory of the node.
+	home string
+
+	el  *ExecutionClient
+	app *runtime.App
+	sb  *components.StorageBackend
+	// stop stops the services of the node.
+	stop context.CancelFunc
+
+	online bool
+	// height is the height of the last block committed by the node.
+	height int64
+}
+
+// newNode starts a node for the validator with the given key, whose execution
+// client starts at the given genesis payload.
+func newNode(
+	n *Network,
+	index int,
+	key signer.LegacyKey,
+	genesis *components.ExecutionPayload,
+) (*Node, error) {
+	blsSigner, err := signer.NewLegacySigner(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var address common.ExecutionAddress
+	//#nosec:G701 // the number of nodes is small.
+	binary.BigEndian.PutUint32(address[16:], uint32(index+1))
+
+	node := &Node{
+		index:   index,
+		cs:      n.cs,
+		signer:  blsSigner,
+		address: address,
+		online:  true,
+	}
+	if err = node.start(n, key, genesis); err != nil {
+		node.Close()
+		return nil, err
+	}
+	return node, nil
+}
+
+// start starts the execution client, the ABCI application and the services
+// of the node.
+func (n *Node) start(
+	network *Network,
+	key signer.LegacyKey,
+	genesis *components.ExecutionPayload,
+) error {
+	var err error
+	if n.home, err = os.MkdirTemp("", "beacon-kit-simulator-"); err != nil {
+		return err
+	}
+
+	// The JWT secret is derived from the key of the validator, although the
+	// execution clients of the network do not authenticate requests.
+	jwtSecret := sha256.Sum256(key[:])
+	jwtPath := filepath.Join(n.home, jwtSecretFile)
+	if err = os.WriteFile(
+		jwtPath, []byte("0x"+hex.EncodeToString(jwtSecret[:])), 0o600,
+	); err != nil {
+		return err
+	}
+
+	if n.el, err = newExecutionClient(
+		genesis, n.cs.DepositEth1ChainID(), network.blockTime,
+		network.blobs, network.blobsPerBlock,
+	); err != nil {
+		return err
+	}
+
+	cfg := config.DefaultConfig()
+	if cfg.Engine.RPCDialURL, err = url.NewFromRaw(n.el.URL()); err != nil {
+		return err
+	}
+	cfg.Engine.JWTSecretPath = jwtPath
+	cfg.PayloadBuilder.SuggestedFeeRecipient = n.address
+	cfg.PayloadBuilder.PayloadTimeout = payloadTimeout
+	cfg.KZG.TrustedSetupPath = network.trustedSetupPath
+	cfg.Validator.EnableOptimisticPayloadBuilds = false
+	cfg.ExecutionSync.PollInterval = syncPollInterval
+
+	appOpts, err := newAppOptions(cfg, n.home, network.chainID)
+	if err != nil {
+		return err
+	}
+	return n.startApp(appOpts, network.logOutput, network.verifier, key)
+}
+
+// startApp builds the ABCI application of the node from the node-core
+// components and starts its services.
+func (n *Node) startApp(
+	appOpts *viper.Viper,
+	logOutput io.Writer,
+	verifier kzg.BlobProofVerifier,
+	key signer.LegacyKey,
+) error {
+	logCfg := phuslu.DefaultConfig()
+	logger := phuslu.NewLogger[sdklog.Logger](logOutput, &logCfg)
+
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   *service.Registry
+		engine     *components.ConsensusEngine
+		extender   *components.VoteExtender
+		apiBackend *components.NodeAPIBackend
+	)
+	if err := depinject.Inject(
+		depinject.Configs(
+			builder.DefaultDepInjectConfig(),
+			depinject.Provide(nodeComponents()...),
+			depinject.Supply(appOpts, logger, n.cs, verifier, key),
+			depinject.Invoke(builder.SetLoggerConfig),
+		),
+		&appBuilder,
+		&registry,
+		&engine,
+		&extender,
+		&apiBackend,
+		&n.sb,
+	); err != nil {
+		return err
+	}
+
+	n.app = runtime.NewBeaconKitApp(
+		dbm.NewMemDB(), nil, true, appBuilder,
+		append(
+			builder.DefaultBaseappOptions(appOpts),
+			builder.WithCometParamStore(n.cs),
+			builder.WithPrepareProposal(engine.PrepareProposal),
+			builder.WithProcessProposal(engine.ProcessProposal),
+			builder.WithExtendVote(extender.ExtendVote),
+			builder.WithVerifyVoteExtension(extender.VerifyVoteExtension),
+			builder.WithPreBlocker(engine.PreBlock),
+		)...,
+	)
+
+	// The node is assembled as in beacond, so that the services querying
+	// the committed state, such as the node API backend, can reach the app.
+	beaconNode := node.New[types.Node]()
+	beaconNode.RegisterApp(n.app)
+	beaconNode.SetServiceRegistry(registry)
+	apiBackend.AttachNode(beaconNode)
+
+	var ctx context.Context
+	ctx, n.stop = context.WithCancel(context.Background())
+	return beaconNode.Start(ctx)
+}
+
+// Index returns the index of the node in the network.
+func (n *Node) Index() int {
+	return n.index
+}
+
+// Online reports whether the node is participating in consensus.
+func (n *Node) Online() bool {
+	return n.online
+}
+
+// PublicKey returns the BLS public key of the node's validator.
+func (n *Node) PublicKey() crypto.BLSPubkey {
+	return n.signer.PublicKey()
+}
+
+// ChainSpec returns the chain spec of the node.
+func (n *Node) ChainSpec() common.ChainSpec {
+	return n.cs
+}
+
+// ExecutionClient returns the execution client of the node.
+func (n *Node) ExecutionClient() *ExecutionClient {
+	return n.el
+}
+
+// State returns the latest committed beacon state of the node. It fails
+// until the node commits its first block.
+func (n *Node) State() (*components.BeaconState, error) {
+	ctx, err := n.app.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, err
+	}
+	return n.sb.StateFromContext(ctx), nil
+}
+
+// StateRoot returns the hash tree root of the latest committed beacon state.
+func (n *Node) StateRoot() (common.Root, error) {
+	st, err := n.State()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return st.HashTreeRoot(), nil
+}
+
+// Slot returns the slot of the latest committed beacon state.
+func (n *Node) Slot() (math.Slot, error) {
+	st, err := n.State()
+	if err != nil {
+		return 0, err
+	}
+	return st.GetSlot()
+}
+
+// cometAddress returns the CometBFT address of the node's validator.
+func (n *Node) cometAddress() []byte {
+	pubkey := n.signer.PublicKey()
+	return cometPubKey(pubkey[:]).Address()
+}
+
+// finalizeBlock finalizes and commits the block on the node and waits for
+// its execution client to follow it.
+func (n *Node) finalizeBlock(
+	ctx context.Context,
+	req *abci.FinalizeBlockRequest,
+	blk *components.BeaconBlock,
+) (*abci.FinalizeBlockResponse, error) {
+	resp, err := n.app.FinalizeBlock(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = n.app.Commit(); err != nil {
+		return nil, err
+	}
+	n.height = req.Height
+
+	// The forkchoice update of the finalized block is sent asynchronously.
+	ctx, cancel := context.WithTimeout(ctx, headTimeout)
+	defer cancel()
+	ticker := time.NewTicker(headPollInterval)
+	defer ticker.Stop()
+	blockHash := blk.GetBody().GetExecutionPayload().GetBlockHash()
+	for n.el.Head() != blockHash {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(
+				ErrExecutionClientBehind, "node %d", n.index,
+			)
+		case <-ticker.C:
+		}
+	}
+	return resp, nil
+}
+
+// AppHash returns the app hash after the last committed block.
+func (n *Node) AppHash() []byte {
+	return n.app.LastCommitID().Hash
+}
+
+// Close stops the node and removes its home directory.
+func (n *Node) Close() {
+	if n.stop != nil {
+		n.stop()
+	}
+	if n.app != nil {
+		_ = n.app.Close()
+	}
+	if n.el != nil {
+		_ = n.el.Close()
+	}
+	if n.home != "" {
+		_ = os.RemoveAll(n.home)
+	}
+}
+
+// newAppOptions returns the application options of a node with the given
+// configuration, as beacond reads them from its app.toml.
+func newAppOptions(
+	cfg *config.Config,
+	home string,
+	chainID string,
+) (*viper.Viper, error) {
+	tmpl, err := template.New("app.toml").Parse(cfgtemplate.TomlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, struct {
+		BeaconKit *config.Config
+	}{cfg}); err != nil {
+		return nil, err
+	}
+
+	appOpts := viper.New()
+	appOpts.SetConfigType("toml")
+	if err = appOpts.ReadConfig(&buf); err != nil {
+		return nil, err
+	}
+	appOpts.Set(flags.FlagHome, home)
+	appOpts.Set(flags.FlagChainID, chainID)
+	appOpts.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+	return appOpts, nil
+}
+
+// nodeComponents returns the node-core components of beacond, leaving out
+// the chain spec and the blob proof verifier, which the network supplies
+// instead. The verifier is shared by the nodes since loading the trusted
+// setup dominates the start-up time of a node.
+func nodeComponents() []any {
+	supplied := map[uintptr]struct{}{
+		reflect.ValueOf(components.ProvideChainSpec).Pointer():         {},
+		reflect.ValueOf(components.ProvideBlobProofVerifier).Pointer(): {},
+	}
+	all := components.DefaultComponentsWithStandardTypes()
+	comps := make([]any, 0, len(all))
+	for _, c := range all {
+		if _, ok := supplied[reflect.ValueOf(c).Pointer()]; !ok {
+			comps = append(comps, c)
+		}
+	}
+	return comps
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Option is a function that configures a Network.
+type Option func(*Network) error
+
+// WithChainSpec sets the chain spec used by every node in the network.
+func WithChainSpec(cs common.ChainSpec) Option {
+	return func(n *Network) error {
+		n.cs = cs
+		return nil
+	}
+}
+
+// WithSeed sets the seed the validator keys are derived from.
+func WithSeed(seed []byte) Option {
+	return func(n *Network) error {
+		n.seed = seed
+		return nil
+	}
+}
+
+// WithGenesisTime sets the genesis time of the network, in unix seconds.
+func WithGenesisTime(genesisTime math.U64) Option {
+	return func(n *Network) error {
+		n.genesisTime = genesisTime
+		return nil
+	}
+}
+
+// WithBlockTime sets the number of seconds between two consecutive slots.
+func WithBlockTime(blockTime math.U64) Option {
+	return func(n *Network) error {
+		n.blockTime = blockTime
+		return nil
+	}
+}
+
+// WithBlobsPerBlock sets the number of blobs every proposal commits to.
+func WithBlobsPerBlock(blobs int) Option {
+	return func(n *Network) error {
+		n.blobsPerBlock = blobs
+		return nil
+	}
+}
+
+// WithTrustedSetupPath sets the path to the KZG trusted setup used by the
+// nodes and the execution clients to commit to blobs.
+func WithTrustedSetupPath(path string) Option {
+	return func(n *Network) error {
+		n.trustedSetupPath = path
+		return nil
+	}
+}
+
+// WithDepositAmounts sets the genesis deposit of each validator, and hence
+// its voting power. Validators without an amount deposit the maximum
+// effective balance.
+func WithDepositAmounts(amounts ...math.Gwei) Option {
+	return func(n *Network) error {
+		n.depositAmounts = amounts
+		return nil
+	}
+}
+
+// WithLogOutput sets the writer the logs of every node are written to. The
+// logs are discarded by default.
+func WithLogOutput(w io.Writer) Option {
+	return func(n *Network) error {
+		n.logOutput = w
+		return nil
+	}
+}
+
+// WithMaxRounds sets the number of rounds attempted at each height before
+// giving up.
+func WithMaxRounds(rounds int) Option {
+	return func(n *Network) error {
+		n.maxRounds = rounds
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// cometPubKey is the CometBFT public key of a validator. It is only used to
+// track the validator sets and the proposer priorities of the network, the
+// vote extension signatures being verified by the application.
+type cometPubKey []byte
+
+// Address returns the CometBFT address of the validator.
+func (k cometPubKey) Address() cmtcrypto.Address {
+	return cmtcrypto.AddressHash(k)
+}
+
+// Bytes returns the compressed BLS public key.
+func (k cometPubKey) Bytes() []byte {
+	return k
+}
+
+// VerifySignature always fails, the network never verifies signatures with
+// the CometBFT keys.
+func (k cometPubKey) VerifySignature([]byte, []byte) bool {
+	return false
+}
+
+// Type returns the CometBFT type of BLS keys.
+func (k cometPubKey) Type() string {
+	return crypto.CometBLSType
+}
+
+// validatorSets tracks the validator sets of the network as CometBFT does:
+// the validator updates returned when finalizing a block take effect two
+// heights later.
+type validatorSets struct {
+	// last is the validator set that voted on the last committed block.
+	last *cmttypes.ValidatorSet
+	// current is the validator set of the next height.
+	current *cmttypes.ValidatorSet
+	// next is the validator set of the height after the next one.
+	next *cmttypes.ValidatorSet
+}
+
+// newValidatorSets returns the validator sets of a chain initialized with the
+// given genesis validators.
+func newValidatorSets(
+	updates []abci.ValidatorUpdate,
+) (*validatorSets, error) {
+	vals, err := toValidators(updates)
+	if err != nil {
+		return nil, err
+	}
+	current := cmttypes.NewValidatorSet(vals)
+	if err = current.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &validatorSets{
+		last:    current.Copy(),
+		current: current,
+		next:    current.CopyIncrementProposerPriority(1),
+	}, nil
+}
+
+// proposer returns the address of the proposer of the given round at the
+// next height, picked by CometBFT's weighted round robin.
+func (v *validatorSets) proposer(round int) []byte {
+	vals := v.current
+	if round > 0 {
+		vals = vals.Copy()
+		//#nosec:G701 // the number of rounds is small.
+		vals.IncrementProposerPriority(int32(round))
+	}
+	return vals.GetProposer().Address
+}
+
+// commit moves the validator sets to the next height, applying the given
+// validator updates to the validator set two heights later.
+func (v *validatorSets) commit(updates []abci.ValidatorUpdate) error {
+	changes, err := toValidators(updates)
+	if err != nil {
+		return err
+	}
+	next := v.next.Copy()
+	if len(changes) > 0 {
+		if err = next.UpdateWithChangeSet(changes); err != nil {
+			return err
+		}
+	}
+	next.IncrementProposerPriority(1)
+
+	v.last, v.current, v.next = v.current, v.next, next
+	return nil
+}
+
+// toValidators converts ABCI validator updates to CometBFT validators.
+func toValidators(
+	updates []abci.ValidatorUpdate,
+) ([]*cmttypes.Validator, error) {
+	vals := make([]*cmttypes.Validator, len(updates))
+	for i, update := range updates {
+		if update.PubKeyType != crypto.CometBLSType {
+			return nil, errors.Wrapf(
+				ErrUnsupportedKeyType, "got %s", update.PubKeyType,
+			)
+		}
+		vals[i] = cmttypes.NewValidator(
+			cometPubKey(update.PubKeyBytes), update.Power,
+		)
+	}
+	return vals, nil
+}
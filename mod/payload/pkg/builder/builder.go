@@ -44,12 +44,13 @@ type PayloadBuilder[
 	chainSpec common.ChainSpec
 	// logger is used for logging within the PayloadBuilder.
 	logger log.Logger[any]
-	// ee is the execution engine.
-	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT]
-	// pc is the payload ID cache, it is used to store
-	// "in-flight" payloads that are being built on
-	// the execution client.
-	pc *cache.PayloadIDCache[
+	// ees are the execution engines payloads are built on, the first of
+	// which is the primary one.
+	ees []ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT]
+	// pcs are the payload ID caches of each of the execution engines, they
+	// are used to store "in-flight" payloads that are being built on the
+	// execution clients.
+	pcs []*cache.PayloadIDCache[
 		PayloadIDT, [32]byte, math.Slot,
 	]
 	// attributesFactory is used to create attributes for the
@@ -70,10 +71,7 @@ func New[
 	cfg *Config,
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
-	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
-	pc *cache.PayloadIDCache[
-		PayloadIDT, [32]byte, math.Slot,
-	],
+	ees []ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
 	af *attributes.Factory[
 		BeaconStateT, PayloadAttributesT, WithdrawalT,
 	],
@@ -81,6 +79,12 @@ func New[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
 ] {
+	pcs := make([]*cache.PayloadIDCache[
+		PayloadIDT, [32]byte, math.Slot,
+	], len(ees))
+	for i := range ees {
+		pcs[i] = cache.NewPayloadIDCache[PayloadIDT, [32]byte, math.Slot]()
+	}
 	return &PayloadBuilder[
 		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 		PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -88,8 +92,8 @@ func New[
 		cfg:               cfg,
 		chainSpec:         chainSpec,
 		logger:            logger,
-		ee:                ee,
-		pc:                pc,
+		ees:               ees,
+		pcs:               pcs,
 		attributesFactory: af,
 	}
 }
@@ -44,7 +44,8 @@ type Config struct {
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
-	// timeout_proposal in the CometBFT configuration.
+	// timeout_proposal in the CometBFT configuration. It also bounds the
+	// retrieval of the built payloads from the execution clients.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
 }
 
@@ -22,15 +22,18 @@ package builder
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// RequestPayloadAsync builds a payload for the given slot and
-// returns the payload ID.
+// RequestPayloadAsync builds a payload for the given slot on each of the
+// execution clients and returns the payload ID of the first of them to
+// accept the build.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -47,7 +50,19 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
-	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
+	var (
+		payloadIDs = make([]*PayloadIDT, len(pb.ees))
+		errs       = make([]error, len(pb.ees))
+		pending    []int
+	)
+	for i, pc := range pb.pcs {
+		if payloadID, found := pc.Get(slot, parentBlockRoot); found {
+			payloadIDs[i] = &payloadID
+			continue
+		}
+		pending = append(pending, i)
+	}
+	if len(pending) == 0 {
 		pb.logger.Warn(
 			"aborting payload build; payload already exists in cache",
 			"for_slot",
@@ -55,7 +70,7 @@ func (pb *PayloadBuilder[
 			"parent_block_root",
 			parentBlockRoot,
 		)
-		return &payloadID, nil
+		return payloadIDs[0], nil
 	}
 
 	// Assemble the payload attributes.
@@ -65,9 +80,51 @@ func (pb *PayloadBuilder[
 		return nil, err
 	}
 
-	// Submit the forkchoice update to the execution client.
-	var payloadID *PayloadIDT
-	payloadID, _, err = pb.ee.NotifyForkchoiceUpdate(
+	// Submit the forkchoice update to the execution clients.
+	var wg sync.WaitGroup
+	for _, i := range pending {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			payloadIDs[i], errs[i] = pb.requestPayload(
+				ctx, i, attrs, slot, parentBlockRoot,
+				headEth1BlockHash, finalEth1BlockHash,
+			)
+		}()
+	}
+	wg.Wait()
+
+	// The failures are only logged when other execution clients may make
+	// up for them, otherwise they are returned.
+	for i, payloadID := range payloadIDs {
+		if errs[i] != nil {
+			pb.logExecutionClientError(
+				"Failed to request payload from execution client",
+				i, slot, errs[i],
+			)
+		} else if payloadID != nil {
+			return payloadID, nil
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// requestPayload submits a forkchoice update with the given attributes to
+// the execution client with the given index and caches the ID of the payload
+// it builds.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) requestPayload(
+	ctx context.Context,
+	i int,
+	attrs PayloadAttributesT,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+) (*PayloadIDT, error) {
+	payloadID, _, err := pb.ees[i].NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
 			State: &engineprimitives.ForkchoiceStateV1{
 				HeadBlockHash:      headEth1BlockHash,
@@ -84,7 +141,7 @@ func (pb *PayloadBuilder[
 
 	// Only add to cache if we received back a payload ID.
 	if payloadID != nil {
-		pb.pc.Set(slot, parentBlockRoot, *payloadID)
+		pb.pcs[i].Set(slot, parentBlockRoot, *payloadID)
 	}
 
 	return payloadID, nil
@@ -108,8 +165,8 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
-	// Build the payload and wait for the execution client to
-	// return the payload ID.
+	// Build the payload and wait for the execution clients to
+	// return the payload IDs.
 	payloadID, err := pb.RequestPayloadAsync(
 		ctx,
 		st,
@@ -139,14 +196,8 @@ func (pb *PayloadBuilder[
 		return nil, ctx.Err()
 	}
 
-	// Get the payload from the execution client.
-	return pb.ee.GetPayload(
-		ctx,
-		&engineprimitives.GetPayloadRequest[PayloadIDT]{
-			PayloadID:   *payloadID,
-			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
-	)
+	// Get the most valuable of the payloads from the execution clients.
+	return pb.getBestPayload(ctx, slot, parentBlockRoot)
 }
 
 // RetrievePayload attempts to pull a previously built payload
@@ -167,22 +218,9 @@ func (pb *PayloadBuilder[
 
 	// Attempt to see if we previously fired off a payload built for
 	// this particular slot and parent block root.
-	payloadID, found := pb.pc.Get(slot, parentBlockRoot)
-	if !found {
-		return nil, ErrPayloadIDNotFound
-	}
-
-	envelope, err := pb.ee.GetPayload(
-		ctx,
-		&engineprimitives.GetPayloadRequest[PayloadIDT]{
-			PayloadID:   payloadID,
-			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
-	)
+	envelope, err := pb.getBestPayload(ctx, slot, parentBlockRoot)
 	if err != nil {
 		return nil, err
-	} else if envelope == nil {
-		return nil, ErrNilPayloadEnvelope
 	}
 
 	overrideBuilder := envelope.ShouldOverrideBuilder()
@@ -216,47 +254,131 @@ func (pb *PayloadBuilder[
 			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
 		)
 	}
-	return envelope, err
+	return envelope, nil
 }
 
-// SendForceHeadFCU builds a payload for the given slot and
-// returns the payload ID.
-//
-// TODO: This should be moved onto a "sync service"
-// of some kind.
+// getBestPayload gets the payloads built for the given slot and parent block
+// root from each of the execution clients concurrently and returns the one
+// of the highest block value, preferring the earliest execution client on
+// ties. The execution clients that do not return their payload within the
+// payload timeout are given up on, rather than waited for. It fails only if
+// none of the payloads could be retrieved.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
-]) SendForceHeadFCU(
+]) getBestPayload(
 	ctx context.Context,
-	st BeaconStateT,
 	slot math.Slot,
-) error {
-	lph, err := st.GetLatestExecutionPayloadHeader()
-	if err != nil {
-		return err
+	parentBlockRoot common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	type result struct {
+		index    int
+		envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+		err      error
 	}
 
-	pb.logger.Info(
-		"Sending startup forkchoice update to execution client",
-		"head_eth1_hash", lph.GetBlockHash(),
-		"safe_eth1_hash", lph.GetParentHash(),
-		"finalized_eth1_hash", lph.GetParentHash(),
-		"for_slot", slot.Base10(),
-	)
+	ctx, cancel := context.WithTimeout(ctx, pb.cfg.PayloadTimeout)
+	defer cancel()
 
-	// Submit the forkchoice update to the execution client.
-	var attrs PayloadAttributesT
-	_, _, err = pb.ee.NotifyForkchoiceUpdate(
-		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
-			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
-			},
-			PayloadAttributes: attrs,
-			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
+	var (
+		envelopes = make(
+			[]engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+			len(pb.ees),
+		)
+		errs        = make([]error, len(pb.ees))
+		pending     = make(map[int]struct{}, len(pb.ees))
+		forkVersion = pb.chainSpec.ActiveForkVersionForSlot(slot)
+		// results is buffered so that the execution clients given up on do
+		// not block their goroutine.
+		results = make(chan result, len(pb.ees))
 	)
-	return err
+	for i, ee := range pb.ees {
+		payloadID, ok := pb.pcs[i].Get(slot, parentBlockRoot)
+		if !ok {
+			continue
+		}
+		pending[i] = struct{}{}
+		go func() {
+			envelope, err := ee.GetPayload(
+				ctx,
+				&engineprimitives.GetPayloadRequest[PayloadIDT]{
+					PayloadID:   payloadID,
+					ForkVersion: forkVersion,
+				},
+			)
+			results <- result{index: i, envelope: envelope, err: err}
+		}()
+	}
+	if len(pending) == 0 {
+		return nil, ErrPayloadIDNotFound
+	}
+
+	for len(pending) > 0 {
+		select {
+		case r := <-results:
+			envelopes[r.index], errs[r.index] = r.envelope, r.err
+			delete(pending, r.index)
+		case <-ctx.Done():
+			for i := range pending {
+				errs[i] = errors.Wrap(ctx.Err(), "payload not retrieved")
+			}
+			clear(pending)
+		}
+	}
+
+	best := -1
+	for i, envelope := range envelopes {
+		switch {
+		case errs[i] != nil:
+			pb.logExecutionClientError(
+				"Failed to get payload from execution client",
+				i, slot, errs[i],
+			)
+		case envelope == nil || envelope.GetExecutionPayload().IsNil():
+			continue
+		case best < 0 ||
+			blockValue(envelope).Gt(blockValue(envelopes[best])):
+			best = i
+		}
+	}
+	if best < 0 {
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+		return nil, ErrNilPayloadEnvelope
+	}
+
+	if len(pb.ees) > 1 {
+		pb.logger.Info(
+			"Selected payload of the highest block value",
+			"execution_client", best,
+			"for_slot", slot.Base10(),
+			"block_value", blockValue(envelopes[best]).Dec(),
+		)
+	}
+	return envelopes[best], nil
+}
+
+// logExecutionClientError logs the error of the execution client with the
+// given index, if payloads are built on other execution clients as well.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) logExecutionClientError(msg string, i int, slot math.Slot, err error) {
+	if len(pb.ees) > 1 {
+		pb.logger.Warn(
+			msg, "execution_client", i, "for_slot", slot.Base10(), "error", err,
+		)
+	}
+}
+
+// blockValue returns the block value of the given envelope, which is zero if
+// the execution client did not return one.
+func blockValue[ExecutionPayloadT any](
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) *math.U256 {
+	if value := envelope.GetValue(); value != nil {
+		return value
+	}
+	return new(math.U256)
 }
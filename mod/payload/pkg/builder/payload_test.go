@@ -0,0 +1,362 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlot        = math.Slot(5)
+	testTimeout     = 100 * time.Millisecond
+	testBlockNumber = 42
+)
+
+var (
+	errFCU        = errors.New("forkchoice update failed")
+	errGetPayload = errors.New("get payload failed")
+)
+
+type (
+	payloadID [8]byte
+
+	testBuilder = builder.PayloadBuilder[
+		*testState, *testPayload, *testHeader, *testAttributes, payloadID,
+		struct{},
+	]
+	testEngine = builder.ExecutionEngine[
+		*testPayload, *testAttributes, payloadID,
+	]
+)
+
+func TestRequestPayloadAsync(t *testing.T) {
+	failing := &mockEngine{id: 1, fcuErr: errFCU}
+	nilID := &mockEngine{}
+	ok := &mockEngine{id: 3}
+	pb := newBuilder(failing, nilID, ok)
+
+	id, err := requestPayload(pb)
+	require.NoError(t, err)
+	require.Equal(t, payloadID{3}, *id)
+	for _, ee := range []*mockEngine{failing, nilID, ok} {
+		require.Equal(t, 1, ee.fcuCalls())
+	}
+
+	// The payload IDs are cached per execution client, so that only the
+	// execution clients without one are requested again.
+	id, err = requestPayload(pb)
+	require.NoError(t, err)
+	require.Equal(t, payloadID{3}, *id)
+	require.Equal(t, 2, failing.fcuCalls())
+	require.Equal(t, 2, nilID.fcuCalls())
+	require.Equal(t, 1, ok.fcuCalls())
+}
+
+func TestRequestPayloadAsync_AllFailing(t *testing.T) {
+	pb := newBuilder(
+		&mockEngine{fcuErr: errFCU}, &mockEngine{fcuErr: errGetPayload},
+	)
+	_, err := requestPayload(pb)
+	require.ErrorIs(t, err, errFCU)
+	require.ErrorIs(t, err, errGetPayload)
+}
+
+func TestRetrievePayload_HighestValue(t *testing.T) {
+	low := &mockEngine{id: 1, value: 10}
+	high := &mockEngine{id: 2, value: 20}
+	pb := newBuilder(low, high)
+
+	_, err := requestPayload(pb)
+	require.NoError(t, err)
+	envelope, err := pb.RetrievePayload(
+		context.Background(), testSlot, common.Root{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), envelope.GetExecutionPayload().id)
+}
+
+func TestRetrievePayload_TieBreaking(t *testing.T) {
+	first := &mockEngine{id: 1, value: 10}
+	second := &mockEngine{id: 2, value: 10}
+	unvalued := &mockEngine{id: 3, nilValue: true}
+	pb := newBuilder(unvalued, first, second)
+
+	_, err := requestPayload(pb)
+	require.NoError(t, err)
+	envelope, err := pb.RetrievePayload(
+		context.Background(), testSlot, common.Root{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), envelope.GetExecutionPayload().id)
+}
+
+func TestRetrievePayload_Failing(t *testing.T) {
+	failing := &mockEngine{id: 1, value: 100, getErr: errGetPayload}
+	ok := &mockEngine{id: 2, value: 1}
+	pb := newBuilder(failing, ok)
+
+	_, err := requestPayload(pb)
+	require.NoError(t, err)
+	envelope, err := pb.RetrievePayload(
+		context.Background(), testSlot, common.Root{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), envelope.GetExecutionPayload().id)
+
+	// The payload is only lost if all execution clients fail.
+	ok.setGetErr(errGetPayload)
+	_, err = pb.RetrievePayload(context.Background(), testSlot, common.Root{})
+	require.ErrorIs(t, err, errGetPayload)
+}
+
+func TestRetrievePayload_Slow(t *testing.T) {
+	// The slow execution client ignores the cancellation of its request, the
+	// payload of the others is returned at the deadline nonetheless.
+	slow := &mockEngine{id: 1, value: 100, delay: time.Hour}
+	ok := &mockEngine{id: 2, value: 1}
+	pb := newBuilder(slow, ok)
+
+	_, err := requestPayload(pb)
+	require.NoError(t, err)
+	start := time.Now()
+	envelope, err := pb.RetrievePayload(
+		context.Background(), testSlot, common.Root{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), envelope.GetExecutionPayload().id)
+	require.Less(t, time.Since(start), 10*testTimeout)
+
+	// Without another payload, the deadline fails the retrieval.
+	pb = newBuilder(slow)
+	_, err = requestPayload(pb)
+	require.NoError(t, err)
+	_, err = pb.RetrievePayload(context.Background(), testSlot, common.Root{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetrievePayload_NotRequested(t *testing.T) {
+	pb := newBuilder(&mockEngine{id: 1})
+	_, err := pb.RetrievePayload(
+		context.Background(), testSlot, common.Root{},
+	)
+	require.ErrorIs(t, err, builder.ErrPayloadIDNotFound)
+}
+
+func newBuilder(ees ...*mockEngine) *testBuilder {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:             4,
+			EpochsPerHistoricalVector: 8,
+		},
+	)
+	engines := make([]testEngine, len(ees))
+	for i, ee := range ees {
+		engines[i] = ee
+	}
+	return builder.New[
+		*testState, *testPayload, *testHeader, *testAttributes, payloadID,
+	](
+		&builder.Config{Enabled: true, PayloadTimeout: testTimeout},
+		cs,
+		noop.NewLogger[any](),
+		engines,
+		attributes.NewAttributesFactory[
+			*testState, *testAttributes, struct{},
+		](cs, noop.NewLogger[any](), common.ExecutionAddress{}),
+	)
+}
+
+func requestPayload(pb *testBuilder) (*payloadID, error) {
+	return pb.RequestPayloadAsync(
+		context.Background(), &testState{}, testSlot, 0,
+		common.Root{}, common.ExecutionHash{}, common.ExecutionHash{},
+	)
+}
+
+// mockEngine is an execution engine building the payload of the given ID
+// and value.
+type mockEngine struct {
+	id       byte
+	value    uint64
+	nilValue bool
+	fcuErr   error
+	delay    time.Duration
+
+	mu     sync.Mutex
+	getErr error
+	fcus   int
+}
+
+func (m *mockEngine) NotifyForkchoiceUpdate(
+	context.Context,
+	*engineprimitives.ForkchoiceUpdateRequest[*testAttributes],
+) (*payloadID, *common.ExecutionHash, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fcus++
+	if m.fcuErr != nil {
+		return nil, nil, m.fcuErr
+	}
+	if m.id == 0 {
+		return nil, nil, nil
+	}
+	return &payloadID{m.id}, nil, nil
+}
+
+func (m *mockEngine) GetPayload(
+	_ context.Context,
+	req *engineprimitives.GetPayloadRequest[payloadID],
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	time.Sleep(m.delay)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	env := &testEnvelope{
+		payload: &testPayload{id: uint64(req.PayloadID[0])},
+	}
+	if !m.nilValue {
+		env.value = math.NewU256(m.value)
+	}
+	return env, nil
+}
+
+func (m *mockEngine) fcuCalls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fcus
+}
+
+func (m *mockEngine) setGetErr(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getErr = err
+}
+
+type testEnvelope struct {
+	payload *testPayload
+	value   *math.U256
+}
+
+func (e *testEnvelope) GetExecutionPayload() *testPayload { return e.payload }
+
+func (e *testEnvelope) GetValue() *math.U256 { return e.value }
+
+func (e *testEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return nil
+}
+
+func (e *testEnvelope) ShouldOverrideBuilder() bool { return false }
+
+func (e *testEnvelope) GetExecutionRequests() []bytes.Bytes { return nil }
+
+// testPayload is an execution payload identified by the ID of the payload
+// build it results from.
+type testPayload struct {
+	id uint64
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (p *testPayload) Version() uint32 { return 0 }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{byte(p.id)}
+}
+
+func (p *testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (p *testPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+type testHeader struct{}
+
+func (*testHeader) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (*testHeader) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+type testAttributes struct{}
+
+func (*testAttributes) New(
+	uint32, uint64, common.Bytes32, common.ExecutionAddress, []struct{},
+	common.Root,
+) (*testAttributes, error) {
+	return &testAttributes{}, nil
+}
+
+func (*testAttributes) Version() uint32 { return 0 }
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (*testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type testState struct{}
+
+func (*testState) GetRandaoMixAtIndex(uint64) (common.Bytes32, error) {
+	return common.Bytes32{}, nil
+}
+
+func (*testState) ExpectedWithdrawals() ([]struct{}, error) {
+	return nil, nil
+}
+
+func (*testState) GetLatestExecutionPayloadHeader() (*testHeader, error) {
+	return &testHeader{}, nil
+}
+
+func (*testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (*testState) GetBlockRootAtIndex(uint64) (common.Root, error) {
+	return common.Root{}, nil
+}
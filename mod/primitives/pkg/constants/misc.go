@@ -31,4 +31,10 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// ValidatorRegistryLimit is the maximum number of validators in the
+	// registry.
+	ValidatorRegistryLimit uint64 = 1099511627776
+	// PendingConsolidationsLimit is the maximum number of pending
+	// consolidations in the state.
+	PendingConsolidationsLimit uint64 = 262144
 )
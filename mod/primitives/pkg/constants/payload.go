@@ -34,6 +34,12 @@ const (
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
+	// MaxAttestationsPerBlock is the maximum number of attestations per
+	// block. A block carries at most one attestation per validator, hence
+	// the limit is the one of the validator registry, so that no voter of
+	// the validator set is left uncredited.
+	MaxAttestationsPerBlock uint64 = ValidatorRegistryLimit
+
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
@@ -21,6 +21,7 @@
 package constraints
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
@@ -52,3 +53,10 @@ type DynamicSSZField[SelfPtrT, SelfT any] interface {
 	ssz.DynamicObject
 	SSZField[SelfPtrT, SelfT]
 }
+
+// SSZSchemaDefiner is an interface for types that define the SSZ schema they
+// are encoded with, e.g. to resolve the generalized indices of their fields.
+type SSZSchemaDefiner interface {
+	// SSZSchema returns the SSZ schema of the type.
+	SSZSchema() schema.SSZType
+}
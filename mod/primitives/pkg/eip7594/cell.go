@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip7594
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// FieldElementsPerCell is the number of field elements in a cell.
+	FieldElementsPerCell = 64
+	// BytesPerFieldElement is the number of bytes in a field element.
+	BytesPerFieldElement = 32
+	// BytesPerCell is the number of bytes in a cell.
+	BytesPerCell = FieldElementsPerCell * BytesPerFieldElement
+	// CellsPerExtBlob is the number of cells in an erasure coded blob, which
+	// is also the number of data columns.
+	CellsPerExtBlob = 128
+	// NumberOfColumns is the number of data columns of an extended blob
+	// matrix.
+	NumberOfColumns = CellsPerExtBlob
+)
+
+// Cell is a contiguous range of FieldElementsPerCell evaluations of an
+// erasure coded blob. Its SSZ representation matches a ByteVector of
+// BytesPerCell bytes.
+type Cell [FieldElementsPerCell]bytes.B32
+
+// Bytes returns the cell as a flat byte slice.
+func (c *Cell) Bytes() []byte {
+	bz := make([]byte, 0, BytesPerCell)
+	for i := range c {
+		bz = append(bz, c[i][:]...)
+	}
+	return bz
+}
+
+// SetBytes sets the cell from a flat byte slice of BytesPerCell bytes.
+func (c *Cell) SetBytes(bz []byte) error {
+	if len(bz) != BytesPerCell {
+		return ErrInvalidCellLength
+	}
+	for i := range c {
+		copy(c[i][:], bz[i*BytesPerFieldElement:])
+	}
+	return nil
+}
+
+// UnmarshalJSON parses a cell in hex syntax.
+func (c *Cell) UnmarshalJSON(input []byte) error {
+	bz := make([]byte, BytesPerCell)
+	if err := bytes.UnmarshalFixedJSON(input, bz); err != nil {
+		return err
+	}
+	return c.SetBytes(bz)
+}
+
+// MarshalText returns the hex representation of c.
+func (c Cell) MarshalText() ([]byte, error) {
+	return bytes.Bytes(c.Bytes()).MarshalText()
+}
+
+// DefineSSZ defines the SSZ encoding for the Cell object.
+func (c *Cell) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUnsafeArrayOfStaticBytes(codec, c[:])
+}
+
+// SizeSSZ returns the size of the Cell object in SSZ encoding.
+func (c *Cell) SizeSSZ() uint32 {
+	return BytesPerCell
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the Cell object.
+func (c *Cell) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
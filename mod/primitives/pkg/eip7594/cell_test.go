@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip7594_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip7594"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func testCell() *eip7594.Cell {
+	bz := make([]byte, eip7594.BytesPerCell)
+	for i := range bz {
+		bz[i] = byte(i)
+	}
+	cell := new(eip7594.Cell)
+	if err := cell.SetBytes(bz); err != nil {
+		panic(err)
+	}
+	return cell
+}
+
+func TestCell_SSZ(t *testing.T) {
+	cell := testCell()
+
+	bz := make([]byte, cell.SizeSSZ())
+	require.NoError(t, ssz.EncodeToBytes(bz, cell))
+	require.Equal(t, cell.Bytes(), bz)
+
+	decoded := new(eip7594.Cell)
+	require.NoError(t, ssz.DecodeFromBytes(bz, decoded))
+	require.Equal(t, cell, decoded)
+}
+
+func TestCell_HashTreeRoot(t *testing.T) {
+	cell := testCell()
+
+	// A cell is merkleized as a ByteVector, i.e. over its 32 byte chunks.
+	leaves := make([]common.Root, eip7594.FieldElementsPerCell)
+	for i := range cell {
+		leaves[i] = common.Root(cell[i])
+	}
+	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		leaves, eip7594.FieldElementsPerCell,
+	)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(tree.Root()), cell.HashTreeRoot())
+}
+
+func TestCell_JSON(t *testing.T) {
+	cell := testCell()
+
+	text, err := cell.MarshalText()
+	require.NoError(t, err)
+
+	decoded := new(eip7594.Cell)
+	require.NoError(t, decoded.UnmarshalJSON([]byte(`"`+string(text)+`"`)))
+	require.Equal(t, cell, decoded)
+
+	require.ErrorIs(
+		t, decoded.SetBytes(make([]byte, 1)), eip7594.ErrInvalidCellLength,
+	)
+}
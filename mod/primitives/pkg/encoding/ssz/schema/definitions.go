@@ -122,7 +122,9 @@ func DefineByteList(limit uint64) SSZType {
 
 func (l list) ID() ID { return List }
 
-func (l list) ItemLength() uint64 { return l.elementType.ItemLength() }
+// ItemLength returns the length of a list as an item of a composite type,
+// which is the chunk of its root.
+func (l list) ItemLength() uint64 { return constants.BytesPerChunk }
 
 func (l list) HashChunkCount() uint64 {
 	totalBytes := l.Length() * l.elementType.ItemLength()
@@ -150,7 +152,7 @@ func (l list) ItemPosition(p string) (uint64, uint8, uint8, error) {
 		//#nosec:G701 // can't overflow.
 		uint8(start % constants.BytesPerChunk),
 		//#nosec:G701 // can't overflow.
-		uint8(start%constants.BytesPerChunk + l.ItemLength()), nil
+		uint8(start%constants.BytesPerChunk + l.elementType.ItemLength()), nil
 }
 
 /* -------------------------------------------------------------------------- */
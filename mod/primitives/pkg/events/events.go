@@ -32,5 +32,10 @@ const (
 	BlobSidecarsReceived        = "blob-sidecars-received"
 	BlobSidecarsProcessRequest  = "blob-sidecars-process-request"
 	BlobSidecarsProcessed       = "blob-sidecars-processed"
+	DataColumnsBuilt            = "data-columns-built"
+	DataColumnsReceived         = "data-columns-received"
+	DataColumnsProcessRequest   = "data-columns-process-request"
+	DataColumnsProcessed        = "data-columns-processed"
 	GenesisDataProcessRequest   = "genesis-data-process-request"
+	ExecutionSyncStatusChanged  = "execution-sync-status-changed"
 )
@@ -134,6 +134,24 @@ func (u U64) ILog2Floor() uint8 {
 	return log.ILog2Floor(u)
 }
 
+// ISqrt returns the largest integer x such that x**2 <= u.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#integer_squareroot
+//
+//nolint:lll // From Ethereum 2.0 spec.
+func (u U64) ISqrt() U64 {
+	if u == U64(1<<64-1) {
+		return U64(1<<32 - 1)
+	}
+	x := u
+	y := (x + 1) / 2
+	for y < x {
+		x = y
+		y = (x + u/x) / 2
+	}
+	return x
+}
+
 // ---------------------------- Gwei Methods ----------------------------
 
 // GweiFromWei returns the value of Wei in Gwei.
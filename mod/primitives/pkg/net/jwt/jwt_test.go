@@ -171,3 +171,16 @@ func TestSecretRoundTripEncoding(t *testing.T) {
 		"Round trip encoding failed",
 	)
 }
+
+func TestVerifySignedJWT(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	token, err := jwt.BuildSignedJWT(secret)
+	require.NoError(t, err)
+	require.NoError(t, jwt.VerifySignedJWT(secret, token))
+
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+	require.ErrorIs(t, jwt.VerifySignedJWT(other, token), jwt.ErrInvalidJWT)
+	require.ErrorIs(t, jwt.VerifySignedJWT(secret, "token"), jwt.ErrInvalidJWT)
+}
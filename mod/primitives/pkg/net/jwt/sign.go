@@ -27,6 +27,10 @@ import (
 	gjwt "github.com/golang-jwt/jwt/v5"
 )
 
+// MaxIssuedAtDrift is the maximum drift between the issuance time of a JWT
+// and the time it is verified at, as defined by the Engine API specification.
+const MaxIssuedAtDrift = 60 * time.Second
+
 // BuildSignedJWT builds a signed JWT from the provided JWT secret.
 func BuildSignedJWT(s *Secret) (string, error) {
 	token := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
@@ -38,3 +42,21 @@ func BuildSignedJWT(s *Secret) (string, error) {
 	}
 	return str, nil
 }
+
+// VerifySignedJWT verifies that the given JWT is signed with the provided JWT
+// secret and was issued within MaxIssuedAtDrift of the current time.
+func VerifySignedJWT(s *Secret, token string) error {
+	claims := new(gjwt.RegisteredClaims)
+	if _, err := gjwt.ParseWithClaims(
+		token, claims,
+		func(*gjwt.Token) (any, error) { return s[:], nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+	); err != nil {
+		return errors.Wrapf(ErrInvalidJWT, "%w", err)
+	}
+	if claims.IssuedAt == nil ||
+		time.Since(claims.IssuedAt.Time).Abs() > MaxIssuedAtDrift {
+		return errors.Wrap(ErrInvalidJWT, "stale issued at")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package transition
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// BlockRewardsSize is the size of the BlockRewards object in bytes.
+// 7 fields of 8 bytes each.
+const BlockRewardsSize = 56
+
+var _ ssz.StaticObject = (*BlockRewards)(nil)
+
+// BlockRewards is the breakdown of the rewards and penalties applied by the
+// state transition of a single beacon block.
+type BlockRewards struct {
+	// Slot is the slot of the block.
+	Slot math.Slot
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex math.ValidatorIndex
+	// ProposerReward is the reward credited to the proposer for including
+	// the attestations of the block.
+	ProposerReward math.Gwei
+	// ExecutionValue is the value of the execution payload, i.e. the
+	// priority fees paid to the fee recipient of the proposer.
+	ExecutionValue math.Gwei
+	// AttestationRewards is the sum of the attestation rewards applied by
+	// the epoch processing triggered by the block, if any.
+	AttestationRewards math.Gwei
+	// AttestationPenalties is the sum of the attestation and inactivity
+	// penalties applied by the epoch processing triggered by the block,
+	// if any.
+	AttestationPenalties math.Gwei
+	// SlashingPenalties is the sum of the slashing penalties applied by
+	// the epoch processing triggered by the block, if any.
+	SlashingPenalties math.Gwei
+}
+
+// Empty creates an empty BlockRewards.
+func (*BlockRewards) Empty() *BlockRewards {
+	return &BlockRewards{}
+}
+
+// ProposerIncome returns the income of the proposer for the block, which
+// is the sum of its consensus reward and the value of the payload.
+func (r *BlockRewards) ProposerIncome() math.Gwei {
+	return r.ProposerReward + r.ExecutionValue
+}
+
+// SizeSSZ returns the SSZ encoded size of the BlockRewards object in bytes.
+func (*BlockRewards) SizeSSZ() uint32 {
+	return BlockRewardsSize
+}
+
+// DefineSSZ defines the SSZ encoding for the BlockRewards object.
+func (r *BlockRewards) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &r.Slot)
+	ssz.DefineUint64(codec, &r.ProposerIndex)
+	ssz.DefineUint64(codec, &r.ProposerReward)
+	ssz.DefineUint64(codec, &r.ExecutionValue)
+	ssz.DefineUint64(codec, &r.AttestationRewards)
+	ssz.DefineUint64(codec, &r.AttestationPenalties)
+	ssz.DefineUint64(codec, &r.SlashingPenalties)
+}
+
+// MarshalSSZ marshals the BlockRewards object to SSZ format.
+func (r *BlockRewards) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, r.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the BlockRewards object from SSZ format.
+func (r *BlockRewards) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BlockRewards object.
+func (r *BlockRewards) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// ProposerIncome is the income of a proposer aggregated over a range of
+// slots.
+type ProposerIncome struct {
+	// ProposerIndex is the index of the proposer.
+	ProposerIndex math.ValidatorIndex
+	// StartSlot is the first slot of the range, inclusive.
+	StartSlot math.Slot
+	// EndSlot is the last slot of the range, inclusive.
+	EndSlot math.Slot
+	// Blocks is the number of blocks proposed in the range.
+	Blocks uint64
+	// ProposerRewards is the sum of the consensus rewards of the blocks.
+	ProposerRewards math.Gwei
+	// ExecutionValue is the sum of the payload values of the blocks.
+	ExecutionValue math.Gwei
+}
+
+// Add accumulates the rewards of a block proposed in the range.
+func (i *ProposerIncome) Add(r *BlockRewards) {
+	i.Blocks++
+	i.ProposerRewards += r.ProposerReward
+	i.ExecutionValue += r.ExecutionValue
+}
+
+// Total returns the total income of the proposer in the range.
+func (i *ProposerIncome) Total() math.Gwei {
+	return i.ProposerRewards + i.ExecutionValue
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package transition_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRewards_MarshalUnmarshalSSZ(t *testing.T) {
+	rewards := &transition.BlockRewards{
+		Slot:                 10,
+		ProposerIndex:        3,
+		ProposerReward:       100,
+		ExecutionValue:       250,
+		AttestationRewards:   1000,
+		AttestationPenalties: 40,
+		SlashingPenalties:    7,
+	}
+
+	bz, err := rewards.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, transition.BlockRewardsSize)
+
+	decoded := (&transition.BlockRewards{}).Empty()
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	assert.Equal(t, rewards, decoded)
+	assert.Equal(t, rewards.HashTreeRoot(), decoded.HashTreeRoot())
+	assert.Equal(t, math.Gwei(350), decoded.ProposerIncome())
+}
+
+func TestProposerIncome_Add(t *testing.T) {
+	income := &transition.ProposerIncome{ProposerIndex: 3}
+	income.Add(&transition.BlockRewards{
+		ProposerReward: 100, ExecutionValue: 250,
+	})
+	income.Add(&transition.BlockRewards{
+		ProposerReward: 50, ExecutionValue: 0,
+	})
+
+	assert.Equal(t, uint64(2), income.Blocks)
+	assert.Equal(t, math.Gwei(150), income.ProposerRewards)
+	assert.Equal(t, math.Gwei(250), income.ExecutionValue)
+	assert.Equal(t, math.Gwei(400), income.Total())
+}
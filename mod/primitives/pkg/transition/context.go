@@ -38,6 +38,28 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// BlockRewards, if set, collects the rewards and penalties applied by
+	// the state transition of the block.
+	BlockRewards *BlockRewards
+	// CommitVoters are the CometBFT addresses of the validators whose votes
+	// for the previous block were committed in the last commit of the block.
+	CommitVoters [][]byte
+}
+
+// commitVotersKey is the key of the commit voters in a standard context.
+type commitVotersKey struct{}
+
+// WithCommitVoters returns a copy of the standard context carrying the
+// CometBFT addresses of the validators that committed to the previous block.
+func WithCommitVoters(ctx context.Context, voters [][]byte) context.Context {
+	return context.WithValue(ctx, commitVotersKey{}, voters)
+}
+
+// CommitVotersFromContext returns the CometBFT addresses of the validators
+// that committed to the previous block carried by the standard context.
+func CommitVotersFromContext(ctx context.Context) [][]byte {
+	voters, _ := ctx.Value(commitVotersKey{}).([][]byte)
+	return voters
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -65,6 +87,19 @@ func (c *Context) GetSkipValidateResult() bool {
 	return c.SkipValidateResult
 }
 
+// GetBlockRewards returns the collector of the rewards and penalties applied
+// by the state transition, which may be nil.
+func (c *Context) GetBlockRewards() *BlockRewards {
+	return c.BlockRewards
+}
+
+// GetCommitVoters returns the CometBFT addresses of the validators whose
+// votes for the previous block were committed in the last commit of the
+// block.
+func (c *Context) GetCommitVoters() [][]byte {
+	return c.CommitVoters
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
@@ -236,8 +236,12 @@ func (app *BaseApp) PrepareProposal(
 		return nil, errors.New("PrepareProposal called with invalid height")
 	}
 
+	prepareCtx := app.getContextForProposal(
+		app.prepareProposalState.Context(), req.Height,
+	)
 	app.prepareProposalState.SetContext(
-		app.getContextForProposal(app.prepareProposalState.Context(), req.Height).
+		prepareCtx.
+			WithConsensusParams(app.GetConsensusParams(prepareCtx)).
 			WithVoteInfos(toVoteInfo(req.LocalLastCommit.Votes)).
 
 			// this is a set of votes that are not finalized yet, wait for
@@ -333,8 +337,12 @@ func (app *BaseApp) ProcessProposal(
 		app.setState(execModeFinalize, header)
 	}
 
+	processCtx := app.getContextForProposal(
+		app.processProposalState.Context(), req.Height,
+	)
 	app.processProposalState.SetContext(
-		app.getContextForProposal(app.processProposalState.Context(), req.Height).
+		processCtx.
+			WithConsensusParams(app.GetConsensusParams(processCtx)).
 			WithVoteInfos(req.ProposedLastCommit.Votes).
 
 			// this is a set of votes that are not finalized yet, wait for
@@ -378,6 +386,85 @@ func (app *BaseApp) ProcessProposal(
 	return resp, nil
 }
 
+// ExtendVote implements the ExtendVote ABCI method and returns a
+// ResponseExtendVote. It calls the application's ExtendVote handler which is
+// responsible for extending the pre-commit vote of the validator with
+// application-specific data. If the handler is not set or fails, the vote is
+// sent without an extension, so that the validator is still able to vote.
+func (app *BaseApp) ExtendVote(
+	_ context.Context,
+	req *abci.ExtendVoteRequest,
+) (*abci.ExtendVoteResponse, error) {
+	if app.extendVote == nil {
+		return &abci.ExtendVoteResponse{}, nil
+	}
+
+	resp, err := app.extendVote(app.voteExtensionContext(req.Height), req)
+	if err != nil {
+		app.logger.Error(
+			"failed to extend vote",
+			"height",
+			req.Height,
+			"hash",
+			fmt.Sprintf("%X", req.Hash),
+			"err",
+			err,
+		)
+		return &abci.ExtendVoteResponse{}, nil
+	}
+	return resp, nil
+}
+
+// VerifyVoteExtension implements the VerifyVoteExtension ABCI method and
+// returns a ResponseVerifyVoteExtension. It calls the application's
+// VerifyVoteExtension handler which is responsible for verifying the vote
+// extension of another validator during the pre-commit phase. The response
+// MUST be deterministic, any error returned by the handler rejects the vote
+// extension.
+func (app *BaseApp) VerifyVoteExtension(
+	req *abci.VerifyVoteExtensionRequest,
+) (*abci.VerifyVoteExtensionResponse, error) {
+	if app.verifyVoteExt == nil {
+		return &abci.VerifyVoteExtensionResponse{
+			Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		}, nil
+	}
+
+	resp, err := app.verifyVoteExt(app.voteExtensionContext(req.Height), req)
+	if err != nil {
+		app.logger.Error(
+			"failed to verify vote extension",
+			"height",
+			req.Height,
+			"validator",
+			fmt.Sprintf("%X", req.ValidatorAddress),
+			"err",
+			err,
+		)
+		return &abci.VerifyVoteExtensionResponse{
+			Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		}, nil
+	}
+	return resp, nil
+}
+
+// voteExtensionContext returns a context for the vote extension handlers,
+// which is branched from the latest committed state. At the initial height
+// the genesis state is not committed yet, so the finalize block state is
+// used instead.
+func (app *BaseApp) voteExtensionContext(height int64) sdk.Context {
+	var ms storetypes.MultiStore = app.cms.CacheMultiStore()
+	if height == app.initialHeight && app.finalizeBlockState != nil {
+		ms = app.finalizeBlockState.Context().MultiStore()
+	}
+	return sdk.NewContext(ms, false, app.logger).
+		WithBlockHeight(height).
+		WithHeaderInfo(coreheader.Info{
+			ChainID: app.chainID,
+			Height:  height,
+		})
+}
+
 // internalFinalizeBlock executes the block, called by the Optimistic
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state
@@ -71,12 +71,14 @@ type BaseApp struct {
 	cms         storetypes.CommitMultiStore // Main (uncached) state
 	storeLoader StoreLoader                 // function to handle store loading, may be overridden with SetStoreLoader()
 
-	initChainer     sdk.InitChainer            // ABCI InitChain handler
-	preBlocker      sdk.PreBlocker             // logic to run before BeginBlocker
-	beginBlocker    sdk.BeginBlocker           // (legacy ABCI) BeginBlock handler
-	endBlocker      sdk.EndBlocker             // (legacy ABCI) EndBlock handler
-	processProposal sdk.ProcessProposalHandler // ABCI ProcessProposal handler
-	prepareProposal sdk.PrepareProposalHandler // ABCI PrepareProposal handler
+	initChainer     sdk.InitChainer                // ABCI InitChain handler
+	preBlocker      sdk.PreBlocker                 // logic to run before BeginBlocker
+	beginBlocker    sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
+	endBlocker      sdk.EndBlocker                 // (legacy ABCI) EndBlock handler
+	processProposal sdk.ProcessProposalHandler     // ABCI ProcessProposal handler
+	prepareProposal sdk.PrepareProposalHandler     // ABCI PrepareProposal handler
+	extendVote      sdk.ExtendVoteHandler          // ABCI ExtendVote handler
+	verifyVoteExt   sdk.VerifyVoteExtensionHandler // ABCI VerifyVoteExtension handler
 
 	// volatile states:
 	//
@@ -80,30 +80,6 @@ func (app *BaseApp) SnapshotManager() *snapshots.Manager {
 	return &snapshots.Manager{}
 }
 
-func (app *BaseApp) ExtendVote(
-	_ context.Context,
-	_ *abci.ExtendVoteRequest,
-) (*abci.ExtendVoteResponse, error) {
-	return &abci.ExtendVoteResponse{}, nil
-}
-
-// VerifyVoteExtension implements the VerifyVoteExtension ABCI method and
-// returns
-// a ResponseVerifyVoteExtension. It calls the applications' VerifyVoteExtension
-// handler which is responsible for performing application-specific business
-// logic in verifying a vote extension from another validator during the
-// pre-commit
-// phase. The response MUST be deterministic. An error is returned if vote
-// extensions are not enabled or if verifyVoteExt fails or panics.
-// We highly recommend a size validation due to performance degradation,
-// see more here
-// https://docs.cometbft.com/v1.0/references/qa/cometbft-qa-38#vote-extensions-testbed
-func (app *BaseApp) VerifyVoteExtension(
-	*abci.VerifyVoteExtensionRequest,
-) (*abci.VerifyVoteExtensionResponse, error) {
-	return &abci.VerifyVoteExtensionResponse{}, nil
-}
-
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (a *BaseApp) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {}
@@ -42,7 +42,7 @@ import (
 
 // InitGenesis is called by the base app to initialize the state of the.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _, GenesisT, _,
 ]) InitGenesis(
 	ctx context.Context,
 	bz []byte,
@@ -56,6 +56,10 @@ func (h *ABCIMiddleware[
 	if err := json.Unmarshal(bz, data); err != nil {
 		return nil, err
 	}
+	ctx, id := newRequestContext(ctx)
+	valUpdatesCh, cancel := h.valUpdateReplies.Expect(id)
+	defer cancel()
+
 	// Send a request to the chain service to process the genesis data.
 	if err := h.genesisBroker.Publish(ctx, asynctypes.NewEvent(
 		ctx, events.GenesisDataProcessRequest, *data,
@@ -65,7 +69,7 @@ func (h *ABCIMiddleware[
 
 	// Wait for the genesis data to be processed.
 	g.Go(func() error {
-		valUpdates, genesisErr = h.waitForGenesisData(ctx)
+		valUpdates, genesisErr = h.waitForGenesisData(ctx, valUpdatesCh)
 		return genesisErr
 	})
 
@@ -78,11 +82,13 @@ func (h *ABCIMiddleware[
 // waitForGenesisData waits for the genesis data to be processed and returns
 // the validator updates.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
-]) waitForGenesisData(ctx context.Context) (
-	transition.ValidatorUpdates, error) {
+	_, _, _, _, _, _, GenesisT, _,
+]) waitForGenesisData(
+	ctx context.Context,
+	valUpdatesCh <-chan *valUpdatesEvent,
+) (transition.ValidatorUpdates, error) {
 	select {
-	case msg := <-h.valUpdateSub:
+	case msg := <-valUpdatesCh:
 		if msg.Type() != events.ValidatorSetUpdated {
 			return nil, errors.Wrapf(
 				ErrUnexpectedEvent,
@@ -101,7 +107,7 @@ func (h *ABCIMiddleware[
 
 // prepareProposal is the internal handler for preparing proposals.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, SlotDataT,
+	_, _, _, _, _, _, _, SlotDataT,
 ]) PrepareProposal(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -114,6 +120,16 @@ func (h *ABCIMiddleware[
 	)
 	defer h.metrics.measurePrepareProposalDuration(startTime)
 
+	// The beacon block and the blob sidecars, or the data column sidecars
+	// once PeerDAS is active, both reply to the request.
+	ctx, id := newRequestContext(ctx)
+	blkCh, cancelBlk := h.blkReplies.Expect(id)
+	defer cancelBlk()
+	sidecarsCh, cancelSidecars := h.sidecarsReplies.Expect(id)
+	defer cancelSidecars()
+	columnsCh, cancelColumns := h.columnsReplies.Expect(id)
+	defer cancelColumns()
+
 	// Send a request to the validator service to give us a beacon block
 	// and blob sidecards to pass to ABCI.
 	if err := h.slotBroker.Publish(ctx, asynctypes.NewEvent(
@@ -124,13 +140,17 @@ func (h *ABCIMiddleware[
 
 	// Wait for the beacon block to be built.
 	g.Go(func() error {
-		beaconBlockBz, beaconBlockErr = h.waitforBeaconBlk(ctx)
+		beaconBlockBz, beaconBlockErr = h.waitforBeaconBlk(ctx, blkCh)
 		return beaconBlockErr
 	})
 
 	// Wait for the sidecars to be built.
 	g.Go(func() error {
-		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx)
+		if h.chainSpec.PeerDASActiveForSlot(slotData.GetSlot()) {
+			sidecarsBz, sidecarsErr = h.waitForDataColumns(ctx, columnsCh)
+			return sidecarsErr
+		}
+		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx, sidecarsCh)
 		return sidecarsErr
 	})
 
@@ -141,12 +161,15 @@ func (h *ABCIMiddleware[
 
 // waitForSidecars waits for the sidecars to be built and returns them.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
-]) waitForSidecars(ctx context.Context) ([]byte, error) {
+	_, _, BlobSidecarsT, _, _, _, _, _,
+]) waitForSidecars(
+	ctx context.Context,
+	sidecarsCh <-chan *asynctypes.Event[BlobSidecarsT],
+) ([]byte, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
-	case msg := <-h.sidecarsCh:
+	case msg := <-sidecarsCh:
 		if msg.Error() != nil {
 			return nil, msg.Error()
 		}
@@ -154,14 +177,36 @@ func (h *ABCIMiddleware[
 	}
 }
 
+// waitForDataColumns waits for the data column sidecars to be built and
+// returns them.
+func (h *ABCIMiddleware[
+	_, _, _, DataColumnSidecarsT, _, _, _, _,
+]) waitForDataColumns(
+	ctx context.Context,
+	columnsCh <-chan *asynctypes.Event[DataColumnSidecarsT],
+) ([]byte, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg := <-columnsCh:
+		if msg.Error() != nil {
+			return nil, msg.Error()
+		}
+		return msg.Data().MarshalSSZ()
+	}
+}
+
 // waitforBeaconBlk waits for the beacon block to be built and returns it.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
-]) waitforBeaconBlk(ctx context.Context) ([]byte, error) {
+	_, BeaconBlockT, _, _, _, _, _, _,
+]) waitforBeaconBlk(
+	ctx context.Context,
+	blkCh <-chan *asynctypes.Event[BeaconBlockT],
+) ([]byte, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
-	case beaconBlock := <-h.blkCh:
+	case beaconBlock := <-blkCh:
 		if beaconBlock.Error() != nil {
 			return nil, beaconBlock.Error()
 		}
@@ -179,7 +224,7 @@ func (h *ABCIMiddleware[
 // ProcessProposal processes the proposal for the ABCI middleware.
 // It handles both the beacon block and blob sidecars concurrently.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, BlobSidecarsT, DataColumnSidecarsT, _, _, _, _,
 ]) ProcessProposal(
 	ctx context.Context,
 	req proto.Message,
@@ -187,6 +232,7 @@ func (h *ABCIMiddleware[
 	var (
 		blk       BeaconBlockT
 		sidecars  BlobSidecarsT
+		columns   DataColumnSidecarsT
 		err       error
 		g, _      = errgroup.WithContext(ctx)
 		startTime = time.Now()
@@ -197,6 +243,7 @@ func (h *ABCIMiddleware[
 	}
 
 	defer h.metrics.measureProcessProposalDuration(startTime)
+	ctx = withCommitVoters(ctx, abciReq.ProposedLastCommit.Votes)
 
 	// Request the beacon block.
 	if blk, err = h.beaconBlockGossiper.Request(ctx, abciReq); err != nil {
@@ -208,6 +255,26 @@ func (h *ABCIMiddleware[
 		return h.verifyBeaconBlock(ctx, blk)
 	})
 
+	// Once PeerDAS is active the proposal carries data column sidecars in
+	// place of the blob sidecars.
+	if h.chainSpec.PeerDASActiveForSlot(blk.GetSlot()) {
+		columns, err = encoding.
+			UnmarshalBlobSidecarsFromABCIRequest[DataColumnSidecarsT](
+			abciReq, BlobSidecarsTxIndex,
+		)
+		if err != nil {
+			return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		}
+
+		// Begin sampling the data column sidecars.
+		g.Go(func() error {
+			return h.publishDataColumns(
+				ctx, events.DataColumnsReceived, columns,
+			)
+		})
+		return h.createProcessProposalResponse(g.Wait())
+	}
+
 	// Request the blob sidecars.
 	if sidecars, err = h.blobGossiper.Request(ctx, abciReq); err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
@@ -227,11 +294,15 @@ func (h *ABCIMiddleware[
 // It requests the block, publishes a received event, and waits for
 // verification.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, BlobSidecarsT, _, _, _, _, _,
 ]) verifyBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
 ) error {
+	ctx, id := newRequestContext(ctx)
+	blkCh, cancel := h.blkReplies.Expect(id)
+	defer cancel()
+
 	// Publish the received event.
 	if err := h.blkBroker.Publish(
 		ctx,
@@ -244,7 +315,7 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case msg := <-h.blkCh:
+	case msg := <-blkCh:
 		if msg.Type() != events.BeaconBlockVerified {
 			return errors.Wrapf(
 				ErrUnexpectedEvent, "unexpected event type: %s", msg.Type(),
@@ -258,11 +329,15 @@ func (h *ABCIMiddleware[
 // It requests the sidecars, publishes a received event, and waits for
 // processing.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, BlobSidecarsT, _, _, _, _, _,
 ]) verifyBlobSidecars(
 	ctx context.Context,
 	sidecars BlobSidecarsT,
 ) error {
+	ctx, id := newRequestContext(ctx)
+	sidecarsCh, cancel := h.sidecarsReplies.Expect(id)
+	defer cancel()
+
 	// Publish the received event.
 	if err := h.sidecarsBroker.Publish(
 		ctx,
@@ -275,7 +350,7 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case msg := <-h.sidecarsCh:
+	case msg := <-sidecarsCh:
 		if msg.Type() != events.BlobSidecarsProcessed {
 			return errors.Wrapf(
 				ErrUnexpectedEvent, "unexpected event type: %s", msg.Type(),
@@ -285,10 +360,42 @@ func (h *ABCIMiddleware[
 	}
 }
 
+// publishDataColumns publishes the data column sidecars under the given
+// event type and waits for the DA service to reply.
+func (h *ABCIMiddleware[
+	_, _, _, DataColumnSidecarsT, _, _, _, _,
+]) publishDataColumns(
+	ctx context.Context,
+	eventType asynctypes.EventID,
+	columns DataColumnSidecarsT,
+) error {
+	ctx, id := newRequestContext(ctx)
+	columnsCh, cancel := h.columnsReplies.Expect(id)
+	defer cancel()
+
+	if err := h.columnsBroker.Publish(
+		ctx, asynctypes.NewEvent(ctx, eventType, columns),
+	); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case msg := <-columnsCh:
+		if msg.Type() != events.DataColumnsProcessed {
+			return errors.Wrapf(
+				ErrUnexpectedEvent, "unexpected event type: %s", msg.Type(),
+			)
+		}
+		return msg.Error()
+	}
+}
+
 // createResponse generates the appropriate ProcessProposalResponse based on the
 // error.
 func (*ABCIMiddleware[
-	_, BeaconBlockT, _, BlobSidecarsT, _, _, _,
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _,
 ]) createProcessProposalResponse(
 	err error,
 ) (proto.Message, error) {
@@ -308,7 +415,7 @@ func (*ABCIMiddleware[
 // is responsible for aggregating oracle data from each validator and writing
 // the oracle data to the store.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) PreBlock(
 	_ context.Context, req proto.Message,
 ) error {
@@ -323,18 +430,42 @@ func (h *ABCIMiddleware[
 
 // EndBlock returns the validator set updates from the beacon state.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, BlobSidecarsT, DataColumnSidecarsT, _, _, _, _,
 ]) EndBlock(
 	ctx context.Context,
 ) (transition.ValidatorUpdates, error) {
+	slot := math.Slot(h.req.Height)
+	ctx = withCommitVoters(ctx, h.req.DecidedLastCommit.Votes)
+	if h.chainSpec.PeerDASActiveForSlot(slot) {
+		blk, columns, err := encoding.
+			ExtractBlobsAndBlockFromRequest[BeaconBlockT, DataColumnSidecarsT](
+			h.req,
+			BeaconBlockTxIndex,
+			BlobSidecarsTxIndex,
+			h.chainSpec.ActiveForkVersionForSlot(slot),
+		)
+		if err != nil {
+			// If we don't have a block, we can't do anything.
+			//nolint:nilerr // by design.
+			return nil, nil
+		}
+
+		// Send the data columns to the DA service to be stored.
+		if err = h.publishDataColumns(
+			ctx, events.DataColumnsProcessRequest, columns,
+		); err != nil {
+			return nil, err
+		}
+		return h.processBeaconBlock(ctx, blk)
+	}
+
 	blk, blobs, err := encoding.
 		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](
 		h.req,
 		BeaconBlockTxIndex,
 		BlobSidecarsTxIndex,
-		h.chainSpec.ActiveForkVersionForSlot(
-			math.Slot(h.req.Height),
-		))
+		h.chainSpec.ActiveForkVersionForSlot(slot),
+	)
 	if err != nil {
 		// If we don't have a block, we can't do anything.
 		//nolint:nilerr // by design.
@@ -354,8 +485,12 @@ func (h *ABCIMiddleware[
 
 // processSidecars publishes the sidecars and waits for a response.
 func (h *ABCIMiddleware[
-	_, _, BlobSidecarsT, _, _, _, _,
+	_, _, BlobSidecarsT, _, _, _, _, _,
 ]) processSidecars(ctx context.Context, blobs BlobSidecarsT) error {
+	ctx, id := newRequestContext(ctx)
+	sidecarsCh, cancel := h.sidecarsReplies.Expect(id)
+	defer cancel()
+
 	// Publish the sidecars.
 	if err := h.sidecarsBroker.Publish(ctx, asynctypes.NewEvent(
 		ctx, events.BlobSidecarsProcessRequest, blobs,
@@ -367,7 +502,7 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case msg := <-h.sidecarsCh:
+	case msg := <-sidecarsCh:
 		if msg.Type() != events.BlobSidecarsProcessed {
 			return errors.Wrapf(
 				ErrUnexpectedEvent,
@@ -380,10 +515,14 @@ func (h *ABCIMiddleware[
 
 // processBeaconBlock processes the beacon block and returns validator updates.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _,
 ]) processBeaconBlock(
 	ctx context.Context, blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
+	ctx, id := newRequestContext(ctx)
+	valUpdatesCh, cancel := h.valUpdateReplies.Expect(id)
+	defer cancel()
+
 	// Publish the verified block event.
 	if err := h.blkBroker.Publish(
 		ctx, asynctypes.NewEvent(
@@ -394,7 +533,7 @@ func (h *ABCIMiddleware[
 
 	// Wait for the block to be processed.
 	select {
-	case msg := <-h.valUpdateSub:
+	case msg := <-valUpdatesCh:
 		if msg.Type() != events.ValidatorSetUpdated {
 			return nil, errors.Wrapf(
 				ErrUnexpectedEvent,
@@ -35,8 +35,12 @@ import (
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
 )
 
+// valUpdatesEvent is the event carrying the validator set updates.
+type valUpdatesEvent = asynctypes.Event[transition.ValidatorUpdates]
+
 // ABCIMiddleware is a middleware between ABCI and the validator logic.
 type ABCIMiddleware[
 	AvailabilityStoreT any,
@@ -45,10 +49,14 @@ type ABCIMiddleware[
 		constraints.SSZMarshallable
 		Empty() BlobSidecarsT
 	},
+	DataColumnSidecarsT interface {
+		constraints.SSZMarshallable
+		Empty() DataColumnSidecarsT
+	},
 	DepositT,
 	ExecutionPayloadT any,
 	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	SlotDataT SlotData,
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
@@ -81,17 +89,26 @@ type ABCIMiddleware[
 	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]]
 	// sidecarsBroker is a feed for sidecars.
 	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]]
+	// columnsBroker is a feed for data column sidecars.
+	columnsBroker *broker.Broker[*asynctypes.Event[DataColumnSidecarsT]]
 	// slotBroker is a feed for slots.
 	slotBroker *broker.Broker[*asynctypes.Event[SlotDataT]]
 
 	// TODO: this is a temporary hack.
 	req *cmtabci.FinalizeBlockRequest
 
-	// Channels
-	// blkCh is used to communicate the beacon block to the EndBlock method.
-	blkCh chan *asynctypes.Event[BeaconBlockT]
-	// sidecarsCh is used to communicate the sidecars to the EndBlock method.
-	sidecarsCh chan *asynctypes.Event[BlobSidecarsT]
+	// Replies
+	//
+	// blkReplies routes the beacon block replies to the awaiting request.
+	blkReplies *broker.Replies[*asynctypes.Event[BeaconBlockT]]
+	// sidecarsReplies routes the sidecars replies to the awaiting request.
+	sidecarsReplies *broker.Replies[*asynctypes.Event[BlobSidecarsT]]
+	// columnsReplies routes the data column sidecars replies to the awaiting
+	// request.
+	columnsReplies *broker.Replies[*asynctypes.Event[DataColumnSidecarsT]]
+	// valUpdateReplies routes the validator set updates to the awaiting
+	// request.
+	valUpdateReplies *broker.Replies[*valUpdatesEvent]
 	// valUpdateSub is the channel for listening for incoming validator set
 	// updates.
 	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates]
@@ -105,10 +122,14 @@ func NewABCIMiddleware[
 		constraints.SSZMarshallable
 		Empty() BlobSidecarsT
 	},
+	DataColumnSidecarsT interface {
+		constraints.SSZMarshallable
+		Empty() DataColumnSidecarsT
+	},
 	DepositT,
 	ExecutionPayloadT any,
 	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	SlotDataT SlotData,
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
@@ -116,15 +137,17 @@ func NewABCIMiddleware[
 	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]],
 	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]],
 	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]],
+	columnsBroker *broker.Broker[*asynctypes.Event[DataColumnSidecarsT]],
 	slotBroker *broker.Broker[*asynctypes.Event[SlotDataT]],
 	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates],
 ) *ABCIMiddleware[
-	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-	ExecutionPayloadT, GenesisT, SlotDataT,
+	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DataColumnSidecarsT,
+	DepositT, ExecutionPayloadT, GenesisT, SlotDataT,
 ] {
+	columnsReplies := broker.NewReplies[*asynctypes.Event[DataColumnSidecarsT]]()
 	return &ABCIMiddleware[
-		AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-		ExecutionPayloadT, GenesisT, SlotDataT,
+		AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DataColumnSidecarsT,
+		DepositT, ExecutionPayloadT, GenesisT, SlotDataT,
 	]{
 		chainSpec: chainSpec,
 		blobGossiper: rp2p.NewNoopBlobHandler[
@@ -136,35 +159,32 @@ func NewABCIMiddleware[
 		](
 			chainSpec,
 		),
-		logger:         logger,
-		metrics:        newABCIMiddlewareMetrics(telemetrySink),
-		genesisBroker:  genesisBroker,
-		blkBroker:      blkBroker,
-		sidecarsBroker: sidecarsBroker,
-		slotBroker:     slotBroker,
-		blkCh: make(
-			chan *asynctypes.Event[BeaconBlockT],
-			1,
-		),
-		sidecarsCh: make(
-			chan *asynctypes.Event[BlobSidecarsT],
-			1,
-		),
-		valUpdateSub: valUpdateSub,
+		logger:           logger,
+		metrics:          newABCIMiddlewareMetrics(telemetrySink),
+		genesisBroker:    genesisBroker,
+		blkBroker:        blkBroker,
+		sidecarsBroker:   sidecarsBroker,
+		columnsBroker:    columnsBroker,
+		slotBroker:       slotBroker,
+		blkReplies:       broker.NewReplies[*asynctypes.Event[BeaconBlockT]](),
+		sidecarsReplies:  broker.NewReplies[*asynctypes.Event[BlobSidecarsT]](),
+		columnsReplies:   columnsReplies,
+		valUpdateReplies: broker.NewReplies[*valUpdatesEvent](),
+		valUpdateSub:     valUpdateSub,
 	}
 }
 
 // Name returns the name of the middleware.
 func (am *ABCIMiddleware[
-	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-	ExecutionPayloadT, GenesisT, SlotDataT,
+	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DataColumnSidecarsT,
+	DepositT, ExecutionPayloadT, GenesisT, SlotDataT,
 ]) Name() string {
 	return "abci-middleware"
 }
 
 // Start the middleware.
 func (am *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
 	subBlkCh, err := am.blkBroker.Subscribe()
 	if err != nil {
@@ -176,17 +196,24 @@ func (am *ABCIMiddleware[
 		return err
 	}
 
-	go am.start(ctx, subBlkCh, subSidecarsCh)
+	subColumnsCh, err := am.columnsBroker.Subscribe()
+	if err != nil {
+		return err
+	}
+
+	go am.start(ctx, subBlkCh, subSidecarsCh, subColumnsCh)
 	return nil
 }
 
-// start starts the middleware.
+// start starts the middleware, routing the replies of the services to the
+// requests awaiting them.
 func (am *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, BlobSidecarsT, DataColumnSidecarsT, _, _, _, _,
 ]) start(
 	ctx context.Context,
 	blkCh chan *asynctypes.Event[BeaconBlockT],
 	sidecarsCh chan *asynctypes.Event[BlobSidecarsT],
+	columnsCh chan *asynctypes.Event[DataColumnSidecarsT],
 ) {
 	for {
 		select {
@@ -197,15 +224,72 @@ func (am *ABCIMiddleware[
 			case events.BeaconBlockBuilt:
 				fallthrough
 			case events.BeaconBlockVerified:
-				am.blkCh <- msg
+				if !am.blkReplies.Deliver(msg) {
+					am.logStaleReply(msg.Type(), msg.CorrelationID())
+				}
 			}
 		case msg := <-sidecarsCh:
 			switch msg.Type() {
 			case events.BlobSidecarsBuilt:
 				fallthrough
 			case events.BlobSidecarsProcessed:
-				am.sidecarsCh <- msg
+				if !am.sidecarsReplies.Deliver(msg) {
+					am.logStaleReply(msg.Type(), msg.CorrelationID())
+				}
+			}
+		case msg := <-columnsCh:
+			switch msg.Type() {
+			case events.DataColumnsBuilt:
+				fallthrough
+			case events.DataColumnsProcessed:
+				if !am.columnsReplies.Deliver(msg) {
+					am.logStaleReply(msg.Type(), msg.CorrelationID())
+				}
+			}
+		case msg := <-am.valUpdateSub:
+			if !am.valUpdateReplies.Deliver(msg) {
+				am.logStaleReply(msg.Type(), msg.CorrelationID())
 			}
 		}
 	}
 }
+
+// logStaleReply logs a reply no request is awaiting anymore, e.g. because
+// the request timed out.
+func (am *ABCIMiddleware[
+	_, _, _, _, _, _, _, _,
+]) logStaleReply(
+	eventType asynctypes.EventID,
+	id asynctypes.CorrelationID,
+) {
+	am.logger.Warn(
+		"Dropping stale reply",
+		"event", eventType,
+		"correlation_id", id,
+	)
+}
+
+// newRequestContext tags the context with a new CorrelationID, which the
+// replies to the request carry.
+func newRequestContext(
+	ctx context.Context,
+) (context.Context, asynctypes.CorrelationID) {
+	id := asynctypes.NewCorrelationID()
+	return asynctypes.WithCorrelationID(ctx, id), id
+}
+
+// withCommitVoters tags the context with the CometBFT addresses of the
+// validators whose votes for the previous block were committed, which the
+// state transition credits.
+func withCommitVoters(
+	ctx context.Context,
+	votes []cmtabci.VoteInfo,
+) context.Context {
+	voters := make([][]byte, 0, len(votes))
+	for _, vote := range votes {
+		if vote.BlockIdFlag == cmttypes.BlockIDFlagCommit {
+			voters = append(voters, vote.Validator.Address)
+		}
+	}
+	return transition.WithCommitVoters(ctx, voters)
+}
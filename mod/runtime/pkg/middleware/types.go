@@ -39,6 +39,12 @@ type BeaconBlock[SelfT any] interface {
 	NewFromSSZ([]byte, uint32) (SelfT, error)
 }
 
+// SlotData is an interface for accessing the slot of a proposal request.
+type SlotData interface {
+	// GetSlot returns the slot to propose a block for.
+	GetSlot() math.Slot
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
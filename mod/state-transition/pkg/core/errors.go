@@ -46,13 +46,9 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
-	// ErrRewardsLengthMismatch is returned when the length of the rewards
-	// in a block does not match the expected value.
-	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
-
-	// ErrPenaltiesLengthMismatch is returned when the length of the penalties
-	// in a block does not match the expected value.
-	ErrPenaltiesLengthMismatch = errors.New("penalties length mismatch")
+	// ErrInvalidDepositSignature is returned when the signature of a deposit
+	// creating a validator is invalid.
+	ErrInvalidDepositSignature = errors.New("invalid deposit signature")
 
 	// ErrExceedsBlockBlobLimit is returned when the block exceeds the blob
 	// limit.
@@ -66,4 +62,19 @@ var (
 	// ErrStateRootMismatch is returned when the state root in a block header
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
+
+	// ErrUnsupportedForkUpgrade is returned when the state is asked to
+	// upgrade to a fork it does not know how to migrate to.
+	ErrUnsupportedForkUpgrade = errors.New("unsupported fork upgrade")
+
+	// ErrExecutionRequestsMismatch is returned when the fork version of a
+	// block body, which selects whether it carries the execution requests,
+	// does not match the active fork.
+	ErrExecutionRequestsMismatch = errors.New(
+		"execution requests do not match the active fork")
+
+	// ErrInvalidAttestation is returned when an attestation included in a
+	// block body does not attest to the previous slot of a known validator,
+	// or when the attestations are not sorted by validator index.
+	ErrInvalidAttestation = errors.New("invalid attestation")
 )
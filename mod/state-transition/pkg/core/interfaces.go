@@ -23,6 +23,7 @@ package core
 import (
 	"context"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -84,6 +85,18 @@ type ReadOnlyBeaconState[
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	GetTotalValidators() (uint64, error)
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	GetValidatorPower(math.ValidatorIndex) (math.Gwei, error)
+	GetActiveValidatorIndices() ([]math.ValidatorIndex, error)
+	GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+	GetCurrentEpochParticipation(math.ValidatorIndex) (byte, error)
+	GetInactivityScore(math.ValidatorIndex) (math.U64, error)
+	GetExitBalanceToConsume() (math.Gwei, error)
+	GetEarliestExitEpoch() (math.Epoch, error)
+	GetConsolidationBalanceToConsume() (math.Gwei, error)
+	GetEarliestConsolidationEpoch() (math.Epoch, error)
+	GetPendingConsolidations() (
+		[]*engineprimitives.PendingConsolidation, error,
+	)
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
@@ -110,6 +123,17 @@ type WriteOnlyBeaconState[
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 	SetTotalSlashing(math.Gwei) error
+	SetValidatorPower(math.ValidatorIndex, math.Gwei) error
+	SetPreviousEpochParticipation(math.ValidatorIndex, byte) error
+	SetCurrentEpochParticipation(math.ValidatorIndex, byte) error
+	RotateEpochParticipation() error
+	SetInactivityScore(math.ValidatorIndex, math.U64) error
+	AppendHistoricalSummary([]common.Root, common.Root, common.Root) error
+	SetExitBalanceToConsume(math.Gwei) error
+	SetEarliestExitEpoch(math.Epoch) error
+	SetConsolidationBalanceToConsume(math.Gwei) error
+	SetEarliestConsolidationEpoch(math.Epoch) error
+	SetPendingConsolidations([]*engineprimitives.PendingConsolidation) error
 }
 
 // WriteOnlyStateRoots defines a struct which only has write access to state
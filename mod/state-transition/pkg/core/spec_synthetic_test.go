@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/spectest"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpec_Synthetic runs the runner over vectors generated in the layout of
+// consensus-spec-tests, so that it is exercised without the real vectors.
+func TestSpec_Synthetic(t *testing.T) {
+	var (
+		root   = t.TempDir()
+		p      = spectest.Presets[spectest.PresetMinimal]
+		pre    = newSyntheticState(t, p)
+		epoch0 = common.Bytes32{0xaa}
+	)
+	pre.Slot = math.Slot(p.SlotsPerEpoch - 1)
+	pre.RandaoMixes[0] = epoch0
+	pre.Slashings[1] = 5e9
+	post := *pre
+
+	// Epoch processing.
+	post.RandaoMixes = append([]common.Bytes32(nil), pre.RandaoMixes...)
+	post.RandaoMixes[1] = epoch0
+	writeCase(t, root, "epoch_processing", "randao_mixes_reset", "mix",
+		map[string]*spectest.BeaconState{"pre": pre, "post": &post}, nil)
+
+	post = *pre
+	post.Slashings = make([]uint64, len(pre.Slashings))
+	writeCase(t, root, "epoch_processing", "slashings_reset", "reset",
+		map[string]*spectest.BeaconState{"pre": pre, "post": &post}, nil)
+
+	// Participation flags, moved to the previous epoch.
+	participating := *pre
+	participating.PreviousEpochParticipation = []byte{0b001, 0b000}
+	participating.CurrentEpochParticipation = []byte{0b111, 0b011}
+	post = participating
+	post.PreviousEpochParticipation = participating.CurrentEpochParticipation
+	post.CurrentEpochParticipation = []byte{0, 0}
+	writeCase(t, root, "epoch_processing", "participation_flag_updates",
+		"rotate",
+		map[string]*spectest.BeaconState{"pre": &participating, "post": &post},
+		nil)
+
+	// Historical summaries, at the end of a period.
+	periodEnd := *pre
+	periodEnd.Slot = math.Slot(p.SlotsPerHistoricalRoot - 1)
+	periodEnd.BlockRoots = make([]common.Root, p.SlotsPerHistoricalRoot)
+	periodEnd.StateRoots = make([]common.Root, p.SlotsPerHistoricalRoot)
+	for i := range periodEnd.BlockRoots {
+		periodEnd.BlockRoots[i] = common.Root{0x01, byte(i)}
+		periodEnd.StateRoots[i] = common.Root{0x02, byte(i)}
+	}
+	post = periodEnd
+	post.HistoricalSummaries = []*types.HistoricalSummary{{
+		BlockSummaryRoot: vectorRoot(periodEnd.BlockRoots),
+		StateSummaryRoot: vectorRoot(periodEnd.StateRoots),
+	}}
+	writeCase(t, root, "epoch_processing", "historical_summaries_update",
+		"period_end",
+		map[string]*spectest.BeaconState{"pre": &periodEnd, "post": &post},
+		nil)
+	writeCase(t, root, "epoch_processing", "historical_summaries_update",
+		"mid_period",
+		map[string]*spectest.BeaconState{"pre": pre, "post": pre}, nil)
+
+	// Slots, across an epoch boundary.
+	post = *pre
+	post.Slot = pre.Slot + 2
+	post.RandaoMixes = append([]common.Bytes32(nil), pre.RandaoMixes...)
+	post.RandaoMixes[1] = epoch0
+	post.Slashings = make([]uint64, len(pre.Slashings))
+	writeCase(t, root, "sanity", "slots", "two_slots",
+		map[string]*spectest.BeaconState{"pre": pre, "post": &post}, nil)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(caseDir(root, "sanity", "slots", "two_slots"),
+			"slots.yaml"),
+		[]byte("2\n"), 0o600,
+	))
+
+	// SSZ static.
+	fork := &types.Fork{CurrentVersion: common.Version{4}, Epoch: 3}
+	writeCase(t, root, "ssz_static", "Fork", "case_0", nil,
+		map[string][]byte{"serialized": mustMarshal(t, fork)})
+	require.NoError(t, os.WriteFile(
+		filepath.Join(caseDir(root, "ssz_static", "Fork", "case_0"),
+			"roots.yaml"),
+		[]byte("root: '"+fork.HashTreeRoot().String()+"'\n"), 0o600,
+	))
+
+	// A handler beacon-kit skips, which is walked but not run.
+	writeCase(t, root, "operations", "attestation", "skipped",
+		map[string]*spectest.BeaconState{"pre": pre}, nil)
+
+	runSpecTests(t, root)
+}
+
+func TestSpecHandlerFor(t *testing.T) {
+	run, _, err := specHandlerFor(spectest.Case{
+		Runner: "sanity", Handler: "slots",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, run)
+
+	run, reason, err := specHandlerFor(spectest.Case{
+		Runner: "operations", Handler: "attestation",
+	})
+	require.NoError(t, err)
+	require.Nil(t, run)
+	require.NotEmpty(t, reason)
+
+	run, reason, err = specHandlerFor(spectest.Case{
+		Runner: "fork_choice", Handler: "on_block",
+	})
+	require.NoError(t, err)
+	require.Nil(t, run)
+	require.NotEmpty(t, reason)
+
+	_, _, err = specHandlerFor(spectest.Case{
+		Runner: "operations", Handler: "consolidation_request",
+	})
+	require.ErrorIs(t, err, spectest.ErrUnknownHandler)
+}
+
+// newSyntheticState returns a state with a couple of active validators.
+func newSyntheticState(
+	t *testing.T,
+	p spectest.Preset,
+) *spectest.BeaconState {
+	t.Helper()
+	st := spectest.NewBeaconState(p)
+	for i := range 2 {
+		st.Validators = append(st.Validators, &types.Validator{
+			Pubkey:            [48]byte{byte(i + 1)},
+			EffectiveBalance:  math.Gwei(32e9),
+			ExitEpoch:         math.Epoch(^uint64(0)),
+			WithdrawableEpoch: math.Epoch(^uint64(0)),
+		})
+		st.Balances = append(st.Balances, 32e9)
+	}
+	return st
+}
+
+// caseDir returns the directory of a synthetic minimal preset case.
+func caseDir(root, runner, handler, name string) string {
+	return filepath.Join(
+		root, spectest.PresetMinimal, spectest.ForkDeneb,
+		runner, handler, "pyspec_tests", name,
+	)
+}
+
+// writeCase writes the states and objects of a synthetic case.
+func writeCase(
+	t *testing.T,
+	root, runner, handler, name string,
+	states map[string]*spectest.BeaconState,
+	objects map[string][]byte,
+) {
+	t.Helper()
+	dir := caseDir(root, runner, handler, name)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	files := make(map[string][]byte, len(states)+len(objects))
+	for file, st := range states {
+		files[file] = mustMarshal(t, st)
+	}
+	for file, bz := range objects {
+		files[file] = bz
+	}
+	for file, bz := range files {
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, file+".ssz_snappy"),
+			snappy.Encode(nil, bz), 0o600,
+		))
+	}
+}
+
+func mustMarshal(
+	t *testing.T,
+	obj interface{ MarshalSSZ() ([]byte, error) },
+) []byte {
+	t.Helper()
+	bz, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	return bz
+}
+
+// vectorRoot returns the hash tree root of a vector of roots, whose length is
+// a power of two.
+func vectorRoot(roots []common.Root) common.Root {
+	if len(roots) == 1 {
+		return roots[0]
+	}
+	left := vectorRoot(roots[:len(roots)/2])
+	right := vectorRoot(roots[len(roots)/2:])
+	return sha256.Sum256(append(left[:], right[:]...))
+}
@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/spectest"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	specBeaconState = state.StateDB[
+		*types.BeaconBlockHeader,
+		*types.BeaconState[
+			*types.BeaconBlockHeader,
+			*types.Eth1Data,
+			*types.ExecutionPayloadHeader,
+			*types.Fork,
+			*types.Validator,
+			types.BeaconBlockHeader,
+			types.Eth1Data,
+			types.ExecutionPayloadHeader,
+			types.Fork,
+			types.Validator,
+		],
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.HistoricalSummary,
+		*spectest.KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+
+	specStateProcessor = StateProcessor[
+		*types.AttestationData,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*specBeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*spectest.KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	]
+
+	// specHandler runs a single case of a handler.
+	specHandler func(
+		t *testing.T, sp *specStateProcessor, c spectest.Case, p spectest.Preset,
+	)
+)
+
+// specEngine is an execution engine whose verdict on every payload is set
+// by the case being run.
+type specEngine struct {
+	valid bool
+}
+
+func (e *specEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, engineprimitives.Withdrawals,
+	],
+) error {
+	if !e.valid {
+		return engineerrors.ErrInvalidPayloadStatus
+	}
+	return nil
+}
+
+// specHandlers maps runner/handler to the function running its cases.
+//
+//nolint:gochecknoglobals // test table.
+var specHandlers = map[string]specHandler{
+	"operations/execution_payload":                 runExecutionPayload,
+	"epoch_processing/slashings_reset":             runSlashingsReset,
+	"epoch_processing/randao_mixes_reset":          runRandaoMixesReset,
+	"epoch_processing/historical_summaries_update": runHistoricalSummariesUpdate,
+	"epoch_processing/participation_flag_updates":  runParticipationFlagUpdates,
+	"sanity/slots":                      runSlots,
+	"ssz_static/BeaconBlockHeader":      runSSZStatic[types.BeaconBlockHeader],
+	"ssz_static/DepositMessage":         runSSZStatic[types.DepositMessage],
+	"ssz_static/Eth1Data":               runSSZStatic[types.Eth1Data],
+	"ssz_static/ExecutionPayload":       runSSZStatic[types.ExecutionPayload],
+	"ssz_static/ExecutionPayloadHeader": runSSZStatic[types.ExecutionPayloadHeader],
+	"ssz_static/Fork":                   runSSZStatic[types.Fork],
+	"ssz_static/ForkData":               runSSZStatic[types.ForkData],
+	"ssz_static/HistoricalSummary":      runSSZStatic[types.HistoricalSummary],
+	"ssz_static/SigningData":            runSSZStatic[types.SigningData],
+	"ssz_static/Validator":              runSSZStatic[types.Validator],
+	"ssz_static/Withdrawal":             runSSZStatic[engineprimitives.Withdrawal],
+}
+
+// TestSpec runs the consensus-spec-tests vectors found under the directory
+// set in spectest.EnvDir.
+func TestSpec(t *testing.T) {
+	root := os.Getenv(spectest.EnvDir)
+	if root == "" {
+		t.Skipf("%s is not set", spectest.EnvDir)
+	}
+	runSpecTests(t, root)
+}
+
+// runSpecTests runs every handler of the vectors under root, failing on the
+// handlers that are neither run nor skipped.
+func runSpecTests(t *testing.T, root string) {
+	t.Helper()
+	for name, preset := range spectest.Presets {
+		t.Run(name, func(t *testing.T) {
+			engine := &specEngine{valid: true}
+			sp := newSpecStateProcessor(preset, engine)
+			runners, err := spectest.Runners(root, name, spectest.ForkDeneb)
+			require.NoError(t, err)
+			for _, runner := range runners {
+				var handlers []string
+				handlers, err = spectest.Handlers(
+					root, name, spectest.ForkDeneb, runner,
+				)
+				require.NoError(t, err)
+				for _, handler := range handlers {
+					t.Run(path.Join(runner, handler), func(t *testing.T) {
+						runSpecHandler(t, sp, engine, spectest.Case{
+							Preset:  name,
+							Fork:    spectest.ForkDeneb,
+							Runner:  runner,
+							Handler: handler,
+						}, root, preset)
+					})
+				}
+			}
+		})
+	}
+}
+
+// runSpecHandler runs every case of the handler h.
+func runSpecHandler(
+	t *testing.T,
+	sp *specStateProcessor,
+	engine *specEngine,
+	h spectest.Case,
+	root string,
+	preset spectest.Preset,
+) {
+	t.Helper()
+	run, reason, err := specHandlerFor(h)
+	require.NoError(t, err)
+	if run == nil {
+		t.Skip(reason)
+	}
+
+	cases, err := spectest.Cases(root, h.Preset, h.Fork, h.Runner, h.Handler)
+	require.NoError(t, err)
+	for _, c := range cases {
+		t.Run(path.Join(c.Suite, c.Name), func(t *testing.T) {
+			if reason, ok := spectest.SkipReason(c); ok {
+				t.Skip(reason)
+			}
+			engine.valid = true
+			run(t, sp, c, preset)
+		})
+	}
+}
+
+// specHandlerFor returns the function running the cases of the handler h, or
+// the reason h is skipped. Every handler of the vectors must be either mapped
+// in specHandlers or skipped with a reason in spectest/skip.go.
+func specHandlerFor(h spectest.Case) (specHandler, string, error) {
+	if run, ok := specHandlers[path.Join(h.Runner, h.Handler)]; ok {
+		return run, "", nil
+	}
+	if reason, ok := spectest.SkipReason(h); ok {
+		return nil, reason, nil
+	}
+	return nil, "", errors.Wrapf(
+		spectest.ErrUnknownHandler,
+		"%s/%s is neither mapped nor skipped with a reason in spectest/skip.go",
+		h.Runner, h.Handler,
+	)
+}
+
+// newSpecStateProcessor returns a state processor for the given preset.
+func newSpecStateProcessor(
+	p spectest.Preset,
+	engine *specEngine,
+) *specStateProcessor {
+	return NewStateProcessor[
+		*types.AttestationData,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*specBeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*spectest.KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](p.ChainSpec(), engine, nil)
+}
+
+// loadState reads the pre state of the case into a beacon state along with
+// the expected post state, which is nil if the case expects an error.
+func loadState(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) (*specBeaconState, *spectest.BeaconState) {
+	t.Helper()
+	pre, err := c.ReadState("pre", p)
+	require.NoError(t, err)
+
+	var post *spectest.BeaconState
+	if c.Has("post") {
+		post, err = c.ReadState("post", p)
+		require.NoError(t, err)
+	}
+	return (&specBeaconState{}).NewFromDB(pre.KVStore(), sp.cs), post
+}
+
+// checkPost checks the outcome of running a case against its post state.
+func checkPost(
+	t *testing.T,
+	st *specBeaconState,
+	post *spectest.BeaconState,
+	err error,
+	fields ...spectest.Field,
+) {
+	t.Helper()
+	if post == nil {
+		require.Error(t, err, "expected the case to fail")
+		return
+	}
+	require.NoError(t, err)
+	kv, ok := st.KVStore.(*spectest.KVStore)
+	require.True(t, ok)
+	require.NoError(t, spectest.Compare(kv, post, fields...))
+}
+
+func runExecutionPayload(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) {
+	st, post := loadState(t, sp, c, p)
+	bz, err := c.ReadSSZ("body")
+	require.NoError(t, err)
+	body, err := spectest.DecodeBeaconBlockBody(bz, p)
+	require.NoError(t, err)
+
+	var execution struct {
+		ExecutionValid bool `yaml:"execution_valid"`
+	}
+	require.NoError(t, c.ReadYAML("execution", &execution))
+	engine, ok := sp.executionEngine.(*specEngine)
+	require.True(t, ok)
+	engine.valid = execution.ExecutionValid
+
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	err = sp.processExecutionPayload(
+		&transition.Context{Context: context.Background()},
+		st,
+		&types.BeaconBlock{Slot: slot, Body: body},
+	)
+	checkPost(t, st, post, err, spectest.FieldLatestExecutionPayloadHeader)
+}
+
+func runSlashingsReset(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) {
+	st, post := loadState(t, sp, c, p)
+	err := sp.processSlashingsReset(st)
+	checkPost(t, st, post, err, spectest.FieldSlashings)
+}
+
+func runRandaoMixesReset(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) {
+	st, post := loadState(t, sp, c, p)
+	err := sp.processRandaoMixesReset(st)
+	checkPost(t, st, post, err, spectest.FieldRandaoMixes)
+}
+
+func runHistoricalSummariesUpdate(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) {
+	st, post := loadState(t, sp, c, p)
+	err := sp.processHistoricalSummariesUpdate(st)
+	checkPost(t, st, post, err, spectest.FieldHistoricalSummaries)
+}
+
+func runParticipationFlagUpdates(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) {
+	st, post := loadState(t, sp, c, p)
+	err := sp.processParticipationFlagUpdates(st)
+	checkPost(t, st, post, err,
+		spectest.FieldPreviousEpochParticipation,
+		spectest.FieldCurrentEpochParticipation,
+	)
+}
+
+// runSlots only compares the fields that do not depend on the state root,
+// which beacon-kit computes over its own state.
+func runSlots(
+	t *testing.T,
+	sp *specStateProcessor,
+	c spectest.Case,
+	p spectest.Preset,
+) {
+	st, post := loadState(t, sp, c, p)
+	var slots uint64
+	require.NoError(t, c.ReadYAML("slots", &slots))
+
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	_, err = sp.ProcessSlots(st, slot+math.Slot(slots))
+	checkPost(t, st, post, err,
+		spectest.FieldSlot,
+		spectest.FieldRandaoMixes,
+		spectest.FieldSlashings,
+	)
+}
+
+// sszStatic is an SSZ object of the ssz_static handlers.
+type sszStatic[T any] interface {
+	*T
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ([]byte) error
+	HashTreeRoot() common.Root
+}
+
+func runSSZStatic[T any, PT sszStatic[T]](
+	t *testing.T,
+	_ *specStateProcessor,
+	c spectest.Case,
+	_ spectest.Preset,
+) {
+	bz, err := c.ReadSSZ("serialized")
+	require.NoError(t, err)
+	var roots struct {
+		Root string `yaml:"root"`
+	}
+	require.NoError(t, c.ReadYAML("roots", &roots))
+
+	obj := PT(new(T))
+	require.NoError(t, obj.UnmarshalSSZ(bz))
+	require.Equal(t, roots.Root, obj.HashTreeRoot().String())
+	reencoded, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+}
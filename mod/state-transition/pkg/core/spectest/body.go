@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// blsSignatureSize is the size of a BLS signature.
+	blsSignatureSize = 96
+	// graffitiSize is the size of the graffiti of a block body.
+	graffitiSize = 32
+	// kzgCommitmentSize is the size of a KZG commitment.
+	kzgCommitmentSize = 48
+	// depositProofLength is the number of roots in a deposit proof.
+	depositProofLength = 33
+	// depositDataSize is the SSZ size of a DepositData.
+	depositDataSize = blsPubkeySize + bytesPerRoot + bytesPerUint64 +
+		blsSignatureSize
+)
+
+// DecodeBeaconBlockBody decodes a deneb BeaconBlockBody of the consensus
+// specs encoded with the given preset. Only the fields beacon-kit has a
+// counterpart for are decoded; the remaining operations must be empty.
+func DecodeBeaconBlockBody(
+	bz []byte,
+	p Preset,
+) (*types.BeaconBlockBody, error) {
+	var (
+		err  error
+		body = &types.BeaconBlockBody{Eth1Data: &types.Eth1Data{}}
+		d    = newDecoder(bz)
+	)
+
+	copy(body.RandaoReveal[:], d.next(blsSignatureSize))
+	eth1DataBz := d.next(eth1DataSize)
+	copy(body.Graffiti[:], d.next(graffitiSize))
+	d.offset()                                            // proposer_slashings
+	d.offset()                                            // attester_slashings
+	d.offset()                                            // attestations
+	d.offset()                                            // deposits
+	d.offset()                                            // voluntary_exits
+	d.next(int(p.SyncCommitteeSize/8 + blsSignatureSize)) // sync_aggregate
+	d.offset()                                            // execution_payload
+	d.offset()                                            // bls_to_execution_changes
+	d.offset()                                            // blob_kzg_commitments
+
+	variable, err := d.variable()
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range []int{0, 1, 2, 4, 6} {
+		if len(variable[i]) != 0 {
+			return nil, errors.Wrapf(
+				ErrInvalidSSZ, "unsupported operations in field %d", i,
+			)
+		}
+	}
+
+	if err = body.Eth1Data.UnmarshalSSZ(eth1DataBz); err != nil {
+		return nil, err
+	}
+	depositsBz, err := decodeList(
+		variable[3], depositProofLength*bytesPerRoot+depositDataSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	body.Deposits = make([]*types.Deposit, len(depositsBz))
+	for i, depositBz := range depositsBz {
+		if body.Deposits[i], err = DecodeDeposit(depositBz, 0); err != nil {
+			return nil, err
+		}
+	}
+	body.ExecutionPayload = &types.ExecutionPayload{}
+	if err = body.ExecutionPayload.UnmarshalSSZ(variable[5]); err != nil {
+		return nil, err
+	}
+	commitments, err := decodeList(variable[7], kzgCommitmentSize)
+	if err != nil {
+		return nil, err
+	}
+	body.BlobKzgCommitments = make([]eip4844.KZGCommitment, len(commitments))
+	for i, commitment := range commitments {
+		copy(body.BlobKzgCommitments[i][:], commitment)
+	}
+	return body, nil
+}
+
+// DecodeDeposit decodes a Deposit of the consensus specs into a beacon-kit
+// deposit with the given index. The merkle proof is dropped, as beacon-kit
+// reads deposits from the deposit contract rather than from eth1 data.
+func DecodeDeposit(bz []byte, index uint64) (*types.Deposit, error) {
+	d := newDecoder(bz)
+	d.next(depositProofLength * bytesPerRoot)
+	dep := &types.Deposit{Index: index}
+	copy(dep.Pubkey[:], d.next(blsPubkeySize))
+	copy(dep.Credentials[:], d.next(bytesPerRoot))
+	dep.Amount = math.Gwei(d.uint64())
+	copy(dep.Signature[:], d.next(blsSignatureSize))
+	if _, err := d.variable(); err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
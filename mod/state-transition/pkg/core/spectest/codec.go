@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// bytesPerOffset is the size of an SSZ offset.
+	bytesPerOffset = 4
+	// bytesPerUint64 is the size of an SSZ uint64.
+	bytesPerUint64 = 8
+	// bytesPerRoot is the size of an SSZ root.
+	bytesPerRoot = 32
+)
+
+// decoder reads the fixed part of an SSZ container field by field and
+// splits the variable part according to the offsets it read.
+type decoder struct {
+	buf     []byte
+	pos     int
+	offsets []int
+	err     error
+}
+
+// newDecoder returns a decoder over the given SSZ container.
+func newDecoder(buf []byte) *decoder {
+	return &decoder{buf: buf}
+}
+
+// next returns the next n bytes of the fixed part.
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return make([]byte, n)
+	}
+	if d.pos+n > len(d.buf) {
+		d.err = errors.Wrapf(
+			ErrInvalidSSZ, "need %d bytes at %d, have %d",
+			n, d.pos, len(d.buf),
+		)
+		return make([]byte, n)
+	}
+	bz := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return bz
+}
+
+// uint64 reads a uint64 from the fixed part.
+func (d *decoder) uint64() uint64 {
+	return binary.LittleEndian.Uint64(d.next(bytesPerUint64))
+}
+
+// offset reads the offset of a variable-size field.
+func (d *decoder) offset() {
+	d.offsets = append(
+		d.offsets,
+		int(binary.LittleEndian.Uint32(d.next(bytesPerOffset))),
+	)
+}
+
+// variable returns the contents of the variable-size fields, in the order
+// their offsets were read. It must be called once the fixed part has been
+// fully read.
+func (d *decoder) variable() ([][]byte, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.offsets) == 0 {
+		if d.pos != len(d.buf) {
+			return nil, errors.Wrapf(
+				ErrInvalidSSZ, "%d trailing bytes", len(d.buf)-d.pos,
+			)
+		}
+		return nil, nil
+	}
+	if d.offsets[0] != d.pos {
+		return nil, errors.Wrapf(
+			ErrInvalidSSZ, "first offset %d, fixed size %d",
+			d.offsets[0], d.pos,
+		)
+	}
+
+	fields := make([][]byte, len(d.offsets))
+	for i, start := range d.offsets {
+		end := len(d.buf)
+		if i+1 < len(d.offsets) {
+			end = d.offsets[i+1]
+		}
+		if start > end || end > len(d.buf) {
+			return nil, errors.Wrapf(
+				ErrInvalidSSZ, "invalid offsets %d..%d", start, end,
+			)
+		}
+		fields[i] = d.buf[start:end]
+	}
+	return fields, nil
+}
+
+// encoder builds an SSZ container from fixed and variable-size fields,
+// computing the offsets of the latter.
+type encoder struct {
+	fixed    [][]byte
+	variable map[int][]byte
+}
+
+// newEncoder returns an empty encoder.
+func newEncoder() *encoder {
+	return &encoder{variable: make(map[int][]byte)}
+}
+
+// putFixed appends a fixed-size field.
+func (e *encoder) putFixed(bz []byte) {
+	e.fixed = append(e.fixed, bz)
+}
+
+// putUint64 appends a uint64 field.
+func (e *encoder) putUint64(v uint64) {
+	e.putFixed(binary.LittleEndian.AppendUint64(nil, v))
+}
+
+// putVariable appends a variable-size field.
+func (e *encoder) putVariable(bz []byte) {
+	e.variable[len(e.fixed)] = bz
+	e.fixed = append(e.fixed, make([]byte, bytesPerOffset))
+}
+
+// bytes returns the encoded container.
+func (e *encoder) bytes() []byte {
+	size := 0
+	for _, bz := range e.fixed {
+		size += len(bz)
+	}
+
+	out := make([]byte, 0, size)
+	offset := size
+	for i, bz := range e.fixed {
+		if content, ok := e.variable[i]; ok {
+			//#nosec:G115 // containers are far smaller than 4GiB.
+			bz = binary.LittleEndian.AppendUint32(nil, uint32(offset))
+			offset += len(content)
+		}
+		out = append(out, bz...)
+	}
+	for i := range e.fixed {
+		if content, ok := e.variable[i]; ok {
+			out = append(out, content...)
+		}
+	}
+	return out
+}
+
+// decodeList splits a list of fixed-size elements.
+func decodeList(bz []byte, size int) ([][]byte, error) {
+	if len(bz)%size != 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidSSZ, "list of %d bytes is not a multiple of %d",
+			len(bz), size,
+		)
+	}
+	elems := make([][]byte, 0, len(bz)/size)
+	for i := 0; i < len(bz); i += size {
+		elems = append(elems, bz[i:i+size])
+	}
+	return elems, nil
+}
+
+// decodeUint64s decodes a list or vector of uint64s.
+func decodeUint64s(bz []byte) ([]uint64, error) {
+	elems, err := decodeList(bz, bytesPerUint64)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]uint64, len(elems))
+	for i, elem := range elems {
+		values[i] = binary.LittleEndian.Uint64(elem)
+	}
+	return values, nil
+}
+
+// encodeUint64s encodes a list or vector of uint64s.
+func encodeUint64s(values []uint64) []byte {
+	bz := make([]byte, 0, len(values)*bytesPerUint64)
+	for _, v := range values {
+		bz = binary.LittleEndian.AppendUint64(bz, v)
+	}
+	return bz
+}
+
+// decodeRoots decodes a list or vector of 32 byte roots.
+func decodeRoots[RootT ~[32]byte](bz []byte) ([]RootT, error) {
+	elems, err := decodeList(bz, bytesPerRoot)
+	if err != nil {
+		return nil, err
+	}
+	roots := make([]RootT, len(elems))
+	for i, elem := range elems {
+		copy(roots[i][:], elem)
+	}
+	return roots, nil
+}
+
+// encodeRoots encodes a list or vector of 32 byte roots.
+func encodeRoots[RootT ~[32]byte](roots []RootT) []byte {
+	bz := make([]byte, 0, len(roots)*bytesPerRoot)
+	for _, root := range roots {
+		bz = append(bz, root[:]...)
+	}
+	return bz
+}
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import (
+	"reflect"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Field is a field of the BeaconState that can be compared against the
+// beacon-kit state.
+type Field string
+
+const (
+	FieldSlot                         Field = "slot"
+	FieldFork                         Field = "fork"
+	FieldLatestBlockHeader            Field = "latest_block_header"
+	FieldEth1Data                     Field = "eth1_data"
+	FieldEth1DepositIndex             Field = "eth1_deposit_index"
+	FieldValidators                   Field = "validators"
+	FieldBalances                     Field = "balances"
+	FieldRandaoMixes                  Field = "randao_mixes"
+	FieldSlashings                    Field = "slashings"
+	FieldLatestExecutionPayloadHeader Field = "latest_execution_payload_header"
+	FieldNextWithdrawalIndex          Field = "next_withdrawal_index"
+	FieldNextWithdrawalValidatorIndex Field = "next_withdrawal_validator_index"
+	FieldHistoricalSummaries          Field = "historical_summaries"
+	FieldPreviousEpochParticipation   Field = "previous_epoch_participation"
+	FieldCurrentEpochParticipation    Field = "current_epoch_participation"
+)
+
+// AllFields are the fields of the BeaconState beacon-kit keeps.
+//
+//nolint:gochecknoglobals // list of constants.
+var AllFields = []Field{
+	FieldSlot,
+	FieldFork,
+	FieldLatestBlockHeader,
+	FieldEth1Data,
+	FieldEth1DepositIndex,
+	FieldValidators,
+	FieldBalances,
+	FieldRandaoMixes,
+	FieldSlashings,
+	FieldLatestExecutionPayloadHeader,
+	FieldNextWithdrawalIndex,
+	FieldNextWithdrawalValidatorIndex,
+	FieldHistoricalSummaries,
+	FieldPreviousEpochParticipation,
+	FieldCurrentEpochParticipation,
+}
+
+// KVStore loads the state into a new in-memory KVStore.
+func (st *BeaconState) KVStore() *KVStore {
+	kv := NewKVStore()
+	kv.genesisValidatorsRoot = st.GenesisValidatorsRoot
+	kv.slot = st.Slot
+	kv.fork = copyPtr(st.Fork)
+	kv.latestBlockHeader = copyPtr(st.LatestBlockHeader)
+	kv.eth1Data = copyPtr(st.Eth1Data)
+	kv.eth1DepositIndex = st.Eth1DepositIndex
+	kv.latestExecutionPayloadHeader = copyPtr(st.LatestExecutionPayloadHeader)
+	kv.nextWithdrawalIndex = st.NextWithdrawalIndex
+	kv.nextWithdrawalValidatorIndex = st.NextWithdrawalValidatorIndex
+	for i, root := range st.BlockRoots {
+		kv.blockRoots[uint64(i)] = root
+	}
+	for i, root := range st.StateRoots {
+		kv.stateRoots[uint64(i)] = root
+	}
+	for i, mix := range st.RandaoMixes {
+		kv.randaoMixes[uint64(i)] = mix
+	}
+	for i, amount := range st.Slashings {
+		kv.slashings[uint64(i)] = math.Gwei(amount)
+		kv.totalSlashing += math.Gwei(amount)
+	}
+	for _, val := range st.Validators {
+		kv.validators = append(kv.validators, copyPtr(val))
+	}
+	kv.balances = append(kv.balances, st.Balances...)
+	for i, flags := range st.PreviousEpochParticipation {
+		kv.previousEpochParticipation[math.ValidatorIndex(i)] = flags
+	}
+	for i, flags := range st.CurrentEpochParticipation {
+		kv.currentEpochParticipation[math.ValidatorIndex(i)] = flags
+	}
+	for _, summary := range st.HistoricalSummaries {
+		kv.historicalSummaries = append(
+			kv.historicalSummaries, copyPtr(summary),
+		)
+	}
+	return kv
+}
+
+// Compare returns an error describing every field of the given fields in
+// which the KVStore differs from the expected state.
+func Compare(kv *KVStore, expected *BeaconState, fields ...Field) error {
+	var errs []error
+	for _, field := range fields {
+		actual, want := kv.field(field), expected.field(field)
+		if !reflect.DeepEqual(actual, want) {
+			errs = append(errs, errors.Wrapf(
+				ErrStateMismatch, "%s: got %+v, want %+v",
+				field, actual, want,
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// field returns the value of the given field of the state.
+func (st *BeaconState) field(field Field) any {
+	switch field {
+	case FieldSlot:
+		return st.Slot
+	case FieldFork:
+		return st.Fork
+	case FieldLatestBlockHeader:
+		return st.LatestBlockHeader
+	case FieldEth1Data:
+		return st.Eth1Data
+	case FieldEth1DepositIndex:
+		return st.Eth1DepositIndex
+	case FieldValidators:
+		return st.Validators
+	case FieldBalances:
+		return st.Balances
+	case FieldRandaoMixes:
+		return st.RandaoMixes
+	case FieldSlashings:
+		return st.Slashings
+	case FieldLatestExecutionPayloadHeader:
+		return st.LatestExecutionPayloadHeader
+	case FieldNextWithdrawalIndex:
+		return st.NextWithdrawalIndex
+	case FieldNextWithdrawalValidatorIndex:
+		return st.NextWithdrawalValidatorIndex
+	case FieldHistoricalSummaries:
+		return append(
+			[]*types.HistoricalSummary{}, st.HistoricalSummaries...,
+		)
+	case FieldPreviousEpochParticipation:
+		return append([]byte{}, st.PreviousEpochParticipation...)
+	case FieldCurrentEpochParticipation:
+		return append([]byte{}, st.CurrentEpochParticipation...)
+	default:
+		return nil
+	}
+}
+
+// field returns the value of the given field of the KVStore, in the form
+// the BeaconState holds it.
+func (kv *KVStore) field(field Field) any {
+	switch field {
+	case FieldSlot:
+		return kv.slot
+	case FieldFork:
+		return kv.fork
+	case FieldLatestBlockHeader:
+		return kv.latestBlockHeader
+	case FieldEth1Data:
+		return kv.eth1Data
+	case FieldEth1DepositIndex:
+		return kv.eth1DepositIndex
+	case FieldValidators:
+		return kv.validators
+	case FieldBalances:
+		return kv.balances
+	case FieldRandaoMixes:
+		return vector(kv.randaoMixes)
+	case FieldSlashings:
+		slashings := vector(kv.slashings)
+		amounts := make([]uint64, len(slashings))
+		for i, amount := range slashings {
+			amounts[i] = amount.Unwrap()
+		}
+		return amounts
+	case FieldLatestExecutionPayloadHeader:
+		return kv.latestExecutionPayloadHeader
+	case FieldNextWithdrawalIndex:
+		return kv.nextWithdrawalIndex
+	case FieldNextWithdrawalValidatorIndex:
+		return kv.nextWithdrawalValidatorIndex
+	case FieldHistoricalSummaries:
+		return append(
+			[]*types.HistoricalSummary{}, kv.historicalSummaries...,
+		)
+	case FieldPreviousEpochParticipation:
+		return participation(
+			kv.previousEpochParticipation, len(kv.validators),
+		)
+	case FieldCurrentEpochParticipation:
+		return participation(
+			kv.currentEpochParticipation, len(kv.validators),
+		)
+	default:
+		return nil
+	}
+}
+
+// vector returns the values of a map indexed from zero as a slice.
+func vector[V any](m map[uint64]V) []V {
+	values := make([]V, len(m))
+	for i := range values {
+		values[i] = m[uint64(i)]
+	}
+	return values
+}
+
+// participation returns the participation flags of the first n validators.
+func participation(m map[math.ValidatorIndex]byte, n int) []byte {
+	flags := make([]byte, n)
+	for i := range flags {
+		flags[i] = m[math.ValidatorIndex(i)]
+	}
+	return flags
+}
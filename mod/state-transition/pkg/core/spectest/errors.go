@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnknownPreset is returned when a preset is not known.
+	ErrUnknownPreset = errors.New("unknown preset")
+
+	// ErrInvalidSSZ is returned when an SSZ object of the vectors cannot be
+	// decoded.
+	ErrInvalidSSZ = errors.New("invalid ssz")
+
+	// ErrNotFound is returned when a value is missing from the KVStore.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnknownHandler is returned when a handler of the vectors is neither
+	// run nor skipped.
+	ErrUnknownHandler = errors.New("unknown handler")
+
+	// ErrStateMismatch is returned when a post state does not match the
+	// expected post state of a case.
+	ErrStateMismatch = errors.New("state mismatch")
+)
@@ -0,0 +1,709 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import (
+	"context"
+	"crypto/sha256"
+	"slices"
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// cometBFTAddressSize is the size of a CometBFT address.
+const cometBFTAddressSize = 20
+
+// KVStore is an in-memory implementation of the KVStore backing a StateDB.
+// It holds the beacon state the same way the persistent store does, so a
+// spec state can be loaded into it and run through the StateProcessor
+// without a database.
+type KVStore struct {
+	ctx context.Context
+
+	genesisValidatorsRoot         common.Root
+	slot                          math.Slot
+	fork                          *types.Fork
+	latestBlockHeader             *types.BeaconBlockHeader
+	blockRoots                    map[uint64]common.Root
+	stateRoots                    map[uint64]common.Root
+	eth1Data                      *types.Eth1Data
+	eth1DepositIndex              uint64
+	latestExecutionPayloadHeader  *types.ExecutionPayloadHeader
+	validators                    []*types.Validator
+	balances                      []uint64
+	nextWithdrawalIndex           uint64
+	nextWithdrawalValidatorIndex  math.ValidatorIndex
+	randaoMixes                   map[uint64]common.Bytes32
+	slashings                     map[uint64]math.Gwei
+	totalSlashing                 math.Gwei
+	validatorPowers               map[math.ValidatorIndex]math.Gwei
+	previousEpochParticipation    map[math.ValidatorIndex]byte
+	currentEpochParticipation     map[math.ValidatorIndex]byte
+	inactivityScores              map[math.ValidatorIndex]math.U64
+	historicalSummaries           []*types.HistoricalSummary
+	historicalBlockRoots          map[uint64][]common.Root
+	exitBalanceToConsume          math.Gwei
+	earliestExitEpoch             math.Epoch
+	consolidationBalanceToConsume math.Gwei
+	earliestConsolidationEpoch    math.Epoch
+	pendingConsolidations         []*engineprimitives.PendingConsolidation
+}
+
+// NewKVStore returns an empty KVStore.
+func NewKVStore() *KVStore {
+	return &KVStore{
+		ctx:             context.Background(),
+		blockRoots:      make(map[uint64]common.Root),
+		stateRoots:      make(map[uint64]common.Root),
+		randaoMixes:     make(map[uint64]common.Bytes32),
+		slashings:       make(map[uint64]math.Gwei),
+		validatorPowers: make(map[math.ValidatorIndex]math.Gwei),
+		previousEpochParticipation: make(
+			map[math.ValidatorIndex]byte,
+		),
+		currentEpochParticipation: make(map[math.ValidatorIndex]byte),
+		inactivityScores:          make(map[math.ValidatorIndex]math.U64),
+		historicalBlockRoots:      make(map[uint64][]common.Root),
+	}
+}
+
+// Context returns the context of the KVStore.
+func (kv *KVStore) Context() context.Context {
+	return kv.ctx
+}
+
+// WithContext returns a copy of the KVStore with the given context.
+func (kv *KVStore) WithContext(ctx context.Context) *KVStore {
+	cpy := kv.Copy()
+	cpy.ctx = ctx
+	return cpy
+}
+
+// Copy returns a deep copy of the KVStore.
+func (kv *KVStore) Copy() *KVStore {
+	cpy := *kv
+	cpy.fork = copyPtr(kv.fork)
+	cpy.latestBlockHeader = copyPtr(kv.latestBlockHeader)
+	cpy.eth1Data = copyPtr(kv.eth1Data)
+	cpy.latestExecutionPayloadHeader = copyPtr(kv.latestExecutionPayloadHeader)
+	cpy.blockRoots = copyMap(kv.blockRoots)
+	cpy.stateRoots = copyMap(kv.stateRoots)
+	cpy.randaoMixes = copyMap(kv.randaoMixes)
+	cpy.slashings = copyMap(kv.slashings)
+	cpy.validatorPowers = copyMap(kv.validatorPowers)
+	cpy.previousEpochParticipation = copyMap(kv.previousEpochParticipation)
+	cpy.currentEpochParticipation = copyMap(kv.currentEpochParticipation)
+	cpy.inactivityScores = copyMap(kv.inactivityScores)
+	cpy.balances = slices.Clone(kv.balances)
+	cpy.historicalSummaries = slices.Clone(kv.historicalSummaries)
+	cpy.historicalBlockRoots = copyMap(kv.historicalBlockRoots)
+	cpy.pendingConsolidations = copyPtrs(kv.pendingConsolidations)
+	cpy.validators = make([]*types.Validator, len(kv.validators))
+	for i, val := range kv.validators {
+		cpy.validators[i] = copyPtr(val)
+	}
+	return &cpy
+}
+
+// GetLatestExecutionPayloadHeader returns the latest execution payload
+// header.
+func (kv *KVStore) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	if kv.latestExecutionPayloadHeader == nil {
+		return nil, errors.Wrap(ErrNotFound, "latest execution payload header")
+	}
+	return copyPtr(kv.latestExecutionPayloadHeader), nil
+}
+
+// SetLatestExecutionPayloadHeader sets the latest execution payload header.
+func (kv *KVStore) SetLatestExecutionPayloadHeader(
+	header *types.ExecutionPayloadHeader,
+) error {
+	kv.latestExecutionPayloadHeader = copyPtr(header)
+	return nil
+}
+
+// GetEth1DepositIndex returns the eth1 deposit index.
+func (kv *KVStore) GetEth1DepositIndex() (uint64, error) {
+	return kv.eth1DepositIndex, nil
+}
+
+// SetEth1DepositIndex sets the eth1 deposit index.
+func (kv *KVStore) SetEth1DepositIndex(index uint64) error {
+	kv.eth1DepositIndex = index
+	return nil
+}
+
+// GetBalance returns the balance of a validator.
+func (kv *KVStore) GetBalance(idx math.ValidatorIndex) (math.Gwei, error) {
+	if idx.Unwrap() >= uint64(len(kv.balances)) {
+		return 0, errors.Wrapf(ErrNotFound, "balance %d", idx)
+	}
+	return math.Gwei(kv.balances[idx]), nil
+}
+
+// SetBalance sets the balance of a validator.
+func (kv *KVStore) SetBalance(idx math.ValidatorIndex, balance math.Gwei) error {
+	if idx.Unwrap() >= uint64(len(kv.balances)) {
+		return errors.Wrapf(ErrNotFound, "balance %d", idx)
+	}
+	kv.balances[idx] = balance.Unwrap()
+	return nil
+}
+
+// GetSlot returns the current slot.
+func (kv *KVStore) GetSlot() (math.Slot, error) {
+	return kv.slot, nil
+}
+
+// SetSlot sets the current slot.
+func (kv *KVStore) SetSlot(slot math.Slot) error {
+	kv.slot = slot
+	return nil
+}
+
+// GetFork returns the fork.
+func (kv *KVStore) GetFork() (*types.Fork, error) {
+	if kv.fork == nil {
+		return nil, errors.Wrap(ErrNotFound, "fork")
+	}
+	return copyPtr(kv.fork), nil
+}
+
+// SetFork sets the fork.
+func (kv *KVStore) SetFork(fork *types.Fork) error {
+	kv.fork = copyPtr(fork)
+	return nil
+}
+
+// GetGenesisValidatorsRoot returns the genesis validators root.
+func (kv *KVStore) GetGenesisValidatorsRoot() (common.Root, error) {
+	return kv.genesisValidatorsRoot, nil
+}
+
+// SetGenesisValidatorsRoot sets the genesis validators root.
+func (kv *KVStore) SetGenesisValidatorsRoot(root common.Root) error {
+	kv.genesisValidatorsRoot = root
+	return nil
+}
+
+// GetLatestBlockHeader returns the latest block header.
+func (kv *KVStore) GetLatestBlockHeader() (*types.BeaconBlockHeader, error) {
+	if kv.latestBlockHeader == nil {
+		return nil, errors.Wrap(ErrNotFound, "latest block header")
+	}
+	return copyPtr(kv.latestBlockHeader), nil
+}
+
+// SetLatestBlockHeader sets the latest block header.
+func (kv *KVStore) SetLatestBlockHeader(header *types.BeaconBlockHeader) error {
+	kv.latestBlockHeader = copyPtr(header)
+	return nil
+}
+
+// GetBlockRootAtIndex returns the block root at the given index.
+func (kv *KVStore) GetBlockRootAtIndex(index uint64) (common.Root, error) {
+	root, ok := kv.blockRoots[index]
+	if !ok {
+		return common.Root{}, errors.Wrapf(ErrNotFound, "block root %d", index)
+	}
+	return root, nil
+}
+
+// UpdateBlockRootAtIndex sets the block root at the given index.
+func (kv *KVStore) UpdateBlockRootAtIndex(
+	index uint64,
+	root common.Root,
+) error {
+	kv.blockRoots[index] = root
+	return nil
+}
+
+// StateRootAtIndex returns the state root at the given index.
+func (kv *KVStore) StateRootAtIndex(index uint64) (common.Root, error) {
+	root, ok := kv.stateRoots[index]
+	if !ok {
+		return common.Root{}, errors.Wrapf(ErrNotFound, "state root %d", index)
+	}
+	return root, nil
+}
+
+// UpdateStateRootAtIndex sets the state root at the given index.
+func (kv *KVStore) UpdateStateRootAtIndex(
+	index uint64,
+	root common.Root,
+) error {
+	kv.stateRoots[index] = root
+	return nil
+}
+
+// GetEth1Data returns the eth1 data.
+func (kv *KVStore) GetEth1Data() (*types.Eth1Data, error) {
+	if kv.eth1Data == nil {
+		return nil, errors.Wrap(ErrNotFound, "eth1 data")
+	}
+	return copyPtr(kv.eth1Data), nil
+}
+
+// SetEth1Data sets the eth1 data.
+func (kv *KVStore) SetEth1Data(data *types.Eth1Data) error {
+	kv.eth1Data = copyPtr(data)
+	return nil
+}
+
+// GetValidators returns all validators.
+func (kv *KVStore) GetValidators() (types.Validators, error) {
+	vals := make(types.Validators, len(kv.validators))
+	for i, val := range kv.validators {
+		vals[i] = copyPtr(val)
+	}
+	return vals, nil
+}
+
+// GetBalances returns the balances of all validators.
+func (kv *KVStore) GetBalances() ([]uint64, error) {
+	return slices.Clone(kv.balances), nil
+}
+
+// GetNextWithdrawalIndex returns the next withdrawal index.
+func (kv *KVStore) GetNextWithdrawalIndex() (uint64, error) {
+	return kv.nextWithdrawalIndex, nil
+}
+
+// SetNextWithdrawalIndex sets the next withdrawal index.
+func (kv *KVStore) SetNextWithdrawalIndex(index uint64) error {
+	kv.nextWithdrawalIndex = index
+	return nil
+}
+
+// GetNextWithdrawalValidatorIndex returns the next withdrawal validator
+// index.
+func (kv *KVStore) GetNextWithdrawalValidatorIndex() (
+	math.ValidatorIndex, error,
+) {
+	return kv.nextWithdrawalValidatorIndex, nil
+}
+
+// SetNextWithdrawalValidatorIndex sets the next withdrawal validator index.
+func (kv *KVStore) SetNextWithdrawalValidatorIndex(
+	index math.ValidatorIndex,
+) error {
+	kv.nextWithdrawalValidatorIndex = index
+	return nil
+}
+
+// GetTotalSlashing returns the total slashing.
+func (kv *KVStore) GetTotalSlashing() (math.Gwei, error) {
+	return kv.totalSlashing, nil
+}
+
+// SetTotalSlashing sets the total slashing.
+func (kv *KVStore) SetTotalSlashing(total math.Gwei) error {
+	kv.totalSlashing = total
+	return nil
+}
+
+// GetRandaoMixAtIndex returns the randao mix at the given index.
+func (kv *KVStore) GetRandaoMixAtIndex(index uint64) (common.Bytes32, error) {
+	mix, ok := kv.randaoMixes[index]
+	if !ok {
+		return common.Bytes32{}, errors.Wrapf(
+			ErrNotFound, "randao mix %d", index,
+		)
+	}
+	return mix, nil
+}
+
+// UpdateRandaoMixAtIndex sets the randao mix at the given index.
+func (kv *KVStore) UpdateRandaoMixAtIndex(
+	index uint64,
+	mix common.Bytes32,
+) error {
+	kv.randaoMixes[index] = mix
+	return nil
+}
+
+// GetSlashings returns the slashings that are set, in index order.
+func (kv *KVStore) GetSlashings() ([]uint64, error) {
+	indices := make([]uint64, 0, len(kv.slashings))
+	for index := range kv.slashings {
+		indices = append(indices, index)
+	}
+	slices.Sort(indices)
+
+	slashings := make([]uint64, len(indices))
+	for i, index := range indices {
+		slashings[i] = kv.slashings[index].Unwrap()
+	}
+	return slashings, nil
+}
+
+// GetSlashingAtIndex returns the slashing at the given index.
+func (kv *KVStore) GetSlashingAtIndex(index uint64) (math.Gwei, error) {
+	return kv.slashings[index], nil
+}
+
+// SetSlashingAtIndex sets the slashing at the given index.
+func (kv *KVStore) SetSlashingAtIndex(index uint64, amount math.Gwei) error {
+	kv.slashings[index] = amount
+	return nil
+}
+
+// GetTotalValidators returns the number of validators.
+func (kv *KVStore) GetTotalValidators() (uint64, error) {
+	return uint64(len(kv.validators)), nil
+}
+
+// GetTotalActiveBalances returns the total effective balance of the
+// validators active in the current epoch.
+func (kv *KVStore) GetTotalActiveBalances(
+	slotsPerEpoch uint64,
+) (math.Gwei, error) {
+	var (
+		total math.Gwei
+		epoch = math.Epoch(kv.slot.Unwrap() / slotsPerEpoch)
+	)
+	for _, val := range kv.validators {
+		if val.IsActive(epoch) {
+			total += val.GetEffectiveBalance()
+		}
+	}
+	return total, nil
+}
+
+// ValidatorByIndex returns the validator at the given index.
+func (kv *KVStore) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*types.Validator, error) {
+	if index.Unwrap() >= uint64(len(kv.validators)) {
+		return nil, errors.Wrapf(ErrNotFound, "validator %d", index)
+	}
+	return copyPtr(kv.validators[index]), nil
+}
+
+// UpdateValidatorAtIndex sets the validator at the given index.
+func (kv *KVStore) UpdateValidatorAtIndex(
+	index math.ValidatorIndex,
+	val *types.Validator,
+) error {
+	if index.Unwrap() >= uint64(len(kv.validators)) {
+		return errors.Wrapf(ErrNotFound, "validator %d", index)
+	}
+	kv.validators[index] = copyPtr(val)
+	return nil
+}
+
+// ValidatorIndexByPubkey returns the index of the validator with the given
+// public key.
+func (kv *KVStore) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for i, val := range kv.validators {
+		if val.GetPubkey() == pubkey {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errors.Wrapf(ErrNotFound, "validator %x", pubkey)
+}
+
+// ValidatorIndexByCometBFTAddress returns the index of the validator with
+// the given CometBFT address.
+func (kv *KVStore) ValidatorIndexByCometBFTAddress(
+	cometBFTAddress []byte,
+) (math.ValidatorIndex, error) {
+	for i, val := range kv.validators {
+		pubkey := val.GetPubkey()
+		hash := sha256.Sum256(pubkey[:])
+		if string(hash[:cometBFTAddressSize]) == string(cometBFTAddress) {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errors.Wrapf(ErrNotFound, "validator %x", cometBFTAddress)
+}
+
+// AddValidator appends a validator with a zero balance.
+func (kv *KVStore) AddValidator(val *types.Validator) error {
+	kv.validators = append(kv.validators, copyPtr(val))
+	kv.balances = append(kv.balances, 0)
+	return nil
+}
+
+// AddValidatorBartio appends a validator with a balance equal to its
+// effective balance.
+func (kv *KVStore) AddValidatorBartio(val *types.Validator) error {
+	kv.validators = append(kv.validators, copyPtr(val))
+	kv.balances = append(kv.balances, val.GetEffectiveBalance().Unwrap())
+	return nil
+}
+
+// GetValidatorsByEffectiveBalance returns all validators sorted by
+// effective balance.
+func (kv *KVStore) GetValidatorsByEffectiveBalance() (
+	[]*types.Validator, error,
+) {
+	vals, err := kv.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(vals, func(i, j int) bool {
+		return vals[i].GetEffectiveBalance() < vals[j].GetEffectiveBalance()
+	})
+	return vals, nil
+}
+
+// GetValidatorPower returns the CometBFT voting power of the validator at the
+// given index.
+func (kv *KVStore) GetValidatorPower(
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	return kv.validatorPowers[idx], nil
+}
+
+// SetValidatorPower sets the CometBFT voting power of the validator at the
+// given index.
+func (kv *KVStore) SetValidatorPower(
+	idx math.ValidatorIndex,
+	power math.Gwei,
+) error {
+	if power == 0 {
+		delete(kv.validatorPowers, idx)
+		return nil
+	}
+	kv.validatorPowers[idx] = power
+	return nil
+}
+
+// GetActiveValidatorIndices returns the indices of the validators in the
+// active validator set, sorted by index.
+func (kv *KVStore) GetActiveValidatorIndices() (
+	[]math.ValidatorIndex, error,
+) {
+	indices := make([]math.ValidatorIndex, 0, len(kv.validatorPowers))
+	for idx := range kv.validatorPowers {
+		indices = append(indices, idx)
+	}
+	slices.Sort(indices)
+	return indices, nil
+}
+
+// GetPreviousEpochParticipation returns the participation flags of the
+// validator at the given index for the previous epoch.
+func (kv *KVStore) GetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return kv.previousEpochParticipation[idx], nil
+}
+
+// SetPreviousEpochParticipation sets the participation flags of the
+// validator at the given index for the previous epoch.
+func (kv *KVStore) SetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	kv.previousEpochParticipation[idx] = flags
+	return nil
+}
+
+// GetCurrentEpochParticipation returns the participation flags of the
+// validator at the given index for the current epoch.
+func (kv *KVStore) GetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return kv.currentEpochParticipation[idx], nil
+}
+
+// SetCurrentEpochParticipation sets the participation flags of the
+// validator at the given index for the current epoch.
+func (kv *KVStore) SetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	kv.currentEpochParticipation[idx] = flags
+	return nil
+}
+
+// RotateEpochParticipation moves the participation flags of the current
+// epoch to the previous epoch and clears the ones of the current epoch.
+func (kv *KVStore) RotateEpochParticipation() error {
+	kv.previousEpochParticipation = kv.currentEpochParticipation
+	kv.currentEpochParticipation = make(map[math.ValidatorIndex]byte)
+	return nil
+}
+
+// GetInactivityScore returns the inactivity score of the validator at the
+// given index.
+func (kv *KVStore) GetInactivityScore(
+	idx math.ValidatorIndex,
+) (math.U64, error) {
+	return kv.inactivityScores[idx], nil
+}
+
+// SetInactivityScore sets the inactivity score of the validator at the
+// given index.
+func (kv *KVStore) SetInactivityScore(
+	idx math.ValidatorIndex,
+	score math.U64,
+) error {
+	kv.inactivityScores[idx] = score
+	return nil
+}
+
+// GetHistoricalSummaries returns the historical summaries.
+func (kv *KVStore) GetHistoricalSummaries() (
+	[]*types.HistoricalSummary, error,
+) {
+	return slices.Clone(kv.historicalSummaries), nil
+}
+
+// AddHistoricalSummary appends a historical summary.
+func (kv *KVStore) AddHistoricalSummary(
+	summary *types.HistoricalSummary,
+) error {
+	kv.historicalSummaries = append(kv.historicalSummaries, copyPtr(summary))
+	return nil
+}
+
+// SetHistoricalBlockRoots stores the block roots summarized by the
+// historical summary at the given index.
+func (kv *KVStore) SetHistoricalBlockRoots(
+	index uint64,
+	roots []common.Root,
+) error {
+	kv.historicalBlockRoots[index] = slices.Clone(roots)
+	return nil
+}
+
+// GetHistoricalBlockRoots returns the block roots summarized by the
+// historical summary at the given index.
+func (kv *KVStore) GetHistoricalBlockRoots(
+	index uint64,
+) ([]common.Root, error) {
+	roots, ok := kv.historicalBlockRoots[index]
+	if !ok {
+		return nil, errors.Wrapf(
+			ErrNotFound, "historical block roots %d", index,
+		)
+	}
+	return slices.Clone(roots), nil
+}
+
+// GetExitBalanceToConsume returns the balance left to exit in the epoch of
+// the earliest exit epoch.
+func (kv *KVStore) GetExitBalanceToConsume() (math.Gwei, error) {
+	return kv.exitBalanceToConsume, nil
+}
+
+// SetExitBalanceToConsume sets the balance left to exit in the epoch of the
+// earliest exit epoch.
+func (kv *KVStore) SetExitBalanceToConsume(balance math.Gwei) error {
+	kv.exitBalanceToConsume = balance
+	return nil
+}
+
+// GetEarliestExitEpoch returns the earliest epoch a validator can exit at.
+func (kv *KVStore) GetEarliestExitEpoch() (math.Epoch, error) {
+	return kv.earliestExitEpoch, nil
+}
+
+// SetEarliestExitEpoch sets the earliest epoch a validator can exit at.
+func (kv *KVStore) SetEarliestExitEpoch(epoch math.Epoch) error {
+	kv.earliestExitEpoch = epoch
+	return nil
+}
+
+// GetConsolidationBalanceToConsume returns the balance left to consolidate
+// in the epoch of the earliest consolidation epoch.
+func (kv *KVStore) GetConsolidationBalanceToConsume() (math.Gwei, error) {
+	return kv.consolidationBalanceToConsume, nil
+}
+
+// SetConsolidationBalanceToConsume sets the balance left to consolidate in
+// the epoch of the earliest consolidation epoch.
+func (kv *KVStore) SetConsolidationBalanceToConsume(balance math.Gwei) error {
+	kv.consolidationBalanceToConsume = balance
+	return nil
+}
+
+// GetEarliestConsolidationEpoch returns the earliest epoch a consolidation
+// can be processed at.
+func (kv *KVStore) GetEarliestConsolidationEpoch() (math.Epoch, error) {
+	return kv.earliestConsolidationEpoch, nil
+}
+
+// SetEarliestConsolidationEpoch sets the earliest epoch a consolidation can
+// be processed at.
+func (kv *KVStore) SetEarliestConsolidationEpoch(epoch math.Epoch) error {
+	kv.earliestConsolidationEpoch = epoch
+	return nil
+}
+
+// GetPendingConsolidations returns the pending consolidations.
+func (kv *KVStore) GetPendingConsolidations() (
+	[]*engineprimitives.PendingConsolidation, error,
+) {
+	return copyPtrs(kv.pendingConsolidations), nil
+}
+
+// SetPendingConsolidations sets the pending consolidations.
+func (kv *KVStore) SetPendingConsolidations(
+	consolidations []*engineprimitives.PendingConsolidation,
+) error {
+	kv.pendingConsolidations = copyPtrs(consolidations)
+	return nil
+}
+
+// copyPtrs returns a copy of the slice holding shallow copies of the values
+// behind its pointers.
+func copyPtrs[T any](ptrs []*T) []*T {
+	if ptrs == nil {
+		return nil
+	}
+	cpy := make([]*T, len(ptrs))
+	for i, ptr := range ptrs {
+		cpy[i] = copyPtr(ptr)
+	}
+	return cpy
+}
+
+// copyPtr returns a shallow copy of the value behind the pointer.
+func copyPtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	cpy := *v
+	return &cpy
+}
+
+// copyMap returns a copy of the map.
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	cpy := make(map[K]V, len(m))
+	for k, v := range m {
+		cpy[k] = v
+	}
+	return cpy
+}
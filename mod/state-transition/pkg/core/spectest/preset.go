@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// PresetMainnet is the name of the mainnet preset.
+	PresetMainnet = "mainnet"
+	// PresetMinimal is the name of the minimal preset.
+	PresetMinimal = "minimal"
+	// ForkDeneb is the name of the deneb fork, the only fork beacon-kit
+	// implements.
+	ForkDeneb = "deneb"
+)
+
+// Preset holds the preset values of the consensus specs that determine the
+// shape of the vectors and the behaviour of the state transition.
+type Preset struct {
+	SlotsPerEpoch                    uint64
+	SlotsPerHistoricalRoot           uint64
+	EpochsPerHistoricalVector        uint64
+	EpochsPerSlashingsVector         uint64
+	HistoricalRootsLimit             uint64
+	SyncCommitteeSize                uint64
+	MaxDepositsPerBlock              uint64
+	MaxWithdrawalsPerPayload         uint64
+	MaxValidatorsPerWithdrawalsSweep uint64
+	MaxBlobCommitmentsPerBlock       uint64
+	MaxBlobsPerBlock                 uint64
+	KZGCommitmentInclusionProofDepth uint64
+}
+
+// Presets are the presets the vectors are published for.
+//
+//nolint:gochecknoglobals,mnd // spec constants.
+var Presets = map[string]Preset{
+	PresetMainnet: {
+		SlotsPerEpoch:                    32,
+		SlotsPerHistoricalRoot:           8192,
+		EpochsPerHistoricalVector:        65536,
+		EpochsPerSlashingsVector:         8192,
+		HistoricalRootsLimit:             16777216,
+		SyncCommitteeSize:                512,
+		MaxDepositsPerBlock:              16,
+		MaxWithdrawalsPerPayload:         16,
+		MaxValidatorsPerWithdrawalsSweep: 16384,
+		MaxBlobCommitmentsPerBlock:       4096,
+		MaxBlobsPerBlock:                 6,
+		KZGCommitmentInclusionProofDepth: 17,
+	},
+	PresetMinimal: {
+		SlotsPerEpoch:                    8,
+		SlotsPerHistoricalRoot:           64,
+		EpochsPerHistoricalVector:        64,
+		EpochsPerSlashingsVector:         64,
+		HistoricalRootsLimit:             16777216,
+		SyncCommitteeSize:                32,
+		MaxDepositsPerBlock:              16,
+		MaxWithdrawalsPerPayload:         4,
+		MaxValidatorsPerWithdrawalsSweep: 16,
+		MaxBlobCommitmentsPerBlock:       16,
+		MaxBlobsPerBlock:                 6,
+		KZGCommitmentInclusionProofDepth: 9,
+	},
+}
+
+// PresetByName returns the preset with the given name.
+func PresetByName(name string) (Preset, error) {
+	preset, ok := Presets[name]
+	if !ok {
+		return Preset{}, errors.Wrapf(ErrUnknownPreset, "%s", name)
+	}
+	return preset, nil
+}
+
+// ChainSpec returns a chain spec matching the preset, with the phase0
+// configuration values used by the vectors.
+func (p Preset) ChainSpec() chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+] {
+	return chain.NewChainSpec(p.SpecData())
+}
+
+// SpecData returns the chain spec data matching the preset, which callers
+// may adjust (e.g. the fork epochs) before building a chain spec.
+//
+//nolint:mnd // spec constants.
+func (p Preset) SpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+] {
+	return chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]{
+		MinDepositAmount:                    uint64(1e9),
+		MaxEffectiveBalance:                 uint64(32e9),
+		EjectionBalance:                     uint64(16e9),
+		EffectiveBalanceIncrement:           uint64(1e9),
+		SlotsPerEpoch:                       p.SlotsPerEpoch,
+		MinEpochsToInactivityPenalty:        4,
+		SlotsPerHistoricalRoot:              p.SlotsPerHistoricalRoot,
+		DomainTypeProposer:                  common.DomainType{0x00},
+		DomainTypeAttester:                  common.DomainType{0x01},
+		DomainTypeRandao:                    common.DomainType{0x02},
+		DomainTypeDeposit:                   common.DomainType{0x03},
+		DomainTypeVoluntaryExit:             common.DomainType{0x04},
+		DomainTypeSelectionProof:            common.DomainType{0x05},
+		DomainTypeAggregateAndProof:         common.DomainType{0x06},
+		DomainTypeApplicationMask:           common.DomainType{0, 0, 0, 0x01},
+		MaxDepositsPerBlock:                 p.MaxDepositsPerBlock,
+		DepositEth1ChainID:                  1,
+		Eth1FollowDistance:                  2048,
+		TargetSecondsPerEth1Block:           14,
+		DenebPlusForkEpoch:                  math.Epoch(^uint64(0) - 1),
+		ElectraForkEpoch:                    math.Epoch(^uint64(0)),
+		PeerDASForkEpoch:                    math.Epoch(^uint64(0)),
+		EpochsPerHistoricalVector:           p.EpochsPerHistoricalVector,
+		EpochsPerSlashingsVector:            p.EpochsPerSlashingsVector,
+		HistoricalRootsLimit:                p.HistoricalRootsLimit,
+		ValidatorRegistryLimit:              1 << 40,
+		MaxActiveValidators:                 1 << 40,
+		BaseRewardFactor:                    64,
+		InactivityPenaltyQuotient:           1 << 24,
+		InactivityScoreBias:                 4,
+		InactivityScoreRecoveryRate:         16,
+		ProportionalSlashingMultiplier:      3,
+		MaxWithdrawalsPerPayload:            p.MaxWithdrawalsPerPayload,
+		MaxValidatorsPerWithdrawalsSweep:    p.MaxValidatorsPerWithdrawalsSweep,
+		MinEpochsForBlobsSidecarsRequest:    4096,
+		MaxBlobCommitmentsPerBlock:          p.MaxBlobCommitmentsPerBlock,
+		MaxBlobsPerBlock:                    p.MaxBlobsPerBlock,
+		FieldElementsPerBlob:                4096,
+		BytesPerBlob:                        131072,
+		KZGCommitmentInclusionProofDepth:    p.KZGCommitmentInclusionProofDepth,
+		CustodyRequirement:                  4,
+		SamplesPerSlot:                      8,
+		MinPerEpochChurnLimitElectra:        128e9,
+		MaxPerEpochActivationExitChurnLimit: 256e9,
+		ChurnLimitQuotient:                  1 << 16,
+	}
+}
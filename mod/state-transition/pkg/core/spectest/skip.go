@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import "path"
+
+// skips lists the handlers and cases of the vectors beacon-kit does not run,
+// keyed by runner, runner/handler or runner/handler/case, optionally
+// prefixed by the preset, with the reason beacon-kit diverges from the specs.
+//
+//nolint:gochecknoglobals,lll // list of constants.
+var skips = map[string]string{
+	// Operations beacon-kit does not have, as CometBFT provides the
+	// consensus and attestations.
+	"operations/attestation":                                "attestations are replaced by CometBFT votes",
+	"operations/attester_slashing":                          "slashings are not implemented",
+	"operations/proposer_slashing":                          "slashings are not implemented",
+	"operations/voluntary_exit":                             "voluntary exits are not implemented",
+	"operations/sync_aggregate":                             "sync committees are not implemented",
+	"operations/bls_to_execution_change":                    "withdrawal credentials are fixed at deposit",
+	"operations/block_header":                               "block headers carry the beacon-kit state root",
+	"operations/withdrawals":                                "every validator in the sweep gets a withdrawal, zero-amount ones included, and must have eth1 credentials",
+	"operations/deposit":                                    "deposits are read from the deposit contract and credited to the effective balance",
+	"operations/execution_payload/invalid_future_timestamp": "payload timestamps are not checked against the slot",
+	"operations/execution_payload/invalid_past_timestamp":   "payload timestamps are not checked against the slot",
+
+	// Epoch processing that depends on attestations or the sync committee.
+	"epoch_processing/justification_and_finalization": "finality is provided by CometBFT",
+	"epoch_processing/inactivity_updates":             "the inactivity leak applies per validator, as CometBFT finalizes every block",
+	"epoch_processing/rewards_and_penalties":          "the active set and its balance come from the CometBFT voting power and deltas only apply from Electra",
+	"epoch_processing/effective_balance_updates":      "effective balances are updated by deposits and withdrawals",
+	"epoch_processing/registry_updates":               "the validator set is driven by the deposit contract",
+	"epoch_processing/slashings":                      "slashings are not implemented",
+	"epoch_processing/eth1_data_reset":                "eth1 data is not voted on",
+	"epoch_processing/sync_committee_updates":         "sync committees are not implemented",
+
+	// Lists whose limits beacon-kit fixes to the mainnet preset.
+	"minimal/ssz_static/ExecutionPayload": "withdrawals limit is fixed to the mainnet preset",
+
+	// Containers beacon-kit does not have, or encodes differently.
+	"ssz_static/AggregateAndProof":           "attestations are replaced by CometBFT votes",
+	"ssz_static/Attestation":                 "attestations are replaced by CometBFT votes",
+	"ssz_static/AttestationData":             "attestation data carries the slot, index and block root only",
+	"ssz_static/AttesterSlashing":            "slashings are not implemented",
+	"ssz_static/BeaconBlock":                 "blocks carry no attestations, slashings, exits or sync aggregate",
+	"ssz_static/BeaconBlockBody":             "bodies carry no attestations, slashings, exits or sync aggregate",
+	"ssz_static/BeaconState":                 "the state carries no checkpoints, inactivity scores or sync committees",
+	"ssz_static/BlobIdentifier":              "blobs are identified by the block they are committed to",
+	"ssz_static/BlobSidecar":                 "blob sidecars carry the beacon-kit inclusion proof",
+	"ssz_static/BLSToExecutionChange":        "withdrawal credentials are fixed at deposit",
+	"ssz_static/Checkpoint":                  "finality is provided by CometBFT",
+	"ssz_static/ContributionAndProof":        "sync committees are not implemented",
+	"ssz_static/Deposit":                     "deposits are read from the deposit contract and carry its index",
+	"ssz_static/DepositData":                 "deposits are read from the deposit contract and carry its index",
+	"ssz_static/Eth1Block":                   "eth1 data is not voted on",
+	"ssz_static/HistoricalBatch":             "historical roots are replaced by historical summaries",
+	"ssz_static/IndexedAttestation":          "attestations are replaced by CometBFT votes",
+	"ssz_static/LightClientBootstrap":        "the light client follows the CometBFT commits",
+	"ssz_static/LightClientFinalityUpdate":   "the light client follows the CometBFT commits",
+	"ssz_static/LightClientHeader":           "the light client follows the CometBFT commits",
+	"ssz_static/LightClientOptimisticUpdate": "the light client follows the CometBFT commits",
+	"ssz_static/LightClientUpdate":           "the light client follows the CometBFT commits",
+	"ssz_static/PendingAttestation":          "attestations are replaced by CometBFT votes",
+	"ssz_static/PowBlock":                    "the merge transition is not supported",
+	"ssz_static/ProposerSlashing":            "slashings are not implemented",
+	"ssz_static/SignedAggregateAndProof":     "attestations are replaced by CometBFT votes",
+	"ssz_static/SignedBeaconBlock":           "blocks carry no attestations, slashings, exits or sync aggregate",
+	"ssz_static/SignedBeaconBlockHeader":     "slashings are not implemented",
+	"ssz_static/SignedBLSToExecutionChange":  "withdrawal credentials are fixed at deposit",
+	"ssz_static/SignedContributionAndProof":  "sync committees are not implemented",
+	"ssz_static/SignedVoluntaryExit":         "voluntary exits are not implemented",
+	"ssz_static/SyncAggregate":               "sync committees are not implemented",
+	"ssz_static/SyncAggregatorSelectionData": "sync committees are not implemented",
+	"ssz_static/SyncCommittee":               "sync committees are not implemented",
+	"ssz_static/SyncCommitteeContribution":   "sync committees are not implemented",
+	"ssz_static/SyncCommitteeMessage":        "sync committees are not implemented",
+	"ssz_static/VoluntaryExit":               "voluntary exits are not implemented",
+
+	// Sanity blocks carry attestations and the spec state root.
+	"sanity/blocks": "blocks carry the beacon-kit state root and no attestations",
+
+	// Runners covering what CometBFT or the node provides instead.
+	"finality":     "finality is provided by CometBFT",
+	"fork":         "the deneb vectors upgrade from capella, which beacon-kit does not run",
+	"fork_choice":  "fork choice is provided by CometBFT",
+	"genesis":      "genesis is built from the deposits of the genesis file",
+	"light_client": "the light client follows the CometBFT commits",
+	"merkle_proof": "proofs are built against the beacon-kit block body",
+	"random":       "blocks carry the beacon-kit state root and no attestations",
+	"rewards":      "rewards are credited from the CometBFT votes",
+	"shuffling":    "proposers are selected by CometBFT",
+	"sync":         "optimistic sync is not implemented",
+	"transition":   "the deneb vectors transition from capella, which beacon-kit does not run",
+}
+
+// SkipReason returns the reason the case is skipped, if it is.
+func SkipReason(c Case) (string, bool) {
+	for _, key := range []string{
+		c.Runner,
+		path.Join(c.Runner, c.Handler),
+		path.Join(c.Runner, c.Handler, c.Name),
+	} {
+		if reason, ok := skips[key]; ok {
+			return reason, true
+		}
+		if reason, ok := skips[path.Join(c.Preset, key)]; ok {
+			return reason, true
+		}
+	}
+	return "", false
+}
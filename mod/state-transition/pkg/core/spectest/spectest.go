@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package spectest loads the official Ethereum consensus-spec-tests vectors
+// and maps them onto the beacon-kit state transition.
+//
+// Vectors are laid out as
+// <root>/<preset>/<fork>/<runner>/<handler>/<suite>/<case>/, where each
+// case directory holds snappy-compressed SSZ objects and YAML metadata.
+package spectest
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/golang/snappy"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvDir is the environment variable pointing at the root of the
+	// extracted vectors, e.g. consensus-spec-tests/tests.
+	EnvDir = "CONSENSUS_SPEC_TESTS_DIR"
+	// sszSnappyExtension is the extension of snappy-compressed SSZ files.
+	sszSnappyExtension = ".ssz_snappy"
+	// yamlExtension is the extension of YAML files.
+	yamlExtension = ".yaml"
+)
+
+// Case is a single test case of the consensus-spec-tests vectors.
+type Case struct {
+	// Preset is the preset of the case, e.g. mainnet or minimal.
+	Preset string
+	// Fork is the fork of the case, e.g. deneb.
+	Fork string
+	// Runner is the test runner, e.g. operations or sanity.
+	Runner string
+	// Handler is the handler within the runner, e.g. withdrawals.
+	Handler string
+	// Suite is the suite within the handler, e.g. pyspec_tests.
+	Suite string
+	// Name is the name of the case.
+	Name string
+	// Dir is the directory holding the files of the case.
+	Dir string
+}
+
+// Cases returns every case of the given handler, sorted by suite and name.
+// It returns no cases if the handler does not exist in the vectors.
+func Cases(root, preset, fork, runner, handler string) ([]Case, error) {
+	handlerDir := filepath.Join(root, preset, fork, runner, handler)
+	suites, err := os.ReadDir(handlerDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var cases []Case
+	for _, suite := range suites {
+		if !suite.IsDir() {
+			continue
+		}
+
+		var entries []os.DirEntry
+		entries, err = os.ReadDir(filepath.Join(handlerDir, suite.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			cases = append(cases, Case{
+				Preset:  preset,
+				Fork:    fork,
+				Runner:  runner,
+				Handler: handler,
+				Suite:   suite.Name(),
+				Name:    entry.Name(),
+				Dir: filepath.Join(
+					handlerDir, suite.Name(), entry.Name(),
+				),
+			})
+		}
+	}
+
+	sort.Slice(cases, func(i, j int) bool {
+		if cases[i].Suite != cases[j].Suite {
+			return cases[i].Suite < cases[j].Suite
+		}
+		return cases[i].Name < cases[j].Name
+	})
+	return cases, nil
+}
+
+// Runners returns the names of all runners of a fork, sorted.
+func Runners(root, preset, fork string) ([]string, error) {
+	return subdirs(filepath.Join(root, preset, fork))
+}
+
+// Handlers returns the names of all handlers of a runner, sorted.
+func Handlers(root, preset, fork, runner string) ([]string, error) {
+	return subdirs(filepath.Join(root, preset, fork, runner))
+}
+
+// subdirs returns the names of the subdirectories of dir, sorted, or none if
+// dir does not exist.
+func subdirs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// Path returns the path of the case relative to the preset directory, in the
+// form runner/handler/suite/name.
+func (c Case) Path() string {
+	return filepath.ToSlash(
+		filepath.Join(c.Runner, c.Handler, c.Suite, c.Name),
+	)
+}
+
+// Has reports whether the case contains the SSZ object with the given name.
+func (c Case) Has(name string) bool {
+	_, err := os.Stat(filepath.Join(c.Dir, name+sszSnappyExtension))
+	return err == nil
+}
+
+// ReadSSZ reads and decompresses the SSZ object with the given name.
+func (c Case) ReadSSZ(name string) ([]byte, error) {
+	compressed, err := os.ReadFile(
+		filepath.Join(c.Dir, name+sszSnappyExtension),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Decode(nil, compressed)
+}
+
+// ReadYAML decodes the YAML file with the given name into v.
+func (c Case) ReadYAML(name string, v any) error {
+	bz, err := os.ReadFile(filepath.Join(c.Dir, name+yamlExtension))
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(bz, v)
+}
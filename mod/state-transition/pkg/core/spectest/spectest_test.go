@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/spectest"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestState(t *testing.T) (*spectest.BeaconState, spectest.Preset) {
+	t.Helper()
+	p, err := spectest.PresetByName(spectest.PresetMinimal)
+	require.NoError(t, err)
+
+	st := spectest.NewBeaconState(p)
+	st.Slot = 17
+	st.Fork.CurrentVersion = common.Version{4, 0, 0, 1}
+	st.HistoricalRoots = []common.Root{{1}, {2}}
+	st.Eth1DataVotes = []*types.Eth1Data{{DepositCount: 3}}
+	st.Eth1DepositIndex = 2
+	for i := range 2 {
+		st.Validators = append(st.Validators, &types.Validator{
+			Pubkey:           [48]byte{byte(i + 1)},
+			EffectiveBalance: math.Gwei(32e9),
+			ExitEpoch:        math.Epoch(^uint64(0)),
+		})
+		st.Balances = append(st.Balances, 32e9+uint64(i))
+	}
+	st.RandaoMixes[2] = common.Bytes32{7}
+	st.Slashings[3] = 1e9
+	st.PreviousEpochParticipation = []byte{1, 0}
+	st.CurrentEpochParticipation = []byte{0, 1}
+	st.InactivityScores = []uint64{0, 5}
+	st.NextWithdrawalIndex = 9
+	st.NextWithdrawalValidatorIndex = 1
+	return st, p
+}
+
+func TestBeaconState_RoundTrip(t *testing.T) {
+	st, p := newTestState(t)
+	bz, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded, err := spectest.DecodeBeaconState(bz, p)
+	require.NoError(t, err)
+	require.Equal(t, st.Slot, decoded.Slot)
+	require.Equal(t, st.Validators, decoded.Validators)
+	require.Equal(t, st.Balances, decoded.Balances)
+	require.Equal(t, st.RandaoMixes, decoded.RandaoMixes)
+	require.Equal(t, st.InactivityScores, decoded.InactivityScores)
+
+	reencoded, err := decoded.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+}
+
+func TestDecodeBeaconState_Invalid(t *testing.T) {
+	st, p := newTestState(t)
+	bz, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	_, err = spectest.DecodeBeaconState(bz[:100], p)
+	require.ErrorIs(t, err, spectest.ErrInvalidSSZ)
+
+	// A state of the mainnet preset does not decode as a minimal one.
+	mainnet := spectest.NewBeaconState(spectest.Presets[spectest.PresetMainnet])
+	bz, err = mainnet.MarshalSSZ()
+	require.NoError(t, err)
+	_, err = spectest.DecodeBeaconState(bz, p)
+	require.ErrorIs(t, err, spectest.ErrInvalidSSZ)
+}
+
+func TestCompare(t *testing.T) {
+	st, _ := newTestState(t)
+	kv := st.KVStore()
+	require.NoError(t, spectest.Compare(kv, st, spectest.AllFields...))
+
+	require.NoError(t, kv.SetBalance(1, 1))
+	require.NoError(t, kv.UpdateRandaoMixAtIndex(0, common.Bytes32{1}))
+	err := spectest.Compare(kv, st, spectest.AllFields...)
+	require.ErrorIs(t, err, spectest.ErrStateMismatch)
+	require.ErrorContains(t, err, "balances")
+	require.ErrorContains(t, err, "randao_mixes")
+	require.NoError(t, spectest.Compare(kv, st, spectest.FieldSlashings))
+}
+
+func TestKVStore_Copy(t *testing.T) {
+	st, _ := newTestState(t)
+	kv := st.KVStore()
+	cpy := kv.Copy()
+
+	val, err := cpy.ValidatorByIndex(0)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, cpy.UpdateValidatorAtIndex(0, val))
+	require.NoError(t, cpy.SetBalance(0, 0))
+
+	original, err := kv.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.False(t, original.Slashed)
+	balance, err := kv.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9), balance)
+
+	idx, err := kv.ValidatorIndexByPubkey([48]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(1), idx)
+	_, err = kv.ValidatorIndexByPubkey([48]byte{3})
+	require.ErrorIs(t, err, spectest.ErrNotFound)
+}
+
+func TestCases(t *testing.T) {
+	root := t.TempDir()
+	handlerDir := filepath.Join(
+		root, spectest.PresetMinimal, spectest.ForkDeneb,
+		"operations", "withdrawals",
+	)
+	for _, name := range []string{"case_b", "case_a"} {
+		dir := filepath.Join(handlerDir, "pyspec_tests", name)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "pre.ssz_snappy"),
+			snappy.Encode(nil, []byte(name)), 0o600,
+		))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "meta.yaml"), []byte("slots: 3\n"), 0o600,
+		))
+	}
+
+	runners, err := spectest.Runners(
+		root, spectest.PresetMinimal, spectest.ForkDeneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"operations"}, runners)
+
+	handlers, err := spectest.Handlers(
+		root, spectest.PresetMinimal, spectest.ForkDeneb, "operations",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"withdrawals"}, handlers)
+
+	cases, err := spectest.Cases(
+		root, spectest.PresetMinimal, spectest.ForkDeneb,
+		"operations", "withdrawals",
+	)
+	require.NoError(t, err)
+	require.Len(t, cases, 2)
+	require.Equal(t, "case_a", cases[0].Name)
+	require.Equal(t, "operations/withdrawals/pyspec_tests/case_a",
+		cases[0].Path())
+
+	require.True(t, cases[0].Has("pre"))
+	require.False(t, cases[0].Has("post"))
+	bz, err := cases[0].ReadSSZ("pre")
+	require.NoError(t, err)
+	require.Equal(t, []byte("case_a"), bz)
+
+	var meta struct {
+		Slots uint64 `yaml:"slots"`
+	}
+	require.NoError(t, cases[0].ReadYAML("meta", &meta))
+	require.Equal(t, uint64(3), meta.Slots)
+
+	cases, err = spectest.Cases(
+		root, spectest.PresetMainnet, spectest.ForkDeneb,
+		"operations", "withdrawals",
+	)
+	require.NoError(t, err)
+	require.Empty(t, cases)
+}
+
+func TestSkipReason(t *testing.T) {
+	for _, tc := range []struct {
+		c    spectest.Case
+		skip bool
+	}{
+		{spectest.Case{Runner: "operations", Handler: "execution_payload"}, false},
+		{spectest.Case{Runner: "operations", Handler: "attestation"}, true},
+		{spectest.Case{
+			Runner:  "operations",
+			Handler: "execution_payload",
+			Name:    "invalid_future_timestamp",
+		}, true},
+		{spectest.Case{
+			Preset:  spectest.PresetMinimal,
+			Runner:  "ssz_static",
+			Handler: "ExecutionPayload",
+		}, true},
+		{spectest.Case{
+			Preset:  spectest.PresetMainnet,
+			Runner:  "ssz_static",
+			Handler: "ExecutionPayload",
+		}, false},
+	} {
+		reason, skip := spectest.SkipReason(tc.c)
+		require.Equal(t, tc.skip, skip, tc.c)
+		require.Equal(t, tc.skip, reason != "", tc.c)
+	}
+}
@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// forkSize is the SSZ size of a Fork.
+	forkSize = 16
+	// blockHeaderSize is the SSZ size of a BeaconBlockHeader.
+	blockHeaderSize = 112
+	// eth1DataSize is the SSZ size of an Eth1Data.
+	eth1DataSize = 72
+	// validatorSize is the SSZ size of a Validator.
+	validatorSize = 121
+	// checkpointSize is the SSZ size of a Checkpoint.
+	checkpointSize = 40
+	// blsPubkeySize is the size of a BLS public key.
+	blsPubkeySize = 48
+)
+
+// BeaconState is the deneb BeaconState of the consensus specs. Fields that
+// have no counterpart in the beacon-kit state are kept in their SSZ form so
+// that the state round trips.
+type BeaconState struct {
+	GenesisTime                  uint64
+	GenesisValidatorsRoot        common.Root
+	Slot                         math.Slot
+	Fork                         *types.Fork
+	LatestBlockHeader            *types.BeaconBlockHeader
+	BlockRoots                   []common.Root
+	StateRoots                   []common.Root
+	HistoricalRoots              []common.Root
+	Eth1Data                     *types.Eth1Data
+	Eth1DataVotes                []*types.Eth1Data
+	Eth1DepositIndex             uint64
+	Validators                   []*types.Validator
+	Balances                     []uint64
+	RandaoMixes                  []common.Bytes32
+	Slashings                    []uint64
+	PreviousEpochParticipation   []byte
+	CurrentEpochParticipation    []byte
+	JustificationBits            byte
+	PreviousJustifiedCheckpoint  [checkpointSize]byte
+	CurrentJustifiedCheckpoint   [checkpointSize]byte
+	FinalizedCheckpoint          [checkpointSize]byte
+	InactivityScores             []uint64
+	CurrentSyncCommittee         []byte
+	NextSyncCommittee            []byte
+	LatestExecutionPayloadHeader *types.ExecutionPayloadHeader
+	NextWithdrawalIndex          uint64
+	NextWithdrawalValidatorIndex math.ValidatorIndex
+	HistoricalSummaries          []*types.HistoricalSummary
+}
+
+// NewBeaconState returns an empty BeaconState with the vectors sized for the
+// given preset.
+func NewBeaconState(p Preset) *BeaconState {
+	return &BeaconState{
+		Fork:              &types.Fork{},
+		LatestBlockHeader: &types.BeaconBlockHeader{},
+		BlockRoots:        make([]common.Root, p.SlotsPerHistoricalRoot),
+		StateRoots:        make([]common.Root, p.SlotsPerHistoricalRoot),
+		Eth1Data:          &types.Eth1Data{},
+		RandaoMixes: make(
+			[]common.Bytes32, p.EpochsPerHistoricalVector,
+		),
+		Slashings: make([]uint64, p.EpochsPerSlashingsVector),
+		CurrentSyncCommittee: make(
+			[]byte, (p.SyncCommitteeSize+1)*blsPubkeySize,
+		),
+		NextSyncCommittee: make(
+			[]byte, (p.SyncCommitteeSize+1)*blsPubkeySize,
+		),
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			BaseFeePerGas: new(uint256.Int),
+		},
+	}
+}
+
+// DecodeBeaconState decodes a deneb BeaconState encoded with the given
+// preset.
+//
+//nolint:funlen // mirrors the container definition.
+func DecodeBeaconState(bz []byte, p Preset) (*BeaconState, error) {
+	var (
+		err               error
+		st                = &BeaconState{}
+		d                 = newDecoder(bz)
+		syncCommitteeSize = int((p.SyncCommitteeSize + 1) * blsPubkeySize)
+	)
+
+	st.GenesisTime = d.uint64()
+	copy(st.GenesisValidatorsRoot[:], d.next(bytesPerRoot))
+	st.Slot = math.Slot(d.uint64())
+	forkBz := d.next(forkSize)
+	headerBz := d.next(blockHeaderSize)
+	blockRootsBz := d.next(int(p.SlotsPerHistoricalRoot) * bytesPerRoot)
+	stateRootsBz := d.next(int(p.SlotsPerHistoricalRoot) * bytesPerRoot)
+	d.offset() // historical_roots
+	eth1DataBz := d.next(eth1DataSize)
+	d.offset() // eth1_data_votes
+	st.Eth1DepositIndex = d.uint64()
+	d.offset() // validators
+	d.offset() // balances
+	randaoMixesBz := d.next(int(p.EpochsPerHistoricalVector) * bytesPerRoot)
+	slashingsBz := d.next(int(p.EpochsPerSlashingsVector) * bytesPerUint64)
+	d.offset() // previous_epoch_participation
+	d.offset() // current_epoch_participation
+	st.JustificationBits = d.next(1)[0]
+	copy(st.PreviousJustifiedCheckpoint[:], d.next(checkpointSize))
+	copy(st.CurrentJustifiedCheckpoint[:], d.next(checkpointSize))
+	copy(st.FinalizedCheckpoint[:], d.next(checkpointSize))
+	d.offset() // inactivity_scores
+	st.CurrentSyncCommittee = append([]byte(nil), d.next(syncCommitteeSize)...)
+	st.NextSyncCommittee = append([]byte(nil), d.next(syncCommitteeSize)...)
+	d.offset() // latest_execution_payload_header
+	st.NextWithdrawalIndex = d.uint64()
+	st.NextWithdrawalValidatorIndex = math.ValidatorIndex(d.uint64())
+	d.offset() // historical_summaries
+
+	variable, err := d.variable()
+	if err != nil {
+		return nil, err
+	}
+
+	st.Fork = &types.Fork{}
+	if err = st.Fork.UnmarshalSSZ(forkBz); err != nil {
+		return nil, err
+	}
+	st.LatestBlockHeader = &types.BeaconBlockHeader{}
+	if err = st.LatestBlockHeader.UnmarshalSSZ(headerBz); err != nil {
+		return nil, err
+	}
+	st.Eth1Data = &types.Eth1Data{}
+	if err = st.Eth1Data.UnmarshalSSZ(eth1DataBz); err != nil {
+		return nil, err
+	}
+	if st.BlockRoots, err = decodeRoots[common.Root](blockRootsBz); err != nil {
+		return nil, err
+	}
+	if st.StateRoots, err = decodeRoots[common.Root](stateRootsBz); err != nil {
+		return nil, err
+	}
+	if st.RandaoMixes, err = decodeRoots[common.Bytes32](
+		randaoMixesBz,
+	); err != nil {
+		return nil, err
+	}
+	if st.Slashings, err = decodeUint64s(slashingsBz); err != nil {
+		return nil, err
+	}
+
+	if st.HistoricalRoots, err = decodeRoots[common.Root](
+		variable[0],
+	); err != nil {
+		return nil, err
+	}
+	if st.Eth1DataVotes, err = decodeObjects[*types.Eth1Data](
+		variable[1], eth1DataSize,
+	); err != nil {
+		return nil, err
+	}
+	if st.Validators, err = decodeObjects[*types.Validator](
+		variable[2], validatorSize,
+	); err != nil {
+		return nil, err
+	}
+	if st.Balances, err = decodeUint64s(variable[3]); err != nil {
+		return nil, err
+	}
+	st.PreviousEpochParticipation = append([]byte(nil), variable[4]...)
+	st.CurrentEpochParticipation = append([]byte(nil), variable[5]...)
+	if st.InactivityScores, err = decodeUint64s(variable[6]); err != nil {
+		return nil, err
+	}
+	st.LatestExecutionPayloadHeader = &types.ExecutionPayloadHeader{}
+	if err = st.LatestExecutionPayloadHeader.UnmarshalSSZ(
+		variable[7],
+	); err != nil {
+		return nil, err
+	}
+	if st.HistoricalSummaries, err = decodeObjects[*types.HistoricalSummary](
+		variable[8], types.HistoricalSummarySize,
+	); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// ReadState reads the BeaconState with the given name from the case.
+func (c Case) ReadState(name string, p Preset) (*BeaconState, error) {
+	bz, err := c.ReadSSZ(name)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeBeaconState(bz, p)
+}
+
+// MarshalSSZ encodes the state as a deneb BeaconState.
+func (st *BeaconState) MarshalSSZ() ([]byte, error) {
+	forkBz, err := st.Fork.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	headerBz, err := st.LatestBlockHeader.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	eth1DataBz, err := st.Eth1Data.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	votesBz, err := encodeObjects(st.Eth1DataVotes)
+	if err != nil {
+		return nil, err
+	}
+	validatorsBz, err := encodeObjects(st.Validators)
+	if err != nil {
+		return nil, err
+	}
+	payloadHeaderBz, err := st.LatestExecutionPayloadHeader.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	summariesBz, err := encodeObjects(st.HistoricalSummaries)
+	if err != nil {
+		return nil, err
+	}
+
+	e := newEncoder()
+	e.putUint64(st.GenesisTime)
+	e.putFixed(st.GenesisValidatorsRoot[:])
+	e.putUint64(st.Slot.Unwrap())
+	e.putFixed(forkBz)
+	e.putFixed(headerBz)
+	e.putFixed(encodeRoots(st.BlockRoots))
+	e.putFixed(encodeRoots(st.StateRoots))
+	e.putVariable(encodeRoots(st.HistoricalRoots))
+	e.putFixed(eth1DataBz)
+	e.putVariable(votesBz)
+	e.putUint64(st.Eth1DepositIndex)
+	e.putVariable(validatorsBz)
+	e.putVariable(encodeUint64s(st.Balances))
+	e.putFixed(encodeRoots(st.RandaoMixes))
+	e.putFixed(encodeUint64s(st.Slashings))
+	e.putVariable(st.PreviousEpochParticipation)
+	e.putVariable(st.CurrentEpochParticipation)
+	e.putFixed([]byte{st.JustificationBits})
+	e.putFixed(st.PreviousJustifiedCheckpoint[:])
+	e.putFixed(st.CurrentJustifiedCheckpoint[:])
+	e.putFixed(st.FinalizedCheckpoint[:])
+	e.putVariable(encodeUint64s(st.InactivityScores))
+	e.putFixed(st.CurrentSyncCommittee)
+	e.putFixed(st.NextSyncCommittee)
+	e.putVariable(payloadHeaderBz)
+	e.putUint64(st.NextWithdrawalIndex)
+	e.putUint64(st.NextWithdrawalValidatorIndex.Unwrap())
+	e.putVariable(summariesBz)
+	return e.bytes(), nil
+}
+
+// sszObject is an SSZ object that can be decoded into a new instance.
+type sszObject[T any] interface {
+	*T
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ([]byte) error
+}
+
+// decodeObjects decodes a list of fixed-size SSZ objects.
+func decodeObjects[PT sszObject[T], T any](
+	bz []byte,
+	size int,
+) ([]PT, error) {
+	elems, err := decodeList(bz, size)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]PT, len(elems))
+	for i, elem := range elems {
+		objects[i] = PT(new(T))
+		if err = objects[i].UnmarshalSSZ(elem); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// encodeObjects encodes a list of fixed-size SSZ objects.
+func encodeObjects[PT interface{ MarshalSSZ() ([]byte, error) }](
+	objects []PT,
+) ([]byte, error) {
+	var out []byte
+	for _, object := range objects {
+		bz, err := object.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, bz...)
+	}
+	return out, nil
+}
@@ -23,6 +23,7 @@ package state
 import (
 	"context"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -35,6 +36,7 @@ type KVStore[
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
 	ForkT,
+	HistoricalSummaryT,
 	ValidatorT any,
 	ValidatorsT ~[]ValidatorT,
 ] interface {
@@ -143,4 +145,71 @@ type KVStore[
 	// GetValidatorsByEffectiveBalance retrieves validators by effective
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	// GetValidatorPower retrieves the CometBFT voting power of a validator.
+	GetValidatorPower(idx math.ValidatorIndex) (math.Gwei, error)
+	// SetValidatorPower sets the CometBFT voting power of a validator.
+	SetValidatorPower(idx math.ValidatorIndex, power math.Gwei) error
+	// GetActiveValidatorIndices retrieves the indices of the validators in
+	// the active validator set.
+	GetActiveValidatorIndices() ([]math.ValidatorIndex, error)
+	// GetPreviousEpochParticipation retrieves the participation flags of a
+	// validator for the previous epoch.
+	GetPreviousEpochParticipation(idx math.ValidatorIndex) (byte, error)
+	// SetPreviousEpochParticipation sets the participation flags of a
+	// validator for the previous epoch.
+	SetPreviousEpochParticipation(idx math.ValidatorIndex, flags byte) error
+	// GetCurrentEpochParticipation retrieves the participation flags of a
+	// validator for the current epoch.
+	GetCurrentEpochParticipation(idx math.ValidatorIndex) (byte, error)
+	// SetCurrentEpochParticipation sets the participation flags of a
+	// validator for the current epoch.
+	SetCurrentEpochParticipation(idx math.ValidatorIndex, flags byte) error
+	// RotateEpochParticipation moves the participation flags of the current
+	// epoch to the previous epoch.
+	RotateEpochParticipation() error
+	// GetInactivityScore retrieves the inactivity score of a validator.
+	GetInactivityScore(idx math.ValidatorIndex) (math.U64, error)
+	// SetInactivityScore sets the inactivity score of a validator.
+	SetInactivityScore(idx math.ValidatorIndex, score math.U64) error
+	// GetHistoricalSummaries retrieves all historical summaries.
+	GetHistoricalSummaries() ([]HistoricalSummaryT, error)
+	// AddHistoricalSummary appends a historical summary.
+	AddHistoricalSummary(summary HistoricalSummaryT) error
+	// GetHistoricalBlockRoots retrieves the block roots summarized by the
+	// historical summary at the given index.
+	GetHistoricalBlockRoots(index uint64) ([]common.Root, error)
+	// SetHistoricalBlockRoots sets the block roots summarized by the
+	// historical summary at the given index.
+	SetHistoricalBlockRoots(index uint64, roots []common.Root) error
+	// GetExitBalanceToConsume retrieves the balance left to exit in the
+	// epoch of the earliest exit epoch.
+	GetExitBalanceToConsume() (math.Gwei, error)
+	// SetExitBalanceToConsume sets the balance left to exit in the epoch of
+	// the earliest exit epoch.
+	SetExitBalanceToConsume(balance math.Gwei) error
+	// GetEarliestExitEpoch retrieves the earliest epoch a validator can exit
+	// at.
+	GetEarliestExitEpoch() (math.Epoch, error)
+	// SetEarliestExitEpoch sets the earliest epoch a validator can exit at.
+	SetEarliestExitEpoch(epoch math.Epoch) error
+	// GetConsolidationBalanceToConsume retrieves the balance left to
+	// consolidate in the epoch of the earliest consolidation epoch.
+	GetConsolidationBalanceToConsume() (math.Gwei, error)
+	// SetConsolidationBalanceToConsume sets the balance left to consolidate
+	// in the epoch of the earliest consolidation epoch.
+	SetConsolidationBalanceToConsume(balance math.Gwei) error
+	// GetEarliestConsolidationEpoch retrieves the earliest epoch a
+	// consolidation can be processed at.
+	GetEarliestConsolidationEpoch() (math.Epoch, error)
+	// SetEarliestConsolidationEpoch sets the earliest epoch a consolidation
+	// can be processed at.
+	SetEarliestConsolidationEpoch(epoch math.Epoch) error
+	// GetPendingConsolidations retrieves the pending consolidations.
+	GetPendingConsolidations() (
+		[]*engineprimitives.PendingConsolidation, error,
+	)
+	// SetPendingConsolidations sets the pending consolidations.
+	SetPendingConsolidations(
+		consolidations []*engineprimitives.PendingConsolidation,
+	) error
 }
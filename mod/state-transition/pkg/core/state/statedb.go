@@ -37,17 +37,20 @@ type StateDB[
 		Eth1DataT,
 		ExecutionPayloadHeaderT,
 		ForkT,
+		HistoricalSummaryT,
 		ValidatorT,
 	],
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
 	ForkT any,
+	HistoricalSummaryT HistoricalSummary[HistoricalSummaryT],
 	KVStoreT KVStore[
 		KVStoreT,
 		BeaconBlockHeaderT,
 		Eth1DataT,
 		ExecutionPayloadHeaderT,
 		ForkT,
+		HistoricalSummaryT,
 		ValidatorT,
 		ValidatorsT,
 	],
@@ -62,6 +65,7 @@ type StateDB[
 		Eth1DataT,
 		ExecutionPayloadHeaderT,
 		ForkT,
+		HistoricalSummaryT,
 		ValidatorT,
 		ValidatorsT,
 	]
@@ -71,7 +75,7 @@ type StateDB[
 // NewBeaconStateFromDB creates a new beacon state from an underlying state db.
 func (s *StateDB[
 	BeaconBlockHeaderT, BeaconStateMarshallableT,
-	Eth1DataT, ExecutionPayloadHeaderT, ForkT, KVStoreT,
+	Eth1DataT, ExecutionPayloadHeaderT, ForkT, HistoricalSummaryT, KVStoreT,
 	ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
 ]) NewFromDB(
 	bdb KVStoreT,
@@ -82,6 +86,7 @@ func (s *StateDB[
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
 	ForkT,
+	HistoricalSummaryT,
 	KVStoreT,
 	ValidatorT,
 	ValidatorsT,
@@ -94,6 +99,7 @@ func (s *StateDB[
 		Eth1DataT,
 		ExecutionPayloadHeaderT,
 		ForkT,
+		HistoricalSummaryT,
 		KVStoreT,
 		ValidatorT,
 		ValidatorsT,
@@ -108,7 +114,7 @@ func (s *StateDB[
 // Copy returns a copy of the beacon state.
 func (s *StateDB[
 	BeaconBlockHeaderT, BeaconStateMarshallableT,
-	Eth1DataT, ExecutionPayloadHeaderT, ForkT, KVStoreT,
+	Eth1DataT, ExecutionPayloadHeaderT, ForkT, HistoricalSummaryT, KVStoreT,
 	ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
 ]) Copy() *StateDB[
 	BeaconBlockHeaderT,
@@ -116,6 +122,7 @@ func (s *StateDB[
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
 	ForkT,
+	HistoricalSummaryT,
 	KVStoreT,
 	ValidatorT,
 	ValidatorsT,
@@ -127,7 +134,7 @@ func (s *StateDB[
 
 // IncreaseBalance increases the balance of a validator.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) IncreaseBalance(
 	idx math.ValidatorIndex,
 	delta math.Gwei,
@@ -141,7 +148,7 @@ func (s *StateDB[
 
 // DecreaseBalance decreases the balance of a validator.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) DecreaseBalance(
 	idx math.ValidatorIndex,
 	delta math.Gwei,
@@ -155,7 +162,7 @@ func (s *StateDB[
 
 // UpdateSlashingAtIndex sets the slashing amount in the store.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) UpdateSlashingAtIndex(
 	index uint64,
 	amount math.Gwei,
@@ -188,7 +195,7 @@ func (s *StateDB[
 //
 //nolint:lll
 func (s *StateDB[
-	_, _, _, _, _, _, ValidatorT, _, WithdrawalT, _,
+	_, _, _, _, _, _, _, ValidatorT, _, WithdrawalT, _,
 ]) ExpectedWithdrawals() ([]WithdrawalT, error) {
 	var (
 		validator         ValidatorT
@@ -281,11 +288,36 @@ func (s *StateDB[
 	return withdrawals, nil
 }
 
+// AppendHistoricalSummary appends the summary of the block and state roots
+// of a historical period to the historical summaries. The block roots of the
+// period are kept along with the summary, since the ring they are read from
+// is overwritten by the next period and the blocks may have been pruned.
+func (s *StateDB[
+	_, _, _, _, _, HistoricalSummaryT, _, _, _, _, _,
+]) AppendHistoricalSummary(
+	blockRoots []common.Root,
+	blockSummaryRoot common.Root,
+	stateSummaryRoot common.Root,
+) error {
+	summaries, err := s.GetHistoricalSummaries()
+	if err != nil {
+		return err
+	}
+	if err = s.SetHistoricalBlockRoots(
+		uint64(len(summaries)), blockRoots,
+	); err != nil {
+		return err
+	}
+	return s.AddHistoricalSummary(
+		(*new(HistoricalSummaryT)).New(blockSummaryRoot, stateSummaryRoot),
+	)
+}
+
 // GetMarshallable is the interface for the beacon store.
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
-	_, BeaconStateMarshallableT, _, _, _, _, _, _, _, _,
+	_, BeaconStateMarshallableT, _, _, _, _, _, _, _, _, _,
 ]) GetMarshallable() (BeaconStateMarshallableT, error) {
 	var empty BeaconStateMarshallableT
 
@@ -378,6 +410,36 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	historicalSummaries, err := s.GetHistoricalSummaries()
+	if err != nil {
+		return empty, err
+	}
+
+	exitBalanceToConsume, err := s.GetExitBalanceToConsume()
+	if err != nil {
+		return empty, err
+	}
+
+	earliestExitEpoch, err := s.GetEarliestExitEpoch()
+	if err != nil {
+		return empty, err
+	}
+
+	consolidationBalanceToConsume, err := s.GetConsolidationBalanceToConsume()
+	if err != nil {
+		return empty, err
+	}
+
+	earliestConsolidationEpoch, err := s.GetEarliestConsolidationEpoch()
+	if err != nil {
+		return empty, err
+	}
+
+	pendingConsolidations, err := s.GetPendingConsolidations()
+	if err != nil {
+		return empty, err
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
 		s.cs.ActiveForkVersionForSlot(slot),
@@ -397,12 +459,18 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		historicalSummaries,
+		exitBalanceToConsume,
+		earliestExitEpoch,
+		consolidationBalanceToConsume,
+		earliestConsolidationEpoch,
+		pendingConsolidations,
 	)
 }
 
 // HashTreeRoot is the interface for the beacon store.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) HashTreeRoot() common.Root {
 	st, err := s.GetMarshallable()
 	if err != nil {
@@ -21,6 +21,7 @@
 package state
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -34,6 +35,7 @@ type BeaconStateMarshallable[
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
 	ForkT,
+	HistoricalSummaryT,
 	ValidatorT any,
 ] interface {
 	constraints.SSZMarshallableRootable
@@ -55,9 +57,22 @@ type BeaconStateMarshallable[
 		nextWithdrawalIndex uint64,
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []uint64, totalSlashing math.U64,
+		historicalSummaries []HistoricalSummaryT,
+		exitBalanceToConsume math.Gwei,
+		earliestExitEpoch math.Epoch,
+		consolidationBalanceToConsume math.Gwei,
+		earliestConsolidationEpoch math.Epoch,
+		pendingConsolidations []*engineprimitives.PendingConsolidation,
 	) (T, error)
 }
 
+// HistoricalSummary represents an interface for the summary of the block and
+// state roots of a historical period.
+type HistoricalSummary[T any] interface {
+	// New returns a new historical summary of the given roots.
+	New(blockSummaryRoot, stateSummaryRoot common.Root) T
+}
+
 // Validator represents an interface for a validator with generic withdrawal
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
@@ -25,21 +25,22 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
 // main state transition for the beacon chain.
 type StateProcessor[
+	AttestationDataT AttestationData,
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		AttestationDataT, DepositT, BeaconBlockBodyT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		AttestationDataT, BeaconBlockBodyT, DepositT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
@@ -50,7 +51,7 @@ type StateProcessor[
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
@@ -75,7 +76,7 @@ type StateProcessor[
 		Len() int
 		EncodeIndex(int, *bytes.Buffer)
 	},
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
 	// cs is the chain specification for the beacon chain.
 	cs common.ChainSpec
@@ -89,12 +90,13 @@ type StateProcessor[
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
+	AttestationDataT AttestationData,
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		AttestationDataT, DepositT, BeaconBlockBodyT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
+		AttestationDataT, BeaconBlockBodyT,
 		DepositT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
 		WithdrawalsT,
@@ -105,7 +107,7 @@ func NewStateProcessor[
 		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
@@ -130,7 +132,7 @@ func NewStateProcessor[
 		Len() int
 		EncodeIndex(int, *bytes.Buffer)
 	},
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	cs common.ChainSpec,
 	executionEngine ExecutionEngine[
@@ -138,13 +140,13 @@ func NewStateProcessor[
 	],
 	signer crypto.BLSSigner,
 ) *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
 	ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
 ] {
 	return &StateProcessor[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
 		ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
@@ -157,8 +159,8 @@ func NewStateProcessor[
 
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _,
+	_,
 ]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
@@ -169,7 +171,9 @@ func (sp *StateProcessor[
 	}
 
 	// Process the slots.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	validatorUpdates, err := sp.processSlots(
+		st, blk.GetSlot(), ctx.GetBlockRewards(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -182,10 +186,22 @@ func (sp *StateProcessor[
 	return validatorUpdates, nil
 }
 
+// ProcessSlots processes the slots up to the given slot, including any
+// epoch boundary in between.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.U64,
+) (transition.ValidatorUpdates, error) {
+	return sp.processSlots(st, slot, nil)
+}
+
+// processSlots processes the slots up to the given slot and records the
+// rewards and penalties of the epoch processing in rewards, if not nil.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processSlots(
+	st BeaconStateT, slot math.U64, rewards *transition.BlockRewards,
 ) (transition.ValidatorUpdates, error) {
 	var (
 		validatorUpdates      transition.ValidatorUpdates
@@ -207,7 +223,7 @@ func (sp *StateProcessor[
 		// Process the Epoch Boundary.
 		if uint64(stateSlot+1)%sp.cs.SlotsPerEpoch() == 0 {
 			if epochValidatorUpdates, err =
-				sp.processEpoch(st); err != nil {
+				sp.processEpoch(st, rewards); err != nil {
 				return nil, err
 			}
 			validatorUpdates = append(
@@ -221,6 +237,15 @@ func (sp *StateProcessor[
 		if err = st.SetSlot(stateSlot + 1); err != nil {
 			return nil, err
 		}
+
+		// Upgrade the state when entering the first epoch of a new fork.
+		if uint64(stateSlot+1)%sp.cs.SlotsPerEpoch() == 0 {
+			if err = sp.processForkUpgrade(
+				st, sp.cs.SlotToEpoch(stateSlot+1),
+			); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return validatorUpdates, nil
@@ -228,7 +253,7 @@ func (sp *StateProcessor[
 
 // processSlot is run when a slot is missed.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlot(
 	st BeaconStateT,
 ) error {
@@ -271,7 +296,8 @@ func (sp *StateProcessor[
 // ProcessBlock processes the block, it optionally verifies the
 // state root.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _,
+	_,
 ]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
@@ -282,6 +308,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	rewards := ctx.GetBlockRewards()
+	if rewards != nil {
+		rewards.Slot = blk.GetSlot()
+		rewards.ProposerIndex = blk.GetProposerIndex()
+	}
+
 	// process the execution payload.
 	if err := sp.processExecutionPayload(
 		ctx, st, blk,
@@ -317,6 +349,19 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	// process the requests emitted by the execution layer.
+	if err := sp.processExecutionRequests(st, blk); err != nil {
+		return err
+	}
+
+	// process the participation of the validators that committed to the
+	// previous block, and their attestations of the execution layer view.
+	if err := sp.processAttestations(
+		st, blk, ctx.GetCommitVoters(), rewards,
+	); err != nil {
+		return err
+	}
+
 	// If we are skipping validate, we can skip calculating the state
 	// root to save compute.
 	if ctx.GetSkipValidateResult() {
@@ -338,25 +383,41 @@ func (sp *StateProcessor[
 
 // processEpoch processes the epoch and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processEpoch(
 	st BeaconStateT,
+	rewards *transition.BlockRewards,
 ) (transition.ValidatorUpdates, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	if err := sp.processRewardsAndPenalties(st, rewards); err != nil {
+		return nil, err
+	} else if err = sp.processParticipationFlagUpdates(st); err != nil {
 		return nil, err
 	} else if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
+	} else if err = sp.processHistoricalSummariesUpdate(st); err != nil {
+		return nil, err
+	}
+
+	// The pending consolidations only exist from Electra onwards.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	if sp.cs.ActiveForkVersionForSlot(slot) >= version.Electra {
+		if err = sp.processPendingConsolidations(st); err != nil {
+			return nil, err
+		}
 	}
-	return sp.processSyncCommitteeUpdates(st)
+	return sp.processValidatorSetUpdates(st)
 }
 
 // processBlockHeader processes the header and ensures it matches the local
 // state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
-	_, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, _, _,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -435,82 +496,3 @@ func (sp *StateProcessor[
 	}
 	return nil
 }
-
-// getAttestationDeltas as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
-//
-//nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) getAttestationDeltas(
-	st BeaconStateT,
-) ([]math.Gwei, []math.Gwei, error) {
-	// TODO: implement this function forreal
-	validators, err := st.GetValidators()
-	if err != nil {
-		return nil, nil, err
-	}
-	placeholder := make([]math.Gwei, len(validators))
-	return placeholder, placeholder, nil
-}
-
-// processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#process_rewards_and_penalties
-//
-//nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processRewardsAndPenalties(
-	st BeaconStateT,
-) error {
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-
-	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
-		return nil
-	}
-
-	rewards, penalties, err := sp.getAttestationDeltas(st)
-	if err != nil {
-		return err
-	}
-
-	validators, err := st.GetValidators()
-	if err != nil {
-		return err
-	}
-
-	if len(validators) != len(rewards) {
-		return errors.Wrapf(
-			ErrRewardsLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(rewards),
-		)
-	} else if len(validators) != len(penalties) {
-		return errors.Wrapf(
-			ErrPenaltiesLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(penalties),
-		)
-	}
-
-	for i := range validators {
-		// Increase the balance of the validator.
-		if err = st.IncreaseBalance(
-			math.ValidatorIndex(i),
-			rewards[i],
-		); err != nil {
-			return err
-		}
-
-		// Decrease the balance of the validator.
-		if err = st.DecreaseBalance(
-			math.ValidatorIndex(i),
-			penalties[i],
-		); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
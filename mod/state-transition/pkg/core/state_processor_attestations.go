@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processAttestations verifies the attestations aggregated by the proposer
+// from the vote extensions of the previous block, and credits the
+// participation of the validators that committed to it. Each validator
+// attests at most once to the execution layer view it had while voting on the
+// previous slot, attestations are thus sorted by strictly increasing
+// validator index.
+func (sp *StateProcessor[
+	AttestationDataT, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _,
+]) processAttestations(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	voters [][]byte,
+	rewards *transition.BlockRewards,
+) error {
+	attestations := blk.GetBody().GetAttestations()
+	slot := blk.GetSlot()
+	if len(attestations) != 0 &&
+		sp.cs.ActiveForkVersionForSlot(slot) < version.Electra {
+		return errors.Wrapf(
+			ErrInvalidAttestation, "attestations before electra, slot %d",
+			slot,
+		)
+	}
+
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	for i, att := range attestations {
+		switch {
+		case att.GetSlot()+1 != slot:
+			return errors.Wrapf(
+				ErrInvalidAttestation, "expected slot %d, got %d",
+				slot-1, att.GetSlot(),
+			)
+		case att.GetIndex().Unwrap() >= totalValidators:
+			return errors.Wrapf(
+				ErrInvalidAttestation, "unknown validator index %d",
+				att.GetIndex(),
+			)
+		case i > 0 && att.GetIndex() <= attestations[i-1].GetIndex():
+			return errors.Wrapf(
+				ErrInvalidAttestation, "unsorted validator index %d",
+				att.GetIndex(),
+			)
+		}
+	}
+	return sp.processParticipation(st, blk, voters, rewards)
+}
+
+// processParticipation sets the participation flags of the validators whose
+// votes for the previous slot were committed, for the epoch of that slot,
+// and rewards the proposer for every flag newly set as defined in the Altair
+// specification. A committed vote is a timely vote for the source, in every
+// fork, and the attestation the validator extended it with, from Electra on,
+// credits the target and head flags when its execution layer view matches
+// the parent payload. Only the active validators that are not slashed make
+// up the CometBFT validator set, the votes of other validators are ignored
+// and earn the proposer nothing.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#modified-process_attestation
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttestationDataT, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
+]) processParticipation(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	voters [][]byte,
+	rewards *transition.BlockRewards,
+) error {
+	slot := blk.GetSlot()
+	if len(voters) == 0 || slot == 0 {
+		return nil
+	}
+
+	totalActiveBalance, err := sp.getTotalActiveBalance(st)
+	if err != nil {
+		return err
+	}
+
+	activeIndices, err := st.GetActiveValidatorIndices()
+	if err != nil {
+		return err
+	}
+	active := make(map[math.ValidatorIndex]struct{}, len(activeIndices))
+	for _, idx := range activeIndices {
+		active[idx] = struct{}{}
+	}
+
+	attestations := blk.GetBody().GetAttestations()
+	views := make(map[math.ValidatorIndex]AttestationDataT, len(attestations))
+	for _, att := range attestations {
+		views[math.ValidatorIndex(att.GetIndex())] = att
+	}
+
+	var (
+		flags           byte
+		numerator       uint64
+		increment       = sp.cs.EffectiveBalanceIncrement()
+		perIncrement    = sp.getBaseRewardPerIncrement(totalActiveBalance)
+		current         = sp.cs.SlotToEpoch(slot-1) == sp.cs.SlotToEpoch(slot)
+		parentBlockHash = blk.GetBody().GetExecutionPayload().GetParentHash()
+	)
+	for _, voter := range voters {
+		index, idxErr := st.ValidatorIndexByCometBFTAddress(voter)
+		if idxErr != nil {
+			return errors.Wrapf(idxErr, "commit voter %x", voter)
+		}
+		if _, ok := active[index]; !ok {
+			continue
+		}
+		val, valErr := st.ValidatorByIndex(index)
+		if valErr != nil {
+			return valErr
+		}
+		if val.IsSlashed() {
+			continue
+		}
+
+		if current {
+			flags, err = st.GetCurrentEpochParticipation(index)
+		} else {
+			flags, err = st.GetPreviousEpochParticipation(index)
+		}
+		if err != nil {
+			return err
+		}
+		baseReward := val.GetEffectiveBalance().Unwrap() / increment *
+			perIncrement.Unwrap()
+
+		attested := [NumParticipationFlags]bool{
+			TimelySourceFlagIndex: true,
+		}
+		if att, ok := views[index]; ok {
+			attested[TimelyTargetFlagIndex] =
+				att.GetVerifiedBlockHash() == parentBlockHash
+			attested[TimelyHeadFlagIndex] =
+				att.GetExecutionHeadHash() == parentBlockHash
+		}
+		for flag, weight := range ParticipationFlagWeights {
+			if attested[flag] && !hasFlag(flags, flag) {
+				flags |= 1 << flag
+				numerator += baseReward * weight
+			}
+		}
+
+		if current {
+			err = st.SetCurrentEpochParticipation(index, flags)
+		} else {
+			err = st.SetPreviousEpochParticipation(index, flags)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	const denominator = (WeightDenominator - ProposerWeight) *
+		WeightDenominator / ProposerWeight
+	proposerReward := math.Gwei(numerator / denominator)
+	if rewards != nil {
+		rewards.ProposerReward += proposerReward
+	}
+	return st.IncreaseBalance(blk.GetProposerIndex(), proposerReward)
+}
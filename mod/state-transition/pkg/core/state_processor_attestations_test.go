@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// commitVoters returns the CometBFT addresses of the validators at the given
+// indices, as the spectest store derives them.
+func commitVoters(
+	t *testing.T, st *specBeaconState, indices ...math.ValidatorIndex,
+) [][]byte {
+	t.Helper()
+	voters := make([][]byte, 0, len(indices))
+	for _, idx := range indices {
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		hash := sha256.Sum256(val.Pubkey[:])
+		voters = append(voters, hash[:20])
+	}
+	return voters
+}
+
+func TestProcessAttestations(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	parentHash := common.ExecutionHash{0x01}
+	att := func(slot, index math.U64) *types.AttestationData {
+		var a *types.AttestationData
+		return a.New(
+			slot, index, common.Root{}, common.ExecutionHash{},
+			common.ExecutionHash{}, 0,
+		)
+	}
+	blk := func(atts ...*types.AttestationData) *types.BeaconBlock {
+		body := (&types.BeaconBlockBody{}).Empty(version.Electra)
+		body.ExecutionPayload = &types.ExecutionPayload{
+			ParentHash: parentHash,
+		}
+		body.Attestations = atts
+		return &types.BeaconBlock{Slot: 5, Body: body}
+	}
+
+	// Both validators are in the CometBFT validator set.
+	for idx := range math.ValidatorIndex(2) {
+		require.NoError(t, st.SetValidatorPower(idx, 32e9))
+	}
+	voters := commitVoters(t, st, 0, 1)
+	require.NoError(t, sp.processAttestations(st, blk(), nil, nil))
+
+	// The first validator had verified the parent payload, the second one
+	// only committed to the previous block.
+	verified := att(4, 0)
+	verified.VerifiedBlockHash = parentHash
+	rewards := &transition.BlockRewards{}
+	require.NoError(t, sp.processAttestations(
+		st, blk(verified, att(4, 1)), voters, rewards,
+	))
+	flags, err := st.GetCurrentEpochParticipation(0)
+	require.NoError(t, err)
+	require.Equal(
+		t, byte(1<<TimelySourceFlagIndex|1<<TimelyTargetFlagIndex), flags,
+	)
+	flags, err = st.GetCurrentEpochParticipation(1)
+	require.NoError(t, err)
+	require.Equal(t, byte(1<<TimelySourceFlagIndex), flags)
+
+	// The proposer is rewarded for the flags newly set only.
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Greater(t, balance, math.Gwei(32e9))
+	require.Equal(t, balance-32e9, rewards.ProposerReward)
+	require.NoError(t, sp.processAttestations(
+		st, blk(verified, att(4, 1)), voters, nil,
+	))
+	rebalance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, balance, rebalance)
+
+	// The attestations only credit the extra flags of the validators whose
+	// votes were committed.
+	require.NoError(t, st.SetCurrentEpochParticipation(0, 0))
+	require.NoError(t, sp.processAttestations(
+		st, blk(verified), commitVoters(t, st, 1), nil,
+	))
+	flags, err = st.GetCurrentEpochParticipation(0)
+	require.NoError(t, err)
+	require.Zero(t, flags)
+
+	// A slashed validator is not part of the CometBFT validator set, its
+	// vote cannot be verified and earns the proposer nothing.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+	require.NoError(t, st.SetCurrentEpochParticipation(1, 0))
+	rewards = &transition.BlockRewards{}
+	require.NoError(t, sp.processAttestations(
+		st, blk(att(4, 1)), commitVoters(t, st, 1), rewards,
+	))
+	flags, err = st.GetCurrentEpochParticipation(1)
+	require.NoError(t, err)
+	require.Zero(t, flags)
+	require.Zero(t, rewards.ProposerReward)
+
+	for _, b := range []*types.BeaconBlock{
+		// Attestation for another slot.
+		blk(att(3, 0)),
+		// Unknown validator.
+		blk(att(4, 2)),
+		// Duplicate validator.
+		blk(att(4, 1), att(4, 1)),
+		// Unsorted validators.
+		blk(att(4, 1), att(4, 0)),
+	} {
+		require.ErrorIs(
+			t, sp.processAttestations(st, b, voters, nil),
+			ErrInvalidAttestation,
+		)
+	}
+}
+
+func TestProcessCommitParticipationBeforeElectra(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 10)
+	for idx := range math.ValidatorIndex(2) {
+		require.NoError(t, st.SetValidatorPower(idx, 32e9))
+	}
+	blk := func(atts ...*types.AttestationData) *types.BeaconBlock {
+		// An Electra body carries the attestations even though the slot
+		// is still before the fork.
+		body := (&types.BeaconBlockBody{}).Empty(version.Electra)
+		body.ExecutionPayload = &types.ExecutionPayload{}
+		body.Attestations = atts
+		return &types.BeaconBlock{Slot: 8, Body: body}
+	}
+
+	// The votes of the last commit are credited without vote extensions,
+	// for the epoch of the previous slot.
+	rewards := &transition.BlockRewards{}
+	require.NoError(t, sp.processAttestations(
+		st, blk(), commitVoters(t, st, 1), rewards,
+	))
+	flags, err := st.GetPreviousEpochParticipation(1)
+	require.NoError(t, err)
+	require.Equal(t, byte(1<<TimelySourceFlagIndex), flags)
+	flags, err = st.GetPreviousEpochParticipation(0)
+	require.NoError(t, err)
+	require.Zero(t, flags)
+	require.Positive(t, rewards.ProposerReward)
+
+	// Attestations are only carried from Electra on.
+	var att *types.AttestationData
+	att = att.New(7, 0, common.Root{}, common.ExecutionHash{},
+		common.ExecutionHash{}, 0)
+	require.ErrorIs(
+		t, sp.processAttestations(st, blk(att), nil, nil),
+		ErrInvalidAttestation,
+	)
+}
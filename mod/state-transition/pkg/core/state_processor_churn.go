@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// getBalanceChurnLimit returns the balance that can churn per epoch, from
+// the total active balance of the CometBFT validator set, as per the
+// Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_balance_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getBalanceChurnLimit(
+	st BeaconStateT,
+) (math.Gwei, error) {
+	totalActiveBalance, err := sp.getTotalActiveBalance(st)
+	if err != nil {
+		return 0, err
+	}
+	churn := max(
+		math.Gwei(sp.cs.MinPerEpochChurnLimitElectra()),
+		totalActiveBalance/math.Gwei(sp.cs.ChurnLimitQuotient()),
+	)
+	return churn - churn%math.Gwei(sp.cs.EffectiveBalanceIncrement()), nil
+}
+
+// getActivationExitChurnLimit returns the balance that can exit per epoch,
+// as per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_activation_exit_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getActivationExitChurnLimit(
+	st BeaconStateT,
+) (math.Gwei, error) {
+	churn, err := sp.getBalanceChurnLimit(st)
+	if err != nil {
+		return 0, err
+	}
+	return min(
+		math.Gwei(sp.cs.MaxPerEpochActivationExitChurnLimit()), churn,
+	), nil
+}
+
+// getConsolidationChurnLimit returns the balance that can be consolidated
+// per epoch, which is the balance churn left over by the exits, as per the
+// Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_consolidation_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getConsolidationChurnLimit(
+	st BeaconStateT,
+) (math.Gwei, error) {
+	churn, err := sp.getBalanceChurnLimit(st)
+	if err != nil {
+		return 0, err
+	}
+	return churn - min(
+		math.Gwei(sp.cs.MaxPerEpochActivationExitChurnLimit()), churn,
+	), nil
+}
+
+// computeExitEpochAndUpdateChurn returns the epoch a validator exiting with
+// the given balance exits at, and consumes the balance from the exit churn,
+// as per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-compute_exit_epoch_and_update_churn
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) computeExitEpochAndUpdateChurn(
+	st BeaconStateT,
+	exitBalance math.Gwei,
+) (math.Epoch, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	earliestExitEpoch, err := st.GetEarliestExitEpoch()
+	if err != nil {
+		return 0, err
+	}
+	exitBalanceToConsume, err := st.GetExitBalanceToConsume()
+	if err != nil {
+		return 0, err
+	}
+	perEpochChurn, err := sp.getActivationExitChurnLimit(st)
+	if err != nil {
+		return 0, err
+	}
+
+	earliestExitEpoch, exitBalanceToConsume = consumeChurn(
+		computeActivationExitEpoch(sp.cs.SlotToEpoch(slot)),
+		earliestExitEpoch, exitBalanceToConsume, exitBalance, perEpochChurn,
+	)
+	if err = st.SetExitBalanceToConsume(exitBalanceToConsume); err != nil {
+		return 0, err
+	}
+	return earliestExitEpoch, st.SetEarliestExitEpoch(earliestExitEpoch)
+}
+
+// computeConsolidationEpochAndUpdateChurn returns the epoch a consolidation
+// of the given balance is processed at, and consumes the balance from the
+// consolidation churn, as per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-compute_consolidation_epoch_and_update_churn
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) computeConsolidationEpochAndUpdateChurn(
+	st BeaconStateT,
+	consolidationBalance math.Gwei,
+) (math.Epoch, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	earliestConsolidationEpoch, err := st.GetEarliestConsolidationEpoch()
+	if err != nil {
+		return 0, err
+	}
+	consolidationBalanceToConsume, err := st.GetConsolidationBalanceToConsume()
+	if err != nil {
+		return 0, err
+	}
+	perEpochChurn, err := sp.getConsolidationChurnLimit(st)
+	if err != nil {
+		return 0, err
+	}
+
+	earliestConsolidationEpoch, consolidationBalanceToConsume = consumeChurn(
+		computeActivationExitEpoch(sp.cs.SlotToEpoch(slot)),
+		earliestConsolidationEpoch, consolidationBalanceToConsume,
+		consolidationBalance, perEpochChurn,
+	)
+	if err = st.SetConsolidationBalanceToConsume(
+		consolidationBalanceToConsume,
+	); err != nil {
+		return 0, err
+	}
+	return earliestConsolidationEpoch, st.SetEarliestConsolidationEpoch(
+		earliestConsolidationEpoch,
+	)
+}
+
+// initiateValidatorExit initiates the exit of the validator at the given
+// index through the exit queue, as per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-initiate_validator_exit
+//
+// There is no withdrawability delay, the validator becomes withdrawable as
+// soon as it exits.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator already initiated an exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	exitEpoch, err := sp.computeExitEpochAndUpdateChurn(
+		st, val.GetEffectiveBalance(),
+	)
+	if err != nil {
+		return err
+	}
+	val.SetExitEpoch(exitEpoch)
+	val.SetWithdrawableEpoch(exitEpoch)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// computeActivationExitEpoch returns the epoch at which the activations and
+// exits initiated at the given epoch take effect. beacon-kit has no seed
+// lookahead, so they take effect at the next epoch.
+func computeActivationExitEpoch(epoch math.Epoch) math.Epoch {
+	return epoch + 1
+}
+
+// consumeChurn consumes the balance from the per epoch churn, starting from
+// the earliest epoch and the balance left to consume in the state, and
+// returns the epoch the balance is processed at along with the balance left
+// to consume in that epoch.
+func consumeChurn(
+	activationExitEpoch, earliestEpoch math.Epoch,
+	balanceToConsume, balance, perEpochChurn math.Gwei,
+) (math.Epoch, math.Gwei) {
+	// A new epoch of the queue starts with its whole churn.
+	if earliestEpoch < activationExitEpoch {
+		earliestEpoch = activationExitEpoch
+		balanceToConsume = perEpochChurn
+	}
+
+	// The balance that does not fit in the earliest epoch spills over the
+	// following ones.
+	if balance > balanceToConsume {
+		additionalEpochs := (balance-balanceToConsume-1)/perEpochChurn + 1
+		earliestEpoch += math.Epoch(additionalEpochs)
+		balanceToConsume += additionalEpochs * perEpochChurn
+	}
+	return earliestEpoch, balanceToConsume - balance
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/spectest"
+	"github.com/stretchr/testify/require"
+)
+
+// setChurnLimits replaces the chain spec of a state processor from
+// newForkStateProcessor with one active from Electra at genesis, whose balance
+// churn is minChurn per epoch, at most maxExitChurn of which goes to exits.
+func setChurnLimits(
+	t *testing.T,
+	sp *specStateProcessor,
+	minChurn, maxExitChurn math.Gwei,
+) {
+	t.Helper()
+	p, err := spectest.PresetByName(spectest.PresetMinimal)
+	require.NoError(t, err)
+
+	data := p.SpecData()
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = 0
+	data.MinPerEpochChurnLimitElectra = uint64(minChurn)
+	data.MaxPerEpochActivationExitChurnLimit = uint64(maxExitChurn)
+	sp.cs = chain.NewChainSpec(data)
+}
+
+func TestInitiateValidatorExit_Churn(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	setChurnLimits(t, sp, 48e9, 48e9)
+
+	// The first exit fits in the churn of the activation exit epoch.
+	require.NoError(t, sp.initiateValidatorExit(st, 0))
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(t, math.Epoch(1), val.GetWithdrawableEpoch())
+	balance, err := st.GetExitBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(16e9), balance)
+
+	// Exiting again leaves the queue untouched.
+	require.NoError(t, sp.initiateValidatorExit(st, 0))
+	balance, err = st.GetExitBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(16e9), balance)
+
+	// The second exit spills over the next epoch.
+	require.NoError(t, sp.initiateValidatorExit(st, 1))
+	val, err = st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(2), val.GetExitEpoch())
+	epoch, err := st.GetEarliestExitEpoch()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(2), epoch)
+	balance, err = st.GetExitBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9), balance)
+}
+
+func TestConsumeChurn(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		earliestEpoch    math.Epoch
+		balanceToConsume math.Gwei
+		balance          math.Gwei
+		wantEpoch        math.Epoch
+		wantToConsume    math.Gwei
+	}{
+		{
+			name:          "new epoch",
+			balance:       10,
+			wantEpoch:     5,
+			wantToConsume: 22,
+		},
+		{
+			name:             "fits in the earliest epoch",
+			earliestEpoch:    6,
+			balanceToConsume: 10,
+			balance:          10,
+			wantEpoch:        6,
+			wantToConsume:    0,
+		},
+		{
+			name:             "spills over several epochs",
+			earliestEpoch:    6,
+			balanceToConsume: 10,
+			balance:          70,
+			wantEpoch:        8,
+			wantToConsume:    4,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			epoch, toConsume := consumeChurn(
+				5, tc.earliestEpoch, tc.balanceToConsume, tc.balance, 32,
+			)
+			require.Equal(t, tc.wantEpoch, epoch)
+			require.Equal(t, tc.wantToConsume, toConsume)
+		})
+	}
+}
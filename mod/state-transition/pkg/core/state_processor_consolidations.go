@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// processConsolidationRequest processes an EIP-7251 consolidation request as
+// per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_consolidation_request
+//
+// beacon-kit has no compounding credentials, the target only needs eth1
+// credentials, and the balance it holds over its max effective balance is
+// swept as a partial withdrawal. Invalid requests are ignored, as anyone can
+// emit a request they do not fail the block.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processConsolidationRequest(
+	st BeaconStateT,
+	req *engineprimitives.ConsolidationRequest,
+) error {
+	// Switching to compounding credentials is not supported.
+	if req.SourcePubkey == req.TargetPubkey {
+		return nil
+	}
+
+	// Verify the queue of pending consolidations is not full.
+	pending, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+	if uint64(len(pending)) >= constants.PendingConsolidationsLimit {
+		return nil
+	}
+
+	// Verify there is enough churn left over by the exits to consolidate.
+	churn, err := sp.getConsolidationChurnLimit(st)
+	if err != nil {
+		return err
+	}
+	if churn <= math.Gwei(sp.cs.MaxEffectiveBalance()) {
+		return nil
+	}
+
+	// Verify both validators are known.
+	sourceIndex, err := st.ValidatorIndexByPubkey(req.SourcePubkey)
+	if err != nil {
+		//nolint:nilerr // invalid requests are ignored.
+		return nil
+	}
+	targetIndex, err := st.ValidatorIndexByPubkey(req.TargetPubkey)
+	if err != nil {
+		//nolint:nilerr // invalid requests are ignored.
+		return nil
+	}
+
+	var source, target ValidatorT
+	if source, err = st.ValidatorByIndex(sourceIndex); err != nil {
+		return err
+	}
+	if target, err = st.ValidatorByIndex(targetIndex); err != nil {
+		return err
+	}
+
+	// Verify the request was sent by the withdrawal address of the source,
+	// and the target has eth1 credentials.
+	address, err := source.GetWithdrawalCredentials().ToExecutionAddress()
+	if err != nil || address != req.SourceAddress {
+		//nolint:nilerr // invalid requests are ignored.
+		return nil
+	}
+	if _, err = target.GetWithdrawalCredentials().
+		ToExecutionAddress(); err != nil {
+		//nolint:nilerr // invalid requests are ignored.
+		return nil
+	}
+
+	// Verify both validators are active and not exiting.
+	for _, idx := range []math.ValidatorIndex{sourceIndex, targetIndex} {
+		var active bool
+		if active, err = sp.isActiveValidator(st, idx); err != nil {
+			return err
+		} else if !active {
+			return nil
+		}
+	}
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	if source.GetExitEpoch() != farFutureEpoch ||
+		target.GetExitEpoch() != farFutureEpoch {
+		return nil
+	}
+
+	// Initiate the exit of the source through the consolidation churn.
+	exitEpoch, err := sp.computeConsolidationEpochAndUpdateChurn(
+		st, source.GetEffectiveBalance(),
+	)
+	if err != nil {
+		return err
+	}
+	source.SetExitEpoch(exitEpoch)
+	source.SetWithdrawableEpoch(exitEpoch)
+	if err = st.UpdateValidatorAtIndex(sourceIndex, source); err != nil {
+		return err
+	}
+
+	return st.SetPendingConsolidations(append(
+		pending,
+		(&engineprimitives.PendingConsolidation{}).New(
+			sourceIndex, targetIndex,
+		),
+	))
+}
+
+// processPendingConsolidations moves the balance of the sources of the
+// pending consolidations to their targets once they are about to become
+// withdrawable, as per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_pending_consolidations
+//
+// As effective balances are not updated per epoch, the effective balance
+// moved is also credited to the target, up to its max effective balance, and
+// debited from the source so that it leaves the CometBFT validator set.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processPendingConsolidations(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+
+	pending, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+
+	var (
+		next           int
+		source, target ValidatorT
+		balance        math.Gwei
+	)
+	for _, consolidation := range pending {
+		if source, err = st.ValidatorByIndex(
+			consolidation.GetSourceIndex(),
+		); err != nil {
+			return err
+		}
+
+		// Slashed sources are dropped from the queue.
+		if source.IsSlashed() {
+			next++
+			continue
+		}
+
+		// The queue is ordered by withdrawable epoch.
+		if source.GetWithdrawableEpoch() > nextEpoch {
+			break
+		}
+
+		// Move the active balance of the source to the target.
+		if balance, err = st.GetBalance(
+			consolidation.GetSourceIndex(),
+		); err != nil {
+			return err
+		}
+		moved := min(balance, source.GetEffectiveBalance())
+		if err = st.DecreaseBalance(
+			consolidation.GetSourceIndex(), moved,
+		); err != nil {
+			return err
+		}
+		if err = st.IncreaseBalance(
+			consolidation.GetTargetIndex(), moved,
+		); err != nil {
+			return err
+		}
+
+		if target, err = st.ValidatorByIndex(
+			consolidation.GetTargetIndex(),
+		); err != nil {
+			return err
+		}
+		source.SetEffectiveBalance(source.GetEffectiveBalance() - moved)
+		target.SetEffectiveBalance(min(
+			target.GetEffectiveBalance()+moved,
+			math.Gwei(sp.cs.MaxEffectiveBalance()),
+		))
+		if err = st.UpdateValidatorAtIndex(
+			consolidation.GetSourceIndex(), source,
+		); err != nil {
+			return err
+		}
+		if err = st.UpdateValidatorAtIndex(
+			consolidation.GetTargetIndex(), target,
+		); err != nil {
+			return err
+		}
+		next++
+	}
+	return st.SetPendingConsolidations(pending[next:])
+}
+
+// isActiveValidator returns whether the validator at the given index is part
+// of the CometBFT validator set.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) isActiveValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) (bool, error) {
+	power, err := st.GetValidatorPower(idx)
+	return power > 0, err
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessConsolidationRequest(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	owner := common.ExecutionAddress{0xaa}
+	for idx := range math.ValidatorIndex(2) {
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		val.WithdrawalCredentials = types.
+			NewCredentialsFromExecutionAddress(owner)
+		require.NoError(t, st.UpdateValidatorAtIndex(idx, val))
+		require.NoError(t, st.SetValidatorPower(idx, 32e9))
+	}
+	source, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	target, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	req := &engineprimitives.ConsolidationRequest{
+		SourceAddress: owner,
+		SourcePubkey:  source.Pubkey,
+		TargetPubkey:  target.Pubkey,
+	}
+
+	// The exits take the whole churn by default.
+	require.NoError(t, sp.processConsolidationRequest(st, req))
+	pending, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	setChurnLimits(t, sp, 96e9, 32e9)
+	for _, invalid := range []*engineprimitives.ConsolidationRequest{
+		// Same source and target.
+		{
+			SourceAddress: owner,
+			SourcePubkey:  source.Pubkey,
+			TargetPubkey:  source.Pubkey,
+		},
+		// Sent by another address.
+		{
+			SourceAddress: common.ExecutionAddress{0xbb},
+			SourcePubkey:  source.Pubkey,
+			TargetPubkey:  target.Pubkey,
+		},
+		// Unknown target.
+		{
+			SourceAddress: owner,
+			SourcePubkey:  source.Pubkey,
+			TargetPubkey:  [48]byte{0xff},
+		},
+	} {
+		require.NoError(t, sp.processConsolidationRequest(st, invalid))
+		pending, err = st.GetPendingConsolidations()
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	}
+
+	// The source exits through the consolidation churn.
+	require.NoError(t, sp.processConsolidationRequest(st, req))
+	pending, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, []*engineprimitives.PendingConsolidation{
+		{SourceIndex: 0, TargetIndex: 1},
+	}, pending)
+	source, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), source.GetExitEpoch())
+	require.Equal(t, math.Epoch(1), source.GetWithdrawableEpoch())
+	balance, err := st.GetConsolidationBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9), balance)
+
+	// An exiting source cannot be consolidated again.
+	require.NoError(t, sp.processConsolidationRequest(st, req))
+	pending, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+}
+
+func TestProcessPendingConsolidations(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	for idx := range math.ValidatorIndex(2) {
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		val.EffectiveBalance = 16e9
+		val.ExitEpoch = 1
+		val.WithdrawableEpoch = math.Epoch(2 - idx)
+		require.NoError(t, st.UpdateValidatorAtIndex(idx, val))
+	}
+	require.NoError(t, st.SetPendingConsolidations(
+		[]*engineprimitives.PendingConsolidation{
+			{SourceIndex: 1, TargetIndex: 0},
+			{SourceIndex: 0, TargetIndex: 1},
+		},
+	))
+
+	// Only the consolidations whose source is withdrawable by the next
+	// epoch are applied, the others stay in the queue.
+	require.NoError(t, sp.processPendingConsolidations(st))
+	pending, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, []*engineprimitives.PendingConsolidation{
+		{SourceIndex: 0, TargetIndex: 1},
+	}, pending)
+
+	// The active balance of the source is moved to the target, capped by
+	// the maximum effective balance.
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(16e9), balance)
+	balance, err = st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(48e9), balance)
+	source, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Zero(t, source.GetEffectiveBalance())
+	target, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9), target.GetEffectiveBalance())
+
+	// Slashed sources are dropped from the queue.
+	target.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(0, target))
+	require.NoError(t, sp.processPendingConsolidations(st))
+	pending, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
@@ -39,7 +39,7 @@ const (
 //
 //nolint:gocognit,funlen // todo fix.
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, DepositT,
+	_, _, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, DepositT,
 	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _, _,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
@@ -153,9 +153,21 @@ func (sp *StateProcessor[
 	}
 
 	var updates transition.ValidatorUpdates
-	updates, err = sp.processSyncCommitteeUpdates(st)
+	updates, err = sp.processValidatorSetUpdates(st)
 	if err != nil {
 		return nil, err
 	}
+
+	// A genesis from Electra onwards starts with the churn fields of the
+	// upgrade, which needs the validator set to compute the churn.
+	if sp.cs.ActiveForkVersionForEpoch(
+		math.Epoch(constants.GenesisEpoch),
+	) >= version.Electra {
+		if err = sp.upgradeToElectra(
+			st, math.Epoch(constants.GenesisEpoch),
+		); err != nil {
+			return nil, err
+		}
+	}
 	return updates, nil
 }
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+)
+
+// processHistoricalSummariesUpdate as defined in the Ethereum 2.0
+// specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#historical-summaries-updates
+//
+// At the end of every period of SlotsPerHistoricalRoot slots, the roots of
+// the block roots and state roots of the period are appended to the
+// historical summaries, so that the roots outlive the ring they are kept in.
+// The summaries are tracked from genesis, though they are only part of the
+// hash tree root of the beacon state from Electra onwards.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processHistoricalSummariesUpdate(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// Only append a summary at the end of a period.
+	if (slot.Unwrap()+1)%sp.cs.SlotsPerHistoricalRoot() != 0 {
+		return nil
+	}
+
+	blockRoots, err := sp.historicalRoots(st.GetBlockRootAtIndex)
+	if err != nil {
+		return err
+	}
+	blockSummaryRoot, err := sp.historicalRootsRoot(blockRoots)
+	if err != nil {
+		return err
+	}
+	stateRoots, err := sp.historicalRoots(st.StateRootAtIndex)
+	if err != nil {
+		return err
+	}
+	stateSummaryRoot, err := sp.historicalRootsRoot(stateRoots)
+	if err != nil {
+		return err
+	}
+	return st.AppendHistoricalSummary(
+		blockRoots, blockSummaryRoot, stateSummaryRoot,
+	)
+}
+
+// historicalRoots returns the vector of SlotsPerHistoricalRoot roots read
+// with the given getter.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) historicalRoots(
+	rootAtIndex func(uint64) (common.Root, error),
+) ([]common.Root, error) {
+	var err error
+	roots := make([]common.Root, sp.cs.SlotsPerHistoricalRoot())
+	for i := range roots {
+		if roots[i], err = rootAtIndex(uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+	return roots, nil
+}
+
+// historicalRootsRoot returns the hash tree root of the given vector of
+// SlotsPerHistoricalRoot roots.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) historicalRootsRoot(
+	roots []common.Root,
+) (common.Root, error) {
+	tree, err := merkle.NewTreeWithMaxLeaves(
+		roots, sp.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.Root(), nil
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessHistoricalSummariesUpdate_KeepsBlockRoots(t *testing.T) {
+	sp, st := newForkStateProcessor(t, math.Epoch(^uint64(0)))
+	slotsPerPeriod := sp.cs.SlotsPerHistoricalRoot()
+
+	_, err := sp.ProcessSlots(st, math.Slot(slotsPerPeriod))
+	require.NoError(t, err)
+
+	ring := make([]common.Root, slotsPerPeriod)
+	for i := range ring {
+		ring[i], err = st.GetBlockRootAtIndex(uint64(i))
+		require.NoError(t, err)
+	}
+	blockRoots, err := st.GetHistoricalBlockRoots(0)
+	require.NoError(t, err)
+	require.Equal(t, ring, blockRoots)
+
+	summaries, err := st.GetHistoricalSummaries()
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	summaryRoot, err := sp.historicalRootsRoot(blockRoots)
+	require.NoError(t, err)
+	require.Equal(t, summaryRoot, summaries[0].BlockSummaryRoot)
+
+	// The roots outlive the ring, which the next period overwrites.
+	_, err = sp.ProcessSlots(st, math.Slot(2*slotsPerPeriod))
+	require.NoError(t, err)
+	kept, err := st.GetHistoricalBlockRoots(0)
+	require.NoError(t, err)
+	require.Equal(t, blockRoots, kept)
+	_, err = st.GetHistoricalBlockRoots(1)
+	require.NoError(t, err)
+}
@@ -31,8 +31,8 @@ import (
 // processExecutionPayload processes the execution payload and ensures it
 // matches the local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _,
+	ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _,
 ]) processExecutionPayload(
 	ctx ContextT,
 	st BeaconStateT,
@@ -79,8 +79,7 @@ func (sp *StateProcessor[
 // state
 // and the execution engine.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT,
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) validateExecutionPayload(
 	ctx context.Context,
 	st BeaconStateT,
@@ -111,13 +110,20 @@ func (sp *StateProcessor[
 	}
 
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
+	req := engineprimitives.BuildNewPayloadRequest(
+		payload,
+		body.GetBlobKzgCommitments().ToVersionedHashes(),
+		&parentBeaconBlockRoot,
+		optimisticEngine,
+		sp.cs.ActiveForkVersionForSlot(blk.GetSlot()),
+	)
+	if requests := body.GetExecutionRequests(); requests != nil {
+		if req.ExecutionRequests, err = requests.Encode(); err != nil {
+			return err
+		}
+	}
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
-		ctx, engineprimitives.BuildNewPayloadRequest(
-			payload,
-			body.GetBlobKzgCommitments().ToVersionedHashes(),
-			&parentBeaconBlockRoot,
-			optimisticEngine,
-		),
+		ctx, req,
 	); err != nil {
 		return err
 	}
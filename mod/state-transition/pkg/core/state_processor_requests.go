@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processExecutionRequests processes the requests emitted by the execution
+// layer in the payload of the block, which are only present from Electra
+// onwards.
+func (sp *StateProcessor[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processExecutionRequests(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	// The fork version the body is encoded at selects whether it carries
+	// the requests, hence it must be that of the slot.
+	forkVersion := sp.cs.ActiveForkVersionForSlot(blk.GetSlot())
+	bodyVersion := blk.GetBody().Version()
+	if (forkVersion >= version.Electra) != (bodyVersion >= version.Electra) {
+		return errors.Wrapf(
+			ErrExecutionRequestsMismatch, "slot %d, fork %d, body %d",
+			blk.GetSlot(), forkVersion, bodyVersion,
+		)
+	}
+	requests := blk.GetBody().GetExecutionRequests()
+	if requests == nil {
+		return nil
+	}
+
+	for _, req := range requests.Deposits {
+		if err := sp.processDepositRequest(st, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range requests.Withdrawals {
+		if err := sp.processWithdrawalRequest(st, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range requests.Consolidations {
+		if err := sp.processConsolidationRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest processes an EIP-6110 deposit request as per the
+// Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_deposit_request
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	WithdrawalCredentialsT,
+]) processDepositRequest(
+	st BeaconStateT,
+	req *engineprimitives.DepositRequest,
+) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	// Deposits already included in a block body through the eth1 deposit
+	// contract during the transition are not processed twice.
+	if req.Index.Unwrap() < depositIndex {
+		return nil
+	}
+
+	// The deposit index follows the requests, which may skip the indices
+	// of deposits that were not emitted as requests.
+	if err = st.SetEth1DepositIndex(req.Index.Unwrap() + 1); err != nil {
+		return err
+	}
+
+	var dep DepositT
+	err = sp.applyDeposit(
+		st, dep.New(
+			req.Pubkey,
+			WithdrawalCredentialsT(req.Credentials),
+			req.Amount,
+			req.Signature,
+			req.Index.Unwrap(),
+		),
+	)
+	if errors.Is(err, ErrInvalidDepositSignature) {
+		// A deposit with an invalid signature creates no validator, as
+		// anyone can emit a deposit request it does not fail the block.
+		return nil
+	}
+	return err
+}
+
+// processWithdrawalRequest processes an EIP-7002 withdrawal request as per
+// the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+// Only full exits are supported, partial withdrawals are already swept
+// automatically once a validator is over its max effective balance.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	req *engineprimitives.WithdrawalRequest,
+) error {
+	if !req.IsFullExit() {
+		return nil
+	}
+
+	// Requests for unknown validators are ignored.
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubkey)
+	if err != nil {
+		//nolint:nilerr // invalid requests are ignored.
+		return nil
+	}
+
+	var val ValidatorT
+	if val, err = st.ValidatorByIndex(idx); err != nil {
+		return err
+	}
+
+	// Verify the request was sent by the withdrawal address of the
+	// validator.
+	address, err := val.GetWithdrawalCredentials().ToExecutionAddress()
+	if err != nil || address != req.SourceAddress {
+		//nolint:nilerr // invalid requests are ignored.
+		return nil
+	}
+
+	// The exit goes through the exit queue, which is a no-op for validators
+	// that already initiated an exit.
+	return sp.initiateValidatorExit(st, idx)
+}
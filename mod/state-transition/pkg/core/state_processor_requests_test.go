@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessExecutionRequests_ForkMismatch(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 1)
+	slotsPerEpoch := math.Slot(sp.cs.SlotsPerEpoch())
+
+	// An Electra body before Electra is rejected.
+	blk := &types.BeaconBlock{
+		Slot: 1,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Electra),
+	}
+	require.ErrorIs(
+		t, sp.processExecutionRequests(st, blk),
+		ErrExecutionRequestsMismatch,
+	)
+
+	// A Deneb body from Electra onwards is rejected.
+	blk = &types.BeaconBlock{
+		Slot: slotsPerEpoch,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}
+	require.ErrorIs(
+		t, sp.processExecutionRequests(st, blk),
+		ErrExecutionRequestsMismatch,
+	)
+}
+
+func TestProcessWithdrawalRequest_FullExit(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	owner := common.ExecutionAddress{0xaa}
+
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	val.WithdrawalCredentials = types.NewCredentialsFromExecutionAddress(owner)
+	require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+
+	requests := []*engineprimitives.WithdrawalRequest{
+		// Sent by another address.
+		{SourceAddress: common.ExecutionAddress{0xbb}, ValidatorPubkey: val.Pubkey},
+		// Partial withdrawal.
+		{SourceAddress: owner, ValidatorPubkey: val.Pubkey, Amount: 1},
+		// Unknown validator.
+		{SourceAddress: owner, ValidatorPubkey: [48]byte{0xff}},
+	}
+	for _, req := range requests {
+		require.NoError(t, sp.processWithdrawalRequest(st, req))
+		val, err = st.ValidatorByIndex(0)
+		require.NoError(t, err)
+		require.Equal(
+			t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch(),
+		)
+	}
+
+	require.NoError(t, sp.processWithdrawalRequest(
+		st, &engineprimitives.WithdrawalRequest{
+			SourceAddress:   owner,
+			ValidatorPubkey: val.Pubkey,
+		},
+	))
+	val, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(t, math.Epoch(1), val.GetWithdrawableEpoch())
+}
+
+func TestProcessDepositRequest_TopUp(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	val.EffectiveBalance = math.Gwei(16e9)
+	require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+
+	req := &engineprimitives.DepositRequest{
+		Pubkey: val.Pubkey,
+		Amount: math.Gwei(1e9),
+		Index:  0,
+	}
+	require.NoError(t, sp.processDepositRequest(st, req))
+
+	// Replaying an already processed deposit is a no-op.
+	require.NoError(t, sp.processDepositRequest(st, req))
+
+	index, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), index)
+	val, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(17e9), val.GetEffectiveBalance())
+}
+
+func TestProcessDepositRequest_InvalidSignature(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().VerifySignature(mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("invalid signature"))
+	sp.signer = signer
+
+	validators, err := st.GetValidators()
+	require.NoError(t, err)
+
+	// A deposit with an invalid signature is skipped without failing the
+	// block, the deposit index still moves past it.
+	require.NoError(t, sp.processDepositRequest(
+		st, &engineprimitives.DepositRequest{
+			Pubkey: [48]byte{0xff},
+			Amount: math.Gwei(32e9),
+			Index:  4,
+		},
+	))
+
+	index, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), index)
+	after, err := st.GetValidators()
+	require.NoError(t, err)
+	require.Len(t, after, len(validators))
+	_, err = st.ValidatorIndexByPubkey([48]byte{0xff})
+	require.Error(t, err)
+}
@@ -0,0 +1,358 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Participation flags are set for a validator when it attests, through the
+// vote extensions of the previous block, at least once during an epoch:
+//   - the timely source flag when it committed to the previous block,
+//   - the timely target flag when its execution client verified the
+//     execution payload of the previous block,
+//   - the timely head flag when its execution client already had the
+//     execution payload of the previous block as head.
+const (
+	TimelySourceFlagIndex = iota
+	TimelyTargetFlagIndex
+	TimelyHeadFlagIndex
+	NumParticipationFlags
+)
+
+// Weights of the participation flags and of the proposer reward, out of
+// WeightDenominator, as defined in the Altair specification. The weight of
+// the sync committee is not distributed.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#incentivization-weights
+//
+//nolint:lll // From Ethereum 2.0 spec.
+const (
+	TimelySourceWeight = 14
+	TimelyTargetWeight = 26
+	TimelyHeadWeight   = 14
+	ProposerWeight     = 8
+	WeightDenominator  = 64
+)
+
+// ParticipationFlagWeights are the weights of the participation flags, by
+// flag index.
+//
+//nolint:gochecknoglobals // fixed by the specification.
+var ParticipationFlagWeights = [NumParticipationFlags]uint64{
+	TimelySourceWeight, TimelyTargetWeight, TimelyHeadWeight,
+}
+
+// AttestationDelta holds the rewards and penalties of a validator for its
+// participation in an epoch.
+type AttestationDelta struct {
+	// Index is the index of the validator.
+	Index math.ValidatorIndex
+	// EffectiveBalance is the effective balance of the validator.
+	EffectiveBalance math.Gwei
+	// Rewards are the rewards of the validator by participation flag.
+	Rewards [NumParticipationFlags]math.Gwei
+	// Penalties are the penalties of the validator by participation flag.
+	Penalties [NumParticipationFlags]math.Gwei
+	// InactivityPenalty is the penalty of the validator for leaking.
+	InactivityPenalty math.Gwei
+	// InactivityScore is the inactivity score of the validator once the
+	// epoch is processed.
+	InactivityScore math.U64
+}
+
+// AttestationDeltas holds the rewards and penalties of the validators of the
+// active validator set for their participation in an epoch.
+type AttestationDeltas struct {
+	// Epoch is the epoch the participation is rewarded for.
+	Epoch math.Epoch
+	// BaseRewardPerIncrement is the base reward per effective balance
+	// increment.
+	BaseRewardPerIncrement math.Gwei
+	// ActiveIncrements is the number of effective balance increments of the
+	// active validator set.
+	ActiveIncrements uint64
+	// ParticipatingIncrements is the number of effective balance increments
+	// of the unslashed validators that participated, by flag.
+	ParticipatingIncrements [NumParticipationFlags]uint64
+	// Validators are the deltas of the validators, sorted by index.
+	Validators []*AttestationDelta
+}
+
+// IdealRewards returns the rewards, by participation flag, of a validator
+// with the given effective balance that participated on every flag.
+func (d *AttestationDeltas) IdealRewards(
+	effectiveBalance math.Gwei,
+	effectiveBalanceIncrement uint64,
+) [NumParticipationFlags]math.Gwei {
+	var rewards [NumParticipationFlags]math.Gwei
+	if d.ActiveIncrements == 0 {
+		return rewards
+	}
+	baseReward := effectiveBalance.Unwrap() / effectiveBalanceIncrement *
+		d.BaseRewardPerIncrement.Unwrap()
+	for flag, weight := range ParticipationFlagWeights {
+		rewards[flag] = math.Gwei(
+			baseReward * weight * d.ParticipatingIncrements[flag] /
+				(d.ActiveIncrements * WeightDenominator),
+		)
+	}
+	return rewards
+}
+
+// AttestationDeltas returns the rewards and penalties of the active validator
+// set for its participation in the previous epoch of the given state, as
+// applied when processing the end of its current epoch.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttestationDeltas(
+	st BeaconStateT,
+) (*AttestationDeltas, error) {
+	return sp.getAttestationDeltas(st)
+}
+
+// getAttestationDeltas computes the rewards and penalties of the active
+// validator set for its participation in the previous epoch. The flag
+// deltas follow the Altair specification. As CometBFT finalizes every block,
+// the inactivity leak applies to each validator individually instead, once
+// it missed the target for more than MinEpochsToInactivityPenalty epochs.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#get_flag_index_deltas
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getAttestationDeltas(
+	st BeaconStateT,
+) (*AttestationDeltas, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	// Participation is only tracked from Electra onwards.
+	deltas := &AttestationDeltas{}
+	epoch := sp.cs.SlotToEpoch(slot)
+	if epoch == math.Epoch(constants.GenesisEpoch) ||
+		sp.cs.ActiveForkVersionForEpoch(epoch-1) < version.Electra {
+		return deltas, nil
+	}
+	deltas.Epoch = epoch - 1
+
+	active, err := st.GetActiveValidatorIndices()
+	if err != nil {
+		return nil, err
+	}
+
+	increment := sp.cs.EffectiveBalanceIncrement()
+	flags := make([]byte, len(active))
+	scores := make([]math.U64, len(active))
+	slashed := make([]bool, len(active))
+	deltas.Validators = make([]*AttestationDelta, len(active))
+	for i, idx := range active {
+		val, valErr := st.ValidatorByIndex(idx)
+		if valErr != nil {
+			return nil, valErr
+		}
+		if flags[i], err = st.GetPreviousEpochParticipation(idx); err != nil {
+			return nil, err
+		}
+		if scores[i], err = st.GetInactivityScore(idx); err != nil {
+			return nil, err
+		}
+		slashed[i] = val.IsSlashed()
+		deltas.Validators[i] = &AttestationDelta{
+			Index:            idx,
+			EffectiveBalance: val.GetEffectiveBalance(),
+		}
+	}
+
+	totalActiveBalance, err := sp.getTotalActiveBalance(st)
+	if err != nil {
+		return nil, err
+	}
+	deltas.ActiveIncrements = totalActiveBalance.Unwrap() / increment
+	deltas.BaseRewardPerIncrement = sp.getBaseRewardPerIncrement(
+		totalActiveBalance,
+	)
+	for flag := range NumParticipationFlags {
+		var participating uint64
+		for i, delta := range deltas.Validators {
+			if !slashed[i] && hasFlag(flags[i], flag) {
+				participating += delta.EffectiveBalance.Unwrap()
+			}
+		}
+		deltas.ParticipatingIncrements[flag] = max(
+			participating, increment,
+		) / increment
+	}
+
+	bias := sp.cs.InactivityScoreBias()
+	leakThreshold := math.U64(bias * sp.cs.MinEpochsToInactivityPenalty())
+	for i, delta := range deltas.Validators {
+		baseReward := delta.EffectiveBalance.Unwrap() / increment *
+			deltas.BaseRewardPerIncrement.Unwrap()
+
+		// Validators that missed the target accumulate inactivity, which
+		// is recovered by participating again.
+		target := !slashed[i] && hasFlag(flags[i], TimelyTargetFlagIndex)
+		delta.InactivityScore = scores[i]
+		if target {
+			delta.InactivityScore -= min(
+				math.U64(sp.cs.InactivityScoreRecoveryRate()),
+				delta.InactivityScore,
+			)
+		} else {
+			delta.InactivityScore += math.U64(bias)
+		}
+		leaking := delta.InactivityScore > leakThreshold
+
+		// Leaking validators are not rewarded, missing the head is not
+		// penalized.
+		for flag, weight := range ParticipationFlagWeights {
+			participated := !slashed[i] && hasFlag(flags[i], flag)
+			switch {
+			case participated && !leaking:
+				delta.Rewards[flag] = math.Gwei(
+					baseReward * weight *
+						deltas.ParticipatingIncrements[flag] /
+						(deltas.ActiveIncrements * WeightDenominator),
+				)
+			case !participated && flag != TimelyHeadFlagIndex:
+				delta.Penalties[flag] = math.Gwei(
+					baseReward * weight / WeightDenominator,
+				)
+			}
+		}
+
+		if !target && leaking && bias*sp.cs.InactivityPenaltyQuotient() > 0 {
+			delta.InactivityPenalty = math.Gwei(
+				delta.EffectiveBalance.Unwrap() *
+					delta.InactivityScore.Unwrap() /
+					(bias * sp.cs.InactivityPenaltyQuotient()),
+			)
+		}
+	}
+	return deltas, nil
+}
+
+// processRewardsAndPenalties applies the attestation deltas of the previous
+// epoch to the balances and the inactivity scores of the validators.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#rewards-and-penalties
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processRewardsAndPenalties(
+	st BeaconStateT,
+	blockRewards *transition.BlockRewards,
+) error {
+	deltas, err := sp.getAttestationDeltas(st)
+	if err != nil {
+		return err
+	}
+
+	for _, delta := range deltas.Validators {
+		var rewards, penalties math.Gwei
+		for flag := range NumParticipationFlags {
+			rewards += delta.Rewards[flag]
+			penalties += delta.Penalties[flag]
+		}
+		penalties += delta.InactivityPenalty
+
+		if err = st.IncreaseBalance(delta.Index, rewards); err != nil {
+			return err
+		}
+		if err = st.DecreaseBalance(delta.Index, penalties); err != nil {
+			return err
+		}
+		if err = st.SetInactivityScore(
+			delta.Index, delta.InactivityScore,
+		); err != nil {
+			return err
+		}
+
+		if blockRewards != nil {
+			blockRewards.AttestationRewards += rewards
+			blockRewards.AttestationPenalties += penalties
+		}
+	}
+	return nil
+}
+
+// processParticipationFlagUpdates moves the participation flags of the
+// current epoch to the previous epoch.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#participation-flags-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processParticipationFlagUpdates(
+	st BeaconStateT,
+) error {
+	return st.RotateEpochParticipation()
+}
+
+// getTotalActiveBalance returns the total voting power, in effective
+// balance, of the active validator set, which is at least one effective
+// balance increment.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getTotalActiveBalance(
+	st BeaconStateT,
+) (math.Gwei, error) {
+	active, err := st.GetActiveValidatorIndices()
+	if err != nil {
+		return 0, err
+	}
+
+	var total, power math.Gwei
+	for _, idx := range active {
+		if power, err = st.GetValidatorPower(idx); err != nil {
+			return 0, err
+		}
+		total += power
+	}
+	return max(total, math.Gwei(sp.cs.EffectiveBalanceIncrement())), nil
+}
+
+// getBaseRewardPerIncrement returns the base reward per effective balance
+// increment for the given total active balance.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#get_base_reward_per_increment
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getBaseRewardPerIncrement(
+	totalActiveBalance math.Gwei,
+) math.Gwei {
+	return math.Gwei(
+		sp.cs.EffectiveBalanceIncrement() * sp.cs.BaseRewardFactor() /
+			math.U64(totalActiveBalance).ISqrt().Unwrap(),
+	)
+}
+
+// hasFlag returns whether the given participation flag is set.
+func hasFlag(flags byte, flag int) bool {
+	return flags&(1<<flag) != 0
+}
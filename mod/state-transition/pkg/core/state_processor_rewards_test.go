@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessRewardsAndPenalties(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 0)
+	slotsPerEpoch := math.Slot(sp.cs.SlotsPerEpoch())
+
+	// The first validator participated on every flag of the previous epoch,
+	// the second one missed it.
+	require.NoError(t, st.SetSlot(2*slotsPerEpoch-1))
+	for _, idx := range []math.ValidatorIndex{0, 1} {
+		require.NoError(t, st.SetValidatorPower(idx, 32e9))
+	}
+	require.NoError(t, st.SetPreviousEpochParticipation(0, 0b111))
+
+	deltas, err := sp.getAttestationDeltas(st)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(0), deltas.Epoch)
+	require.Len(t, deltas.Validators, 2)
+
+	participant, missed := deltas.Validators[0], deltas.Validators[1]
+	ideal := deltas.IdealRewards(32e9, sp.cs.EffectiveBalanceIncrement())
+	require.Equal(t, ideal, participant.Rewards)
+	require.Empty(t, participant.Penalties[TimelySourceFlagIndex])
+	require.Empty(t, missed.Rewards[TimelySourceFlagIndex])
+	require.NotEmpty(t, missed.Penalties[TimelySourceFlagIndex])
+	require.NotEmpty(t, missed.Penalties[TimelyTargetFlagIndex])
+	require.Empty(t, missed.Penalties[TimelyHeadFlagIndex])
+	require.Empty(t, missed.InactivityPenalty)
+	require.Equal(t, math.U64(sp.cs.InactivityScoreBias()), missed.InactivityScore)
+
+	rewards := &transition.BlockRewards{}
+	require.NoError(t, sp.processRewardsAndPenalties(st, rewards))
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Greater(t, balance, math.Gwei(32e9))
+	require.Equal(t, balance-32e9, rewards.AttestationRewards)
+	balance, err = st.GetBalance(1)
+	require.NoError(t, err)
+	require.Less(t, balance, math.Gwei(32e9))
+	require.Equal(t, 32e9-balance, rewards.AttestationPenalties)
+
+	// A validator missing the target for more epochs than allowed leaks,
+	// it is not rewarded and is penalized on its inactivity score.
+	threshold := sp.cs.InactivityScoreBias() *
+		sp.cs.MinEpochsToInactivityPenalty()
+	require.NoError(t, st.SetInactivityScore(1, math.U64(threshold)))
+	require.NoError(t, st.SetPreviousEpochParticipation(1, 0b001))
+	deltas, err = sp.getAttestationDeltas(st)
+	require.NoError(t, err)
+	missed = deltas.Validators[1]
+	require.Empty(t, missed.Rewards[TimelySourceFlagIndex])
+	require.NotEmpty(t, missed.InactivityPenalty)
+
+	// Participating again recovers the inactivity score.
+	require.NoError(t, st.SetPreviousEpochParticipation(1, 0b011))
+	deltas, err = sp.getAttestationDeltas(st)
+	require.NoError(t, err)
+	require.Equal(
+		t, math.U64(threshold-sp.cs.InactivityScoreRecoveryRate()),
+		deltas.Validators[1].InactivityScore,
+	)
+	require.Empty(t, deltas.Validators[1].InactivityPenalty)
+}
+
+func TestProcessRewardsAndPenalties_BeforeElectra(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 2)
+	slotsPerEpoch := math.Slot(sp.cs.SlotsPerEpoch())
+
+	// Participation is not tracked before Electra, so missing validators
+	// are not penalized.
+	require.NoError(t, st.SetSlot(2*slotsPerEpoch-1))
+	require.NoError(t, st.SetValidatorPower(0, 32e9))
+	deltas, err := sp.getAttestationDeltas(st)
+	require.NoError(t, err)
+	require.Empty(t, deltas.Validators)
+}
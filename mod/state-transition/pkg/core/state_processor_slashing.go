@@ -22,6 +22,7 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
@@ -29,7 +30,7 @@ import (
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
@@ -48,7 +49,7 @@ func (sp *StateProcessor[
 //
 //nolint:lll,unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processProposerSlashing(
 	_ BeaconStateT,
 	// ps ProposerSlashing,
@@ -61,7 +62,7 @@ func (sp *StateProcessor[
 //
 //nolint:lll,unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processAttesterSlashing(
 	_ BeaconStateT,
 	// as AttesterSlashing,
@@ -77,9 +78,10 @@ func (sp *StateProcessor[
 //
 //nolint:lll,unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlashings(
 	st BeaconStateT,
+	rewards *transition.BlockRewards,
 ) error {
 	totalBalance, err := st.GetTotalActiveBalances(sp.cs.SlotsPerEpoch())
 	if err != nil {
@@ -118,6 +120,7 @@ func (sp *StateProcessor[
 				st, val,
 				adjustedTotalSlashingBalance,
 				uint64(totalBalance),
+				rewards,
 			); err != nil {
 				return err
 			}
@@ -130,12 +133,13 @@ func (sp *StateProcessor[
 //
 //nolint:unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) processSlash(
 	st BeaconStateT,
 	val ValidatorT,
 	adjustedTotalSlashingBalance uint64,
 	totalBalance uint64,
+	rewards *transition.BlockRewards,
 ) error {
 	// Calculate the penalty.
 	increment := sp.cs.EffectiveBalanceIncrement()
@@ -149,5 +153,8 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	if rewards != nil {
+		rewards.SlashingPenalties += math.Gwei(penalty)
+	}
 	return st.DecreaseBalance(idx, math.Gwei(penalty))
 }
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processForkUpgrade performs the upgrade_to_<fork> of the state when the
+// given epoch is the first epoch of a new fork, as per the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/fork.md#upgrading-the-state
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, ForkT, _, _, _, _, _, _, _,
+]) processForkUpgrade(
+	st BeaconStateT,
+	epoch math.Epoch,
+) error {
+	if epoch == 0 {
+		return nil
+	}
+
+	previousVersion := sp.cs.ActiveForkVersionForEpoch(epoch - 1)
+	currentVersion := sp.cs.ActiveForkVersionForEpoch(epoch)
+	if previousVersion == currentVersion {
+		return nil
+	}
+
+	// Migrate the state to the new fork. Deneb+ does not change the fields
+	// of the BeaconState, its containers only live in the block body and
+	// are selected by version.
+	switch currentVersion {
+	case version.DenebPlus:
+	case version.Electra:
+		if err := sp.upgradeToElectra(st, epoch); err != nil {
+			return err
+		}
+	default:
+		return errors.Wrapf(
+			ErrUnsupportedForkUpgrade, "from %d to %d",
+			previousVersion, currentVersion,
+		)
+	}
+
+	var fork ForkT
+	return st.SetFork(
+		fork.New(
+			version.FromUint32[common.Version](previousVersion),
+			version.FromUint32[common.Version](currentVersion),
+			epoch,
+		),
+	)
+}
+
+// upgradeToElectra initializes the churn fields of the BeaconState introduced
+// in Electra, as per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/fork.md#upgrading-the-state
+//
+// beacon-kit has no pending deposits nor pending partial withdrawals, the
+// deposits are applied as they are processed and the partial withdrawals are
+// swept, so only the pending consolidations queue is started, empty.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) upgradeToElectra(
+	st BeaconStateT,
+	epoch math.Epoch,
+) error {
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// The exit queue starts after the exits already initiated.
+	earliestExitEpoch := computeActivationExitEpoch(epoch)
+	for _, val := range vals {
+		if exitEpoch := val.GetExitEpoch(); exitEpoch != math.Epoch(
+			constants.FarFutureEpoch,
+		) {
+			earliestExitEpoch = max(earliestExitEpoch, exitEpoch)
+		}
+	}
+	if err = st.SetEarliestExitEpoch(earliestExitEpoch + 1); err != nil {
+		return err
+	}
+
+	exitBalanceToConsume, err := sp.getActivationExitChurnLimit(st)
+	if err != nil {
+		return err
+	}
+	if err = st.SetExitBalanceToConsume(exitBalanceToConsume); err != nil {
+		return err
+	}
+
+	consolidationBalanceToConsume, err := sp.getConsolidationChurnLimit(st)
+	if err != nil {
+		return err
+	}
+	if err = st.SetConsolidationBalanceToConsume(
+		consolidationBalanceToConsume,
+	); err != nil {
+		return err
+	}
+	if err = st.SetEarliestConsolidationEpoch(
+		computeActivationExitEpoch(epoch),
+	); err != nil {
+		return err
+	}
+	return st.SetPendingConsolidations(
+		[]*engineprimitives.PendingConsolidation{},
+	)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/spectest"
+	"github.com/stretchr/testify/require"
+)
+
+// newForkStateProcessor returns a minimal preset state processor whose
+// chain spec activates Electra at the given epoch, along with a state of two
+// validators at genesis.
+func newForkStateProcessor(
+	t *testing.T,
+	electraEpoch math.Epoch,
+) (*specStateProcessor, *specBeaconState) {
+	t.Helper()
+	p, err := spectest.PresetByName(spectest.PresetMinimal)
+	require.NoError(t, err)
+
+	data := p.SpecData()
+	data.DenebPlusForkEpoch = electraEpoch
+	data.ElectraForkEpoch = electraEpoch
+	cs := chain.NewChainSpec(data)
+
+	sp := NewStateProcessor[
+		*types.AttestationData,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*specBeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*spectest.KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, &specEngine{valid: true}, nil)
+
+	genesis := newSyntheticState(t, p)
+	genesis.Fork = &types.Fork{
+		PreviousVersion: version.FromUint32[common.Version](version.Deneb),
+		CurrentVersion:  version.FromUint32[common.Version](version.Deneb),
+	}
+	return sp, (&specBeaconState{}).NewFromDB(genesis.KVStore(), cs)
+}
+
+func TestProcessSlots_ForkUpgrade(t *testing.T) {
+	sp, st := newForkStateProcessor(t, 1)
+	slotsPerEpoch := math.Slot(sp.cs.SlotsPerEpoch())
+
+	// The fork is untouched until the first slot of the fork epoch.
+	_, err := sp.ProcessSlots(st, slotsPerEpoch-1)
+	require.NoError(t, err)
+	fork, err := st.GetFork()
+	require.NoError(t, err)
+	require.Equal(
+		t, version.FromUint32[common.Version](version.Deneb),
+		fork.CurrentVersion,
+	)
+
+	_, err = sp.ProcessSlots(st, slotsPerEpoch)
+	require.NoError(t, err)
+	fork, err = st.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, &types.Fork{
+		PreviousVersion: version.FromUint32[common.Version](version.Deneb),
+		CurrentVersion:  version.FromUint32[common.Version](version.Electra),
+		Epoch:           1,
+	}, fork)
+
+	// The exit and consolidation queues start after the fork epoch.
+	exitEpoch, err := st.GetEarliestExitEpoch()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(3), exitEpoch)
+	exitBalance, err := st.GetExitBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(128e9), exitBalance)
+	consolidationEpoch, err := st.GetEarliestConsolidationEpoch()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(2), consolidationEpoch)
+	consolidationBalance, err := st.GetConsolidationBalanceToConsume()
+	require.NoError(t, err)
+	require.Zero(t, consolidationBalance)
+
+	// Later epoch boundaries leave the fork alone.
+	_, err = sp.ProcessSlots(st, 3*slotsPerEpoch)
+	require.NoError(t, err)
+	upgraded, err := st.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, fork, upgraded)
+}
+
+func TestProcessForkUpgrade_NoFork(t *testing.T) {
+	sp, st := newForkStateProcessor(t, math.Epoch(^uint64(0)))
+	_, err := sp.ProcessSlots(st, math.Slot(2*sp.cs.SlotsPerEpoch()))
+	require.NoError(t, err)
+
+	fork, err := st.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(0), fork.Epoch)
+	require.Equal(
+		t, version.FromUint32[common.Version](version.Deneb),
+		fork.CurrentVersion,
+	)
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// processValidatorSetUpdates selects the validators with the highest
+// effective balance, up to MaxActiveValidators, as the CometBFT validator set
+// and returns the updates to apply to the previous one. Ties are broken by
+// validator index so that the selection is deterministic. Validators leaving
+// the set are given a voting power of zero, and only the validators whose
+// voting power changed are updated.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processValidatorSetUpdates(
+	st BeaconStateT,
+) (transition.ValidatorUpdates, error) {
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := st.GetActiveValidatorIndices()
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	// Rank the validators by decreasing effective balance, the sort is
+	// stable so validators with the same effective balance remain sorted by
+	// index.
+	ranked := make([]math.ValidatorIndex, 0, len(vals))
+	for i, val := range vals {
+		if val.GetEffectiveBalance() > 0 {
+			ranked = append(ranked, math.ValidatorIndex(i))
+		}
+	}
+	slices.SortStableFunc(ranked, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			vals[b].GetEffectiveBalance(), vals[a].GetEffectiveBalance(),
+		)
+	})
+
+	// A limit of zero leaves the validator set unbounded.
+	selected, waiting := ranked, []math.ValidatorIndex(nil)
+	if limit := sp.cs.MaxActiveValidators(); limit > 0 &&
+		uint64(len(ranked)) > limit {
+		selected, waiting = ranked[:limit], ranked[limit:]
+	}
+
+	// Before the active validator set was tracked, every validator with a
+	// non zero effective balance was part of the CometBFT validator set.
+	legacy := len(active) == 0 && slot != 0
+	if legacy {
+		active = waiting
+	}
+
+	var (
+		updates = make(transition.ValidatorUpdates, 0, len(selected))
+		inSet   = make(map[math.ValidatorIndex]struct{}, len(selected))
+		power   math.Gwei
+	)
+	for _, idx := range selected {
+		inSet[idx] = struct{}{}
+		balance := vals[idx].GetEffectiveBalance()
+		if power, err = st.GetValidatorPower(idx); err != nil {
+			return nil, err
+		} else if power == balance && !legacy {
+			continue
+		}
+		if err = st.SetValidatorPower(idx, balance); err != nil {
+			return nil, err
+		}
+		updates = append(updates, &transition.ValidatorUpdate{
+			Pubkey:           vals[idx].GetPubkey(),
+			EffectiveBalance: balance,
+		})
+	}
+
+	// Remove the validators that left the active validator set.
+	for _, idx := range active {
+		if _, ok := inSet[idx]; ok {
+			continue
+		}
+		if err = st.SetValidatorPower(idx, 0); err != nil {
+			return nil, err
+		}
+		updates = append(updates, &transition.ValidatorUpdate{
+			Pubkey:           vals[idx].GetPubkey(),
+			EffectiveBalance: 0,
+		})
+	}
+	return updates, nil
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/spectest"
+	"github.com/stretchr/testify/require"
+)
+
+// newValidatorSetStateProcessor returns a minimal preset state processor
+// bounding the active validator set to the given size, along with a state of
+// validators with the given effective balances.
+func newValidatorSetStateProcessor(
+	t *testing.T,
+	maxActiveValidators uint64,
+	balances ...math.Gwei,
+) (*specStateProcessor, *specBeaconState) {
+	t.Helper()
+	p, err := spectest.PresetByName(spectest.PresetMinimal)
+	require.NoError(t, err)
+
+	data := p.SpecData()
+	data.MaxActiveValidators = maxActiveValidators
+	cs := chain.NewChainSpec(data)
+
+	sp := NewStateProcessor[
+		*types.AttestationData,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*specBeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*spectest.KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, &specEngine{valid: true}, nil)
+
+	genesis := spectest.NewBeaconState(p)
+	for i, balance := range balances {
+		genesis.Validators = append(genesis.Validators, &types.Validator{
+			Pubkey:            [48]byte{byte(i + 1)},
+			EffectiveBalance:  balance,
+			ExitEpoch:         math.Epoch(^uint64(0)),
+			WithdrawableEpoch: math.Epoch(^uint64(0)),
+		})
+		genesis.Balances = append(genesis.Balances, balance.Unwrap())
+	}
+	return sp, (&specBeaconState{}).NewFromDB(genesis.KVStore(), cs)
+}
+
+// updatesByPubkey returns the voting power of the updates by the first byte
+// of the validator pubkey.
+func updatesByPubkey(updates transition.ValidatorUpdates) map[byte]math.Gwei {
+	powers := make(map[byte]math.Gwei, len(updates))
+	for _, update := range updates {
+		powers[update.Pubkey[0]] = update.EffectiveBalance
+	}
+	return powers
+}
+
+func TestProcessValidatorSetUpdates(t *testing.T) {
+	sp, st := newValidatorSetStateProcessor(
+		t, 2, 32e9, 16e9, 32e9, 0,
+	)
+
+	// The two highest effective balances are selected, the validator without
+	// effective balance is left out.
+	updates, err := sp.processValidatorSetUpdates(st)
+	require.NoError(t, err)
+	require.Equal(
+		t, map[byte]math.Gwei{1: 32e9, 3: 32e9}, updatesByPubkey(updates),
+	)
+	active, err := st.GetActiveValidatorIndices()
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0, 2}, active)
+
+	// Unchanged validators are not updated again.
+	updates, err = sp.processValidatorSetUpdates(st)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// A validator with a higher effective balance replaces the lowest
+	// ranked one, ties being broken by index.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	val.SetEffectiveBalance(32e9)
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+	val, err = st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	val.SetEffectiveBalance(31e9)
+	require.NoError(t, st.UpdateValidatorAtIndex(2, val))
+
+	updates, err = sp.processValidatorSetUpdates(st)
+	require.NoError(t, err)
+	require.Equal(t, map[byte]math.Gwei{2: 32e9, 3: 0}, updatesByPubkey(updates))
+	active, err = st.GetActiveValidatorIndices()
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0, 1}, active)
+}
+
+func TestProcessValidatorSetUpdates_Legacy(t *testing.T) {
+	sp, st := newValidatorSetStateProcessor(t, 1, 32e9, 16e9)
+	require.NoError(t, st.SetSlot(1))
+
+	// Without a tracked active validator set, every validator is assumed to
+	// be part of the CometBFT validator set.
+	updates, err := sp.processValidatorSetUpdates(st)
+	require.NoError(t, err)
+	require.Equal(t, map[byte]math.Gwei{1: 32e9, 2: 0}, updatesByPubkey(updates))
+}
@@ -31,13 +31,32 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
+// AttestationData is the interface for the execution layer view attested by
+// a validator through its vote extension.
+type AttestationData interface {
+	// GetSlot returns the slot the attestation was made for.
+	GetSlot() math.Slot
+	// GetIndex returns the index of the attesting validator.
+	GetIndex() math.U64
+	// GetExecutionHeadHash returns the head block hash of the execution
+	// client of the validator.
+	GetExecutionHeadHash() common.ExecutionHash
+	// GetVerifiedBlockHash returns the block hash of the latest payload
+	// verified by the execution client of the validator.
+	GetVerifiedBlockHash() common.ExecutionHash
+	// GetDepositIndex returns the next deposit index seen by the validator.
+	GetDepositIndex() math.U64
+}
+
 // BeaconBlock represents a generic interface for a beacon block.
 type BeaconBlock[
+	AttestationDataT any,
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		AttestationDataT, BeaconBlockBodyT, DepositT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadT ExecutionPayload[
@@ -62,6 +81,7 @@ type BeaconBlock[
 // BeaconBlockBody represents a generic interface for the body of a beacon
 // block.
 type BeaconBlockBody[
+	AttestationDataT any,
 	BeaconBlockBodyT any,
 	DepositT any,
 	ExecutionPayloadT ExecutionPayload[
@@ -71,6 +91,7 @@ type BeaconBlockBody[
 	WithdrawalsT any,
 ] interface {
 	constraints.EmptyWithVersion[BeaconBlockBodyT]
+	constraints.Versionable
 	// GetRandaoReveal returns the RANDAO reveal signature.
 	GetRandaoReveal() crypto.BLSSignature
 	// GetExecutionPayload returns the execution payload.
@@ -81,6 +102,13 @@ type BeaconBlockBody[
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetExecutionRequests returns the execution requests, which are nil
+	// before Electra.
+	GetExecutionRequests() *engineprimitives.ExecutionRequests
+	// GetAttestations returns the attestations aggregated from the vote
+	// extensions of the previous block, which are only present from Electra
+	// onwards.
+	GetAttestations() []AttestationDataT
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -118,13 +146,29 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetBlockRewards returns the collector of the rewards and penalties
+	// applied by the state transition, which may be nil.
+	GetBlockRewards() *transition.BlockRewards
+	// GetCommitVoters returns the CometBFT addresses of the validators whose
+	// votes for the previous block were committed in the last commit of the
+	// block.
+	GetCommitVoters() [][]byte
 }
 
 // Deposit is the interface for a deposit.
 type Deposit[
+	DepositT any,
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	// New creates a new deposit.
+	New(
+		pubkey crypto.BLSPubkey,
+		credentials WithdrawlCredentialsT,
+		amount math.Gwei,
+		signature crypto.BLSSignature,
+		index uint64,
+	) DepositT
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetPubkey returns the public key of the validator.
@@ -229,12 +273,30 @@ type Validator[
 	SetEffectiveBalance(math.Gwei)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch when the validator exits.
+	SetExitEpoch(math.Epoch)
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
 }
 
 type Validators interface {
 	HashTreeRoot() common.Root
 }
 
+// WithdrawalCredentials is the interface for the withdrawal credentials of
+// a validator.
+type WithdrawalCredentials interface {
+	~[32]byte
+	// ToExecutionAddress returns the execution address of the credentials,
+	// failing if they are not eth1 credentials.
+	ToExecutionAddress() (common.ExecutionAddress, error)
+}
+
 // Withdrawal is the interface for a withdrawal.
 type Withdrawal[WithdrawalT any] interface {
 	// Equals returns true if the withdrawal is equal to the other.
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetExitBalanceToConsume returns the balance left to exit in the epoch of
+// the earliest exit epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetExitBalanceToConsume() (math.Gwei, error) {
+	balance, err := getChurnItem(kv.ctx, kv.exitBalanceToConsume)
+	return math.Gwei(balance), err
+}
+
+// SetExitBalanceToConsume sets the balance left to exit in the epoch of the
+// earliest exit epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetExitBalanceToConsume(balance math.Gwei) error {
+	return kv.exitBalanceToConsume.Set(kv.ctx, balance.Unwrap())
+}
+
+// GetEarliestExitEpoch returns the earliest epoch a validator can exit at.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetEarliestExitEpoch() (math.Epoch, error) {
+	epoch, err := getChurnItem(kv.ctx, kv.earliestExitEpoch)
+	return math.Epoch(epoch), err
+}
+
+// SetEarliestExitEpoch sets the earliest epoch a validator can exit at.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetEarliestExitEpoch(epoch math.Epoch) error {
+	return kv.earliestExitEpoch.Set(kv.ctx, epoch.Unwrap())
+}
+
+// GetConsolidationBalanceToConsume returns the balance left to consolidate
+// in the epoch of the earliest consolidation epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetConsolidationBalanceToConsume() (math.Gwei, error) {
+	balance, err := getChurnItem(kv.ctx, kv.consolidationBalanceToConsume)
+	return math.Gwei(balance), err
+}
+
+// SetConsolidationBalanceToConsume sets the balance left to consolidate in
+// the epoch of the earliest consolidation epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetConsolidationBalanceToConsume(balance math.Gwei) error {
+	return kv.consolidationBalanceToConsume.Set(kv.ctx, balance.Unwrap())
+}
+
+// GetEarliestConsolidationEpoch returns the earliest epoch a consolidation
+// can be processed at.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetEarliestConsolidationEpoch() (math.Epoch, error) {
+	epoch, err := getChurnItem(kv.ctx, kv.earliestConsolidationEpoch)
+	return math.Epoch(epoch), err
+}
+
+// SetEarliestConsolidationEpoch sets the earliest epoch a consolidation can
+// be processed at.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetEarliestConsolidationEpoch(epoch math.Epoch) error {
+	return kv.earliestConsolidationEpoch.Set(kv.ctx, epoch.Unwrap())
+}
+
+// GetPendingConsolidations returns the pending consolidations, in the order
+// of the queue.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetPendingConsolidations() ([]PendingConsolidationT, error) {
+	iter, err := kv.pendingConsolidations.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetPendingConsolidations replaces the queue of pending consolidations.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetPendingConsolidations(
+	consolidations []PendingConsolidationT,
+) error {
+	if err := kv.pendingConsolidations.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+	for i, consolidation := range consolidations {
+		if err := kv.pendingConsolidations.Set(
+			kv.ctx, uint64(i), consolidation,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getChurnItem returns the value of the churn item, which is zero until the
+// state is upgraded to Electra.
+func getChurnItem(
+	ctx context.Context,
+	item collections.Item[uint64],
+) (uint64, error) {
+	value, err := item.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return value, err
+}
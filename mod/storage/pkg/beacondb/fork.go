@@ -23,7 +23,7 @@ package beacondb
 // SetFork sets the fork version for the given epoch.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) SetFork(
 	fork ForkT,
 ) error {
@@ -33,7 +33,7 @@ func (kv *KVStore[
 // GetFork gets the fork version for the given epoch.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetFork() (ForkT, error) {
 	return kv.fork.Get(kv.ctx)
 }
@@ -20,12 +20,15 @@
 
 package beacondb
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
 
 // UpdateBlockRootAtIndex sets a block root in the BeaconStore.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) UpdateBlockRootAtIndex(
 	index uint64,
 	root common.Root,
@@ -36,7 +39,7 @@ func (kv *KVStore[
 // GetBlockRootAtIndex retrieves the block root from the BeaconStore.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetBlockRootAtIndex(
 	index uint64,
 ) (common.Root, error) {
@@ -50,7 +53,7 @@ func (kv *KVStore[
 // SetLatestBlockHeader sets the latest block header in the BeaconStore.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) SetLatestBlockHeader(
 	header BeaconBlockHeaderT,
 ) error {
@@ -60,7 +63,7 @@ func (kv *KVStore[
 // GetLatestBlockHeader retrieves the latest block header from the BeaconStore.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetLatestBlockHeader() (
 	BeaconBlockHeaderT, error,
 ) {
@@ -70,7 +73,7 @@ func (kv *KVStore[
 // UpdateStateRootAtIndex updates the state root at the given slot.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) UpdateStateRootAtIndex(
 	idx uint64,
 	stateRoot common.Root,
@@ -81,7 +84,7 @@ func (kv *KVStore[
 // StateRootAtIndex returns the state root at the given slot.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) StateRootAtIndex(
 	idx uint64,
 ) (common.Root, error) {
@@ -91,3 +94,79 @@ func (kv *KVStore[
 	}
 	return common.Root(bz), nil
 }
+
+// GetHistoricalSummaries returns the historical summaries, in the order they
+// were appended.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetHistoricalSummaries() ([]HistoricalSummaryT, error) {
+	iter, err := kv.historicalSummaries.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// AddHistoricalSummary appends a historical summary to the BeaconStore.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) AddHistoricalSummary(
+	summary HistoricalSummaryT,
+) error {
+	// The summaries are keyed by their position in the list, so the next
+	// index is one past the last key.
+	iter, err := kv.historicalSummaries.Iterate(
+		kv.ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var index uint64
+	if iter.Valid() {
+		var last uint64
+		if last, err = iter.Key(); err != nil {
+			return err
+		}
+		index = last + 1
+	}
+	return kv.historicalSummaries.Set(kv.ctx, index, summary)
+}
+
+// SetHistoricalBlockRoots stores the block roots summarized by the historical
+// summary at the given index, so that they outlive the block roots ring.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetHistoricalBlockRoots(
+	index uint64,
+	roots []common.Root,
+) error {
+	bz := make([]byte, 0, len(roots)*len(common.Root{}))
+	for _, root := range roots {
+		bz = append(bz, root[:]...)
+	}
+	return kv.historicalBlockRoots.Set(kv.ctx, index, bz)
+}
+
+// GetHistoricalBlockRoots returns the block roots summarized by the
+// historical summary at the given index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetHistoricalBlockRoots(
+	index uint64,
+) ([]common.Root, error) {
+	bz, err := kv.historicalBlockRoots.Get(kv.ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	roots := make([]common.Root, len(bz)/len(common.Root{}))
+	for i := range roots {
+		roots[i] = common.Root(bz[i*len(common.Root{}):])
+	}
+	return roots, nil
+}
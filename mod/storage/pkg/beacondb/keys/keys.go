@@ -44,6 +44,17 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	ValidatorPowerPrefix
+	PreviousEpochParticipationPrefix
+	CurrentEpochParticipationPrefix
+	InactivityScoresPrefix
+	HistoricalSummariesPrefix
+	HistoricalBlockRootsPrefix
+	ExitBalanceToConsumePrefix
+	EarliestExitEpochPrefix
+	ConsolidationBalanceToConsumePrefix
+	EarliestConsolidationEpochPrefix
+	PendingConsolidationsPrefix
 )
 
 //nolint:lll
@@ -71,4 +82,15 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	ValidatorPowerPrefixHumanReadable                   = "ValidatorPowerPrefix"
+	PreviousEpochParticipationPrefixHumanReadable       = "PreviousEpochParticipationPrefix"
+	CurrentEpochParticipationPrefixHumanReadable        = "CurrentEpochParticipationPrefix"
+	InactivityScoresPrefixHumanReadable                 = "InactivityScoresPrefix"
+	HistoricalSummariesPrefixHumanReadable              = "HistoricalSummariesPrefix"
+	HistoricalBlockRootsPrefixHumanReadable             = "HistoricalBlockRootsPrefix"
+	ExitBalanceToConsumePrefixHumanReadable             = "ExitBalanceToConsumePrefix"
+	EarliestExitEpochPrefixHumanReadable                = "EarliestExitEpochPrefix"
+	ConsolidationBalanceToConsumePrefixHumanReadable    = "ConsolidationBalanceToConsumePrefix"
+	EarliestConsolidationEpochPrefixHumanReadable       = "EarliestConsolidationEpochPrefix"
+	PendingConsolidationsPrefixHumanReadable            = "PendingConsolidationsPrefix"
 )
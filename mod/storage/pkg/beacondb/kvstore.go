@@ -52,10 +52,21 @@ type KVStore[
 		constraints.Empty[ForkT]
 		constraints.SSZMarshallable
 	},
+	HistoricalSummaryT interface {
+		constraints.Empty[HistoricalSummaryT]
+		constraints.SSZMarshallable
+	},
+	PendingConsolidationT interface {
+		constraints.Empty[PendingConsolidationT]
+		constraints.SSZMarshallable
+	},
 	ValidatorT Validator[ValidatorT],
 	ValidatorsT ~[]ValidatorT,
 ] struct {
 	ctx context.Context
+	// storeService opens the underlying store, which the snapshots are taken
+	// from and restored to.
+	storeService store.KVStoreService
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
 	genesisValidatorsRoot sdkcollections.Item[[]byte]
@@ -93,6 +104,18 @@ type KVStore[
 	]
 	// balances stores the list of balances.
 	balances sdkcollections.Map[uint64, uint64]
+	// validatorPowers stores the CometBFT voting power, in effective balance,
+	// of the validators in the active validator set.
+	validatorPowers sdkcollections.Map[uint64, uint64]
+	// Participation
+	// previousEpochParticipation stores the participation flags of the
+	// validators for the previous epoch.
+	previousEpochParticipation sdkcollections.Map[uint64, uint64]
+	// currentEpochParticipation stores the participation flags of the
+	// validators for the current epoch.
+	currentEpochParticipation sdkcollections.Map[uint64, uint64]
+	// inactivityScores stores the inactivity scores of the validators.
+	inactivityScores sdkcollections.Map[uint64, uint64]
 	// nextWithdrawalIndex stores the next global withdrawal index.
 	nextWithdrawalIndex sdkcollections.Item[uint64]
 	// nextWithdrawalValidatorIndex stores the next withdrawal validator index
@@ -106,6 +129,28 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Historical summaries
+	// historicalSummaries stores the historical summaries, one for each
+	// period of SlotsPerHistoricalRoot slots.
+	historicalSummaries sdkcollections.Map[uint64, HistoricalSummaryT]
+	// historicalBlockRoots stores the block roots summarized by each
+	// historical summary, keyed by the index of the summary.
+	historicalBlockRoots sdkcollections.Map[uint64, []byte]
+	// Churn
+	// exitBalanceToConsume stores the balance left to exit in the epoch of
+	// the earliest exit epoch.
+	exitBalanceToConsume sdkcollections.Item[uint64]
+	// earliestExitEpoch stores the earliest epoch a validator can exit at.
+	earliestExitEpoch sdkcollections.Item[uint64]
+	// consolidationBalanceToConsume stores the balance left to consolidate
+	// in the epoch of the earliest consolidation epoch.
+	consolidationBalanceToConsume sdkcollections.Item[uint64]
+	// earliestConsolidationEpoch stores the earliest epoch a consolidation
+	// can be processed at.
+	earliestConsolidationEpoch sdkcollections.Item[uint64]
+	// pendingConsolidations stores the pending consolidations, keyed by
+	// their position in the queue.
+	pendingConsolidations sdkcollections.Map[uint64, PendingConsolidationT]
 }
 
 // New creates a new instance of Store.
@@ -129,6 +174,14 @@ func New[
 		constraints.Empty[ForkT]
 		constraints.SSZMarshallable
 	},
+	HistoricalSummaryT interface {
+		constraints.Empty[HistoricalSummaryT]
+		constraints.SSZMarshallable
+	},
+	PendingConsolidationT interface {
+		constraints.Empty[PendingConsolidationT]
+		constraints.SSZMarshallable
+	},
 	ValidatorT Validator[ValidatorT],
 	ValidatorsT ~[]ValidatorT,
 ](
@@ -136,14 +189,15 @@ func New[
 	payloadCodec *encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT],
 ) *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
 	return &KVStore[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-		ForkT, ValidatorT, ValidatorsT,
+		ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 	]{
-		ctx: nil,
+		ctx:          nil,
+		storeService: kss,
 		genesisValidatorsRoot: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.GenesisValidatorsRootPrefix}),
@@ -225,6 +279,38 @@ func New[
 			sdkcollections.Uint64Key,
 			sdkcollections.Uint64Value,
 		),
+		validatorPowers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ValidatorPowerPrefix}),
+			keys.ValidatorPowerPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		previousEpochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PreviousEpochParticipationPrefix},
+			),
+			keys.PreviousEpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		currentEpochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.CurrentEpochParticipationPrefix},
+			),
+			keys.CurrentEpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		randaoMix: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.RandaoMixPrefix}),
@@ -267,16 +353,67 @@ func New[
 			keys.LatestBeaconBlockHeaderPrefixHumanReadable,
 			encoding.SSZValueCodec[BeaconBlockHeaderT]{},
 		),
+		historicalSummaries: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.HistoricalSummariesPrefix}),
+			keys.HistoricalSummariesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[HistoricalSummaryT]{},
+		),
+		historicalBlockRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.HistoricalBlockRootsPrefix}),
+			keys.HistoricalBlockRootsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		exitBalanceToConsume: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ExitBalanceToConsumePrefix}),
+			keys.ExitBalanceToConsumePrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		earliestExitEpoch: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EarliestExitEpochPrefix}),
+			keys.EarliestExitEpochPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		consolidationBalanceToConsume: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.ConsolidationBalanceToConsumePrefix},
+			),
+			keys.ConsolidationBalanceToConsumePrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		earliestConsolidationEpoch: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.EarliestConsolidationEpochPrefix},
+			),
+			keys.EarliestConsolidationEpochPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		pendingConsolidations: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PendingConsolidationsPrefix},
+			),
+			keys.PendingConsolidationsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[PendingConsolidationT]{},
+		),
 	}
 }
 
 // Copy returns a copy of the Store.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) Copy() *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ] {
 	// TODO: Decouple the KVStore type from the Cosmos-SDK.
 	cctx, _ := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
@@ -287,7 +424,7 @@ func (kv *KVStore[
 // Context returns the context of the Store.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) Context() context.Context {
 	return kv.ctx
 }
@@ -295,12 +432,12 @@ func (kv *KVStore[
 // WithContext returns a copy of the Store with the given context.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) WithContext(
 	ctx context.Context,
 ) *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ] {
 	cpy := *kv
 	cpy.ctx = ctx
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetPreviousEpochParticipation returns the participation flags of the
+// validator at the given index for the previous epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return kv.getParticipation(kv.previousEpochParticipation, idx)
+}
+
+// SetPreviousEpochParticipation sets the participation flags of the
+// validator at the given index for the previous epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	return kv.setParticipation(kv.previousEpochParticipation, idx, flags)
+}
+
+// GetCurrentEpochParticipation returns the participation flags of the
+// validator at the given index for the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return kv.getParticipation(kv.currentEpochParticipation, idx)
+}
+
+// SetCurrentEpochParticipation sets the participation flags of the
+// validator at the given index for the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	return kv.setParticipation(kv.currentEpochParticipation, idx, flags)
+}
+
+// RotateEpochParticipation moves the participation flags of the current
+// epoch to the previous epoch and clears the ones of the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) RotateEpochParticipation() error {
+	if err := kv.previousEpochParticipation.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+
+	iter, err := kv.currentEpochParticipation.Iterate(kv.ctx, nil)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, entry := range kvs {
+		if err = kv.previousEpochParticipation.Set(
+			kv.ctx, entry.Key, entry.Value,
+		); err != nil {
+			return err
+		}
+	}
+	return kv.currentEpochParticipation.Clear(kv.ctx, nil)
+}
+
+// GetInactivityScore returns the inactivity score of the validator at the
+// given index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetInactivityScore(
+	idx math.ValidatorIndex,
+) (math.U64, error) {
+	score, err := kv.inactivityScores.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return math.U64(score), nil
+}
+
+// SetInactivityScore sets the inactivity score of the validator at the
+// given index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetInactivityScore(
+	idx math.ValidatorIndex,
+	score math.U64,
+) error {
+	if score == 0 {
+		return kv.inactivityScores.Remove(kv.ctx, idx.Unwrap())
+	}
+	return kv.inactivityScores.Set(kv.ctx, idx.Unwrap(), score.Unwrap())
+}
+
+// getParticipation returns the participation flags of the validator at the
+// given index from the given epoch participation, which only holds the
+// validators with at least one flag set.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) getParticipation(
+	participation collections.Map[uint64, uint64],
+	idx math.ValidatorIndex,
+) (byte, error) {
+	flags, err := participation.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	//#nosec:G701 // flags are always stored from a byte.
+	return byte(flags), nil
+}
+
+// setParticipation sets the participation flags of the validator at the
+// given index in the given epoch participation.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) setParticipation(
+	participation collections.Map[uint64, uint64],
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	if flags == 0 {
+		return participation.Remove(kv.ctx, idx.Unwrap())
+	}
+	return participation.Set(kv.ctx, idx.Unwrap(), uint64(flags))
+}
@@ -21,7 +21,9 @@
 package beacondb
 
 import (
+	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -29,7 +31,7 @@ import (
 // AddValidator registers a new validator in the beacon state.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) AddValidator(val ValidatorT) error {
 	// Get the next validator index from the sequence.
 	idx, err := kv.validatorIndex.Next(kv.ctx)
@@ -48,7 +50,7 @@ func (kv *KVStore[
 // AddValidator registers a new validator in the beacon state.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) AddValidatorBartio(val ValidatorT) error {
 	// Get the ne
 	idx, err := kv.validatorIndex.Next(kv.ctx)
@@ -68,7 +70,7 @@ func (kv *KVStore[
 // UpdateValidatorAtIndex updates a validator at a specific index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) UpdateValidatorAtIndex(
 	index math.ValidatorIndex,
 	val ValidatorT,
@@ -79,7 +81,7 @@ func (kv *KVStore[
 // ValidatorIndexByPubkey returns the validator address by index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) ValidatorIndexByPubkey(
 	pubkey crypto.BLSPubkey,
 ) (math.ValidatorIndex, error) {
@@ -96,7 +98,7 @@ func (kv *KVStore[
 // ValidatorIndexByCometBFTAddress returns the validator address by index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) ValidatorIndexByCometBFTAddress(
 	cometBFTAddress []byte,
 ) (math.ValidatorIndex, error) {
@@ -113,7 +115,7 @@ func (kv *KVStore[
 // ValidatorByIndex returns the validator address by index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) ValidatorByIndex(
 	index math.ValidatorIndex,
 ) (ValidatorT, error) {
@@ -128,7 +130,7 @@ func (kv *KVStore[
 // GetValidators retrieves all validators from the beacon state.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetValidators() (
 	ValidatorsT, error,
 ) {
@@ -164,7 +166,7 @@ func (kv *KVStore[
 // GetTotalValidators returns the total number of validators.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetTotalValidators() (uint64, error) {
 	validators, err := kv.GetValidators()
 	if err != nil {
@@ -177,7 +179,7 @@ func (kv *KVStore[
 // effective balance from the beacon state.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetValidatorsByEffectiveBalance() (
 	[]ValidatorT, error,
 ) {
@@ -212,7 +214,7 @@ func (kv *KVStore[
 // GetBalance returns the balance of a validator.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetBalance(
 	idx math.ValidatorIndex,
 ) (math.Gwei, error) {
@@ -223,7 +225,7 @@ func (kv *KVStore[
 // SetBalance sets the balance of a validator.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) SetBalance(
 	idx math.ValidatorIndex,
 	balance math.Gwei,
@@ -234,7 +236,7 @@ func (kv *KVStore[
 // GetBalances returns the balancse of all validator.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetBalances() ([]uint64, error) {
 	var balances []uint64
 	iter, err := kv.balances.Iterate(kv.ctx, nil)
@@ -259,7 +261,7 @@ func (kv *KVStore[
 // TODO: this shouldn't live in KVStore
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-	ForkT, ValidatorT, ValidatorsT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
 ]) GetTotalActiveBalances(
 	slotsPerEpoch uint64,
 ) (math.Gwei, error) {
@@ -285,3 +287,61 @@ func (kv *KVStore[
 		},
 	)
 }
+
+// GetValidatorPower returns the CometBFT voting power, in effective balance,
+// of the validator at the given index, which is zero if the validator is not
+// part of the active validator set.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetValidatorPower(
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	power, err := kv.validatorPowers.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return math.Gwei(power), nil
+}
+
+// SetValidatorPower sets the CometBFT voting power of the validator at the
+// given index, a power of zero removes it from the active validator set.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) SetValidatorPower(
+	idx math.ValidatorIndex,
+	power math.Gwei,
+) error {
+	if power == 0 {
+		return kv.validatorPowers.Remove(kv.ctx, idx.Unwrap())
+	}
+	return kv.validatorPowers.Set(kv.ctx, idx.Unwrap(), power.Unwrap())
+}
+
+// GetActiveValidatorIndices returns the indices of the validators in the
+// active validator set, sorted by index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) GetActiveValidatorIndices() ([]math.ValidatorIndex, error) {
+	iter, err := kv.validatorPowers.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var (
+		indices []math.ValidatorIndex
+		idx     uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		if idx, err = iter.Key(); err != nil {
+			return nil, err
+		}
+		indices = append(indices, math.ValidatorIndex(idx))
+	}
+	return indices, nil
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrInvalidSnapshot is returned when restoring a malformed snapshot.
+var ErrInvalidSnapshot = errors.New("invalid beacon store snapshot")
+
+// Snapshot returns every entry of the BeaconStore, in key order, encoded as
+// length-prefixed keys and values. Unlike the SSZ encoding of the beacon
+// state, it also holds the entries kept outside of the state, such as the
+// participation flags and the validator powers.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) Snapshot() ([]byte, error) {
+	iter, err := kv.storeService.OpenKVStore(kv.ctx).Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var bz []byte
+	for ; iter.Valid(); iter.Next() {
+		bz = binary.AppendUvarint(bz, uint64(len(iter.Key())))
+		bz = append(bz, iter.Key()...)
+		bz = binary.AppendUvarint(bz, uint64(len(iter.Value())))
+		bz = append(bz, iter.Value()...)
+	}
+	return bz, iter.Error()
+}
+
+// Restore replaces every entry of the BeaconStore with the entries of the
+// given snapshot.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, HistoricalSummaryT, PendingConsolidationT, ValidatorT, ValidatorsT,
+]) Restore(snapshot []byte) error {
+	store := kv.storeService.OpenKVStore(kv.ctx)
+	iter, err := store.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	if err = iter.Close(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	for len(snapshot) > 0 {
+		var key, value []byte
+		if key, snapshot, err = nextSnapshotField(snapshot); err != nil {
+			return err
+		}
+		if value, snapshot, err = nextSnapshotField(snapshot); err != nil {
+			return err
+		}
+		if err = store.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextSnapshotField returns the length-prefixed field at the start of the
+// snapshot, along with the rest of the snapshot.
+func nextSnapshotField(snapshot []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(snapshot)
+	if n <= 0 || size > uint64(len(snapshot)-n) {
+		return nil, nil, ErrInvalidSnapshot
+	}
+	//#nosec:G701 // the size is bounded by the length of the snapshot.
+	end := n + int(size)
+	return snapshot[n:end], snapshot[end:], nil
+}
@@ -24,10 +24,12 @@ const (
 	BlockKeyPrefix byte = iota
 	RootsKeyPrefix
 	ExecutionNumbersKeyPrefix
+	RewardsKeyPrefix
 )
 
 const (
 	BlocksMapName           = "blocks"
 	RootsMapName            = "roots"
 	ExecutionNumbersMapName = "execution_numbers"
+	RewardsMapName          = "rewards"
 )
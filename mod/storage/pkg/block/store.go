@@ -29,6 +29,7 @@ import (
 	"cosmossdk.io/core/store"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
@@ -37,6 +38,9 @@ type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
 	blocks           sdkcollections.Map[math.Slot, BeaconBlockT]
 	roots            sdkcollections.Map[[]byte, math.Slot]
 	executionNumbers sdkcollections.Map[math.U64, math.Slot]
+	rewards          sdkcollections.Map[
+		math.Slot, *transition.BlockRewards,
+	]
 
 	mu           sync.RWMutex
 	cdc          *encoding.SSZInterfaceCodec[BeaconBlockT]
@@ -71,6 +75,13 @@ func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 			encoding.U64Key,
 			encoding.U64Value,
 		),
+		rewards: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{RewardsKeyPrefix}),
+			RewardsMapName,
+			encoding.U64Key,
+			encoding.SSZValueCodec[*transition.BlockRewards]{},
+		),
 		cdc: cdc,
 	}
 }
@@ -88,7 +99,7 @@ func (kv *KVStore[BeaconBlockT]) Get(slot math.Slot) (BeaconBlockT, error) {
 func (kv *KVStore[BeaconBlockT]) Set(slot math.Slot, blk BeaconBlockT) error {
 	var (
 		ctx  = context.TODO()
-		root = blk.HashTreeRoot()
+		root = blk.GetBlockRoot()
 		err  error
 	)
 
@@ -133,6 +144,69 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
 	return kv.executionNumbers.Get(context.TODO(), executionNumber)
 }
 
+// GetRewards retrieves the rewards of the block at a given slot from the
+// store.
+func (kv *KVStore[BeaconBlockT]) GetRewards(
+	slot math.Slot,
+) (*transition.BlockRewards, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	return kv.rewards.Get(context.TODO(), slot)
+}
+
+// SetRewards sets the rewards of the block at the slot of the rewards in the
+// store.
+func (kv *KVStore[BeaconBlockT]) SetRewards(
+	rewards *transition.BlockRewards,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	// Rewards of blocks that were already pruned are not stored.
+	if rewards.Slot < kv.earliestSlot {
+		return nil
+	}
+	return kv.rewards.Set(context.TODO(), rewards.Slot, rewards)
+}
+
+// GetProposerIncome aggregates the rewards of the blocks proposed by the given
+// validator in the [start, end] slot range.
+func (kv *KVStore[BeaconBlockT]) GetProposerIncome(
+	proposer math.ValidatorIndex,
+	start, end math.Slot,
+) (*transition.ProposerIncome, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	iter, err := kv.rewards.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[math.Slot]).
+			StartInclusive(start).
+			EndInclusive(end),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	income := &transition.ProposerIncome{
+		ProposerIndex: proposer,
+		StartSlot:     start,
+		EndSlot:       end,
+	}
+	for ; iter.Valid(); iter.Next() {
+		rewards, valueErr := iter.Value()
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		if rewards.ProposerIndex == proposer {
+			income.Add(rewards)
+		}
+	}
+	return income, nil
+}
+
 // Prune removes the [start, end) blocks from the store.
 func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 	var (
@@ -154,7 +228,7 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 			}
 
 			// Block is found so remove from roots map.
-			root := block.HashTreeRoot()
+			root := block.GetBlockRoot()
 			if err = kv.roots.Remove(ctx, root[:]); err != nil {
 				return err
 			}
@@ -167,6 +241,11 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 			}
 		}
 
+		// Remove the rewards of the block.
+		if err = kv.rewards.Remove(ctx, i); err != nil {
+			return err
+		}
+
 		// Finally remove the block from the blocks map.
 		if err = kv.blocks.Remove(ctx, i); err != nil {
 			return err
@@ -30,6 +30,6 @@ type BeaconBlock[T any] interface {
 	constraints.SSZMarshallable
 	NewFromSSZ(bz []byte, version uint32) (T, error)
 	Version() uint32
-	HashTreeRoot() common.Root
+	GetBlockRoot() common.Root
 	GetExecutionNumber() math.U64
 }
@@ -78,6 +78,28 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// NextDepositIndex returns the index following the highest deposit held in
+// the store, or 0 if the store holds no deposits.
+func (kv *KVStore[DepositT]) NextDepositIndex() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.store.Iterate(
+		context.TODO(), new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, nil
+	}
+	index, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return index + 1, nil
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package era reads and writes era files, the archive format of the beacon
+// chain history. An era file holds the blocks of the SlotsPerHistoricalRoot
+// slots of an era along with the beacon state at the start of the next era,
+// each stored as a snappy framed SSZ entry of an e2store file, followed by
+// the slot indices that locate them:
+//
+//	era := Version | block* | state | block-index? | state-index
+//
+// The genesis era holds the genesis state only, hence no block index.
+// See https://github.com/status-im/nimbus-eth2/blob/stable/docs/e2store.md.
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/golang/snappy"
+)
+
+const (
+	// headerSize is the size of the header of an e2store entry, made of the
+	// type, the length of the data and two reserved bytes.
+	headerSize = 8
+	// maxEntryLength is the maximum length of the data of an e2store entry.
+	maxEntryLength = 1<<32 - 1
+	// indexEntrySize is the size of the start slot, of each offset and of the
+	// count of a slot index.
+	indexEntrySize = 8
+	// shortRootSize is the number of bytes of the root in an era file name.
+	shortRootSize = 4
+)
+
+//nolint:gochecknoglobals // entry types.
+var (
+	// typeVersion is the type of the version entry that opens an era file.
+	typeVersion = [2]byte{0x65, 0x32}
+	// typeCompressedSignedBeaconBlock is the type of a snappy framed SSZ
+	// encoded signed beacon block.
+	typeCompressedSignedBeaconBlock = [2]byte{0x01, 0x00}
+	// typeCompressedBeaconState is the type of a snappy framed SSZ encoded
+	// beacon state.
+	typeCompressedBeaconState = [2]byte{0x02, 0x00}
+	// typeSlotIndex is the type of a slot index.
+	typeSlotIndex = [2]byte{0x69, 0x32}
+)
+
+// FileName returns the canonical name of the era file of the given network,
+// of which the short root is the first 4 bytes of the given root, e.g. the
+// root of the historical summaries of the era state.
+func FileName(network string, era uint64, root common.Root) string {
+	return fmt.Sprintf("%s-%05d-%x.era", network, era, root[:shortRootSize])
+}
+
+// StartSlot returns the first slot of the blocks of the given era, which are
+// those of the era preceding its state.
+func StartSlot(era, slotsPerHistoricalRoot uint64) math.Slot {
+	if era == 0 {
+		return 0
+	}
+	return math.Slot((era - 1) * slotsPerHistoricalRoot)
+}
+
+// StateSlot returns the slot of the state of the given era.
+func StateSlot(era, slotsPerHistoricalRoot uint64) math.Slot {
+	return math.Slot(era * slotsPerHistoricalRoot)
+}
+
+// putHeader writes the header of an e2store entry of the given type and data
+// length into the buffer.
+func putHeader(buf []byte, typ [2]byte, length uint32) {
+	copy(buf, typ[:])
+	binary.LittleEndian.PutUint32(buf[2:], length)
+	binary.LittleEndian.PutUint16(buf[6:], 0)
+}
+
+// compress snappy frames the given data.
+func compress(bz []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(bz); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress reads the snappy framed data.
+func decompress(bz []byte) ([]byte, error) {
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(bz)))
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/era"
+	"github.com/stretchr/testify/require"
+)
+
+const slotsPerHistoricalRoot = 8
+
+func TestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := era.NewWriter(&buf, 2, slotsPerHistoricalRoot)
+	require.NoError(t, err)
+
+	blocks := map[math.Slot][]byte{
+		8:  bytes.Repeat([]byte{8}, 100),
+		9:  {9},
+		11: bytes.Repeat([]byte{11}, 1000),
+		15: {15, 15},
+	}
+	for _, slot := range []math.Slot{8, 9, 11, 15} {
+		require.NoError(t, w.WriteBlock(slot, blocks[slot]))
+	}
+	state := bytes.Repeat([]byte{0xaa}, 5000)
+	require.NoError(t, w.Finalize(state))
+
+	// An era file opens with the version entry.
+	require.Equal(t, []byte{0x65, 0x32, 0, 0, 0, 0, 0, 0}, buf.Bytes()[:8])
+
+	r, err := era.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(16), r.StateSlot())
+	require.Equal(t, []math.Slot{8, 9, 11, 15}, r.BlockSlots())
+	for slot, bz := range blocks {
+		got, blockErr := r.Block(slot)
+		require.NoError(t, blockErr)
+		require.Equal(t, bz, got)
+	}
+	got, err := r.State()
+	require.NoError(t, err)
+	require.Equal(t, state, got)
+
+	_, err = r.Block(10)
+	require.ErrorIs(t, err, era.ErrBlockNotFound)
+	_, err = r.Block(7)
+	require.ErrorIs(t, err, era.ErrSlotOutOfRange)
+	_, err = r.Block(16)
+	require.ErrorIs(t, err, era.ErrSlotOutOfRange)
+}
+
+func TestGenesisEra(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := era.NewWriter(&buf, 0, slotsPerHistoricalRoot)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.WriteBlock(0, []byte{1}), era.ErrSlotOutOfRange)
+	require.NoError(t, w.Finalize([]byte{1, 2, 3}))
+
+	r, err := era.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(0), r.StateSlot())
+	require.Empty(t, r.BlockSlots())
+	state, err := r.State()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, state)
+}
+
+func TestWriterOrdering(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := era.NewWriter(&buf, 1, slotsPerHistoricalRoot)
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteBlock(3, []byte{3}))
+	require.ErrorIs(t, w.WriteBlock(3, []byte{3}), era.ErrSlotOutOfRange)
+	require.ErrorIs(t, w.WriteBlock(2, []byte{2}), era.ErrSlotOutOfRange)
+	require.ErrorIs(t, w.WriteBlock(8, []byte{8}), era.ErrSlotOutOfRange)
+	require.NoError(t, w.Finalize(nil))
+	require.ErrorIs(t, w.WriteBlock(4, []byte{4}), era.ErrFinalized)
+	require.ErrorIs(t, w.Finalize(nil), era.ErrFinalized)
+}
+
+func TestReaderInvalidFile(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := era.NewWriter(&buf, 1, slotsPerHistoricalRoot)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteBlock(1, []byte{1}))
+	require.NoError(t, w.Finalize([]byte{2}))
+	bz := buf.Bytes()
+
+	for _, invalid := range [][]byte{
+		nil,
+		bz[:len(bz)-1],
+		bz[8:],
+		append(append([]byte{}, bz...), 0),
+	} {
+		_, err = era.NewReader(
+			bytes.NewReader(invalid), int64(len(invalid)),
+		)
+		require.ErrorIs(t, err, era.ErrInvalidEntry)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	require.Equal(
+		t,
+		"bartio-00042-0a0b0c0d.era",
+		era.FileName("bartio", 42, common.Root{0xa, 0xb, 0xc, 0xd, 0xe}),
+	)
+	require.Equal(t, math.Slot(0), era.StartSlot(0, slotsPerHistoricalRoot))
+	require.Equal(t, math.Slot(8), era.StartSlot(2, slotsPerHistoricalRoot))
+	require.Equal(t, math.Slot(16), era.StateSlot(2, slotsPerHistoricalRoot))
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidEntry is returned when an entry of an era file is malformed
+	// or not of the expected type.
+	ErrInvalidEntry = errors.New("invalid era entry")
+
+	// ErrSlotOutOfRange is returned when a slot is out of the slots of the
+	// blocks of an era, or is not after the slot of the last written block.
+	ErrSlotOutOfRange = errors.New("slot out of era range")
+
+	// ErrBlockNotFound is returned when an era file holds no block at a slot.
+	ErrBlockNotFound = errors.New("block not found in era")
+
+	// ErrFinalized is returned when writing to a finalized era file.
+	ErrFinalized = errors.New("era file already finalized")
+)
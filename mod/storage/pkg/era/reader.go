@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Reader reads the blocks and the state of an era file.
+type Reader struct {
+	r io.ReaderAt
+
+	stateSlot   math.Slot
+	stateOffset int64
+	startSlot   math.Slot
+	// blockOffsets holds the offsets of the blocks of the era from the start
+	// of the file, 0 for slots without a block.
+	blockOffsets []int64
+}
+
+// NewReader creates a new Reader of the era file of the given size, reading
+// its slot indices.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if _, err := readEntry(r, 0, typeVersion); err != nil {
+		return nil, err
+	}
+
+	er := &Reader{r: r}
+	stateSlot, stateOffsets, stateIndexOffset, err := readIndex(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(stateOffsets) != 1 || stateOffsets[0] == 0 {
+		return nil, errors.Wrap(ErrInvalidEntry, "state index")
+	}
+	er.stateSlot, er.stateOffset = stateSlot, stateOffsets[0]
+
+	// The genesis era holds no block.
+	if stateSlot == 0 {
+		return er, nil
+	}
+	er.startSlot, er.blockOffsets, _, err = readIndex(r, stateIndexOffset)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G115 // the number of slots of an index fits in a slot.
+	if er.startSlot+math.Slot(len(er.blockOffsets)) != stateSlot {
+		return nil, errors.Wrap(ErrInvalidEntry, "block index")
+	}
+	return er, nil
+}
+
+// StateSlot returns the slot of the state of the era.
+func (r *Reader) StateSlot() math.Slot {
+	return r.stateSlot
+}
+
+// State returns the SSZ encoded beacon state of the era.
+func (r *Reader) State() ([]byte, error) {
+	return readEntry(r.r, r.stateOffset, typeCompressedBeaconState)
+}
+
+// BlockSlots returns the slots of the blocks of the era, in increasing order.
+func (r *Reader) BlockSlots() []math.Slot {
+	slots := make([]math.Slot, 0, len(r.blockOffsets))
+	for i, offset := range r.blockOffsets {
+		if offset != 0 {
+			//#nosec:G115 // the number of slots of an index fits in a slot.
+			slots = append(slots, r.startSlot+math.Slot(i))
+		}
+	}
+	return slots
+}
+
+// Block returns the SSZ encoded signed beacon block at the given slot.
+func (r *Reader) Block(slot math.Slot) ([]byte, error) {
+	//#nosec:G115 // the number of slots of an index fits in a slot.
+	if slot < r.startSlot ||
+		slot >= r.startSlot+math.Slot(len(r.blockOffsets)) {
+		return nil, errors.Wrapf(ErrSlotOutOfRange, "slot %d", slot)
+	}
+	offset := r.blockOffsets[slot-r.startSlot]
+	if offset == 0 {
+		return nil, errors.Wrapf(ErrBlockNotFound, "slot %d", slot)
+	}
+	return readEntry(r.r, offset, typeCompressedSignedBeaconBlock)
+}
+
+// readIndex reads the slot index that ends at the given offset, returning the
+// start slot and the offsets from the start of the file along with the offset
+// of the index.
+func readIndex(
+	r io.ReaderAt,
+	end int64,
+) (math.Slot, []int64, int64, error) {
+	var countBz [indexEntrySize]byte
+	if _, err := r.ReadAt(countBz[:], end-indexEntrySize); err != nil {
+		return 0, nil, 0, errors.Wrapf(ErrInvalidEntry, "slot index: %v", err)
+	}
+	count := binary.LittleEndian.Uint64(countBz[:])
+	//#nosec:G115 // the index must fit in the file.
+	length := int64(indexEntrySize * (count + 2)) //nolint:mnd // ok.
+	if count == 0 || length > end-headerSize {
+		return 0, nil, 0, errors.Wrapf(
+			ErrInvalidEntry, "slot index of %d slots", count,
+		)
+	}
+
+	indexOffset := end - headerSize - length
+	data, err := readEntry(r, indexOffset, typeSlotIndex)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	//#nosec:G115 // checked above.
+	if int64(len(data)) != length {
+		return 0, nil, 0, errors.Wrap(ErrInvalidEntry, "slot index length")
+	}
+
+	offsets := make([]int64, count)
+	for i := range offsets {
+		//#nosec:G115 // two's complement of the negative relative offset.
+		relative := int64(binary.LittleEndian.Uint64(
+			data[indexEntrySize*(i+1):],
+		))
+		if relative != 0 {
+			offsets[i] = indexOffset + relative
+		}
+	}
+	startSlot := math.Slot(binary.LittleEndian.Uint64(data))
+	return startSlot, offsets, indexOffset, nil
+}
+
+// readEntry reads the data of the e2store entry of the given type at the
+// given offset, decompressing the data of blocks and states.
+func readEntry(r io.ReaderAt, offset int64, typ [2]byte) ([]byte, error) {
+	var header [headerSize]byte
+	if offset < 0 {
+		return nil, errors.Wrapf(ErrInvalidEntry, "offset %d", offset)
+	}
+	if _, err := r.ReadAt(header[:], offset); err != nil {
+		return nil, errors.Wrapf(ErrInvalidEntry, "header: %v", err)
+	}
+	if [2]byte(header[:2]) != typ ||
+		binary.LittleEndian.Uint16(header[6:]) != 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidEntry, "header 0x%x at offset %d", header, offset,
+		)
+	}
+
+	data := make([]byte, binary.LittleEndian.Uint32(header[2:]))
+	if len(data) == 0 {
+		return data, nil
+	}
+	if _, err := r.ReadAt(data, offset+headerSize); err != nil {
+		return nil, errors.Wrapf(ErrInvalidEntry, "data: %v", err)
+	}
+	if typ == typeCompressedSignedBeaconBlock ||
+		typ == typeCompressedBeaconState {
+		return decompress(data)
+	}
+	return data, nil
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Writer writes the blocks and the state of an era to an era file.
+type Writer struct {
+	w      io.Writer
+	offset int64
+
+	era       uint64
+	startSlot math.Slot
+	stateSlot math.Slot
+	nextSlot  math.Slot
+	// blockOffsets holds the offsets of the blocks of the era from the start
+	// of the file, 0 for slots without a block.
+	blockOffsets []int64
+	finalized    bool
+}
+
+// NewWriter creates a new Writer of the given era, writing the version entry
+// that opens the era file.
+func NewWriter(
+	w io.Writer,
+	era uint64,
+	slotsPerHistoricalRoot uint64,
+) (*Writer, error) {
+	ew := &Writer{
+		w:         w,
+		era:       era,
+		startSlot: StartSlot(era, slotsPerHistoricalRoot),
+		stateSlot: StateSlot(era, slotsPerHistoricalRoot),
+	}
+	ew.nextSlot = ew.startSlot
+	if era > 0 {
+		ew.blockOffsets = make([]int64, slotsPerHistoricalRoot)
+	}
+	return ew, ew.writeEntry(typeVersion, nil)
+}
+
+// WriteBlock writes the SSZ encoded signed beacon block at the given slot.
+// Blocks must be written in increasing slot order, slots without a block are
+// simply skipped.
+func (w *Writer) WriteBlock(slot math.Slot, bz []byte) error {
+	if w.finalized {
+		return ErrFinalized
+	}
+	if slot < w.nextSlot || slot >= w.stateSlot {
+		return errors.Wrapf(
+			ErrSlotOutOfRange, "slot %d in era %d", slot, w.era,
+		)
+	}
+
+	compressed, err := compress(bz)
+	if err != nil {
+		return err
+	}
+	w.blockOffsets[slot-w.startSlot] = w.offset
+	w.nextSlot = slot + 1
+	return w.writeEntry(typeCompressedSignedBeaconBlock, compressed)
+}
+
+// Finalize writes the SSZ encoded beacon state at the start of the next era,
+// followed by the slot indices of the blocks and of the state. No entry can
+// be written once the era file is finalized.
+func (w *Writer) Finalize(state []byte) error {
+	if w.finalized {
+		return ErrFinalized
+	}
+	w.finalized = true
+
+	compressed, err := compress(state)
+	if err != nil {
+		return err
+	}
+	stateOffset := w.offset
+	if err = w.writeEntry(typeCompressedBeaconState, compressed); err != nil {
+		return err
+	}
+
+	if w.era > 0 {
+		if err = w.writeIndex(w.startSlot, w.blockOffsets); err != nil {
+			return err
+		}
+	}
+	return w.writeIndex(w.stateSlot, []int64{stateOffset})
+}
+
+// writeIndex writes the slot index of the given offsets from the start of
+// the file, which are stored relative to the start of the index.
+func (w *Writer) writeIndex(startSlot math.Slot, offsets []int64) error {
+	indexOffset := w.offset
+	data := make([]byte, indexEntrySize*(len(offsets)+2)) //nolint:mnd // ok.
+	binary.LittleEndian.PutUint64(data, startSlot.Unwrap())
+	for i, offset := range offsets {
+		if offset == 0 {
+			continue
+		}
+		//#nosec:G115 // two's complement of the negative relative offset.
+		binary.LittleEndian.PutUint64(
+			data[indexEntrySize*(i+1):], uint64(offset-indexOffset),
+		)
+	}
+	binary.LittleEndian.PutUint64(
+		data[len(data)-indexEntrySize:], uint64(len(offsets)),
+	)
+	return w.writeEntry(typeSlotIndex, data)
+}
+
+// writeEntry writes an e2store entry of the given type and data.
+func (w *Writer) writeEntry(typ [2]byte, data []byte) error {
+	if len(data) > maxEntryLength {
+		return errors.Wrapf(
+			ErrInvalidEntry, "entry of %d bytes is too large", len(data),
+		)
+	}
+
+	var header [headerSize]byte
+	//#nosec:G115 // checked above.
+	putHeader(header[:], typ, uint32(len(data)))
+	if _, err := w.w.Write(header[:]); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	w.offset += int64(headerSize + len(data))
+	return nil
+}
@@ -23,12 +23,15 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -85,6 +88,37 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 	return db.DB.Delete(db.prefix(index, key))
 }
 
+// Keys returns the keys stored under the given index. It is only supported
+// when the underlying database is a filesystem backed DB.
+func (db *RangeDB) Keys(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: keys not supported for this db")
+	}
+
+	entries, err := afero.ReadDir(f.fs, strconv.FormatUint(index, 10))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		key, err := hex.ToBytes(
+			strings.TrimSuffix(entry.Name(), "."+f.extension),
+		)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
 // DeleteRange removes all values associated with the given index from the
 // filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
@@ -96,6 +96,31 @@ func TestRangeDB(t *testing.T) {
 				require.False(t, exists)
 			},
 		},
+		{
+			name: "Keys",
+			setupFunc: func(rdb *file.RangeDB) error {
+				for _, key := range []string{"keyA", "keyB"} {
+					if err := rdb.Set(
+						7, []byte(key), []byte("testValue"),
+					); err != nil {
+						return err
+					}
+				}
+				return rdb.Set(8, []byte("keyC"), []byte("testValue"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				keys, err := rdb.Keys(7)
+				require.NoError(t, err)
+				require.ElementsMatch(
+					t, [][]byte{[]byte("keyA"), []byte("keyB")}, keys,
+				)
+
+				keys, err = rdb.Keys(9)
+				require.NoError(t, err)
+				require.Empty(t, keys)
+			},
+		},
 		{
 			name: "DeleteRange",
 			setupFunc: func(rdb *file.RangeDB) error {